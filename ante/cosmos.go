@@ -8,7 +8,6 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/ante"
-	sdkvesting "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
 )
 
 // newCosmosAnteHandler creates the default ante handler for Cosmos transactions
@@ -16,21 +15,21 @@ func newCosmosAnteHandler(ctx sdk.Context, options HandlerOptions) sdk.AnteHandl
 	feemarketParams := options.FeeMarketKeeper.GetParams(ctx)
 	var txFeeChecker ante.TxFeeChecker
 	if options.DynamicFeeChecker {
-		txFeeChecker = evmante.NewDynamicFeeChecker(&feemarketParams)
+		txFeeChecker = evmante.NewDynamicFeeChecker(&feemarketParams, options.EvmKeeper)
 	}
 
 	decorators := []sdk.AnteDecorator{
 		cosmosante.NewRejectMessagesDecorator(), // reject MsgEthereumTxs
-		cosmosante.NewAuthzLimiterDecorator( // disable the Msg types that cannot be included on an authz.MsgExec msgs field
-			sdk.MsgTypeURL(&evmtypes.MsgEthereumTx{}),
-			sdk.MsgTypeURL(&sdkvesting.MsgCreateVestingAccount{}),
-		),
+		// disable the Msg types that cannot be included on an authz.MsgExec msgs field,
+		// on top of the ones disabled by the authz limits of the evm params
+		cosmosante.NewAuthzLimiterDecorator(options.EvmKeeper, sdk.MsgTypeURL(&evmtypes.MsgEthereumTx{})),
 		ante.NewSetUpContextDecorator(),
 		ante.NewExtensionOptionsDecorator(options.ExtensionOptionChecker),
 		ante.NewValidateBasicDecorator(),
 		ante.NewTxTimeoutHeightDecorator(),
 		ante.NewValidateMemoDecorator(options.AccountKeeper),
-		cosmosante.NewMinGasPriceDecorator(&feemarketParams),
+		cosmosante.NewMinGasPriceDecorator(&feemarketParams, options.FeeMarketKeeper, options.EvmKeeper),
+		cosmosante.NewMsgFeeFloorDecorator(&feemarketParams, options.EvmKeeper),
 		ante.NewConsumeGasForTxSizeDecorator(options.AccountKeeper),
 		ante.NewDeductFeeDecorator(options.AccountKeeper, options.BankKeeper, options.FeegrantKeeper, txFeeChecker),
 		// SetPubKeyDecorator must be called before all signature verification decorators
@@ -2,6 +2,10 @@ package cosmos
 
 import (
 	"fmt"
+	"slices"
+
+	anteinterfaces "github.com/cosmos/evm/ante/interfaces"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
 
 	errorsmod "cosmossdk.io/errors"
 
@@ -10,30 +14,54 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/authz"
 )
 
-// maxNestedMsgs defines a cap for the number of nested messages on a MsgExec message
-const maxNestedMsgs = 7
-
 // AuthzLimiterDecorator blocks certain msg types from being granted or executed
 // within the authorization module.
+//
+// The msg types disabled at construction are always blocked, on top of them the
+// decorator enforces the authz limits of the evm params, updatable by governance:
+// the msg types they disable, the nesting depth cap and the inner msgs cap.
 type AuthzLimiterDecorator struct {
-	// disabledMsgTypes is the type urls of the msgs to block.
+	// authzLimitsKeeper provides the authz limits, they aren't enforced if nil.
+	authzLimitsKeeper anteinterfaces.AuthzLimitsKeeper
+	// disabledMsgTypes is the type urls of the msgs to always block.
 	disabledMsgTypes []string
 }
 
 // NewAuthzLimiterDecorator creates a decorator to block certain msg types from being granted or executed within authz.
-func NewAuthzLimiterDecorator(disabledMsgTypes ...string) AuthzLimiterDecorator {
+func NewAuthzLimiterDecorator(authzLimitsKeeper anteinterfaces.AuthzLimitsKeeper, disabledMsgTypes ...string) AuthzLimiterDecorator {
 	return AuthzLimiterDecorator{
-		disabledMsgTypes: disabledMsgTypes,
+		authzLimitsKeeper: authzLimitsKeeper,
+		disabledMsgTypes:  disabledMsgTypes,
 	}
 }
 
 func (ald AuthzLimiterDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
-	if err := ald.checkDisabledMsgs(tx.GetMsgs(), false, 1); err != nil {
+	limits := evmtypes.AuthzLimits{}
+	if ald.authzLimitsKeeper != nil {
+		limits = ald.authzLimitsKeeper.GetAuthzLimits(ctx)
+	}
+
+	checker := authzMsgsChecker{
+		AuthzLimiterDecorator: ald,
+		limits:                limits,
+		maxNestedMsgs:         limits.NestingDepth(),
+	}
+	if err := checker.checkDisabledMsgs(tx.GetMsgs(), false, 1); err != nil {
 		return ctx, errorsmod.Wrapf(errortypes.ErrUnauthorized, "%s", err.Error())
 	}
 	return next(ctx, tx, simulate)
 }
 
+// authzMsgsChecker checks the msgs of a tx against the authz limits.
+type authzMsgsChecker struct {
+	AuthzLimiterDecorator
+	limits evmtypes.AuthzLimits
+	// maxNestedMsgs defines a cap for the number of nested messages on a MsgExec message
+	maxNestedMsgs int
+	// innerMsgs counts the msgs nested in the MsgExec messages of the tx
+	innerMsgs int
+}
+
 // checkDisabledMsgs iterates through the msgs and returns an error if it finds any unauthorized msgs.
 //
 // When searchOnlyInAuthzMsgs is enabled, only authz MsgGrant and MsgExec are blocked, if they contain unauthorized msg types.
@@ -41,9 +69,9 @@ func (ald AuthzLimiterDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate
 //
 // This method is recursive as MsgExec's can wrap other MsgExecs. The check for nested messages is performed up to the
 // maxNestedMsgs threshold. If there are more than that limit, it returns an error
-func (ald AuthzLimiterDecorator) checkDisabledMsgs(msgs []sdk.Msg, isAuthzInnerMsg bool, nestedLvl int) error {
-	if nestedLvl >= maxNestedMsgs {
-		return fmt.Errorf("found more nested msgs than permitted; got: %d, expected: <%d", nestedLvl, maxNestedMsgs)
+func (c *authzMsgsChecker) checkDisabledMsgs(msgs []sdk.Msg, isAuthzInnerMsg bool, nestedLvl int) error {
+	if nestedLvl >= c.maxNestedMsgs {
+		return fmt.Errorf("found more nested msgs than permitted; got: %d, expected: <%d", nestedLvl, c.maxNestedMsgs)
 	}
 	for _, msg := range msgs {
 		switch msg := msg.(type) {
@@ -52,8 +80,12 @@ func (ald AuthzLimiterDecorator) checkDisabledMsgs(msgs []sdk.Msg, isAuthzInnerM
 			if err != nil {
 				return err
 			}
+			c.innerMsgs += len(innerMsgs)
+			if c.limits.MaxInnerMsgs > 0 && c.innerMsgs > int(c.limits.MaxInnerMsgs) {
+				return fmt.Errorf("found more authz inner msgs than permitted; got: %d, expected: <=%d", c.innerMsgs, c.limits.MaxInnerMsgs)
+			}
 			nestedLvl++
-			if err := ald.checkDisabledMsgs(innerMsgs, true, nestedLvl); err != nil {
+			if err := c.checkDisabledMsgs(innerMsgs, true, nestedLvl); err != nil {
 				return err
 			}
 		case *authz.MsgGrant:
@@ -63,12 +95,12 @@ func (ald AuthzLimiterDecorator) checkDisabledMsgs(msgs []sdk.Msg, isAuthzInnerM
 			}
 
 			url := authorization.MsgTypeURL()
-			if ald.isDisabledMsg(url) {
+			if c.isDisabledMsg(url) {
 				return fmt.Errorf("found disabled msg type: %s", url)
 			}
 		default:
 			url := sdk.MsgTypeURL(msg)
-			if isAuthzInnerMsg && ald.isDisabledMsg(url) {
+			if isAuthzInnerMsg && c.isDisabledMsg(url) {
 				return fmt.Errorf("found disabled msg type: %s", url)
 			}
 		}
@@ -77,13 +109,7 @@ func (ald AuthzLimiterDecorator) checkDisabledMsgs(msgs []sdk.Msg, isAuthzInnerM
 }
 
 // isDisabledMsg returns true if the given message is in the list of restricted
-// messages from the AnteHandler.
-func (ald AuthzLimiterDecorator) isDisabledMsg(msgTypeURL string) bool {
-	for _, disabledType := range ald.disabledMsgTypes {
-		if msgTypeURL == disabledType {
-			return true
-		}
-	}
-
-	return false
+// messages from the AnteHandler or from the authz limits.
+func (c *authzMsgsChecker) isDisabledMsg(msgTypeURL string) bool {
+	return slices.Contains(c.disabledMsgTypes, msgTypeURL) || c.limits.IsDisabledMsg(msgTypeURL)
 }
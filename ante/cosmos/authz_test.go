@@ -17,6 +17,7 @@ import (
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/authz"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
@@ -42,6 +43,7 @@ func TestAuthzLimiterDecorator(t *testing.T) {
 	require.NoError(t, err)
 
 	decorator := cosmos.NewAuthzLimiterDecorator(
+		nil,
 		sdk.MsgTypeURL(&evmtypes.MsgEthereumTx{}),
 		sdk.MsgTypeURL(&stakingtypes.MsgUndelegate{}),
 	)
@@ -280,3 +282,108 @@ func TestAuthzLimiterDecorator(t *testing.T) {
 		})
 	}
 }
+
+type authzLimitsKeeper struct {
+	limits evmtypes.AuthzLimits
+}
+
+func (k authzLimitsKeeper) GetAuthzLimits(_ sdk.Context) evmtypes.AuthzLimits {
+	return k.limits
+}
+
+func TestAuthzLimiterDecoratorLimits(t *testing.T) {
+	encodingCfg := encoding.MakeConfig(constants.ExampleChainID.EVMChainID)
+	txCfg := encodingCfg.TxConfig
+	testPrivKeys, testAddresses, err := testutil.GeneratePrivKeyAddressPairs(3)
+	require.NoError(t, err)
+
+	msgSend := banktypes.NewMsgSend(testAddresses[0], testAddresses[1], sdk.NewCoins(sdk.NewInt64Coin("aatom", 1)))
+	msgVote := govv1.NewMsgVote(testAddresses[0], 1, govv1.OptionYes, "")
+	execMsgs := func(msgs ...sdk.Msg) *authz.MsgExec {
+		msgExec := authz.NewMsgExec(testAddresses[2], msgs)
+		return &msgExec
+	}
+
+	testCases := []struct {
+		name        string
+		limits      evmtypes.AuthzLimits
+		msgs        []sdk.Msg
+		expectedErr error
+	}{
+		{
+			"pass - no limits",
+			evmtypes.AuthzLimits{},
+			[]sdk.Msg{execMsgs(msgSend, msgVote)},
+			nil,
+		},
+		{
+			"pass - disabled msg not wrapped in MsgExec",
+			evmtypes.AuthzLimits{DisabledMsgTypes: []string{sdk.MsgTypeURL(msgVote)}},
+			[]sdk.Msg{msgVote},
+			nil,
+		},
+		{
+			"fail - disabled msg wrapped in MsgExec",
+			evmtypes.AuthzLimits{DisabledMsgTypes: []string{sdk.MsgTypeURL(msgVote)}},
+			[]sdk.Msg{execMsgs(msgSend, msgVote)},
+			sdkerrors.ErrUnauthorized,
+		},
+		{
+			"fail - msg always disabled by the decorator",
+			evmtypes.AuthzLimits{},
+			[]sdk.Msg{execMsgs(&evmtypes.MsgEthereumTx{})},
+			sdkerrors.ErrUnauthorized,
+		},
+		{
+			"pass - nesting depth within the cap",
+			evmtypes.AuthzLimits{MaxNestingDepth: 3},
+			[]sdk.Msg{testutil.CreateNestedMsgExec(testAddresses[2], 1, []sdk.Msg{msgSend})},
+			nil,
+		},
+		{
+			"fail - nesting depth over the cap",
+			evmtypes.AuthzLimits{MaxNestingDepth: 3},
+			[]sdk.Msg{testutil.CreateNestedMsgExec(testAddresses[2], 2, []sdk.Msg{msgSend})},
+			sdkerrors.ErrUnauthorized,
+		},
+		{
+			"pass - nesting depth over the default cap raised by the limits",
+			evmtypes.AuthzLimits{MaxNestingDepth: 10},
+			[]sdk.Msg{testutil.CreateNestedMsgExec(testAddresses[2], 6, []sdk.Msg{msgSend})},
+			nil,
+		},
+		{
+			"pass - inner msgs within the cap",
+			evmtypes.AuthzLimits{MaxInnerMsgs: 2},
+			[]sdk.Msg{execMsgs(msgSend, msgVote)},
+			nil,
+		},
+		{
+			"fail - inner msgs over the cap",
+			evmtypes.AuthzLimits{MaxInnerMsgs: 2},
+			[]sdk.Msg{execMsgs(msgSend), execMsgs(msgSend, msgVote)},
+			sdkerrors.ErrUnauthorized,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(fmt.Sprintf("Case %s", tc.name), func(t *testing.T) {
+			decorator := cosmos.NewAuthzLimiterDecorator(
+				authzLimitsKeeper{tc.limits},
+				sdk.MsgTypeURL(&evmtypes.MsgEthereumTx{}),
+			)
+
+			ctx := sdk.Context{}
+			tx, err := testutil.CreateTx(ctx, txCfg, testPrivKeys[0], tc.msgs...)
+			require.NoError(t, err)
+
+			_, err = decorator.AnteHandle(ctx, tx, false, testutil.NoOpNextFn)
+			if tc.expectedErr != nil {
+				require.Error(t, err)
+				require.ErrorIs(t, err, tc.expectedErr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
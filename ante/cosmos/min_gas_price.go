@@ -5,6 +5,8 @@ import (
 	"math/big"
 	"slices"
 
+	evmante "github.com/cosmos/evm/ante/evm"
+	anteinterfaces "github.com/cosmos/evm/ante/interfaces"
 	feemarkettypes "github.com/cosmos/evm/x/feemarket/types"
 	evmtypes "github.com/cosmos/evm/x/vm/types"
 
@@ -22,12 +24,16 @@ import (
 // CONTRACT: Tx must implement FeeTx to use MinGasPriceDecorator
 type MinGasPriceDecorator struct {
 	feemarketParams *feemarkettypes.Params
+	feeMarketKeeper anteinterfaces.FeeMarketKeeper
+	feeTokenKeeper  anteinterfaces.FeeTokenKeeper
 }
 
 // NewMinGasPriceDecorator creates a new MinGasPriceDecorator instance used only for
-// Cosmos transactions.
-func NewMinGasPriceDecorator(feemarketParams *feemarkettypes.Params) MinGasPriceDecorator {
-	return MinGasPriceDecorator{feemarketParams}
+// Cosmos transactions. The fees paid in a whitelisted fee token are converted to
+// the EVM denom with the rates of the fee token keeper, and the gas of their
+// transactions is left out of the base fee burn of the fee market keeper.
+func NewMinGasPriceDecorator(feemarketParams *feemarkettypes.Params, feeMarketKeeper anteinterfaces.FeeMarketKeeper, feeTokenKeeper anteinterfaces.FeeTokenKeeper) MinGasPriceDecorator {
+	return MinGasPriceDecorator{feemarketParams, feeMarketKeeper, feeTokenKeeper}
 }
 
 func (mpd MinGasPriceDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
@@ -46,8 +52,20 @@ func (mpd MinGasPriceDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate
 	//
 	// TODO: is the handling of stake necessary here? Why not adjust the tests to contain the correct denom?
 	validFees := len(feeCoins) == 0 || (len(feeCoins) == 1 && slices.Contains([]string{evmDenom, sdk.DefaultBondDenom}, feeCoins.GetDenomByIndex(0)))
+	feeToken := false
+	if !validFees && len(feeCoins) == 1 {
+		// or a whitelisted fee token
+		_, validFees = mpd.feemarketParams.FeeToken(feeCoins.GetDenomByIndex(0))
+		feeToken = validFees
+	}
 	if !validFees && !simulate {
-		return ctx, fmt.Errorf("expected only native token %s for fee, but got %s", evmDenom, feeCoins.String())
+		return ctx, fmt.Errorf("expected only native token %s for fee, or a whitelisted fee token, but got %s", evmDenom, feeCoins.String())
+	}
+
+	// the gas of the fees paid in a fee token is left out of the base fee burn,
+	// the gas meter being set up for the whole tx at this point
+	if feeToken {
+		mpd.feeMarketKeeper.SetFeeTokenGasMeter(ctx, false)
 	}
 
 	// Short-circuit if min gas price is 0 or if simulating
@@ -84,6 +102,15 @@ func (mpd MinGasPriceDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate
 			requiredFees)
 	}
 
+	// the fees paid in a fee token are compared in the EVM denom
+	feeDenom, rate, err := evmante.FeeTokenRate(ctx, mpd.feeTokenKeeper, mpd.feemarketParams, feeCoins, evmDenom)
+	if err != nil {
+		return ctx, err
+	}
+	if feeDenom != evmDenom {
+		feeCoins = sdk.Coins{{Denom: evmDenom, Amount: evmtypes.ConvertFeeTokenToEVMDenom(feeCoins.AmountOf(feeDenom), rate)}}
+	}
+
 	if !feeCoins.IsAnyGTE(requiredFees) {
 		return ctx, errorsmod.Wrapf(errortypes.ErrInsufficientFee,
 			"provided fee < minimum global fee (%s < %s). Please increase the gas price.",
@@ -0,0 +1,126 @@
+package cosmos
+
+import (
+	"math/big"
+
+	evmante "github.com/cosmos/evm/ante/evm"
+	anteinterfaces "github.com/cosmos/evm/ante/interfaces"
+	feemarkettypes "github.com/cosmos/evm/x/feemarket/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+)
+
+// MsgFeeFloorDecorator checks that the fee of the transaction covers the fee floors
+// of the message types it includes, either directly or nested in an authz MsgExec:
+// - the min fees of the floors are paid for each message of their type
+// - the gas price is at least MinGasPrice times the highest gas price multiplier of the floors
+//
+// The floors are enforced on top of the MinGasPriceDecorator, which checks the global
+// minimum gas price of every transaction. This applies for both CheckTx and DeliverTx.
+// CONTRACT: Tx must implement FeeTx to use MsgFeeFloorDecorator
+type MsgFeeFloorDecorator struct {
+	feemarketParams *feemarkettypes.Params
+	feeTokenKeeper  anteinterfaces.FeeTokenKeeper
+}
+
+// NewMsgFeeFloorDecorator creates a new MsgFeeFloorDecorator instance used only for
+// Cosmos transactions. The fees paid in a whitelisted fee token are converted to
+// the EVM denom with the rates of the fee token keeper.
+func NewMsgFeeFloorDecorator(feemarketParams *feemarkettypes.Params, feeTokenKeeper anteinterfaces.FeeTokenKeeper) MsgFeeFloorDecorator {
+	return MsgFeeFloorDecorator{feemarketParams, feeTokenKeeper}
+}
+
+func (mfd MsgFeeFloorDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
+	// Short-circuit if there are no fee floors or if simulating
+	if len(mfd.feemarketParams.MsgFeeFloors) == 0 || simulate {
+		return next(ctx, tx, simulate)
+	}
+
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return ctx, errorsmod.Wrapf(errortypes.ErrInvalidType, "invalid transaction type %T, expected sdk.FeeTx", tx)
+	}
+
+	floor := msgFeeFloor{minFee: math.ZeroInt(), gasPriceMultiplier: math.LegacyZeroDec()}
+	if err := floor.add(mfd.feemarketParams, tx.GetMsgs()); err != nil {
+		return ctx, errorsmod.Wrapf(errortypes.ErrUnauthorized, "%s", err.Error())
+	}
+
+	// the required fee is the highest of the min fees and of the fee at the floor gas price,
+	// where fee = ceil(minGasPrice * multiplier * gasLimit)
+	gasLimit := math.LegacyNewDecFromBigInt(new(big.Int).SetUint64(feeTx.GetGas()))
+	requiredFee := mfd.feemarketParams.MinGasPrice.Mul(floor.gasPriceMultiplier).Mul(gasLimit).Ceil().RoundInt()
+	requiredFee = math.MaxInt(requiredFee, floor.minFee)
+	if !requiredFee.IsPositive() {
+		return next(ctx, tx, simulate)
+	}
+
+	evmDenom := evmtypes.GetEVMCoinDenom()
+	requiredFees := sdk.Coins{{Denom: evmDenom, Amount: requiredFee}}
+
+	feeCoins := feeTx.GetFee()
+	if feeCoins == nil {
+		return ctx, errorsmod.Wrapf(errortypes.ErrInsufficientFee,
+			"fee not provided. The minimum fee for the messages of this tx is: %s",
+			requiredFees)
+	}
+
+	// the fees paid in a fee token are compared in the EVM denom
+	feeDenom, rate, err := evmante.FeeTokenRate(ctx, mfd.feeTokenKeeper, mfd.feemarketParams, feeCoins, evmDenom)
+	if err != nil {
+		return ctx, err
+	}
+	if feeDenom != evmDenom {
+		feeCoins = sdk.Coins{{Denom: evmDenom, Amount: evmtypes.ConvertFeeTokenToEVMDenom(feeCoins.AmountOf(feeDenom), rate)}}
+	}
+
+	if !feeCoins.IsAnyGTE(requiredFees) {
+		return ctx, errorsmod.Wrapf(errortypes.ErrInsufficientFee,
+			"provided fee < minimum fee for the messages of this tx (%s < %s). Please increase the fee.",
+			feeCoins,
+			requiredFees)
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+// msgFeeFloor is the fee floor of a transaction, accumulated over the fee floors
+// of its messages.
+type msgFeeFloor struct {
+	minFee             math.Int
+	gasPriceMultiplier math.LegacyDec
+}
+
+// add accumulates the fee floors of the msgs. This method is recursive as MsgExec's
+// can wrap other MsgExecs, their nesting depth is capped by the AuthzLimiterDecorator.
+func (f *msgFeeFloor) add(params *feemarkettypes.Params, msgs []sdk.Msg) error {
+	for _, msg := range msgs {
+		if floor, ok := params.MsgFeeFloor(sdk.MsgTypeURL(msg)); ok {
+			if !floor.MinFee.IsNil() {
+				f.minFee = f.minFee.Add(floor.MinFee)
+			}
+			if !floor.GasPriceMultiplier.IsNil() && floor.GasPriceMultiplier.GT(f.gasPriceMultiplier) {
+				f.gasPriceMultiplier = floor.GasPriceMultiplier
+			}
+		}
+
+		execMsg, ok := msg.(*authz.MsgExec)
+		if !ok {
+			continue
+		}
+		innerMsgs, err := execMsg.GetMessages()
+		if err != nil {
+			return err
+		}
+		if err := f.add(params, innerMsgs); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,93 @@
+package cosmos_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/evm/ante/cosmos"
+	"github.com/cosmos/evm/encoding"
+	"github.com/cosmos/evm/testutil"
+	"github.com/cosmos/evm/testutil/constants"
+	feemarkettypes "github.com/cosmos/evm/x/feemarket/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestMsgFeeFloorDecorator(t *testing.T) {
+	evmConfigurator := evmtypes.NewEVMConfigurator()
+	evmConfigurator.ResetTestConfig()
+	err := evmConfigurator.
+		WithEVMCoinInfo(constants.ExampleChainCoinInfo[constants.ExampleChainID]).
+		Configure()
+	require.NoError(t, err)
+
+	encodingCfg := encoding.MakeConfig(constants.ExampleChainID.EVMChainID)
+	txCfg := encodingCfg.TxConfig
+	_, testAddresses, err := testutil.GeneratePrivKeyAddressPairs(2)
+	require.NoError(t, err)
+
+	evmDenom := evmtypes.GetEVMCoinDenom()
+	msgSend := banktypes.NewMsgSend(testAddresses[0], testAddresses[1], sdk.NewCoins(sdk.NewInt64Coin(evmDenom, 1)))
+	msgVote := govv1.NewMsgVote(testAddresses[0], 1, govv1.OptionYes, "")
+	msgDelegate := stakingtypes.NewMsgDelegate(testAddresses[0].String(), sdk.ValAddress(testAddresses[1]).String(), sdk.NewInt64Coin(evmDenom, 1))
+	msgExec := authz.NewMsgExec(testAddresses[1], []sdk.Msg{msgSend})
+
+	params := feemarkettypes.DefaultParams()
+	params.MinGasPrice = math.LegacyNewDec(10)
+	params.MsgFeeFloors = []feemarkettypes.MsgFeeFloor{
+		{MsgTypeUrl: sdk.MsgTypeURL(msgSend), MinFee: math.NewInt(1000)},
+		{MsgTypeUrl: sdk.MsgTypeURL(msgVote), GasPriceMultiplier: math.LegacyNewDec(5)},
+	}
+	decorator := cosmos.NewMsgFeeFloorDecorator(&params, nil)
+
+	testCases := []struct {
+		name        string
+		msgs        []sdk.Msg
+		gas         uint64
+		fee         int64
+		simulate    bool
+		expectedErr error
+	}{
+		{"pass - no fee floor", []sdk.Msg{msgDelegate}, 100, 0, false, nil},
+		{"pass - min fee paid", []sdk.Msg{msgSend}, 100, 1000, false, nil},
+		{"fail - min fee not paid", []sdk.Msg{msgSend}, 100, 999, false, sdkerrors.ErrInsufficientFee},
+		{"fail - min fee paid once for two msgs", []sdk.Msg{msgSend, msgSend}, 100, 1000, false, sdkerrors.ErrInsufficientFee},
+		{"pass - min fee paid for two msgs", []sdk.Msg{msgSend, msgSend}, 100, 2000, false, nil},
+		{"fail - min fee of msg nested in MsgExec not paid", []sdk.Msg{&msgExec}, 100, 999, false, sdkerrors.ErrInsufficientFee},
+		{"pass - min fee of msg nested in MsgExec paid", []sdk.Msg{&msgExec}, 100, 1000, false, nil},
+		{"fail - floor gas price not paid", []sdk.Msg{msgVote}, 100, 4999, false, sdkerrors.ErrInsufficientFee},
+		{"pass - floor gas price paid", []sdk.Msg{msgVote}, 100, 5000, false, nil},
+		{"fail - fee at floor gas price lower than min fee", []sdk.Msg{msgSend, msgVote}, 10, 999, false, sdkerrors.ErrInsufficientFee},
+		{"pass - min fee lower than fee at floor gas price", []sdk.Msg{msgSend, msgVote}, 100, 5000, false, nil},
+		{"pass - simulate", []sdk.Msg{msgSend}, 100, 0, true, nil},
+	}
+
+	for _, tc := range testCases {
+		t.Run(fmt.Sprintf("Case %s", tc.name), func(t *testing.T) {
+			txBuilder := txCfg.NewTxBuilder()
+			require.NoError(t, txBuilder.SetMsgs(tc.msgs...))
+			txBuilder.SetGasLimit(tc.gas)
+			if tc.fee > 0 {
+				txBuilder.SetFeeAmount(sdk.NewCoins(sdk.NewInt64Coin(evmDenom, tc.fee)))
+			}
+
+			_, err := decorator.AnteHandle(sdk.Context{}, txBuilder.GetTx(), tc.simulate, testutil.NoOpNextFn)
+			if tc.expectedErr != nil {
+				require.Error(t, err)
+				require.ErrorIs(t, err, tc.expectedErr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/ethereum/go-ethereum/params"
 
+	anteinterfaces "github.com/cosmos/evm/ante/interfaces"
 	cosmosevmtypes "github.com/cosmos/evm/ante/types"
 	feemarkettypes "github.com/cosmos/evm/x/feemarket/types"
 	evmtypes "github.com/cosmos/evm/x/vm/types"
@@ -25,7 +26,9 @@ import (
 // - when `ExtensionOptionDynamicFeeTx` is omitted, `tipFeeCap` defaults to `MaxInt64`.
 // - when london hardfork is not enabled, it falls back to SDK default behavior (validator min-gas-prices).
 // - Tx priority is set to `effectiveGasPrice / DefaultPriorityReduction`.
-func NewDynamicFeeChecker(feemarketParams *feemarkettypes.Params) authante.TxFeeChecker {
+// - fees paid in a whitelisted fee token are converted to the EVM denom at the
+// rate of the token, and the effective fee is charged in the token.
+func NewDynamicFeeChecker(feemarketParams *feemarkettypes.Params, feeTokenKeeper anteinterfaces.FeeTokenKeeper) authante.TxFeeChecker {
 	return func(ctx sdk.Context, tx sdk.Tx) (sdk.Coins, int64, error) {
 		feeTx, ok := tx.(sdk.FeeTx)
 		if !ok {
@@ -40,7 +43,7 @@ func NewDynamicFeeChecker(feemarketParams *feemarkettypes.Params) authante.TxFee
 		denom := evmtypes.GetEVMCoinDenom()
 		ethCfg := evmtypes.GetEthChainConfig()
 
-		return FeeChecker(ctx, feemarketParams, denom, ethCfg, feeTx)
+		return FeeChecker(ctx, feemarketParams, feeTokenKeeper, denom, ethCfg, feeTx)
 	}
 }
 
@@ -48,6 +51,7 @@ func NewDynamicFeeChecker(feemarketParams *feemarkettypes.Params) authante.TxFee
 func FeeChecker(
 	ctx sdk.Context,
 	feemarketParams *feemarkettypes.Params,
+	feeTokenKeeper anteinterfaces.FeeTokenKeeper,
 	denom string,
 	ethConfig *params.ChainConfig,
 	feeTx sdk.FeeTx,
@@ -57,7 +61,9 @@ func FeeChecker(
 		return checkTxFeeWithValidatorMinGasPrices(ctx, feeTx)
 	}
 
-	baseFee := feemarketParams.BaseFee
+	// Cosmos transactions pay the base fee of the Cosmos lane when fee lanes
+	// are enabled
+	baseFee := feemarketParams.CosmosLaneBaseFee()
 	// if baseFee is not enabled, consider it 0
 	// so the DynamicFeeTx logic can be applied
 	if baseFee.IsNil() || !feemarketParams.IsBaseFeeEnabled(ctx.BlockHeight()) {
@@ -92,7 +98,14 @@ func FeeChecker(
 	}
 
 	feeCoins := feeTx.GetFee()
-	feeAmtDec := sdkmath.LegacyNewDecFromInt(feeCoins.AmountOfNoDenomValidation(denom)) //nolint:staticcheck // TODO: fix
+
+	// the fees paid in a fee token are compared in the EVM denom
+	feeDenom, rate, err := FeeTokenRate(ctx, feeTokenKeeper, feemarketParams, feeCoins, denom)
+	if err != nil {
+		return nil, 0, err
+	}
+	feeAmt := feeCoins.AmountOfNoDenomValidation(feeDenom) //nolint:staticcheck // TODO: fix
+	feeAmtDec := rate.MulInt(feeAmt)
 
 	feeCap := feeAmtDec.QuoInt(gas)
 	if feeCap.LT(baseFee) {
@@ -102,11 +115,17 @@ func FeeChecker(
 	// calculate the effective gas price using the EIP-1559 logic.
 	effectivePrice := effectiveGasPriceLegacyDec(baseFee, feeCap, maxPriorityPrice)
 
+	// charge the effective fee in the denom of the fees, never more than provided
+	effectiveAmt := evmtypes.ConvertFeeToFeeToken(effectivePrice.MulInt(gas).Ceil().RoundInt(), rate)
+	if effectiveAmt.GT(feeAmt) {
+		effectiveAmt = feeAmt
+	}
+
 	// NOTE: create a new coins slice without having to validate the denom
 	effectiveFee := sdk.Coins{
 		{
-			Denom:  denom,
-			Amount: effectivePrice.MulInt(gas).Ceil().RoundInt(),
+			Denom:  feeDenom,
+			Amount: effectiveAmt,
 		},
 	}
 	priorityInt := effectivePrice.Sub(baseFee).QuoInt(evmtypes.DefaultPriorityReduction).TruncateInt()
@@ -1,6 +1,7 @@
 package evm_test
 
 import (
+	"errors"
 	"math/big"
 	"testing"
 
@@ -23,6 +24,18 @@ import (
 	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
 )
 
+const feeTokenDenom = "ibc/usdc"
+
+// fixedRateKeeper only resolves the fixed rates of the fee tokens.
+type fixedRateKeeper struct{}
+
+func (fixedRateKeeper) GetFeeTokenRate(_ sdk.Context, token feemarkettypes.FeeToken) (math.LegacyDec, error) {
+	if !token.HasFixedRate() {
+		return math.LegacyDec{}, errors.New("twap oracle unavailable")
+	}
+	return token.FixedRate, nil
+}
+
 func TestSDKTxFeeChecker(t *testing.T) {
 	// testCases:
 	//   fallback
@@ -158,6 +171,48 @@ func TestSDKTxFeeChecker(t *testing.T) {
 			0,
 			true,
 		},
+		{
+			"success, dynamic fee of the Cosmos lane",
+			deliverTxCtx,
+			func() feemarkettypes.Params {
+				params := feemarketParams
+				params.BaseFee = math.LegacyNewDec(100)
+				params.EnableFeeLanes = true
+				params.CosmosBaseFee = math.LegacyNewDec(10)
+				return params
+			},
+			func() sdk.FeeTx {
+				txBuilder := encodingConfig.TxConfig.NewTxBuilder()
+				txBuilder.SetGasLimit(1)
+				txBuilder.SetFeeAmount(sdk.NewCoins(sdk.NewCoin(testconstants.ExampleAttoDenom, math.NewInt(10))))
+				return txBuilder.GetTx()
+			},
+			true,
+			"10aepix",
+			0,
+			true,
+		},
+		{
+			"fail, dynamic fee below the Cosmos lane base fee",
+			deliverTxCtx,
+			func() feemarkettypes.Params {
+				params := feemarketParams
+				params.BaseFee = math.LegacyNewDec(1)
+				params.EnableFeeLanes = true
+				params.CosmosBaseFee = math.LegacyNewDec(10)
+				return params
+			},
+			func() sdk.FeeTx {
+				txBuilder := encodingConfig.TxConfig.NewTxBuilder()
+				txBuilder.SetGasLimit(1)
+				txBuilder.SetFeeAmount(sdk.NewCoins(sdk.NewCoin(testconstants.ExampleAttoDenom, math.NewInt(5))))
+				return txBuilder.GetTx()
+			},
+			true,
+			"",
+			0,
+			false,
+		},
 		{
 			"success, dynamic fee priority",
 			deliverTxCtx,
@@ -222,6 +277,90 @@ func TestSDKTxFeeChecker(t *testing.T) {
 			5,
 			true,
 		},
+		{
+			"success, dynamic fee paid in fee token",
+			deliverTxCtx,
+			func() feemarkettypes.Params {
+				feemarketParams.BaseFee = math.LegacyNewDec(10)
+				params := feemarketParams
+				params.FeeTokens = []feemarkettypes.FeeToken{{Denom: feeTokenDenom, FixedRate: math.LegacyNewDec(2)}}
+				return params
+			},
+			func() sdk.FeeTx {
+				txBuilder := encodingConfig.TxConfig.NewTxBuilder()
+				txBuilder.SetGasLimit(1)
+				txBuilder.SetFeeAmount(sdk.NewCoins(sdk.NewCoin(feeTokenDenom, math.NewInt(5))))
+				return txBuilder.GetTx()
+			},
+			true,
+			"5" + feeTokenDenom,
+			0,
+			true,
+		},
+		{
+			"success, dynamic fee paid in fee token priority",
+			deliverTxCtx,
+			func() feemarkettypes.Params {
+				feemarketParams.BaseFee = math.LegacyNewDec(10)
+				params := feemarketParams
+				params.FeeTokens = []feemarkettypes.FeeToken{{Denom: feeTokenDenom, FixedRate: math.LegacyNewDec(2)}}
+				return params
+			},
+			func() sdk.FeeTx {
+				txBuilder := encodingConfig.TxConfig.NewTxBuilder()
+				txBuilder.SetGasLimit(1)
+				txBuilder.SetFeeAmount(sdk.NewCoins(sdk.NewCoin(feeTokenDenom, math.NewInt(5).Mul(evmtypes.DefaultPriorityReduction).Add(math.NewInt(5)))))
+				return txBuilder.GetTx()
+			},
+			true,
+			"5000005" + feeTokenDenom,
+			10,
+			true,
+		},
+		{
+			"fail, dynamic fee paid in fee token too low",
+			deliverTxCtx,
+			func() feemarkettypes.Params {
+				feemarketParams.BaseFee = math.LegacyNewDec(10)
+				params := feemarketParams
+				params.FeeTokens = []feemarkettypes.FeeToken{{Denom: feeTokenDenom, FixedRate: math.LegacyNewDec(2)}}
+				return params
+			},
+			func() sdk.FeeTx {
+				txBuilder := encodingConfig.TxConfig.NewTxBuilder()
+				txBuilder.SetGasLimit(1)
+				txBuilder.SetFeeAmount(sdk.NewCoins(sdk.NewCoin(feeTokenDenom, math.NewInt(4))))
+				return txBuilder.GetTx()
+			},
+			true,
+			"",
+			0,
+			false,
+		},
+		{
+			"fail, dynamic fee paid in fee token without rate",
+			deliverTxCtx,
+			func() feemarkettypes.Params {
+				feemarketParams.BaseFee = math.LegacyNewDec(10)
+				params := feemarketParams
+				params.FeeTokens = []feemarkettypes.FeeToken{{
+					Denom:      feeTokenDenom,
+					TwapOracle: "0x1000000000000000000000000000000000000001",
+					TwapToken:  "0x2000000000000000000000000000000000000002",
+				}}
+				return params
+			},
+			func() sdk.FeeTx {
+				txBuilder := encodingConfig.TxConfig.NewTxBuilder()
+				txBuilder.SetGasLimit(1)
+				txBuilder.SetFeeAmount(sdk.NewCoins(sdk.NewCoin(feeTokenDenom, math.NewInt(5))))
+				return txBuilder.GetTx()
+			},
+			true,
+			"",
+			0,
+			false,
+		},
 		{
 			"fail, negative dynamic fee tipFeeCap",
 			deliverTxCtx,
@@ -258,7 +397,7 @@ func TestSDKTxFeeChecker(t *testing.T) {
 				cfg.LondonBlock = big.NewInt(0)
 			}
 			feemarketParams := tc.feemarketParamsFn()
-			fees, priority, err := evm.NewDynamicFeeChecker(&feemarketParams)(tc.ctx, tc.buildTx())
+			fees, priority, err := evm.NewDynamicFeeChecker(&feemarketParams, fixedRateKeeper{})(tc.ctx, tc.buildTx())
 			if tc.expSuccess {
 				require.Equal(t, tc.expFees, fees.String())
 				require.Equal(t, tc.expPriority, priority)
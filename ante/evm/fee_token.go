@@ -0,0 +1,76 @@
+package evm
+
+import (
+	"errors"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	anteinterfaces "github.com/cosmos/evm/ante/interfaces"
+	feemarkettypes "github.com/cosmos/evm/x/feemarket/types"
+	"github.com/cosmos/evm/x/vm/statedb"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// SelectFeeToken returns the whitelisted fee token the fees of an Ethereum
+// transaction are paid with, when the balance verification failed because the
+// sender can't cover the transaction cost in the EVM denom. The value is still
+// transferred in the EVM denom, so the sender must at least cover it. It returns
+// nil if the fees can't be paid in any fee token.
+func SelectFeeToken(
+	ctx sdk.Context,
+	evmKeeper anteinterfaces.EVMKeeper,
+	feemarketParams *feemarkettypes.Params,
+	account *statedb.Account,
+	from common.Address,
+	ethTx *ethtypes.Transaction,
+	balanceErr error,
+) *evmtypes.FeeTokenPayment {
+	if len(feemarketParams.FeeTokens) == 0 || !errors.Is(balanceErr, errortypes.ErrInsufficientFunds) {
+		return nil
+	}
+	if account == nil || account.Balance.ToBig().Cmp(ethTx.Value()) < 0 {
+		return nil
+	}
+
+	maxFees := new(big.Int).Sub(ethTx.Cost(), ethTx.Value())
+	return evmKeeper.SelectFeeToken(ctx, from, sdkmath.NewIntFromBigInt(maxFees))
+}
+
+// feeTokenOf returns the whitelisted fee token the fees of a Cosmos transaction
+// are paid with, if the fees consist of a single coin of a fee token.
+func feeTokenOf(feemarketParams *feemarkettypes.Params, fees sdk.Coins, evmDenom string) (feemarkettypes.FeeToken, bool) {
+	if len(fees) != 1 || fees[0].Denom == evmDenom {
+		return feemarkettypes.FeeToken{}, false
+	}
+	return feemarketParams.FeeToken(fees[0].Denom)
+}
+
+// FeeTokenRate returns the denom the fees of a Cosmos transaction are paid in,
+// and its rate to the EVM denom. Unless the fees are paid in a whitelisted fee
+// token, this is the EVM denom, at a rate of one.
+func FeeTokenRate(
+	ctx sdk.Context,
+	feeTokenKeeper anteinterfaces.FeeTokenKeeper,
+	feemarketParams *feemarkettypes.Params,
+	fees sdk.Coins,
+	evmDenom string,
+) (string, sdkmath.LegacyDec, error) {
+	token, ok := feeTokenOf(feemarketParams, fees, evmDenom)
+	if !ok || feeTokenKeeper == nil {
+		return evmDenom, sdkmath.LegacyOneDec(), nil
+	}
+
+	rate, err := feeTokenKeeper.GetFeeTokenRate(ctx, token)
+	if err != nil {
+		return "", sdkmath.LegacyDec{}, errorsmod.Wrapf(errortypes.ErrInsufficientFee, "fee token %s unavailable: %s", token.Denom, err)
+	}
+	return token.Denom, rate, nil
+}
@@ -179,6 +179,7 @@ func (md MonoDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, ne
 	// using a wrapper of the bank keeper as a dependency to scale all
 	// balances to 18 decimals.
 	account := md.evmKeeper.GetAccount(ctx, fromAddr)
+	var feeToken *evmtypes.FeeTokenPayment
 	if err := VerifyAccountBalance(
 		ctx,
 		md.evmKeeper,
@@ -187,7 +188,12 @@ func (md MonoDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, ne
 		fromAddr,
 		ethTx,
 	); err != nil {
-		return ctx, err
+		// the sender can't cover the cost in the EVM denom, but may pay the
+		// fees in a whitelisted fee token
+		feeToken = SelectFeeToken(ctx, md.evmKeeper, md.feemarketParams, account, fromAddr, ethTx, err)
+		if feeToken == nil {
+			return ctx, err
+		}
 	}
 
 	// 7. can transfer
@@ -217,6 +223,13 @@ func (md MonoDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, ne
 		return ctx, err
 	}
 
+	// the fees paid in a fee token are converted at its rate, which is recorded
+	// to refund the leftover gas in the same token
+	if feeToken != nil {
+		msgFees = sdk.NewCoins(sdk.NewCoin(feeToken.Denom, evmtypes.ConvertFeeToFeeToken(msgFees.AmountOf(evmDenom), feeToken.Rate)))
+	}
+	md.evmKeeper.SetFeeTokenPayment(ctx, feeToken)
+
 	err = ConsumeFeesAndEmitEvent(
 		ctx,
 		md.evmKeeper,
@@ -277,5 +290,11 @@ func (md MonoDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, ne
 		return ctx, err
 	}
 
+	// the gas of the fees paid in a fee token is left out of the base fee
+	// burn, the gas meter of the tx being final from here on
+	if feeToken != nil {
+		md.feeMarketKeeper.SetFeeTokenGasMeter(ctx, true)
+	}
+
 	return next(ctx, tx, simulate)
 }
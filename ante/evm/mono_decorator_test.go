@@ -38,6 +38,10 @@ import (
 // adds missing methods
 type ExtendedEVMKeeper struct {
 	*vmtypes.EVMKeeper
+
+	feeToken        *evmsdktypes.FeeTokenPayment // selected when the sender can't pay in the EVM denom
+	feeTokenPayment *evmsdktypes.FeeTokenPayment
+	deductedFees    sdk.Coins
 }
 
 func NewExtendedEVMKeeper() *ExtendedEVMKeeper {
@@ -50,10 +54,27 @@ func (k *ExtendedEVMKeeper) NewEVM(_ sdk.Context, _ core.Message, _ *statedb.EVM
 	return nil
 }
 
-func (k *ExtendedEVMKeeper) DeductTxCostsFromUserBalance(_ sdk.Context, _ sdk.Coins, _ common.Address) error {
+func (k *ExtendedEVMKeeper) DeductTxCostsFromUserBalance(_ sdk.Context, fees sdk.Coins, _ common.Address) error {
+	k.deductedFees = fees
 	return nil
 }
 
+func (k *ExtendedEVMKeeper) GetFeeTokenRate(_ sdk.Context, token feemarkettypes.FeeToken) (math.LegacyDec, error) {
+	return token.FixedRate, nil
+}
+
+func (k *ExtendedEVMKeeper) SelectFeeToken(_ sdk.Context, _ common.Address, _ math.Int) *evmsdktypes.FeeTokenPayment {
+	return k.feeToken
+}
+
+func (k *ExtendedEVMKeeper) SetFeeTokenPayment(_ sdk.Context, payment *evmsdktypes.FeeTokenPayment) {
+	k.feeTokenPayment = payment
+}
+
+func (k *ExtendedEVMKeeper) GetAuthzLimits(_ sdk.Context) evmsdktypes.AuthzLimits {
+	return evmsdktypes.AuthzLimits{}
+}
+
 func (k *ExtendedEVMKeeper) SpendableCoin(ctx sdk.Context, addr common.Address) *uint256.Int {
 	account := k.GetAccount(ctx, addr)
 	if account != nil {
@@ -77,8 +98,9 @@ func (m MockFeeMarketKeeper) GetParams(ctx sdk.Context) feemarkettypes.Params {
 	return param
 }
 
-func (m MockFeeMarketKeeper) GetBaseFeeEnabled(_ sdk.Context) bool    { return true }
-func (m MockFeeMarketKeeper) GetBaseFee(_ sdk.Context) math.LegacyDec { return math.LegacyZeroDec() }
+func (m MockFeeMarketKeeper) GetBaseFeeEnabled(_ sdk.Context) bool      { return true }
+func (m MockFeeMarketKeeper) GetBaseFee(_ sdk.Context) math.LegacyDec   { return math.LegacyZeroDec() }
+func (m MockFeeMarketKeeper) SetFeeTokenGasMeter(_ sdk.Context, _ bool) {}
 
 // matches the actual signatures
 type MockAccountKeeper struct {
@@ -238,3 +260,83 @@ func TestMonoDecorator(t *testing.T) {
 		})
 	}
 }
+
+func TestMonoDecoratorFeeToken(t *testing.T) {
+	chainID := uint64(constants.EighteenDecimalsChainID)
+	cfg := encoding.MakeConfig(chainID)
+	feeToken := &evmsdktypes.FeeTokenPayment{Denom: "ibc/usdc", Rate: math.LegacyNewDec(3)}
+
+	testCases := []struct {
+		name     string
+		feeToken *evmsdktypes.FeeTokenPayment
+		expFees  sdk.Coins
+		expErr   string
+	}{
+		{
+			"success, fees paid in fee token",
+			feeToken,
+			// 100000 gas at a price of 1, converted at a rate of 3 and rounded up
+			sdk.NewCoins(sdk.NewInt64Coin("ibc/usdc", 33334)),
+			"",
+		},
+		{
+			"failure, no fee token the sender can pay with",
+			nil,
+			nil,
+			"insufficient funds",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			configurator := evmsdktypes.NewEVMConfigurator()
+			configurator.ResetTestConfig()
+			require.NoError(t, evmsdktypes.SetChainConfig(evmsdktypes.DefaultChainConfig(evmsdktypes.DefaultEVMChainID)))
+			require.NoError(t, configurator.
+				WithExtendedEips(evmsdktypes.DefaultCosmosEVMActivators).
+				WithEVMCoinInfo(evmsdktypes.EvmCoinInfo{
+					Denom:         evmsdktypes.DefaultEVMExtendedDenom,
+					ExtendedDenom: evmsdktypes.DefaultEVMExtendedDenom,
+					DisplayDenom:  evmsdktypes.DefaultEVMDisplayDenom,
+					Decimals:      18,
+				}).
+				Configure())
+
+			// the sender has no balance of the EVM denom
+			privKey, _ := ethsecp256k1.GenerateKey()
+			fromAddr := common.BytesToAddress(privKey.PubKey().Address().Bytes())
+			keeper := NewExtendedEVMKeeper()
+			require.NoError(t, keeper.SetAccount(sdk.Context{}, fromAddr, *statedb.NewEmptyAccount()))
+			keeper.feeToken = tc.feeToken
+
+			accountKeeper := MockAccountKeeper{FundedAddr: sdk.AccAddress(fromAddr.Bytes())}
+			feeMarketKeeper := MockFeeMarketKeeper{}
+			params := keeper.GetParams(sdk.Context{})
+			feemarketParams := feeMarketKeeper.GetParams(sdk.Context{})
+			feemarketParams.FeeTokens = []feemarkettypes.FeeToken{{Denom: feeToken.Denom, FixedRate: feeToken.Rate}}
+			monoDec := evm.NewEVMMonoDecorator(accountKeeper, feeMarketKeeper, keeper, 0, &params, &feemarketParams)
+
+			ctx := sdk.NewContext(nil, tmproto.Header{}, false, log.NewNopLogger())
+			ctx = ctx.WithBlockGasMeter(storetypes.NewGasMeter(1e19))
+			ctx = ctx.WithConsensusParams(tmproto.ConsensusParams{Block: &tmproto.BlockParams{MaxBytes: 200000, MaxGas: 81500000}})
+
+			msg := signMsgEthereumTx(t, privKey, &evmsdktypes.EvmTxArgs{
+				Nonce:    0,
+				GasLimit: 100000,
+				GasPrice: big.NewInt(1),
+				Input:    []byte("test"),
+			})
+			tx, err := utiltx.PrepareEthTx(cfg.TxConfig, nil, msg)
+			require.NoError(t, err)
+
+			_, err = monoDec.AnteHandle(ctx, tx, false, func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) { return ctx, nil })
+			if tc.expErr != "" {
+				require.ErrorContains(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expFees, keeper.deductedFees)
+			require.Equal(t, tc.feeToken, keeper.feeTokenPayment)
+		})
+	}
+}
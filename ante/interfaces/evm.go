@@ -11,6 +11,8 @@ import (
 	"github.com/cosmos/evm/x/vm/statedb"
 	evmtypes "github.com/cosmos/evm/x/vm/types"
 
+	sdkmath "cosmossdk.io/math"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/tx"
 )
@@ -24,11 +26,27 @@ type EVMKeeper interface {
 	DeductTxCostsFromUserBalance(ctx sdk.Context, fees sdk.Coins, from common.Address) error
 	SpendableCoin(ctx sdk.Context, addr common.Address) *uint256.Int
 	GetParams(ctx sdk.Context) evmtypes.Params
+	SelectFeeToken(ctx sdk.Context, from common.Address, fees sdkmath.Int) *evmtypes.FeeTokenPayment
+	SetFeeTokenPayment(ctx sdk.Context, payment *evmtypes.FeeTokenPayment)
+
+	FeeTokenKeeper
+	AuthzLimitsKeeper
+}
+
+// FeeTokenKeeper exposes the rates of the fee tokens required for ante handlers
+type FeeTokenKeeper interface {
+	GetFeeTokenRate(ctx sdk.Context, token feemarkettypes.FeeToken) (sdkmath.LegacyDec, error)
+}
+
+// AuthzLimitsKeeper exposes the authz limits of the evm params required for ante handlers
+type AuthzLimitsKeeper interface {
+	GetAuthzLimits(ctx sdk.Context) evmtypes.AuthzLimits
 }
 
 // FeeMarketKeeper exposes the required feemarket keeper interface required for ante handlers
 type FeeMarketKeeper interface {
 	GetParams(ctx sdk.Context) (params feemarkettypes.Params)
+	SetFeeTokenGasMeter(ctx sdk.Context, evm bool)
 }
 
 type ProtoTxProvider interface {
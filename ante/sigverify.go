@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	"github.com/cosmos/evm/crypto/ethsecp256k1"
+	"github.com/cosmos/evm/crypto/webauthn"
 
 	errorsmod "cosmossdk.io/errors"
 	storetypes "cosmossdk.io/store/types"
@@ -21,6 +22,12 @@ var _ authante.SignatureVerificationGasConsumer = SigVerificationGasConsumer
 
 const (
 	Secp256k1VerifyCost uint64 = 21000
+	// WebAuthnVerifyCost covers the secp256r1 verification of a passkey signature
+	// and the hashing and parsing of its WebAuthn envelope, which make it costlier
+	// than a secp256k1 verification. The auth params only hold the costs of the SDK
+	// key types, so like Secp256k1VerifyCost it is a constant, changed with a
+	// software upgrade rather than by governance.
+	WebAuthnVerifyCost uint64 = 30000
 )
 
 // SigVerificationGasConsumer is the Cosmos EVM implementation of SignatureVerificationGasConsumer. It consumes gas
@@ -32,6 +39,8 @@ const (
 //
 // - secp256k1 (Cosmos keys)
 //
+// - webauthn (Passkeys)
+//
 // - ed25519 (Validators)
 //
 // - multisig (Cosmos SDK multisigs)
@@ -49,6 +58,10 @@ func SigVerificationGasConsumer(
 		// Cosmos keys
 		meter.ConsumeGas(params.SigVerifyCostSecp256k1, "ante verify: secp256k1")
 		return nil
+	case *webauthn.PubKey:
+		// Passkeys
+		meter.ConsumeGas(WebAuthnVerifyCost, "ante verify: webauthn")
+		return nil
 	case *ed25519.PubKey:
 		// Validator keys
 		meter.ConsumeGas(params.SigVerifyCostED25519, "ante verify: ed25519")
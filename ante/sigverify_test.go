@@ -7,6 +7,7 @@ import (
 
 	"github.com/cosmos/evm/ante"
 	"github.com/cosmos/evm/crypto/ethsecp256k1"
+	"github.com/cosmos/evm/crypto/webauthn"
 	"github.com/cosmos/evm/encoding"
 	"github.com/cosmos/evm/server/config"
 
@@ -84,6 +85,12 @@ func TestConsumeSignatureVerificationGas(t *testing.T) {
 			p.SigVerifyCostSecp256r1(),
 			true,
 		},
+		{
+			"PubKeyWebAuthn",
+			args{storetypes.NewInfiniteGasMeter(), nil, &webauthn.PubKey{Key: skR1.PubKey().Bytes()}, params},
+			ante.WebAuthnVerifyCost,
+			false,
+		},
 		{
 			"Multisig",
 			args{storetypes.NewInfiniteGasMeter(), multisignature1, multisigKey1, params},
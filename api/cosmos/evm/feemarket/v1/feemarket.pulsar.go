@@ -14,15 +14,124 @@ import (
 	sync "sync"
 )
 
+var _ protoreflect.List = (*_Params_9_list)(nil)
+
+type _Params_9_list struct {
+	list *[]*FeeToken
+}
+
+func (x *_Params_9_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Params_9_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_Params_9_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*FeeToken)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Params_9_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*FeeToken)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Params_9_list) AppendMutable() protoreflect.Value {
+	v := new(FeeToken)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Params_9_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Params_9_list) NewElement() protoreflect.Value {
+	v := new(FeeToken)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Params_9_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_Params_15_list)(nil)
+
+type _Params_15_list struct {
+	list *[]*MsgFeeFloor
+}
+
+func (x *_Params_15_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Params_15_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_Params_15_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*MsgFeeFloor)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Params_15_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*MsgFeeFloor)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Params_15_list) AppendMutable() protoreflect.Value {
+	v := new(MsgFeeFloor)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Params_15_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Params_15_list) NewElement() protoreflect.Value {
+	v := new(MsgFeeFloor)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Params_15_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_Params                             protoreflect.MessageDescriptor
-	fd_Params_no_base_fee                 protoreflect.FieldDescriptor
-	fd_Params_base_fee_change_denominator protoreflect.FieldDescriptor
-	fd_Params_elasticity_multiplier       protoreflect.FieldDescriptor
-	fd_Params_enable_height               protoreflect.FieldDescriptor
-	fd_Params_base_fee                    protoreflect.FieldDescriptor
-	fd_Params_min_gas_price               protoreflect.FieldDescriptor
-	fd_Params_min_gas_multiplier          protoreflect.FieldDescriptor
+	md_Params                              protoreflect.MessageDescriptor
+	fd_Params_no_base_fee                  protoreflect.FieldDescriptor
+	fd_Params_base_fee_change_denominator  protoreflect.FieldDescriptor
+	fd_Params_elasticity_multiplier        protoreflect.FieldDescriptor
+	fd_Params_enable_height                protoreflect.FieldDescriptor
+	fd_Params_base_fee                     protoreflect.FieldDescriptor
+	fd_Params_min_gas_price                protoreflect.FieldDescriptor
+	fd_Params_min_gas_multiplier           protoreflect.FieldDescriptor
+	fd_Params_fee_tokens                   protoreflect.FieldDescriptor
+	fd_Params_base_fee_burn_fraction       protoreflect.FieldDescriptor
+	fd_Params_enable_fee_lanes             protoreflect.FieldDescriptor
+	fd_Params_cosmos_base_fee              protoreflect.FieldDescriptor
+	fd_Params_cosmos_elasticity_multiplier protoreflect.FieldDescriptor
+	fd_Params_evm_lane_gas_share           protoreflect.FieldDescriptor
+	fd_Params_msg_fee_floors               protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -35,6 +144,13 @@ func init() {
 	fd_Params_base_fee = md_Params.Fields().ByName("base_fee")
 	fd_Params_min_gas_price = md_Params.Fields().ByName("min_gas_price")
 	fd_Params_min_gas_multiplier = md_Params.Fields().ByName("min_gas_multiplier")
+	fd_Params_fee_tokens = md_Params.Fields().ByName("fee_tokens")
+	fd_Params_base_fee_burn_fraction = md_Params.Fields().ByName("base_fee_burn_fraction")
+	fd_Params_enable_fee_lanes = md_Params.Fields().ByName("enable_fee_lanes")
+	fd_Params_cosmos_base_fee = md_Params.Fields().ByName("cosmos_base_fee")
+	fd_Params_cosmos_elasticity_multiplier = md_Params.Fields().ByName("cosmos_elasticity_multiplier")
+	fd_Params_evm_lane_gas_share = md_Params.Fields().ByName("evm_lane_gas_share")
+	fd_Params_msg_fee_floors = md_Params.Fields().ByName("msg_fee_floors")
 }
 
 var _ protoreflect.Message = (*fastReflection_Params)(nil)
@@ -144,6 +260,48 @@ func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, proto
 			return
 		}
 	}
+	if len(x.FeeTokens) != 0 {
+		value := protoreflect.ValueOfList(&_Params_9_list{list: &x.FeeTokens})
+		if !f(fd_Params_fee_tokens, value) {
+			return
+		}
+	}
+	if x.BaseFeeBurnFraction != "" {
+		value := protoreflect.ValueOfString(x.BaseFeeBurnFraction)
+		if !f(fd_Params_base_fee_burn_fraction, value) {
+			return
+		}
+	}
+	if x.EnableFeeLanes != false {
+		value := protoreflect.ValueOfBool(x.EnableFeeLanes)
+		if !f(fd_Params_enable_fee_lanes, value) {
+			return
+		}
+	}
+	if x.CosmosBaseFee != "" {
+		value := protoreflect.ValueOfString(x.CosmosBaseFee)
+		if !f(fd_Params_cosmos_base_fee, value) {
+			return
+		}
+	}
+	if x.CosmosElasticityMultiplier != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.CosmosElasticityMultiplier)
+		if !f(fd_Params_cosmos_elasticity_multiplier, value) {
+			return
+		}
+	}
+	if x.EvmLaneGasShare != "" {
+		value := protoreflect.ValueOfString(x.EvmLaneGasShare)
+		if !f(fd_Params_evm_lane_gas_share, value) {
+			return
+		}
+	}
+	if len(x.MsgFeeFloors) != 0 {
+		value := protoreflect.ValueOfList(&_Params_15_list{list: &x.MsgFeeFloors})
+		if !f(fd_Params_msg_fee_floors, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -173,6 +331,20 @@ func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
 		return x.MinGasPrice != ""
 	case "cosmos.evm.feemarket.v1.Params.min_gas_multiplier":
 		return x.MinGasMultiplier != ""
+	case "cosmos.evm.feemarket.v1.Params.fee_tokens":
+		return len(x.FeeTokens) != 0
+	case "cosmos.evm.feemarket.v1.Params.base_fee_burn_fraction":
+		return x.BaseFeeBurnFraction != ""
+	case "cosmos.evm.feemarket.v1.Params.enable_fee_lanes":
+		return x.EnableFeeLanes != false
+	case "cosmos.evm.feemarket.v1.Params.cosmos_base_fee":
+		return x.CosmosBaseFee != ""
+	case "cosmos.evm.feemarket.v1.Params.cosmos_elasticity_multiplier":
+		return x.CosmosElasticityMultiplier != uint32(0)
+	case "cosmos.evm.feemarket.v1.Params.evm_lane_gas_share":
+		return x.EvmLaneGasShare != ""
+	case "cosmos.evm.feemarket.v1.Params.msg_fee_floors":
+		return len(x.MsgFeeFloors) != 0
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.feemarket.v1.Params"))
@@ -203,6 +375,20 @@ func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
 		x.MinGasPrice = ""
 	case "cosmos.evm.feemarket.v1.Params.min_gas_multiplier":
 		x.MinGasMultiplier = ""
+	case "cosmos.evm.feemarket.v1.Params.fee_tokens":
+		x.FeeTokens = nil
+	case "cosmos.evm.feemarket.v1.Params.base_fee_burn_fraction":
+		x.BaseFeeBurnFraction = ""
+	case "cosmos.evm.feemarket.v1.Params.enable_fee_lanes":
+		x.EnableFeeLanes = false
+	case "cosmos.evm.feemarket.v1.Params.cosmos_base_fee":
+		x.CosmosBaseFee = ""
+	case "cosmos.evm.feemarket.v1.Params.cosmos_elasticity_multiplier":
+		x.CosmosElasticityMultiplier = uint32(0)
+	case "cosmos.evm.feemarket.v1.Params.evm_lane_gas_share":
+		x.EvmLaneGasShare = ""
+	case "cosmos.evm.feemarket.v1.Params.msg_fee_floors":
+		x.MsgFeeFloors = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.feemarket.v1.Params"))
@@ -240,6 +426,33 @@ func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) pro
 	case "cosmos.evm.feemarket.v1.Params.min_gas_multiplier":
 		value := x.MinGasMultiplier
 		return protoreflect.ValueOfString(value)
+	case "cosmos.evm.feemarket.v1.Params.fee_tokens":
+		if len(x.FeeTokens) == 0 {
+			return protoreflect.ValueOfList(&_Params_9_list{})
+		}
+		listValue := &_Params_9_list{list: &x.FeeTokens}
+		return protoreflect.ValueOfList(listValue)
+	case "cosmos.evm.feemarket.v1.Params.base_fee_burn_fraction":
+		value := x.BaseFeeBurnFraction
+		return protoreflect.ValueOfString(value)
+	case "cosmos.evm.feemarket.v1.Params.enable_fee_lanes":
+		value := x.EnableFeeLanes
+		return protoreflect.ValueOfBool(value)
+	case "cosmos.evm.feemarket.v1.Params.cosmos_base_fee":
+		value := x.CosmosBaseFee
+		return protoreflect.ValueOfString(value)
+	case "cosmos.evm.feemarket.v1.Params.cosmos_elasticity_multiplier":
+		value := x.CosmosElasticityMultiplier
+		return protoreflect.ValueOfUint32(value)
+	case "cosmos.evm.feemarket.v1.Params.evm_lane_gas_share":
+		value := x.EvmLaneGasShare
+		return protoreflect.ValueOfString(value)
+	case "cosmos.evm.feemarket.v1.Params.msg_fee_floors":
+		if len(x.MsgFeeFloors) == 0 {
+			return protoreflect.ValueOfList(&_Params_15_list{})
+		}
+		listValue := &_Params_15_list{list: &x.MsgFeeFloors}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.feemarket.v1.Params"))
@@ -274,6 +487,24 @@ func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value proto
 		x.MinGasPrice = value.Interface().(string)
 	case "cosmos.evm.feemarket.v1.Params.min_gas_multiplier":
 		x.MinGasMultiplier = value.Interface().(string)
+	case "cosmos.evm.feemarket.v1.Params.fee_tokens":
+		lv := value.List()
+		clv := lv.(*_Params_9_list)
+		x.FeeTokens = *clv.list
+	case "cosmos.evm.feemarket.v1.Params.base_fee_burn_fraction":
+		x.BaseFeeBurnFraction = value.Interface().(string)
+	case "cosmos.evm.feemarket.v1.Params.enable_fee_lanes":
+		x.EnableFeeLanes = value.Bool()
+	case "cosmos.evm.feemarket.v1.Params.cosmos_base_fee":
+		x.CosmosBaseFee = value.Interface().(string)
+	case "cosmos.evm.feemarket.v1.Params.cosmos_elasticity_multiplier":
+		x.CosmosElasticityMultiplier = uint32(value.Uint())
+	case "cosmos.evm.feemarket.v1.Params.evm_lane_gas_share":
+		x.EvmLaneGasShare = value.Interface().(string)
+	case "cosmos.evm.feemarket.v1.Params.msg_fee_floors":
+		lv := value.List()
+		clv := lv.(*_Params_15_list)
+		x.MsgFeeFloors = *clv.list
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.feemarket.v1.Params"))
@@ -294,6 +525,18 @@ func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value proto
 // Mutable is a mutating operation and unsafe for concurrent use.
 func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "cosmos.evm.feemarket.v1.Params.fee_tokens":
+		if x.FeeTokens == nil {
+			x.FeeTokens = []*FeeToken{}
+		}
+		value := &_Params_9_list{list: &x.FeeTokens}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.evm.feemarket.v1.Params.msg_fee_floors":
+		if x.MsgFeeFloors == nil {
+			x.MsgFeeFloors = []*MsgFeeFloor{}
+		}
+		value := &_Params_15_list{list: &x.MsgFeeFloors}
+		return protoreflect.ValueOfList(value)
 	case "cosmos.evm.feemarket.v1.Params.no_base_fee":
 		panic(fmt.Errorf("field no_base_fee of message cosmos.evm.feemarket.v1.Params is not mutable"))
 	case "cosmos.evm.feemarket.v1.Params.base_fee_change_denominator":
@@ -308,6 +551,16 @@ func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protore
 		panic(fmt.Errorf("field min_gas_price of message cosmos.evm.feemarket.v1.Params is not mutable"))
 	case "cosmos.evm.feemarket.v1.Params.min_gas_multiplier":
 		panic(fmt.Errorf("field min_gas_multiplier of message cosmos.evm.feemarket.v1.Params is not mutable"))
+	case "cosmos.evm.feemarket.v1.Params.base_fee_burn_fraction":
+		panic(fmt.Errorf("field base_fee_burn_fraction of message cosmos.evm.feemarket.v1.Params is not mutable"))
+	case "cosmos.evm.feemarket.v1.Params.enable_fee_lanes":
+		panic(fmt.Errorf("field enable_fee_lanes of message cosmos.evm.feemarket.v1.Params is not mutable"))
+	case "cosmos.evm.feemarket.v1.Params.cosmos_base_fee":
+		panic(fmt.Errorf("field cosmos_base_fee of message cosmos.evm.feemarket.v1.Params is not mutable"))
+	case "cosmos.evm.feemarket.v1.Params.cosmos_elasticity_multiplier":
+		panic(fmt.Errorf("field cosmos_elasticity_multiplier of message cosmos.evm.feemarket.v1.Params is not mutable"))
+	case "cosmos.evm.feemarket.v1.Params.evm_lane_gas_share":
+		panic(fmt.Errorf("field evm_lane_gas_share of message cosmos.evm.feemarket.v1.Params is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.feemarket.v1.Params"))
@@ -335,6 +588,22 @@ func (x *fastReflection_Params) NewField(fd protoreflect.FieldDescriptor) protor
 		return protoreflect.ValueOfString("")
 	case "cosmos.evm.feemarket.v1.Params.min_gas_multiplier":
 		return protoreflect.ValueOfString("")
+	case "cosmos.evm.feemarket.v1.Params.fee_tokens":
+		list := []*FeeToken{}
+		return protoreflect.ValueOfList(&_Params_9_list{list: &list})
+	case "cosmos.evm.feemarket.v1.Params.base_fee_burn_fraction":
+		return protoreflect.ValueOfString("")
+	case "cosmos.evm.feemarket.v1.Params.enable_fee_lanes":
+		return protoreflect.ValueOfBool(false)
+	case "cosmos.evm.feemarket.v1.Params.cosmos_base_fee":
+		return protoreflect.ValueOfString("")
+	case "cosmos.evm.feemarket.v1.Params.cosmos_elasticity_multiplier":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "cosmos.evm.feemarket.v1.Params.evm_lane_gas_share":
+		return protoreflect.ValueOfString("")
+	case "cosmos.evm.feemarket.v1.Params.msg_fee_floors":
+		list := []*MsgFeeFloor{}
+		return protoreflect.ValueOfList(&_Params_15_list{list: &list})
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.feemarket.v1.Params"))
@@ -428,6 +697,36 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if len(x.FeeTokens) > 0 {
+			for _, e := range x.FeeTokens {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		l = len(x.BaseFeeBurnFraction)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.EnableFeeLanes {
+			n += 2
+		}
+		l = len(x.CosmosBaseFee)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.CosmosElasticityMultiplier != 0 {
+			n += 1 + runtime.Sov(uint64(x.CosmosElasticityMultiplier))
+		}
+		l = len(x.EvmLaneGasShare)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.MsgFeeFloors) > 0 {
+			for _, e := range x.MsgFeeFloors {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -457,6 +756,74 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.MsgFeeFloors) > 0 {
+			for iNdEx := len(x.MsgFeeFloors) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.MsgFeeFloors[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x7a
+			}
+		}
+		if len(x.EvmLaneGasShare) > 0 {
+			i -= len(x.EvmLaneGasShare)
+			copy(dAtA[i:], x.EvmLaneGasShare)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.EvmLaneGasShare)))
+			i--
+			dAtA[i] = 0x72
+		}
+		if x.CosmosElasticityMultiplier != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.CosmosElasticityMultiplier))
+			i--
+			dAtA[i] = 0x68
+		}
+		if len(x.CosmosBaseFee) > 0 {
+			i -= len(x.CosmosBaseFee)
+			copy(dAtA[i:], x.CosmosBaseFee)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.CosmosBaseFee)))
+			i--
+			dAtA[i] = 0x62
+		}
+		if x.EnableFeeLanes {
+			i--
+			if x.EnableFeeLanes {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x58
+		}
+		if len(x.BaseFeeBurnFraction) > 0 {
+			i -= len(x.BaseFeeBurnFraction)
+			copy(dAtA[i:], x.BaseFeeBurnFraction)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BaseFeeBurnFraction)))
+			i--
+			dAtA[i] = 0x52
+		}
+		if len(x.FeeTokens) > 0 {
+			for iNdEx := len(x.FeeTokens) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.FeeTokens[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x4a
+			}
+		}
 		if len(x.MinGasMultiplier) > 0 {
 			i -= len(x.MinGasMultiplier)
 			copy(dAtA[i:], x.MinGasMultiplier)
@@ -725,103 +1092,1491 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 				}
 				x.MinGasMultiplier = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			default:
-				iNdEx = preIndex
-				skippy, err := runtime.Skip(dAtA[iNdEx:])
-				if err != nil {
+			case 9:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FeeTokens", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.FeeTokens = append(x.FeeTokens, &FeeToken{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.FeeTokens[len(x.FeeTokens)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				if (skippy < 0) || (iNdEx+skippy) < 0 {
+				iNdEx = postIndex
+			case 10:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BaseFeeBurnFraction", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				if (iNdEx + skippy) > l {
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if !options.DiscardUnknown {
-					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				x.BaseFeeBurnFraction = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 11:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EnableFeeLanes", wireType)
 				}
-				iNdEx += skippy
-			}
-		}
-
-		if iNdEx > l {
-			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-		}
-		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
-	}
-	return &protoiface.Methods{
-		NoUnkeyedLiterals: struct{}{},
-		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
-		Size:              size,
-		Marshal:           marshal,
-		Unmarshal:         unmarshal,
-		Merge:             nil,
-		CheckInitialized:  nil,
-	}
-}
-
-// Code generated by protoc-gen-go. DO NOT EDIT.
-// versions:
-// 	protoc-gen-go v1.27.0
-// 	protoc        (unknown)
-// source: cosmos/evm/feemarket/v1/feemarket.proto
-
-const (
-	// Verify that this generated code is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
-	// Verify that runtime/protoimpl is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
-)
-
-// Params defines the EVM module parameters
-type Params struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	// no_base_fee forces the EIP-1559 base fee to 0 (needed for 0 price calls)
-	NoBaseFee bool `protobuf:"varint,1,opt,name=no_base_fee,json=noBaseFee,proto3" json:"no_base_fee,omitempty"`
-	// base_fee_change_denominator bounds the amount the base fee can change
-	// between blocks.
-	BaseFeeChangeDenominator uint32 `protobuf:"varint,2,opt,name=base_fee_change_denominator,json=baseFeeChangeDenominator,proto3" json:"base_fee_change_denominator,omitempty"`
-	// elasticity_multiplier bounds the maximum gas limit an EIP-1559 block may
-	// have.
-	ElasticityMultiplier uint32 `protobuf:"varint,3,opt,name=elasticity_multiplier,json=elasticityMultiplier,proto3" json:"elasticity_multiplier,omitempty"`
-	// enable_height defines at which block height the base fee calculation is
-	// enabled.
-	EnableHeight int64 `protobuf:"varint,5,opt,name=enable_height,json=enableHeight,proto3" json:"enable_height,omitempty"`
-	// base_fee for EIP-1559 blocks.
-	BaseFee string `protobuf:"bytes,6,opt,name=base_fee,json=baseFee,proto3" json:"base_fee,omitempty"`
-	// min_gas_price defines the minimum gas price value for cosmos and eth
-	// transactions
-	MinGasPrice string `protobuf:"bytes,7,opt,name=min_gas_price,json=minGasPrice,proto3" json:"min_gas_price,omitempty"`
-	// min_gas_multiplier bounds the minimum gas used to be charged
-	// to senders based on gas limit
-	MinGasMultiplier string `protobuf:"bytes,8,opt,name=min_gas_multiplier,json=minGasMultiplier,proto3" json:"min_gas_multiplier,omitempty"`
-}
-
-func (x *Params) Reset() {
-	*x = Params{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_evm_feemarket_v1_feemarket_proto_msgTypes[0]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *Params) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*Params) ProtoMessage() {}
-
-// Deprecated: Use Params.ProtoReflect.Descriptor instead.
-func (*Params) Descriptor() ([]byte, []int) {
-	return file_cosmos_evm_feemarket_v1_feemarket_proto_rawDescGZIP(), []int{0}
-}
-
-func (x *Params) GetNoBaseFee() bool {
-	if x != nil {
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.EnableFeeLanes = bool(v != 0)
+			case 12:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CosmosBaseFee", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.CosmosBaseFee = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 13:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CosmosElasticityMultiplier", wireType)
+				}
+				x.CosmosElasticityMultiplier = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.CosmosElasticityMultiplier |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 14:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EvmLaneGasShare", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.EvmLaneGasShare = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 15:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MsgFeeFloors", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MsgFeeFloors = append(x.MsgFeeFloors, &MsgFeeFloor{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.MsgFeeFloors[len(x.MsgFeeFloors)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgFeeFloor                      protoreflect.MessageDescriptor
+	fd_MsgFeeFloor_msg_type_url         protoreflect.FieldDescriptor
+	fd_MsgFeeFloor_min_fee              protoreflect.FieldDescriptor
+	fd_MsgFeeFloor_gas_price_multiplier protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_evm_feemarket_v1_feemarket_proto_init()
+	md_MsgFeeFloor = File_cosmos_evm_feemarket_v1_feemarket_proto.Messages().ByName("MsgFeeFloor")
+	fd_MsgFeeFloor_msg_type_url = md_MsgFeeFloor.Fields().ByName("msg_type_url")
+	fd_MsgFeeFloor_min_fee = md_MsgFeeFloor.Fields().ByName("min_fee")
+	fd_MsgFeeFloor_gas_price_multiplier = md_MsgFeeFloor.Fields().ByName("gas_price_multiplier")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgFeeFloor)(nil)
+
+type fastReflection_MsgFeeFloor MsgFeeFloor
+
+func (x *MsgFeeFloor) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgFeeFloor)(x)
+}
+
+func (x *MsgFeeFloor) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_evm_feemarket_v1_feemarket_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgFeeFloor_messageType fastReflection_MsgFeeFloor_messageType
+var _ protoreflect.MessageType = fastReflection_MsgFeeFloor_messageType{}
+
+type fastReflection_MsgFeeFloor_messageType struct{}
+
+func (x fastReflection_MsgFeeFloor_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgFeeFloor)(nil)
+}
+func (x fastReflection_MsgFeeFloor_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgFeeFloor)
+}
+func (x fastReflection_MsgFeeFloor_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgFeeFloor
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgFeeFloor) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgFeeFloor
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgFeeFloor) Type() protoreflect.MessageType {
+	return _fastReflection_MsgFeeFloor_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgFeeFloor) New() protoreflect.Message {
+	return new(fastReflection_MsgFeeFloor)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgFeeFloor) Interface() protoreflect.ProtoMessage {
+	return (*MsgFeeFloor)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgFeeFloor) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.MsgTypeUrl != "" {
+		value := protoreflect.ValueOfString(x.MsgTypeUrl)
+		if !f(fd_MsgFeeFloor_msg_type_url, value) {
+			return
+		}
+	}
+	if x.MinFee != "" {
+		value := protoreflect.ValueOfString(x.MinFee)
+		if !f(fd_MsgFeeFloor_min_fee, value) {
+			return
+		}
+	}
+	if x.GasPriceMultiplier != "" {
+		value := protoreflect.ValueOfString(x.GasPriceMultiplier)
+		if !f(fd_MsgFeeFloor_gas_price_multiplier, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgFeeFloor) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.evm.feemarket.v1.MsgFeeFloor.msg_type_url":
+		return x.MsgTypeUrl != ""
+	case "cosmos.evm.feemarket.v1.MsgFeeFloor.min_fee":
+		return x.MinFee != ""
+	case "cosmos.evm.feemarket.v1.MsgFeeFloor.gas_price_multiplier":
+		return x.GasPriceMultiplier != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.feemarket.v1.MsgFeeFloor"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.feemarket.v1.MsgFeeFloor does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgFeeFloor) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.evm.feemarket.v1.MsgFeeFloor.msg_type_url":
+		x.MsgTypeUrl = ""
+	case "cosmos.evm.feemarket.v1.MsgFeeFloor.min_fee":
+		x.MinFee = ""
+	case "cosmos.evm.feemarket.v1.MsgFeeFloor.gas_price_multiplier":
+		x.GasPriceMultiplier = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.feemarket.v1.MsgFeeFloor"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.feemarket.v1.MsgFeeFloor does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgFeeFloor) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.evm.feemarket.v1.MsgFeeFloor.msg_type_url":
+		value := x.MsgTypeUrl
+		return protoreflect.ValueOfString(value)
+	case "cosmos.evm.feemarket.v1.MsgFeeFloor.min_fee":
+		value := x.MinFee
+		return protoreflect.ValueOfString(value)
+	case "cosmos.evm.feemarket.v1.MsgFeeFloor.gas_price_multiplier":
+		value := x.GasPriceMultiplier
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.feemarket.v1.MsgFeeFloor"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.feemarket.v1.MsgFeeFloor does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgFeeFloor) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.evm.feemarket.v1.MsgFeeFloor.msg_type_url":
+		x.MsgTypeUrl = value.Interface().(string)
+	case "cosmos.evm.feemarket.v1.MsgFeeFloor.min_fee":
+		x.MinFee = value.Interface().(string)
+	case "cosmos.evm.feemarket.v1.MsgFeeFloor.gas_price_multiplier":
+		x.GasPriceMultiplier = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.feemarket.v1.MsgFeeFloor"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.feemarket.v1.MsgFeeFloor does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgFeeFloor) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.evm.feemarket.v1.MsgFeeFloor.msg_type_url":
+		panic(fmt.Errorf("field msg_type_url of message cosmos.evm.feemarket.v1.MsgFeeFloor is not mutable"))
+	case "cosmos.evm.feemarket.v1.MsgFeeFloor.min_fee":
+		panic(fmt.Errorf("field min_fee of message cosmos.evm.feemarket.v1.MsgFeeFloor is not mutable"))
+	case "cosmos.evm.feemarket.v1.MsgFeeFloor.gas_price_multiplier":
+		panic(fmt.Errorf("field gas_price_multiplier of message cosmos.evm.feemarket.v1.MsgFeeFloor is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.feemarket.v1.MsgFeeFloor"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.feemarket.v1.MsgFeeFloor does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgFeeFloor) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.evm.feemarket.v1.MsgFeeFloor.msg_type_url":
+		return protoreflect.ValueOfString("")
+	case "cosmos.evm.feemarket.v1.MsgFeeFloor.min_fee":
+		return protoreflect.ValueOfString("")
+	case "cosmos.evm.feemarket.v1.MsgFeeFloor.gas_price_multiplier":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.feemarket.v1.MsgFeeFloor"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.feemarket.v1.MsgFeeFloor does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgFeeFloor) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.evm.feemarket.v1.MsgFeeFloor", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgFeeFloor) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgFeeFloor) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgFeeFloor) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgFeeFloor) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgFeeFloor)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.MsgTypeUrl)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.MinFee)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.GasPriceMultiplier)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgFeeFloor)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.GasPriceMultiplier) > 0 {
+			i -= len(x.GasPriceMultiplier)
+			copy(dAtA[i:], x.GasPriceMultiplier)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.GasPriceMultiplier)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.MinFee) > 0 {
+			i -= len(x.MinFee)
+			copy(dAtA[i:], x.MinFee)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MinFee)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.MsgTypeUrl) > 0 {
+			i -= len(x.MsgTypeUrl)
+			copy(dAtA[i:], x.MsgTypeUrl)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MsgTypeUrl)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgFeeFloor)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgFeeFloor: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgFeeFloor: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MinFee", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MinFee = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GasPriceMultiplier", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.GasPriceMultiplier = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_FeeToken             protoreflect.MessageDescriptor
+	fd_FeeToken_denom       protoreflect.FieldDescriptor
+	fd_FeeToken_fixed_rate  protoreflect.FieldDescriptor
+	fd_FeeToken_twap_oracle protoreflect.FieldDescriptor
+	fd_FeeToken_twap_token  protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_evm_feemarket_v1_feemarket_proto_init()
+	md_FeeToken = File_cosmos_evm_feemarket_v1_feemarket_proto.Messages().ByName("FeeToken")
+	fd_FeeToken_denom = md_FeeToken.Fields().ByName("denom")
+	fd_FeeToken_fixed_rate = md_FeeToken.Fields().ByName("fixed_rate")
+	fd_FeeToken_twap_oracle = md_FeeToken.Fields().ByName("twap_oracle")
+	fd_FeeToken_twap_token = md_FeeToken.Fields().ByName("twap_token")
+}
+
+var _ protoreflect.Message = (*fastReflection_FeeToken)(nil)
+
+type fastReflection_FeeToken FeeToken
+
+func (x *FeeToken) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_FeeToken)(x)
+}
+
+func (x *FeeToken) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_evm_feemarket_v1_feemarket_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_FeeToken_messageType fastReflection_FeeToken_messageType
+var _ protoreflect.MessageType = fastReflection_FeeToken_messageType{}
+
+type fastReflection_FeeToken_messageType struct{}
+
+func (x fastReflection_FeeToken_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_FeeToken)(nil)
+}
+func (x fastReflection_FeeToken_messageType) New() protoreflect.Message {
+	return new(fastReflection_FeeToken)
+}
+func (x fastReflection_FeeToken_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_FeeToken
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_FeeToken) Descriptor() protoreflect.MessageDescriptor {
+	return md_FeeToken
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_FeeToken) Type() protoreflect.MessageType {
+	return _fastReflection_FeeToken_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_FeeToken) New() protoreflect.Message {
+	return new(fastReflection_FeeToken)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_FeeToken) Interface() protoreflect.ProtoMessage {
+	return (*FeeToken)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_FeeToken) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Denom != "" {
+		value := protoreflect.ValueOfString(x.Denom)
+		if !f(fd_FeeToken_denom, value) {
+			return
+		}
+	}
+	if x.FixedRate != "" {
+		value := protoreflect.ValueOfString(x.FixedRate)
+		if !f(fd_FeeToken_fixed_rate, value) {
+			return
+		}
+	}
+	if x.TwapOracle != "" {
+		value := protoreflect.ValueOfString(x.TwapOracle)
+		if !f(fd_FeeToken_twap_oracle, value) {
+			return
+		}
+	}
+	if x.TwapToken != "" {
+		value := protoreflect.ValueOfString(x.TwapToken)
+		if !f(fd_FeeToken_twap_token, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_FeeToken) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.evm.feemarket.v1.FeeToken.denom":
+		return x.Denom != ""
+	case "cosmos.evm.feemarket.v1.FeeToken.fixed_rate":
+		return x.FixedRate != ""
+	case "cosmos.evm.feemarket.v1.FeeToken.twap_oracle":
+		return x.TwapOracle != ""
+	case "cosmos.evm.feemarket.v1.FeeToken.twap_token":
+		return x.TwapToken != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.feemarket.v1.FeeToken"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.feemarket.v1.FeeToken does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_FeeToken) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.evm.feemarket.v1.FeeToken.denom":
+		x.Denom = ""
+	case "cosmos.evm.feemarket.v1.FeeToken.fixed_rate":
+		x.FixedRate = ""
+	case "cosmos.evm.feemarket.v1.FeeToken.twap_oracle":
+		x.TwapOracle = ""
+	case "cosmos.evm.feemarket.v1.FeeToken.twap_token":
+		x.TwapToken = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.feemarket.v1.FeeToken"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.feemarket.v1.FeeToken does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_FeeToken) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.evm.feemarket.v1.FeeToken.denom":
+		value := x.Denom
+		return protoreflect.ValueOfString(value)
+	case "cosmos.evm.feemarket.v1.FeeToken.fixed_rate":
+		value := x.FixedRate
+		return protoreflect.ValueOfString(value)
+	case "cosmos.evm.feemarket.v1.FeeToken.twap_oracle":
+		value := x.TwapOracle
+		return protoreflect.ValueOfString(value)
+	case "cosmos.evm.feemarket.v1.FeeToken.twap_token":
+		value := x.TwapToken
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.feemarket.v1.FeeToken"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.feemarket.v1.FeeToken does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_FeeToken) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.evm.feemarket.v1.FeeToken.denom":
+		x.Denom = value.Interface().(string)
+	case "cosmos.evm.feemarket.v1.FeeToken.fixed_rate":
+		x.FixedRate = value.Interface().(string)
+	case "cosmos.evm.feemarket.v1.FeeToken.twap_oracle":
+		x.TwapOracle = value.Interface().(string)
+	case "cosmos.evm.feemarket.v1.FeeToken.twap_token":
+		x.TwapToken = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.feemarket.v1.FeeToken"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.feemarket.v1.FeeToken does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_FeeToken) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.evm.feemarket.v1.FeeToken.denom":
+		panic(fmt.Errorf("field denom of message cosmos.evm.feemarket.v1.FeeToken is not mutable"))
+	case "cosmos.evm.feemarket.v1.FeeToken.fixed_rate":
+		panic(fmt.Errorf("field fixed_rate of message cosmos.evm.feemarket.v1.FeeToken is not mutable"))
+	case "cosmos.evm.feemarket.v1.FeeToken.twap_oracle":
+		panic(fmt.Errorf("field twap_oracle of message cosmos.evm.feemarket.v1.FeeToken is not mutable"))
+	case "cosmos.evm.feemarket.v1.FeeToken.twap_token":
+		panic(fmt.Errorf("field twap_token of message cosmos.evm.feemarket.v1.FeeToken is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.feemarket.v1.FeeToken"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.feemarket.v1.FeeToken does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_FeeToken) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.evm.feemarket.v1.FeeToken.denom":
+		return protoreflect.ValueOfString("")
+	case "cosmos.evm.feemarket.v1.FeeToken.fixed_rate":
+		return protoreflect.ValueOfString("")
+	case "cosmos.evm.feemarket.v1.FeeToken.twap_oracle":
+		return protoreflect.ValueOfString("")
+	case "cosmos.evm.feemarket.v1.FeeToken.twap_token":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.feemarket.v1.FeeToken"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.feemarket.v1.FeeToken does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_FeeToken) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.evm.feemarket.v1.FeeToken", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_FeeToken) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_FeeToken) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_FeeToken) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_FeeToken) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*FeeToken)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Denom)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.FixedRate)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.TwapOracle)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.TwapToken)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*FeeToken)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.TwapToken) > 0 {
+			i -= len(x.TwapToken)
+			copy(dAtA[i:], x.TwapToken)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.TwapToken)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.TwapOracle) > 0 {
+			i -= len(x.TwapOracle)
+			copy(dAtA[i:], x.TwapOracle)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.TwapOracle)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.FixedRate) > 0 {
+			i -= len(x.FixedRate)
+			copy(dAtA[i:], x.FixedRate)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.FixedRate)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Denom) > 0 {
+			i -= len(x.Denom)
+			copy(dAtA[i:], x.Denom)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Denom)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*FeeToken)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: FeeToken: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: FeeToken: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Denom = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FixedRate", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.FixedRate = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TwapOracle", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.TwapOracle = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TwapToken", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.TwapToken = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: cosmos/evm/feemarket/v1/feemarket.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Params defines the EVM module parameters
+type Params struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// no_base_fee forces the EIP-1559 base fee to 0 (needed for 0 price calls)
+	NoBaseFee bool `protobuf:"varint,1,opt,name=no_base_fee,json=noBaseFee,proto3" json:"no_base_fee,omitempty"`
+	// base_fee_change_denominator bounds the amount the base fee can change
+	// between blocks.
+	BaseFeeChangeDenominator uint32 `protobuf:"varint,2,opt,name=base_fee_change_denominator,json=baseFeeChangeDenominator,proto3" json:"base_fee_change_denominator,omitempty"`
+	// elasticity_multiplier bounds the maximum gas limit an EIP-1559 block may
+	// have.
+	ElasticityMultiplier uint32 `protobuf:"varint,3,opt,name=elasticity_multiplier,json=elasticityMultiplier,proto3" json:"elasticity_multiplier,omitempty"`
+	// enable_height defines at which block height the base fee calculation is
+	// enabled.
+	EnableHeight int64 `protobuf:"varint,5,opt,name=enable_height,json=enableHeight,proto3" json:"enable_height,omitempty"`
+	// base_fee for EIP-1559 blocks.
+	BaseFee string `protobuf:"bytes,6,opt,name=base_fee,json=baseFee,proto3" json:"base_fee,omitempty"`
+	// min_gas_price defines the minimum gas price value for cosmos and eth
+	// transactions
+	MinGasPrice string `protobuf:"bytes,7,opt,name=min_gas_price,json=minGasPrice,proto3" json:"min_gas_price,omitempty"`
+	// min_gas_multiplier bounds the minimum gas used to be charged
+	// to senders based on gas limit
+	MinGasMultiplier string `protobuf:"bytes,8,opt,name=min_gas_multiplier,json=minGasMultiplier,proto3" json:"min_gas_multiplier,omitempty"`
+	// fee_tokens defines the tokens, other than the EVM denom, that the fees of
+	// cosmos and eth transactions can be paid with
+	FeeTokens []*FeeToken `protobuf:"bytes,9,rep,name=fee_tokens,json=feeTokens,proto3" json:"fee_tokens,omitempty"`
+	// base_fee_burn_fraction is the fraction, between 0 and 1, of the base fee
+	// revenue of each block (base fee * block gas used) that is burned from the
+	// fee collector instead of being distributed to the stakers
+	BaseFeeBurnFraction string `protobuf:"bytes,10,opt,name=base_fee_burn_fraction,json=baseFeeBurnFraction,proto3" json:"base_fee_burn_fraction,omitempty"`
+	// enable_fee_lanes splits the fee market into an EVM lane and a Cosmos lane,
+	// each with its own gas target, elasticity and base fee updated from the gas
+	// of its own transactions. base_fee and elasticity_multiplier then apply to
+	// the EVM lane.
+	EnableFeeLanes bool `protobuf:"varint,11,opt,name=enable_fee_lanes,json=enableFeeLanes,proto3" json:"enable_fee_lanes,omitempty"`
+	// cosmos_base_fee is the base fee of the Cosmos lane, used when fee lanes are
+	// enabled.
+	CosmosBaseFee string `protobuf:"bytes,12,opt,name=cosmos_base_fee,json=cosmosBaseFee,proto3" json:"cosmos_base_fee,omitempty"`
+	// cosmos_elasticity_multiplier bounds the maximum gas the Cosmos lane may
+	// use, used when fee lanes are enabled.
+	CosmosElasticityMultiplier uint32 `protobuf:"varint,13,opt,name=cosmos_elasticity_multiplier,json=cosmosElasticityMultiplier,proto3" json:"cosmos_elasticity_multiplier,omitempty"`
+	// evm_lane_gas_share is the share, between 0 and 1 exclusive, of the block
+	// gas limit allotted to the EVM lane when fee lanes are enabled. The rest is
+	// allotted to the Cosmos lane.
+	EvmLaneGasShare string `protobuf:"bytes,14,opt,name=evm_lane_gas_share,json=evmLaneGasShare,proto3" json:"evm_lane_gas_share,omitempty"`
+	// msg_fee_floors defines the fee floors of the cosmos transactions including
+	// messages of the given types, enforced on top of min_gas_price
+	MsgFeeFloors []*MsgFeeFloor `protobuf:"bytes,15,rep,name=msg_fee_floors,json=msgFeeFloors,proto3" json:"msg_fee_floors,omitempty"`
+}
+
+func (x *Params) Reset() {
+	*x = Params{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_evm_feemarket_v1_feemarket_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Params) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Params) ProtoMessage() {}
+
+// Deprecated: Use Params.ProtoReflect.Descriptor instead.
+func (*Params) Descriptor() ([]byte, []int) {
+	return file_cosmos_evm_feemarket_v1_feemarket_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Params) GetNoBaseFee() bool {
+	if x != nil {
 		return x.NoBaseFee
 	}
 	return false
@@ -869,6 +2624,186 @@ func (x *Params) GetMinGasMultiplier() string {
 	return ""
 }
 
+func (x *Params) GetFeeTokens() []*FeeToken {
+	if x != nil {
+		return x.FeeTokens
+	}
+	return nil
+}
+
+func (x *Params) GetBaseFeeBurnFraction() string {
+	if x != nil {
+		return x.BaseFeeBurnFraction
+	}
+	return ""
+}
+
+func (x *Params) GetEnableFeeLanes() bool {
+	if x != nil {
+		return x.EnableFeeLanes
+	}
+	return false
+}
+
+func (x *Params) GetCosmosBaseFee() string {
+	if x != nil {
+		return x.CosmosBaseFee
+	}
+	return ""
+}
+
+func (x *Params) GetCosmosElasticityMultiplier() uint32 {
+	if x != nil {
+		return x.CosmosElasticityMultiplier
+	}
+	return 0
+}
+
+func (x *Params) GetEvmLaneGasShare() string {
+	if x != nil {
+		return x.EvmLaneGasShare
+	}
+	return ""
+}
+
+func (x *Params) GetMsgFeeFloors() []*MsgFeeFloor {
+	if x != nil {
+		return x.MsgFeeFloors
+	}
+	return nil
+}
+
+// MsgFeeFloor defines the minimum fee of the cosmos transactions including a
+// message type, either directly or nested in an authz MsgExec.
+type MsgFeeFloor struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// msg_type_url is the type url of the message, e.g.
+	// /cosmos.bank.v1beta1.MsgSend
+	MsgTypeUrl string `protobuf:"bytes,1,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
+	// min_fee is the minimum fee, in the EVM denom, paid for each message of the
+	// type included in the transaction
+	MinFee string `protobuf:"bytes,2,opt,name=min_fee,json=minFee,proto3" json:"min_fee,omitempty"`
+	// gas_price_multiplier multiplies min_gas_price to get the minimum gas price
+	// of the transactions including the message type
+	GasPriceMultiplier string `protobuf:"bytes,3,opt,name=gas_price_multiplier,json=gasPriceMultiplier,proto3" json:"gas_price_multiplier,omitempty"`
+}
+
+func (x *MsgFeeFloor) Reset() {
+	*x = MsgFeeFloor{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_evm_feemarket_v1_feemarket_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgFeeFloor) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgFeeFloor) ProtoMessage() {}
+
+// Deprecated: Use MsgFeeFloor.ProtoReflect.Descriptor instead.
+func (*MsgFeeFloor) Descriptor() ([]byte, []int) {
+	return file_cosmos_evm_feemarket_v1_feemarket_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *MsgFeeFloor) GetMsgTypeUrl() string {
+	if x != nil {
+		return x.MsgTypeUrl
+	}
+	return ""
+}
+
+func (x *MsgFeeFloor) GetMinFee() string {
+	if x != nil {
+		return x.MinFee
+	}
+	return ""
+}
+
+func (x *MsgFeeFloor) GetGasPriceMultiplier() string {
+	if x != nil {
+		return x.GasPriceMultiplier
+	}
+	return ""
+}
+
+// FeeToken defines a token that transaction fees can be paid with, converted
+// from the EVM denom either at a fixed rate or at the rate of an on-chain TWAP
+// oracle.
+type FeeToken struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// denom of the token, e.g. an IBC voucher or the denom of an ERC20 token
+	// pair
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// fixed_rate is the amount of the EVM denom (in its base unit) that one base
+	// unit of the token is worth. It must be set if twap_oracle is empty.
+	FixedRate string `protobuf:"bytes,2,opt,name=fixed_rate,json=fixedRate,proto3" json:"fixed_rate,omitempty"`
+	// twap_oracle is the hex address of the pool oracle contract providing the
+	// rate of the token, implementing
+	// `consult(address token, uint256 amountIn) returns (uint256 amountOut)`
+	// where amountOut is denominated in the EVM denom.
+	TwapOracle string `protobuf:"bytes,3,opt,name=twap_oracle,json=twapOracle,proto3" json:"twap_oracle,omitempty"`
+	// twap_token is the hex address of the ERC20 contract of the token, as
+	// registered on the oracle. It must be set if twap_oracle is set.
+	TwapToken string `protobuf:"bytes,4,opt,name=twap_token,json=twapToken,proto3" json:"twap_token,omitempty"`
+}
+
+func (x *FeeToken) Reset() {
+	*x = FeeToken{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_evm_feemarket_v1_feemarket_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FeeToken) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FeeToken) ProtoMessage() {}
+
+// Deprecated: Use FeeToken.ProtoReflect.Descriptor instead.
+func (*FeeToken) Descriptor() ([]byte, []int) {
+	return file_cosmos_evm_feemarket_v1_feemarket_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *FeeToken) GetDenom() string {
+	if x != nil {
+		return x.Denom
+	}
+	return ""
+}
+
+func (x *FeeToken) GetFixedRate() string {
+	if x != nil {
+		return x.FixedRate
+	}
+	return ""
+}
+
+func (x *FeeToken) GetTwapOracle() string {
+	if x != nil {
+		return x.TwapOracle
+	}
+	return ""
+}
+
+func (x *FeeToken) GetTwapToken() string {
+	if x != nil {
+		return x.TwapToken
+	}
+	return ""
+}
+
 var File_cosmos_evm_feemarket_v1_feemarket_proto protoreflect.FileDescriptor
 
 var file_cosmos_evm_feemarket_v1_feemarket_proto_rawDesc = []byte{
@@ -878,7 +2813,7 @@ var file_cosmos_evm_feemarket_v1_feemarket_proto_rawDesc = []byte{
 	0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x66, 0x65, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2e,
 	0x76, 0x31, 0x1a, 0x11, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2f, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2e,
 	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xe8, 0x03, 0x0a, 0x06,
+	0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xf6, 0x07, 0x0a, 0x06,
 	0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x1e, 0x0a, 0x0b, 0x6e, 0x6f, 0x5f, 0x62, 0x61, 0x73,
 	0x65, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x6e, 0x6f, 0x42,
 	0x61, 0x73, 0x65, 0x46, 0x65, 0x65, 0x12, 0x3d, 0x0a, 0x1b, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x66,
@@ -905,26 +2840,81 @@ var file_cosmos_evm_feemarket_v1_feemarket_proto_rawDesc = []byte{
 	0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64,
 	0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79,
 	0x44, 0x65, 0x63, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x10, 0x6d, 0x69, 0x6e, 0x47, 0x61, 0x73,
-	0x4d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x3a, 0x22, 0x8a, 0xe7, 0xb0, 0x2a,
-	0x1d, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x65, 0x76, 0x6d, 0x2f, 0x78, 0x2f, 0x66, 0x65,
-	0x65, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2f, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x4a, 0x04,
-	0x08, 0x04, 0x10, 0x05, 0x52, 0x10, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x5f, 0x62, 0x61,
-	0x73, 0x65, 0x5f, 0x66, 0x65, 0x65, 0x42, 0xe2, 0x01, 0x0a, 0x1b, 0x63, 0x6f, 0x6d, 0x2e, 0x63,
-	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x66, 0x65, 0x65, 0x6d, 0x61, 0x72,
-	0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x42, 0x0e, 0x46, 0x65, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x65,
-	0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x34, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
-	0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
-	0x73, 0x2f, 0x65, 0x76, 0x6d, 0x2f, 0x66, 0x65, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2f,
-	0x76, 0x31, 0x3b, 0x66, 0x65, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x76, 0x31, 0xa2, 0x02,
-	0x03, 0x43, 0x45, 0x46, 0xaa, 0x02, 0x17, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x45, 0x76,
-	0x6d, 0x2e, 0x46, 0x65, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2e, 0x56, 0x31, 0xca, 0x02,
-	0x17, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x45, 0x76, 0x6d, 0x5c, 0x46, 0x65, 0x65, 0x6d,
-	0x61, 0x72, 0x6b, 0x65, 0x74, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x23, 0x43, 0x6f, 0x73, 0x6d, 0x6f,
-	0x73, 0x5c, 0x45, 0x76, 0x6d, 0x5c, 0x46, 0x65, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x5c,
-	0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02,
-	0x1a, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x3a, 0x3a, 0x45, 0x76, 0x6d, 0x3a, 0x3a, 0x46, 0x65,
-	0x65, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x33,
+	0x4d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x12, 0x46, 0x0a, 0x0a, 0x66, 0x65,
+	0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21,
+	0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x66, 0x65, 0x65, 0x6d,
+	0x61, 0x72, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x65, 0x65, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x09, 0x66, 0x65, 0x65, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x73, 0x12, 0x5d, 0x0a, 0x16, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x62,
+	0x75, 0x72, 0x6e, 0x5f, 0x66, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x28, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65,
+	0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x13, 0x62, 0x61,
+	0x73, 0x65, 0x46, 0x65, 0x65, 0x42, 0x75, 0x72, 0x6e, 0x46, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x28, 0x0a, 0x10, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x66, 0x65, 0x65, 0x5f,
+	0x6c, 0x61, 0x6e, 0x65, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x65, 0x6e, 0x61,
+	0x62, 0x6c, 0x65, 0x46, 0x65, 0x65, 0x4c, 0x61, 0x6e, 0x65, 0x73, 0x12, 0x50, 0x0a, 0x0f, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x0c,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x28, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e,
+	0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0d,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x42, 0x61, 0x73, 0x65, 0x46, 0x65, 0x65, 0x12, 0x40, 0x0a,
+	0x1c, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5f, 0x65, 0x6c, 0x61, 0x73, 0x74, 0x69, 0x63, 0x69,
+	0x74, 0x79, 0x5f, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x18, 0x0d, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x1a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x45, 0x6c, 0x61, 0x73, 0x74,
+	0x69, 0x63, 0x69, 0x74, 0x79, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x12,
+	0x55, 0x0a, 0x12, 0x65, 0x76, 0x6d, 0x5f, 0x6c, 0x61, 0x6e, 0x65, 0x5f, 0x67, 0x61, 0x73, 0x5f,
+	0x73, 0x68, 0x61, 0x72, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x42, 0x28, 0xc8, 0xde, 0x1f,
+	0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69,
+	0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63,
+	0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0f, 0x65, 0x76, 0x6d, 0x4c, 0x61, 0x6e, 0x65, 0x47, 0x61,
+	0x73, 0x53, 0x68, 0x61, 0x72, 0x65, 0x12, 0x50, 0x0a, 0x0e, 0x6d, 0x73, 0x67, 0x5f, 0x66, 0x65,
+	0x65, 0x5f, 0x66, 0x6c, 0x6f, 0x6f, 0x72, 0x73, 0x18, 0x0f, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24,
+	0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x66, 0x65, 0x65, 0x6d,
+	0x61, 0x72, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x46, 0x65, 0x65, 0x46,
+	0x6c, 0x6f, 0x6f, 0x72, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0c, 0x6d, 0x73, 0x67, 0x46,
+	0x65, 0x65, 0x46, 0x6c, 0x6f, 0x6f, 0x72, 0x73, 0x3a, 0x22, 0x8a, 0xe7, 0xb0, 0x2a, 0x1d, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x65, 0x76, 0x6d, 0x2f, 0x78, 0x2f, 0x66, 0x65, 0x65, 0x6d,
+	0x61, 0x72, 0x6b, 0x65, 0x74, 0x2f, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x4a, 0x04, 0x08, 0x04,
+	0x10, 0x05, 0x52, 0x10, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x5f, 0x62, 0x61, 0x73, 0x65,
+	0x5f, 0x66, 0x65, 0x65, 0x22, 0xbe, 0x01, 0x0a, 0x0b, 0x4d, 0x73, 0x67, 0x46, 0x65, 0x65, 0x46,
+	0x6c, 0x6f, 0x6f, 0x72, 0x12, 0x20, 0x0a, 0x0c, 0x6d, 0x73, 0x67, 0x5f, 0x74, 0x79, 0x70, 0x65,
+	0x5f, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6d, 0x73, 0x67, 0x54,
+	0x79, 0x70, 0x65, 0x55, 0x72, 0x6c, 0x12, 0x36, 0x0a, 0x07, 0x6d, 0x69, 0x6e, 0x5f, 0x66, 0x65,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1d, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f,
+	0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61,
+	0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0x52, 0x06, 0x6d, 0x69, 0x6e, 0x46, 0x65, 0x65, 0x12, 0x55,
+	0x0a, 0x14, 0x67, 0x61, 0x73, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x6d, 0x75, 0x6c, 0x74,
+	0x69, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x23, 0xc8, 0xde,
+	0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e,
+	0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65,
+	0x63, 0x52, 0x12, 0x67, 0x61, 0x73, 0x50, 0x72, 0x69, 0x63, 0x65, 0x4d, 0x75, 0x6c, 0x74, 0x69,
+	0x70, 0x6c, 0x69, 0x65, 0x72, 0x22, 0xa4, 0x01, 0x0a, 0x08, 0x46, 0x65, 0x65, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x42, 0x0a, 0x0a, 0x66, 0x69, 0x78, 0x65,
+	0x64, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x23, 0xc8, 0xde,
+	0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e,
+	0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65,
+	0x63, 0x52, 0x09, 0x66, 0x69, 0x78, 0x65, 0x64, 0x52, 0x61, 0x74, 0x65, 0x12, 0x1f, 0x0a, 0x0b,
+	0x74, 0x77, 0x61, 0x70, 0x5f, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x74, 0x77, 0x61, 0x70, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x12, 0x1d, 0x0a,
+	0x0a, 0x74, 0x77, 0x61, 0x70, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x74, 0x77, 0x61, 0x70, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x42, 0xe2, 0x01, 0x0a,
+	0x1b, 0x63, 0x6f, 0x6d, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e,
+	0x66, 0x65, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x42, 0x0e, 0x46, 0x65,
+	0x65, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x34,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x65, 0x76, 0x6d, 0x2f, 0x66, 0x65, 0x65, 0x6d,
+	0x61, 0x72, 0x6b, 0x65, 0x74, 0x2f, 0x76, 0x31, 0x3b, 0x66, 0x65, 0x65, 0x6d, 0x61, 0x72, 0x6b,
+	0x65, 0x74, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x43, 0x45, 0x46, 0xaa, 0x02, 0x17, 0x43, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2e, 0x45, 0x76, 0x6d, 0x2e, 0x46, 0x65, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x65,
+	0x74, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x17, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x45, 0x76,
+	0x6d, 0x5c, 0x46, 0x65, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x5c, 0x56, 0x31, 0xe2, 0x02,
+	0x23, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x45, 0x76, 0x6d, 0x5c, 0x46, 0x65, 0x65, 0x6d,
+	0x61, 0x72, 0x6b, 0x65, 0x74, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x1a, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x3a, 0x3a, 0x45,
+	0x76, 0x6d, 0x3a, 0x3a, 0x46, 0x65, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x3a, 0x3a, 0x56,
+	0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -939,16 +2929,20 @@ func file_cosmos_evm_feemarket_v1_feemarket_proto_rawDescGZIP() []byte {
 	return file_cosmos_evm_feemarket_v1_feemarket_proto_rawDescData
 }
 
-var file_cosmos_evm_feemarket_v1_feemarket_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_cosmos_evm_feemarket_v1_feemarket_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
 var file_cosmos_evm_feemarket_v1_feemarket_proto_goTypes = []interface{}{
-	(*Params)(nil), // 0: cosmos.evm.feemarket.v1.Params
+	(*Params)(nil),      // 0: cosmos.evm.feemarket.v1.Params
+	(*MsgFeeFloor)(nil), // 1: cosmos.evm.feemarket.v1.MsgFeeFloor
+	(*FeeToken)(nil),    // 2: cosmos.evm.feemarket.v1.FeeToken
 }
 var file_cosmos_evm_feemarket_v1_feemarket_proto_depIdxs = []int32{
-	0, // [0:0] is the sub-list for method output_type
-	0, // [0:0] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	2, // 0: cosmos.evm.feemarket.v1.Params.fee_tokens:type_name -> cosmos.evm.feemarket.v1.FeeToken
+	1, // 1: cosmos.evm.feemarket.v1.Params.msg_fee_floors:type_name -> cosmos.evm.feemarket.v1.MsgFeeFloor
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
 }
 
 func init() { file_cosmos_evm_feemarket_v1_feemarket_proto_init() }
@@ -969,6 +2963,30 @@ func file_cosmos_evm_feemarket_v1_feemarket_proto_init() {
 				return nil
 			}
 		}
+		file_cosmos_evm_feemarket_v1_feemarket_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgFeeFloor); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_evm_feemarket_v1_feemarket_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FeeToken); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -976,7 +2994,7 @@ func file_cosmos_evm_feemarket_v1_feemarket_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_cosmos_evm_feemarket_v1_feemarket_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   1,
+			NumMessages:   3,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -1583,23 +1583,23 @@ func (x *fastReflection_QueryBaseFeeResponse) ProtoMethods() *protoiface.Methods
 }
 
 var (
-	md_QueryBlockGasRequest protoreflect.MessageDescriptor
+	md_QueryCosmosBaseFeeRequest protoreflect.MessageDescriptor
 )
 
 func init() {
 	file_cosmos_evm_feemarket_v1_query_proto_init()
-	md_QueryBlockGasRequest = File_cosmos_evm_feemarket_v1_query_proto.Messages().ByName("QueryBlockGasRequest")
+	md_QueryCosmosBaseFeeRequest = File_cosmos_evm_feemarket_v1_query_proto.Messages().ByName("QueryCosmosBaseFeeRequest")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryBlockGasRequest)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryCosmosBaseFeeRequest)(nil)
 
-type fastReflection_QueryBlockGasRequest QueryBlockGasRequest
+type fastReflection_QueryCosmosBaseFeeRequest QueryCosmosBaseFeeRequest
 
-func (x *QueryBlockGasRequest) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryBlockGasRequest)(x)
+func (x *QueryCosmosBaseFeeRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryCosmosBaseFeeRequest)(x)
 }
 
-func (x *QueryBlockGasRequest) slowProtoReflect() protoreflect.Message {
+func (x *QueryCosmosBaseFeeRequest) slowProtoReflect() protoreflect.Message {
 	mi := &file_cosmos_evm_feemarket_v1_query_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1611,43 +1611,43 @@ func (x *QueryBlockGasRequest) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryBlockGasRequest_messageType fastReflection_QueryBlockGasRequest_messageType
-var _ protoreflect.MessageType = fastReflection_QueryBlockGasRequest_messageType{}
+var _fastReflection_QueryCosmosBaseFeeRequest_messageType fastReflection_QueryCosmosBaseFeeRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryCosmosBaseFeeRequest_messageType{}
 
-type fastReflection_QueryBlockGasRequest_messageType struct{}
+type fastReflection_QueryCosmosBaseFeeRequest_messageType struct{}
 
-func (x fastReflection_QueryBlockGasRequest_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryBlockGasRequest)(nil)
+func (x fastReflection_QueryCosmosBaseFeeRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryCosmosBaseFeeRequest)(nil)
 }
-func (x fastReflection_QueryBlockGasRequest_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryBlockGasRequest)
+func (x fastReflection_QueryCosmosBaseFeeRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryCosmosBaseFeeRequest)
 }
-func (x fastReflection_QueryBlockGasRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryBlockGasRequest
+func (x fastReflection_QueryCosmosBaseFeeRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryCosmosBaseFeeRequest
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryBlockGasRequest) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryBlockGasRequest
+func (x *fastReflection_QueryCosmosBaseFeeRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryCosmosBaseFeeRequest
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryBlockGasRequest) Type() protoreflect.MessageType {
-	return _fastReflection_QueryBlockGasRequest_messageType
+func (x *fastReflection_QueryCosmosBaseFeeRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryCosmosBaseFeeRequest_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryBlockGasRequest) New() protoreflect.Message {
-	return new(fastReflection_QueryBlockGasRequest)
+func (x *fastReflection_QueryCosmosBaseFeeRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryCosmosBaseFeeRequest)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryBlockGasRequest) Interface() protoreflect.ProtoMessage {
-	return (*QueryBlockGasRequest)(x)
+func (x *fastReflection_QueryCosmosBaseFeeRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryCosmosBaseFeeRequest)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -1655,7 +1655,7 @@ func (x *fastReflection_QueryBlockGasRequest) Interface() protoreflect.ProtoMess
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryBlockGasRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_QueryCosmosBaseFeeRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 }
 
 // Has reports whether a field is populated.
@@ -1669,13 +1669,13 @@ func (x *fastReflection_QueryBlockGasRequest) Range(f func(protoreflect.FieldDes
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryBlockGasRequest) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryCosmosBaseFeeRequest) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.feemarket.v1.QueryBlockGasRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.feemarket.v1.QueryCosmosBaseFeeRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.feemarket.v1.QueryBlockGasRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.feemarket.v1.QueryCosmosBaseFeeRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1685,13 +1685,13 @@ func (x *fastReflection_QueryBlockGasRequest) Has(fd protoreflect.FieldDescripto
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryBlockGasRequest) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryCosmosBaseFeeRequest) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.feemarket.v1.QueryBlockGasRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.feemarket.v1.QueryCosmosBaseFeeRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.feemarket.v1.QueryBlockGasRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.feemarket.v1.QueryCosmosBaseFeeRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1701,13 +1701,13 @@ func (x *fastReflection_QueryBlockGasRequest) Clear(fd protoreflect.FieldDescrip
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryBlockGasRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryCosmosBaseFeeRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.feemarket.v1.QueryBlockGasRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.feemarket.v1.QueryCosmosBaseFeeRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.feemarket.v1.QueryBlockGasRequest does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.feemarket.v1.QueryCosmosBaseFeeRequest does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -1721,13 +1721,13 @@ func (x *fastReflection_QueryBlockGasRequest) Get(descriptor protoreflect.FieldD
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryBlockGasRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryCosmosBaseFeeRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.feemarket.v1.QueryBlockGasRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.feemarket.v1.QueryCosmosBaseFeeRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.feemarket.v1.QueryBlockGasRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.feemarket.v1.QueryCosmosBaseFeeRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1741,36 +1741,36 @@ func (x *fastReflection_QueryBlockGasRequest) Set(fd protoreflect.FieldDescripto
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryBlockGasRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryCosmosBaseFeeRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.feemarket.v1.QueryBlockGasRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.feemarket.v1.QueryCosmosBaseFeeRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.feemarket.v1.QueryBlockGasRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.feemarket.v1.QueryCosmosBaseFeeRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryBlockGasRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryCosmosBaseFeeRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.feemarket.v1.QueryBlockGasRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.feemarket.v1.QueryCosmosBaseFeeRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.feemarket.v1.QueryBlockGasRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.feemarket.v1.QueryCosmosBaseFeeRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryBlockGasRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryCosmosBaseFeeRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.evm.feemarket.v1.QueryBlockGasRequest", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.evm.feemarket.v1.QueryCosmosBaseFeeRequest", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -1778,7 +1778,7 @@ func (x *fastReflection_QueryBlockGasRequest) WhichOneof(d protoreflect.OneofDes
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryBlockGasRequest) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryCosmosBaseFeeRequest) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -1789,7 +1789,7 @@ func (x *fastReflection_QueryBlockGasRequest) GetUnknown() protoreflect.RawField
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryBlockGasRequest) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryCosmosBaseFeeRequest) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -1801,7 +1801,7 @@ func (x *fastReflection_QueryBlockGasRequest) SetUnknown(fields protoreflect.Raw
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryBlockGasRequest) IsValid() bool {
+func (x *fastReflection_QueryCosmosBaseFeeRequest) IsValid() bool {
 	return x != nil
 }
 
@@ -1811,9 +1811,9 @@ func (x *fastReflection_QueryBlockGasRequest) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryBlockGasRequest) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryCosmosBaseFeeRequest) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryBlockGasRequest)
+		x := input.Message.Interface().(*QueryCosmosBaseFeeRequest)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1835,7 +1835,7 @@ func (x *fastReflection_QueryBlockGasRequest) ProtoMethods() *protoiface.Methods
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryBlockGasRequest)
+		x := input.Message.Interface().(*QueryCosmosBaseFeeRequest)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1865,7 +1865,7 @@ func (x *fastReflection_QueryBlockGasRequest) ProtoMethods() *protoiface.Methods
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryBlockGasRequest)
+		x := input.Message.Interface().(*QueryCosmosBaseFeeRequest)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1897,10 +1897,10 @@ func (x *fastReflection_QueryBlockGasRequest) ProtoMethods() *protoiface.Methods
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryBlockGasRequest: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryCosmosBaseFeeRequest: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryBlockGasRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryCosmosBaseFeeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			default:
@@ -1939,25 +1939,25 @@ func (x *fastReflection_QueryBlockGasRequest) ProtoMethods() *protoiface.Methods
 }
 
 var (
-	md_QueryBlockGasResponse     protoreflect.MessageDescriptor
-	fd_QueryBlockGasResponse_gas protoreflect.FieldDescriptor
+	md_QueryCosmosBaseFeeResponse          protoreflect.MessageDescriptor
+	fd_QueryCosmosBaseFeeResponse_base_fee protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_evm_feemarket_v1_query_proto_init()
-	md_QueryBlockGasResponse = File_cosmos_evm_feemarket_v1_query_proto.Messages().ByName("QueryBlockGasResponse")
-	fd_QueryBlockGasResponse_gas = md_QueryBlockGasResponse.Fields().ByName("gas")
+	md_QueryCosmosBaseFeeResponse = File_cosmos_evm_feemarket_v1_query_proto.Messages().ByName("QueryCosmosBaseFeeResponse")
+	fd_QueryCosmosBaseFeeResponse_base_fee = md_QueryCosmosBaseFeeResponse.Fields().ByName("base_fee")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryBlockGasResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryCosmosBaseFeeResponse)(nil)
 
-type fastReflection_QueryBlockGasResponse QueryBlockGasResponse
+type fastReflection_QueryCosmosBaseFeeResponse QueryCosmosBaseFeeResponse
 
-func (x *QueryBlockGasResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryBlockGasResponse)(x)
+func (x *QueryCosmosBaseFeeResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryCosmosBaseFeeResponse)(x)
 }
 
-func (x *QueryBlockGasResponse) slowProtoReflect() protoreflect.Message {
+func (x *QueryCosmosBaseFeeResponse) slowProtoReflect() protoreflect.Message {
 	mi := &file_cosmos_evm_feemarket_v1_query_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1969,43 +1969,43 @@ func (x *QueryBlockGasResponse) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryBlockGasResponse_messageType fastReflection_QueryBlockGasResponse_messageType
-var _ protoreflect.MessageType = fastReflection_QueryBlockGasResponse_messageType{}
+var _fastReflection_QueryCosmosBaseFeeResponse_messageType fastReflection_QueryCosmosBaseFeeResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryCosmosBaseFeeResponse_messageType{}
 
-type fastReflection_QueryBlockGasResponse_messageType struct{}
+type fastReflection_QueryCosmosBaseFeeResponse_messageType struct{}
 
-func (x fastReflection_QueryBlockGasResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryBlockGasResponse)(nil)
+func (x fastReflection_QueryCosmosBaseFeeResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryCosmosBaseFeeResponse)(nil)
 }
-func (x fastReflection_QueryBlockGasResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryBlockGasResponse)
+func (x fastReflection_QueryCosmosBaseFeeResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryCosmosBaseFeeResponse)
 }
-func (x fastReflection_QueryBlockGasResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryBlockGasResponse
+func (x fastReflection_QueryCosmosBaseFeeResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryCosmosBaseFeeResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryBlockGasResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryBlockGasResponse
+func (x *fastReflection_QueryCosmosBaseFeeResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryCosmosBaseFeeResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryBlockGasResponse) Type() protoreflect.MessageType {
-	return _fastReflection_QueryBlockGasResponse_messageType
+func (x *fastReflection_QueryCosmosBaseFeeResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryCosmosBaseFeeResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryBlockGasResponse) New() protoreflect.Message {
-	return new(fastReflection_QueryBlockGasResponse)
+func (x *fastReflection_QueryCosmosBaseFeeResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryCosmosBaseFeeResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryBlockGasResponse) Interface() protoreflect.ProtoMessage {
-	return (*QueryBlockGasResponse)(x)
+func (x *fastReflection_QueryCosmosBaseFeeResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryCosmosBaseFeeResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -2013,10 +2013,10 @@ func (x *fastReflection_QueryBlockGasResponse) Interface() protoreflect.ProtoMes
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryBlockGasResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Gas != int64(0) {
-		value := protoreflect.ValueOfInt64(x.Gas)
-		if !f(fd_QueryBlockGasResponse_gas, value) {
+func (x *fastReflection_QueryCosmosBaseFeeResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.BaseFee != "" {
+		value := protoreflect.ValueOfString(x.BaseFee)
+		if !f(fd_QueryCosmosBaseFeeResponse_base_fee, value) {
 			return
 		}
 	}
@@ -2033,15 +2033,15 @@ func (x *fastReflection_QueryBlockGasResponse) Range(f func(protoreflect.FieldDe
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryBlockGasResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryCosmosBaseFeeResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.evm.feemarket.v1.QueryBlockGasResponse.gas":
-		return x.Gas != int64(0)
+	case "cosmos.evm.feemarket.v1.QueryCosmosBaseFeeResponse.base_fee":
+		return x.BaseFee != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.feemarket.v1.QueryBlockGasResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.feemarket.v1.QueryCosmosBaseFeeResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.feemarket.v1.QueryBlockGasResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.feemarket.v1.QueryCosmosBaseFeeResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2051,15 +2051,15 @@ func (x *fastReflection_QueryBlockGasResponse) Has(fd protoreflect.FieldDescript
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryBlockGasResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryCosmosBaseFeeResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.evm.feemarket.v1.QueryBlockGasResponse.gas":
-		x.Gas = int64(0)
+	case "cosmos.evm.feemarket.v1.QueryCosmosBaseFeeResponse.base_fee":
+		x.BaseFee = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.feemarket.v1.QueryBlockGasResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.feemarket.v1.QueryCosmosBaseFeeResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.feemarket.v1.QueryBlockGasResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.feemarket.v1.QueryCosmosBaseFeeResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2069,16 +2069,16 @@ func (x *fastReflection_QueryBlockGasResponse) Clear(fd protoreflect.FieldDescri
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryBlockGasResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryCosmosBaseFeeResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.evm.feemarket.v1.QueryBlockGasResponse.gas":
-		value := x.Gas
-		return protoreflect.ValueOfInt64(value)
+	case "cosmos.evm.feemarket.v1.QueryCosmosBaseFeeResponse.base_fee":
+		value := x.BaseFee
+		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.feemarket.v1.QueryBlockGasResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.feemarket.v1.QueryCosmosBaseFeeResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.feemarket.v1.QueryBlockGasResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.feemarket.v1.QueryCosmosBaseFeeResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -2092,15 +2092,15 @@ func (x *fastReflection_QueryBlockGasResponse) Get(descriptor protoreflect.Field
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryBlockGasResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryCosmosBaseFeeResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.evm.feemarket.v1.QueryBlockGasResponse.gas":
-		x.Gas = value.Int()
+	case "cosmos.evm.feemarket.v1.QueryCosmosBaseFeeResponse.base_fee":
+		x.BaseFee = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.feemarket.v1.QueryBlockGasResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.feemarket.v1.QueryCosmosBaseFeeResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.feemarket.v1.QueryBlockGasResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.feemarket.v1.QueryCosmosBaseFeeResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2114,40 +2114,40 @@ func (x *fastReflection_QueryBlockGasResponse) Set(fd protoreflect.FieldDescript
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryBlockGasResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryCosmosBaseFeeResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.evm.feemarket.v1.QueryBlockGasResponse.gas":
-		panic(fmt.Errorf("field gas of message cosmos.evm.feemarket.v1.QueryBlockGasResponse is not mutable"))
+	case "cosmos.evm.feemarket.v1.QueryCosmosBaseFeeResponse.base_fee":
+		panic(fmt.Errorf("field base_fee of message cosmos.evm.feemarket.v1.QueryCosmosBaseFeeResponse is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.feemarket.v1.QueryBlockGasResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.feemarket.v1.QueryCosmosBaseFeeResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.feemarket.v1.QueryBlockGasResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.feemarket.v1.QueryCosmosBaseFeeResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryBlockGasResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryCosmosBaseFeeResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.evm.feemarket.v1.QueryBlockGasResponse.gas":
-		return protoreflect.ValueOfInt64(int64(0))
+	case "cosmos.evm.feemarket.v1.QueryCosmosBaseFeeResponse.base_fee":
+		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.feemarket.v1.QueryBlockGasResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.feemarket.v1.QueryCosmosBaseFeeResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.feemarket.v1.QueryBlockGasResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.feemarket.v1.QueryCosmosBaseFeeResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryBlockGasResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryCosmosBaseFeeResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.evm.feemarket.v1.QueryBlockGasResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.evm.feemarket.v1.QueryCosmosBaseFeeResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -2155,7 +2155,7 @@ func (x *fastReflection_QueryBlockGasResponse) WhichOneof(d protoreflect.OneofDe
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryBlockGasResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryCosmosBaseFeeResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -2166,7 +2166,7 @@ func (x *fastReflection_QueryBlockGasResponse) GetUnknown() protoreflect.RawFiel
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryBlockGasResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryCosmosBaseFeeResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -2178,7 +2178,7 @@ func (x *fastReflection_QueryBlockGasResponse) SetUnknown(fields protoreflect.Ra
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryBlockGasResponse) IsValid() bool {
+func (x *fastReflection_QueryCosmosBaseFeeResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -2188,9 +2188,9 @@ func (x *fastReflection_QueryBlockGasResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryBlockGasResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryCosmosBaseFeeResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryBlockGasResponse)
+		x := input.Message.Interface().(*QueryCosmosBaseFeeResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2202,8 +2202,9 @@ func (x *fastReflection_QueryBlockGasResponse) ProtoMethods() *protoiface.Method
 		var n int
 		var l int
 		_ = l
-		if x.Gas != 0 {
-			n += 1 + runtime.Sov(uint64(x.Gas))
+		l = len(x.BaseFee)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -2215,7 +2216,7 @@ func (x *fastReflection_QueryBlockGasResponse) ProtoMethods() *protoiface.Method
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryBlockGasResponse)
+		x := input.Message.Interface().(*QueryCosmosBaseFeeResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2234,10 +2235,12 @@ func (x *fastReflection_QueryBlockGasResponse) ProtoMethods() *protoiface.Method
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Gas != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.Gas))
+		if len(x.BaseFee) > 0 {
+			i -= len(x.BaseFee)
+			copy(dAtA[i:], x.BaseFee)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BaseFee)))
 			i--
-			dAtA[i] = 0x8
+			dAtA[i] = 0xa
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -2250,7 +2253,7 @@ func (x *fastReflection_QueryBlockGasResponse) ProtoMethods() *protoiface.Method
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryBlockGasResponse)
+		x := input.Message.Interface().(*QueryCosmosBaseFeeResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2282,17 +2285,17 @@ func (x *fastReflection_QueryBlockGasResponse) ProtoMethods() *protoiface.Method
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryBlockGasResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryCosmosBaseFeeResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryBlockGasResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryCosmosBaseFeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Gas", wireType)
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BaseFee", wireType)
 				}
-				x.Gas = 0
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -2302,11 +2305,24 @@ func (x *fastReflection_QueryBlockGasResponse) ProtoMethods() *protoiface.Method
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.Gas |= int64(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.BaseFee = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -2342,67 +2358,2641 @@ func (x *fastReflection_QueryBlockGasResponse) ProtoMethods() *protoiface.Method
 	}
 }
 
-// Code generated by protoc-gen-go. DO NOT EDIT.
-// versions:
-// 	protoc-gen-go v1.27.0
-// 	protoc        (unknown)
-// source: cosmos/evm/feemarket/v1/query.proto
-
-const (
-	// Verify that this generated code is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
-	// Verify that runtime/protoimpl is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+var (
+	md_QueryBlockGasRequest protoreflect.MessageDescriptor
 )
 
-// QueryParamsRequest defines the request type for querying x/vm parameters.
-type QueryParamsRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func init() {
+	file_cosmos_evm_feemarket_v1_query_proto_init()
+	md_QueryBlockGasRequest = File_cosmos_evm_feemarket_v1_query_proto.Messages().ByName("QueryBlockGasRequest")
 }
 
-func (x *QueryParamsRequest) Reset() {
-	*x = QueryParamsRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_evm_feemarket_v1_query_proto_msgTypes[0]
+var _ protoreflect.Message = (*fastReflection_QueryBlockGasRequest)(nil)
+
+type fastReflection_QueryBlockGasRequest QueryBlockGasRequest
+
+func (x *QueryBlockGasRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryBlockGasRequest)(x)
+}
+
+func (x *QueryBlockGasRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_evm_feemarket_v1_query_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
+	return mi.MessageOf(x)
 }
 
-func (x *QueryParamsRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
+var _fastReflection_QueryBlockGasRequest_messageType fastReflection_QueryBlockGasRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryBlockGasRequest_messageType{}
 
-func (*QueryParamsRequest) ProtoMessage() {}
+type fastReflection_QueryBlockGasRequest_messageType struct{}
 
-// Deprecated: Use QueryParamsRequest.ProtoReflect.Descriptor instead.
-func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
-	return file_cosmos_evm_feemarket_v1_query_proto_rawDescGZIP(), []int{0}
+func (x fastReflection_QueryBlockGasRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryBlockGasRequest)(nil)
+}
+func (x fastReflection_QueryBlockGasRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryBlockGasRequest)
+}
+func (x fastReflection_QueryBlockGasRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryBlockGasRequest
 }
 
-// QueryParamsResponse defines the response type for querying x/vm parameters.
-type QueryParamsResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	// params define the evm module parameters.
-	Params *Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryBlockGasRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryBlockGasRequest
 }
 
-func (x *QueryParamsResponse) Reset() {
-	*x = QueryParamsResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_evm_feemarket_v1_query_proto_msgTypes[1]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryBlockGasRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryBlockGasRequest_messageType
 }
 
-func (x *QueryParamsResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryBlockGasRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryBlockGasRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryBlockGasRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryBlockGasRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryBlockGasRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryBlockGasRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.feemarket.v1.QueryBlockGasRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.feemarket.v1.QueryBlockGasRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryBlockGasRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.feemarket.v1.QueryBlockGasRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.feemarket.v1.QueryBlockGasRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryBlockGasRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.feemarket.v1.QueryBlockGasRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.feemarket.v1.QueryBlockGasRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryBlockGasRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.feemarket.v1.QueryBlockGasRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.feemarket.v1.QueryBlockGasRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryBlockGasRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.feemarket.v1.QueryBlockGasRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.feemarket.v1.QueryBlockGasRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryBlockGasRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.feemarket.v1.QueryBlockGasRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.feemarket.v1.QueryBlockGasRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryBlockGasRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.evm.feemarket.v1.QueryBlockGasRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryBlockGasRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryBlockGasRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryBlockGasRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryBlockGasRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryBlockGasRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryBlockGasRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryBlockGasRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryBlockGasRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryBlockGasRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryBlockGasResponse     protoreflect.MessageDescriptor
+	fd_QueryBlockGasResponse_gas protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_evm_feemarket_v1_query_proto_init()
+	md_QueryBlockGasResponse = File_cosmos_evm_feemarket_v1_query_proto.Messages().ByName("QueryBlockGasResponse")
+	fd_QueryBlockGasResponse_gas = md_QueryBlockGasResponse.Fields().ByName("gas")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryBlockGasResponse)(nil)
+
+type fastReflection_QueryBlockGasResponse QueryBlockGasResponse
+
+func (x *QueryBlockGasResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryBlockGasResponse)(x)
+}
+
+func (x *QueryBlockGasResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_evm_feemarket_v1_query_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryBlockGasResponse_messageType fastReflection_QueryBlockGasResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryBlockGasResponse_messageType{}
+
+type fastReflection_QueryBlockGasResponse_messageType struct{}
+
+func (x fastReflection_QueryBlockGasResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryBlockGasResponse)(nil)
+}
+func (x fastReflection_QueryBlockGasResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryBlockGasResponse)
+}
+func (x fastReflection_QueryBlockGasResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryBlockGasResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryBlockGasResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryBlockGasResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryBlockGasResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryBlockGasResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryBlockGasResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryBlockGasResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryBlockGasResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryBlockGasResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryBlockGasResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Gas != int64(0) {
+		value := protoreflect.ValueOfInt64(x.Gas)
+		if !f(fd_QueryBlockGasResponse_gas, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryBlockGasResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.evm.feemarket.v1.QueryBlockGasResponse.gas":
+		return x.Gas != int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.feemarket.v1.QueryBlockGasResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.feemarket.v1.QueryBlockGasResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryBlockGasResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.evm.feemarket.v1.QueryBlockGasResponse.gas":
+		x.Gas = int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.feemarket.v1.QueryBlockGasResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.feemarket.v1.QueryBlockGasResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryBlockGasResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.evm.feemarket.v1.QueryBlockGasResponse.gas":
+		value := x.Gas
+		return protoreflect.ValueOfInt64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.feemarket.v1.QueryBlockGasResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.feemarket.v1.QueryBlockGasResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryBlockGasResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.evm.feemarket.v1.QueryBlockGasResponse.gas":
+		x.Gas = value.Int()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.feemarket.v1.QueryBlockGasResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.feemarket.v1.QueryBlockGasResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryBlockGasResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.evm.feemarket.v1.QueryBlockGasResponse.gas":
+		panic(fmt.Errorf("field gas of message cosmos.evm.feemarket.v1.QueryBlockGasResponse is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.feemarket.v1.QueryBlockGasResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.feemarket.v1.QueryBlockGasResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryBlockGasResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.evm.feemarket.v1.QueryBlockGasResponse.gas":
+		return protoreflect.ValueOfInt64(int64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.feemarket.v1.QueryBlockGasResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.feemarket.v1.QueryBlockGasResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryBlockGasResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.evm.feemarket.v1.QueryBlockGasResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryBlockGasResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryBlockGasResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryBlockGasResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryBlockGasResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryBlockGasResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Gas != 0 {
+			n += 1 + runtime.Sov(uint64(x.Gas))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryBlockGasResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Gas != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Gas))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryBlockGasResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryBlockGasResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryBlockGasResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Gas", wireType)
+				}
+				x.Gas = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Gas |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryBurnedFeesRequest protoreflect.MessageDescriptor
+)
+
+func init() {
+	file_cosmos_evm_feemarket_v1_query_proto_init()
+	md_QueryBurnedFeesRequest = File_cosmos_evm_feemarket_v1_query_proto.Messages().ByName("QueryBurnedFeesRequest")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryBurnedFeesRequest)(nil)
+
+type fastReflection_QueryBurnedFeesRequest QueryBurnedFeesRequest
+
+func (x *QueryBurnedFeesRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryBurnedFeesRequest)(x)
+}
+
+func (x *QueryBurnedFeesRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_evm_feemarket_v1_query_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryBurnedFeesRequest_messageType fastReflection_QueryBurnedFeesRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryBurnedFeesRequest_messageType{}
+
+type fastReflection_QueryBurnedFeesRequest_messageType struct{}
+
+func (x fastReflection_QueryBurnedFeesRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryBurnedFeesRequest)(nil)
+}
+func (x fastReflection_QueryBurnedFeesRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryBurnedFeesRequest)
+}
+func (x fastReflection_QueryBurnedFeesRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryBurnedFeesRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryBurnedFeesRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryBurnedFeesRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryBurnedFeesRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryBurnedFeesRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryBurnedFeesRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryBurnedFeesRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryBurnedFeesRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryBurnedFeesRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryBurnedFeesRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryBurnedFeesRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.feemarket.v1.QueryBurnedFeesRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.feemarket.v1.QueryBurnedFeesRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryBurnedFeesRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.feemarket.v1.QueryBurnedFeesRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.feemarket.v1.QueryBurnedFeesRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryBurnedFeesRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.feemarket.v1.QueryBurnedFeesRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.feemarket.v1.QueryBurnedFeesRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryBurnedFeesRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.feemarket.v1.QueryBurnedFeesRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.feemarket.v1.QueryBurnedFeesRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryBurnedFeesRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.feemarket.v1.QueryBurnedFeesRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.feemarket.v1.QueryBurnedFeesRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryBurnedFeesRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.feemarket.v1.QueryBurnedFeesRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.feemarket.v1.QueryBurnedFeesRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryBurnedFeesRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.evm.feemarket.v1.QueryBurnedFeesRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryBurnedFeesRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryBurnedFeesRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryBurnedFeesRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryBurnedFeesRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryBurnedFeesRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryBurnedFeesRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryBurnedFeesRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryBurnedFeesRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryBurnedFeesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryBurnedFeesResponse                   protoreflect.MessageDescriptor
+	fd_QueryBurnedFeesResponse_total_burned_fees protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_evm_feemarket_v1_query_proto_init()
+	md_QueryBurnedFeesResponse = File_cosmos_evm_feemarket_v1_query_proto.Messages().ByName("QueryBurnedFeesResponse")
+	fd_QueryBurnedFeesResponse_total_burned_fees = md_QueryBurnedFeesResponse.Fields().ByName("total_burned_fees")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryBurnedFeesResponse)(nil)
+
+type fastReflection_QueryBurnedFeesResponse QueryBurnedFeesResponse
+
+func (x *QueryBurnedFeesResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryBurnedFeesResponse)(x)
+}
+
+func (x *QueryBurnedFeesResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_evm_feemarket_v1_query_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryBurnedFeesResponse_messageType fastReflection_QueryBurnedFeesResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryBurnedFeesResponse_messageType{}
+
+type fastReflection_QueryBurnedFeesResponse_messageType struct{}
+
+func (x fastReflection_QueryBurnedFeesResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryBurnedFeesResponse)(nil)
+}
+func (x fastReflection_QueryBurnedFeesResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryBurnedFeesResponse)
+}
+func (x fastReflection_QueryBurnedFeesResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryBurnedFeesResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryBurnedFeesResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryBurnedFeesResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryBurnedFeesResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryBurnedFeesResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryBurnedFeesResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryBurnedFeesResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryBurnedFeesResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryBurnedFeesResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryBurnedFeesResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.TotalBurnedFees != "" {
+		value := protoreflect.ValueOfString(x.TotalBurnedFees)
+		if !f(fd_QueryBurnedFeesResponse_total_burned_fees, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryBurnedFeesResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.evm.feemarket.v1.QueryBurnedFeesResponse.total_burned_fees":
+		return x.TotalBurnedFees != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.feemarket.v1.QueryBurnedFeesResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.feemarket.v1.QueryBurnedFeesResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryBurnedFeesResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.evm.feemarket.v1.QueryBurnedFeesResponse.total_burned_fees":
+		x.TotalBurnedFees = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.feemarket.v1.QueryBurnedFeesResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.feemarket.v1.QueryBurnedFeesResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryBurnedFeesResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.evm.feemarket.v1.QueryBurnedFeesResponse.total_burned_fees":
+		value := x.TotalBurnedFees
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.feemarket.v1.QueryBurnedFeesResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.feemarket.v1.QueryBurnedFeesResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryBurnedFeesResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.evm.feemarket.v1.QueryBurnedFeesResponse.total_burned_fees":
+		x.TotalBurnedFees = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.feemarket.v1.QueryBurnedFeesResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.feemarket.v1.QueryBurnedFeesResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryBurnedFeesResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.evm.feemarket.v1.QueryBurnedFeesResponse.total_burned_fees":
+		panic(fmt.Errorf("field total_burned_fees of message cosmos.evm.feemarket.v1.QueryBurnedFeesResponse is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.feemarket.v1.QueryBurnedFeesResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.feemarket.v1.QueryBurnedFeesResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryBurnedFeesResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.evm.feemarket.v1.QueryBurnedFeesResponse.total_burned_fees":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.feemarket.v1.QueryBurnedFeesResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.feemarket.v1.QueryBurnedFeesResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryBurnedFeesResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.evm.feemarket.v1.QueryBurnedFeesResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryBurnedFeesResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryBurnedFeesResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryBurnedFeesResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryBurnedFeesResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryBurnedFeesResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.TotalBurnedFees)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryBurnedFeesResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.TotalBurnedFees) > 0 {
+			i -= len(x.TotalBurnedFees)
+			copy(dAtA[i:], x.TotalBurnedFees)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.TotalBurnedFees)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryBurnedFeesResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryBurnedFeesResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryBurnedFeesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TotalBurnedFees", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.TotalBurnedFees = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_QueryMsgFeeFloorsRequest_1_list)(nil)
+
+type _QueryMsgFeeFloorsRequest_1_list struct {
+	list *[]string
+}
+
+func (x *_QueryMsgFeeFloorsRequest_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QueryMsgFeeFloorsRequest_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_QueryMsgFeeFloorsRequest_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QueryMsgFeeFloorsRequest_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QueryMsgFeeFloorsRequest_1_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message QueryMsgFeeFloorsRequest at list field MsgTypeUrls as it is not of Message kind"))
+}
+
+func (x *_QueryMsgFeeFloorsRequest_1_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QueryMsgFeeFloorsRequest_1_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_QueryMsgFeeFloorsRequest_1_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_QueryMsgFeeFloorsRequest               protoreflect.MessageDescriptor
+	fd_QueryMsgFeeFloorsRequest_msg_type_urls protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_evm_feemarket_v1_query_proto_init()
+	md_QueryMsgFeeFloorsRequest = File_cosmos_evm_feemarket_v1_query_proto.Messages().ByName("QueryMsgFeeFloorsRequest")
+	fd_QueryMsgFeeFloorsRequest_msg_type_urls = md_QueryMsgFeeFloorsRequest.Fields().ByName("msg_type_urls")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryMsgFeeFloorsRequest)(nil)
+
+type fastReflection_QueryMsgFeeFloorsRequest QueryMsgFeeFloorsRequest
+
+func (x *QueryMsgFeeFloorsRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryMsgFeeFloorsRequest)(x)
+}
+
+func (x *QueryMsgFeeFloorsRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_evm_feemarket_v1_query_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryMsgFeeFloorsRequest_messageType fastReflection_QueryMsgFeeFloorsRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryMsgFeeFloorsRequest_messageType{}
+
+type fastReflection_QueryMsgFeeFloorsRequest_messageType struct{}
+
+func (x fastReflection_QueryMsgFeeFloorsRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryMsgFeeFloorsRequest)(nil)
+}
+func (x fastReflection_QueryMsgFeeFloorsRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryMsgFeeFloorsRequest)
+}
+func (x fastReflection_QueryMsgFeeFloorsRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryMsgFeeFloorsRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryMsgFeeFloorsRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryMsgFeeFloorsRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryMsgFeeFloorsRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryMsgFeeFloorsRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryMsgFeeFloorsRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryMsgFeeFloorsRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryMsgFeeFloorsRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryMsgFeeFloorsRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryMsgFeeFloorsRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.MsgTypeUrls) != 0 {
+		value := protoreflect.ValueOfList(&_QueryMsgFeeFloorsRequest_1_list{list: &x.MsgTypeUrls})
+		if !f(fd_QueryMsgFeeFloorsRequest_msg_type_urls, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryMsgFeeFloorsRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.evm.feemarket.v1.QueryMsgFeeFloorsRequest.msg_type_urls":
+		return len(x.MsgTypeUrls) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.feemarket.v1.QueryMsgFeeFloorsRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.feemarket.v1.QueryMsgFeeFloorsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryMsgFeeFloorsRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.evm.feemarket.v1.QueryMsgFeeFloorsRequest.msg_type_urls":
+		x.MsgTypeUrls = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.feemarket.v1.QueryMsgFeeFloorsRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.feemarket.v1.QueryMsgFeeFloorsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryMsgFeeFloorsRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.evm.feemarket.v1.QueryMsgFeeFloorsRequest.msg_type_urls":
+		if len(x.MsgTypeUrls) == 0 {
+			return protoreflect.ValueOfList(&_QueryMsgFeeFloorsRequest_1_list{})
+		}
+		listValue := &_QueryMsgFeeFloorsRequest_1_list{list: &x.MsgTypeUrls}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.feemarket.v1.QueryMsgFeeFloorsRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.feemarket.v1.QueryMsgFeeFloorsRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryMsgFeeFloorsRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.evm.feemarket.v1.QueryMsgFeeFloorsRequest.msg_type_urls":
+		lv := value.List()
+		clv := lv.(*_QueryMsgFeeFloorsRequest_1_list)
+		x.MsgTypeUrls = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.feemarket.v1.QueryMsgFeeFloorsRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.feemarket.v1.QueryMsgFeeFloorsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryMsgFeeFloorsRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.evm.feemarket.v1.QueryMsgFeeFloorsRequest.msg_type_urls":
+		if x.MsgTypeUrls == nil {
+			x.MsgTypeUrls = []string{}
+		}
+		value := &_QueryMsgFeeFloorsRequest_1_list{list: &x.MsgTypeUrls}
+		return protoreflect.ValueOfList(value)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.feemarket.v1.QueryMsgFeeFloorsRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.feemarket.v1.QueryMsgFeeFloorsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryMsgFeeFloorsRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.evm.feemarket.v1.QueryMsgFeeFloorsRequest.msg_type_urls":
+		list := []string{}
+		return protoreflect.ValueOfList(&_QueryMsgFeeFloorsRequest_1_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.feemarket.v1.QueryMsgFeeFloorsRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.feemarket.v1.QueryMsgFeeFloorsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryMsgFeeFloorsRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.evm.feemarket.v1.QueryMsgFeeFloorsRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryMsgFeeFloorsRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryMsgFeeFloorsRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryMsgFeeFloorsRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryMsgFeeFloorsRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryMsgFeeFloorsRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if len(x.MsgTypeUrls) > 0 {
+			for _, s := range x.MsgTypeUrls {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryMsgFeeFloorsRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.MsgTypeUrls) > 0 {
+			for iNdEx := len(x.MsgTypeUrls) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.MsgTypeUrls[iNdEx])
+				copy(dAtA[i:], x.MsgTypeUrls[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MsgTypeUrls[iNdEx])))
+				i--
+				dAtA[i] = 0xa
+			}
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryMsgFeeFloorsRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryMsgFeeFloorsRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryMsgFeeFloorsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrls", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MsgTypeUrls = append(x.MsgTypeUrls, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_QueryMsgFeeFloorsResponse_1_list)(nil)
+
+type _QueryMsgFeeFloorsResponse_1_list struct {
+	list *[]*MsgFeeFloor
+}
+
+func (x *_QueryMsgFeeFloorsResponse_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QueryMsgFeeFloorsResponse_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_QueryMsgFeeFloorsResponse_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*MsgFeeFloor)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QueryMsgFeeFloorsResponse_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*MsgFeeFloor)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QueryMsgFeeFloorsResponse_1_list) AppendMutable() protoreflect.Value {
+	v := new(MsgFeeFloor)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryMsgFeeFloorsResponse_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QueryMsgFeeFloorsResponse_1_list) NewElement() protoreflect.Value {
+	v := new(MsgFeeFloor)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryMsgFeeFloorsResponse_1_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_QueryMsgFeeFloorsResponse                protoreflect.MessageDescriptor
+	fd_QueryMsgFeeFloorsResponse_msg_fee_floors protoreflect.FieldDescriptor
+	fd_QueryMsgFeeFloorsResponse_min_gas_price  protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_evm_feemarket_v1_query_proto_init()
+	md_QueryMsgFeeFloorsResponse = File_cosmos_evm_feemarket_v1_query_proto.Messages().ByName("QueryMsgFeeFloorsResponse")
+	fd_QueryMsgFeeFloorsResponse_msg_fee_floors = md_QueryMsgFeeFloorsResponse.Fields().ByName("msg_fee_floors")
+	fd_QueryMsgFeeFloorsResponse_min_gas_price = md_QueryMsgFeeFloorsResponse.Fields().ByName("min_gas_price")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryMsgFeeFloorsResponse)(nil)
+
+type fastReflection_QueryMsgFeeFloorsResponse QueryMsgFeeFloorsResponse
+
+func (x *QueryMsgFeeFloorsResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryMsgFeeFloorsResponse)(x)
+}
+
+func (x *QueryMsgFeeFloorsResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_evm_feemarket_v1_query_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryMsgFeeFloorsResponse_messageType fastReflection_QueryMsgFeeFloorsResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryMsgFeeFloorsResponse_messageType{}
+
+type fastReflection_QueryMsgFeeFloorsResponse_messageType struct{}
+
+func (x fastReflection_QueryMsgFeeFloorsResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryMsgFeeFloorsResponse)(nil)
+}
+func (x fastReflection_QueryMsgFeeFloorsResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryMsgFeeFloorsResponse)
+}
+func (x fastReflection_QueryMsgFeeFloorsResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryMsgFeeFloorsResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryMsgFeeFloorsResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryMsgFeeFloorsResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryMsgFeeFloorsResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryMsgFeeFloorsResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryMsgFeeFloorsResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryMsgFeeFloorsResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryMsgFeeFloorsResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryMsgFeeFloorsResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryMsgFeeFloorsResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.MsgFeeFloors) != 0 {
+		value := protoreflect.ValueOfList(&_QueryMsgFeeFloorsResponse_1_list{list: &x.MsgFeeFloors})
+		if !f(fd_QueryMsgFeeFloorsResponse_msg_fee_floors, value) {
+			return
+		}
+	}
+	if x.MinGasPrice != "" {
+		value := protoreflect.ValueOfString(x.MinGasPrice)
+		if !f(fd_QueryMsgFeeFloorsResponse_min_gas_price, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryMsgFeeFloorsResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.evm.feemarket.v1.QueryMsgFeeFloorsResponse.msg_fee_floors":
+		return len(x.MsgFeeFloors) != 0
+	case "cosmos.evm.feemarket.v1.QueryMsgFeeFloorsResponse.min_gas_price":
+		return x.MinGasPrice != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.feemarket.v1.QueryMsgFeeFloorsResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.feemarket.v1.QueryMsgFeeFloorsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryMsgFeeFloorsResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.evm.feemarket.v1.QueryMsgFeeFloorsResponse.msg_fee_floors":
+		x.MsgFeeFloors = nil
+	case "cosmos.evm.feemarket.v1.QueryMsgFeeFloorsResponse.min_gas_price":
+		x.MinGasPrice = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.feemarket.v1.QueryMsgFeeFloorsResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.feemarket.v1.QueryMsgFeeFloorsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryMsgFeeFloorsResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.evm.feemarket.v1.QueryMsgFeeFloorsResponse.msg_fee_floors":
+		if len(x.MsgFeeFloors) == 0 {
+			return protoreflect.ValueOfList(&_QueryMsgFeeFloorsResponse_1_list{})
+		}
+		listValue := &_QueryMsgFeeFloorsResponse_1_list{list: &x.MsgFeeFloors}
+		return protoreflect.ValueOfList(listValue)
+	case "cosmos.evm.feemarket.v1.QueryMsgFeeFloorsResponse.min_gas_price":
+		value := x.MinGasPrice
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.feemarket.v1.QueryMsgFeeFloorsResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.feemarket.v1.QueryMsgFeeFloorsResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryMsgFeeFloorsResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.evm.feemarket.v1.QueryMsgFeeFloorsResponse.msg_fee_floors":
+		lv := value.List()
+		clv := lv.(*_QueryMsgFeeFloorsResponse_1_list)
+		x.MsgFeeFloors = *clv.list
+	case "cosmos.evm.feemarket.v1.QueryMsgFeeFloorsResponse.min_gas_price":
+		x.MinGasPrice = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.feemarket.v1.QueryMsgFeeFloorsResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.feemarket.v1.QueryMsgFeeFloorsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryMsgFeeFloorsResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.evm.feemarket.v1.QueryMsgFeeFloorsResponse.msg_fee_floors":
+		if x.MsgFeeFloors == nil {
+			x.MsgFeeFloors = []*MsgFeeFloor{}
+		}
+		value := &_QueryMsgFeeFloorsResponse_1_list{list: &x.MsgFeeFloors}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.evm.feemarket.v1.QueryMsgFeeFloorsResponse.min_gas_price":
+		panic(fmt.Errorf("field min_gas_price of message cosmos.evm.feemarket.v1.QueryMsgFeeFloorsResponse is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.feemarket.v1.QueryMsgFeeFloorsResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.feemarket.v1.QueryMsgFeeFloorsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryMsgFeeFloorsResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.evm.feemarket.v1.QueryMsgFeeFloorsResponse.msg_fee_floors":
+		list := []*MsgFeeFloor{}
+		return protoreflect.ValueOfList(&_QueryMsgFeeFloorsResponse_1_list{list: &list})
+	case "cosmos.evm.feemarket.v1.QueryMsgFeeFloorsResponse.min_gas_price":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.feemarket.v1.QueryMsgFeeFloorsResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.feemarket.v1.QueryMsgFeeFloorsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryMsgFeeFloorsResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.evm.feemarket.v1.QueryMsgFeeFloorsResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryMsgFeeFloorsResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryMsgFeeFloorsResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryMsgFeeFloorsResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryMsgFeeFloorsResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryMsgFeeFloorsResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if len(x.MsgFeeFloors) > 0 {
+			for _, e := range x.MsgFeeFloors {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		l = len(x.MinGasPrice)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryMsgFeeFloorsResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.MinGasPrice) > 0 {
+			i -= len(x.MinGasPrice)
+			copy(dAtA[i:], x.MinGasPrice)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MinGasPrice)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.MsgFeeFloors) > 0 {
+			for iNdEx := len(x.MsgFeeFloors) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.MsgFeeFloors[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
+			}
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryMsgFeeFloorsResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryMsgFeeFloorsResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryMsgFeeFloorsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MsgFeeFloors", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MsgFeeFloors = append(x.MsgFeeFloors, &MsgFeeFloor{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.MsgFeeFloors[len(x.MsgFeeFloors)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MinGasPrice", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MinGasPrice = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: cosmos/evm/feemarket/v1/query.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// QueryParamsRequest defines the request type for querying x/vm parameters.
+type QueryParamsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *QueryParamsRequest) Reset() {
+	*x = QueryParamsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_evm_feemarket_v1_query_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryParamsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryParamsRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryParamsRequest.ProtoReflect.Descriptor instead.
+func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
+	return file_cosmos_evm_feemarket_v1_query_proto_rawDescGZIP(), []int{0}
+}
+
+// QueryParamsResponse defines the response type for querying x/vm parameters.
+type QueryParamsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// params define the evm module parameters.
+	Params *Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
+}
+
+func (x *QueryParamsResponse) Reset() {
+	*x = QueryParamsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_evm_feemarket_v1_query_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryParamsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
 func (*QueryParamsResponse) ProtoMessage() {}
@@ -2484,6 +5074,71 @@ func (x *QueryBaseFeeResponse) GetBaseFee() string {
 	return ""
 }
 
+// QueryCosmosBaseFeeRequest defines the request type for querying the base fee
+// of the Cosmos lane.
+type QueryCosmosBaseFeeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *QueryCosmosBaseFeeRequest) Reset() {
+	*x = QueryCosmosBaseFeeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_evm_feemarket_v1_query_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryCosmosBaseFeeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryCosmosBaseFeeRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryCosmosBaseFeeRequest.ProtoReflect.Descriptor instead.
+func (*QueryCosmosBaseFeeRequest) Descriptor() ([]byte, []int) {
+	return file_cosmos_evm_feemarket_v1_query_proto_rawDescGZIP(), []int{4}
+}
+
+// QueryCosmosBaseFeeResponse returns the base fee of the Cosmos lane.
+type QueryCosmosBaseFeeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// base_fee is the base fee of the Cosmos lane
+	BaseFee string `protobuf:"bytes,1,opt,name=base_fee,json=baseFee,proto3" json:"base_fee,omitempty"`
+}
+
+func (x *QueryCosmosBaseFeeResponse) Reset() {
+	*x = QueryCosmosBaseFeeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_evm_feemarket_v1_query_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryCosmosBaseFeeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryCosmosBaseFeeResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryCosmosBaseFeeResponse.ProtoReflect.Descriptor instead.
+func (*QueryCosmosBaseFeeResponse) Descriptor() ([]byte, []int) {
+	return file_cosmos_evm_feemarket_v1_query_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *QueryCosmosBaseFeeResponse) GetBaseFee() string {
+	if x != nil {
+		return x.BaseFee
+	}
+	return ""
+}
+
 // QueryBlockGasRequest defines the request type for querying the EIP1559 base
 // fee.
 type QueryBlockGasRequest struct {
@@ -2495,7 +5150,7 @@ type QueryBlockGasRequest struct {
 func (x *QueryBlockGasRequest) Reset() {
 	*x = QueryBlockGasRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_evm_feemarket_v1_query_proto_msgTypes[4]
+		mi := &file_cosmos_evm_feemarket_v1_query_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2509,7 +5164,7 @@ func (*QueryBlockGasRequest) ProtoMessage() {}
 
 // Deprecated: Use QueryBlockGasRequest.ProtoReflect.Descriptor instead.
 func (*QueryBlockGasRequest) Descriptor() ([]byte, []int) {
-	return file_cosmos_evm_feemarket_v1_query_proto_rawDescGZIP(), []int{4}
+	return file_cosmos_evm_feemarket_v1_query_proto_rawDescGZIP(), []int{6}
 }
 
 // QueryBlockGasResponse returns block gas used for a given height.
@@ -2525,7 +5180,7 @@ type QueryBlockGasResponse struct {
 func (x *QueryBlockGasResponse) Reset() {
 	*x = QueryBlockGasResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_evm_feemarket_v1_query_proto_msgTypes[5]
+		mi := &file_cosmos_evm_feemarket_v1_query_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2539,7 +5194,7 @@ func (*QueryBlockGasResponse) ProtoMessage() {}
 
 // Deprecated: Use QueryBlockGasResponse.ProtoReflect.Descriptor instead.
 func (*QueryBlockGasResponse) Descriptor() ([]byte, []int) {
-	return file_cosmos_evm_feemarket_v1_query_proto_rawDescGZIP(), []int{5}
+	return file_cosmos_evm_feemarket_v1_query_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *QueryBlockGasResponse) GetGas() int64 {
@@ -2549,6 +5204,159 @@ func (x *QueryBlockGasResponse) GetGas() int64 {
 	return 0
 }
 
+// QueryBurnedFeesRequest defines the request type for querying the cumulative
+// amount of base fee revenue burned.
+type QueryBurnedFeesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *QueryBurnedFeesRequest) Reset() {
+	*x = QueryBurnedFeesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_evm_feemarket_v1_query_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryBurnedFeesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryBurnedFeesRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryBurnedFeesRequest.ProtoReflect.Descriptor instead.
+func (*QueryBurnedFeesRequest) Descriptor() ([]byte, []int) {
+	return file_cosmos_evm_feemarket_v1_query_proto_rawDescGZIP(), []int{8}
+}
+
+// QueryBurnedFeesResponse returns the cumulative amount of base fee revenue
+// burned.
+type QueryBurnedFeesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// total_burned_fees is the cumulative amount burned, in the extended EVM
+	// denom
+	TotalBurnedFees string `protobuf:"bytes,1,opt,name=total_burned_fees,json=totalBurnedFees,proto3" json:"total_burned_fees,omitempty"`
+}
+
+func (x *QueryBurnedFeesResponse) Reset() {
+	*x = QueryBurnedFeesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_evm_feemarket_v1_query_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryBurnedFeesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryBurnedFeesResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryBurnedFeesResponse.ProtoReflect.Descriptor instead.
+func (*QueryBurnedFeesResponse) Descriptor() ([]byte, []int) {
+	return file_cosmos_evm_feemarket_v1_query_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *QueryBurnedFeesResponse) GetTotalBurnedFees() string {
+	if x != nil {
+		return x.TotalBurnedFees
+	}
+	return ""
+}
+
+// QueryMsgFeeFloorsRequest defines the request type for querying the fee
+// floors of the message types.
+type QueryMsgFeeFloorsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// msg_type_urls filters the fee floors by message type url, all the fee
+	// floors are returned if empty
+	MsgTypeUrls []string `protobuf:"bytes,1,rep,name=msg_type_urls,json=msgTypeUrls,proto3" json:"msg_type_urls,omitempty"`
+}
+
+func (x *QueryMsgFeeFloorsRequest) Reset() {
+	*x = QueryMsgFeeFloorsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_evm_feemarket_v1_query_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryMsgFeeFloorsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryMsgFeeFloorsRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryMsgFeeFloorsRequest.ProtoReflect.Descriptor instead.
+func (*QueryMsgFeeFloorsRequest) Descriptor() ([]byte, []int) {
+	return file_cosmos_evm_feemarket_v1_query_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *QueryMsgFeeFloorsRequest) GetMsgTypeUrls() []string {
+	if x != nil {
+		return x.MsgTypeUrls
+	}
+	return nil
+}
+
+// QueryMsgFeeFloorsResponse returns the fee floors of the message types.
+type QueryMsgFeeFloorsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// msg_fee_floors are the fee floors of the message types
+	MsgFeeFloors []*MsgFeeFloor `protobuf:"bytes,1,rep,name=msg_fee_floors,json=msgFeeFloors,proto3" json:"msg_fee_floors,omitempty"`
+	// min_gas_price is the minimum gas price multiplied by the gas price
+	// multipliers of the fee floors
+	MinGasPrice string `protobuf:"bytes,2,opt,name=min_gas_price,json=minGasPrice,proto3" json:"min_gas_price,omitempty"`
+}
+
+func (x *QueryMsgFeeFloorsResponse) Reset() {
+	*x = QueryMsgFeeFloorsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_evm_feemarket_v1_query_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryMsgFeeFloorsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryMsgFeeFloorsResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryMsgFeeFloorsResponse.ProtoReflect.Descriptor instead.
+func (*QueryMsgFeeFloorsResponse) Descriptor() ([]byte, []int) {
+	return file_cosmos_evm_feemarket_v1_query_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *QueryMsgFeeFloorsResponse) GetMsgFeeFloors() []*MsgFeeFloor {
+	if x != nil {
+		return x.MsgFeeFloors
+	}
+	return nil
+}
+
+func (x *QueryMsgFeeFloorsResponse) GetMinGasPrice() string {
+	if x != nil {
+		return x.MinGasPrice
+	}
+	return ""
+}
+
 var File_cosmos_evm_feemarket_v1_query_proto protoreflect.FileDescriptor
 
 var file_cosmos_evm_feemarket_v1_query_proto_rawDesc = []byte{
@@ -2576,54 +5384,117 @@ var file_cosmos_evm_feemarket_v1_query_proto_rawDesc = []byte{
 	0x0a, 0x08, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
 	0x42, 0x1f, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e,
 	0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65,
-	0x63, 0x52, 0x07, 0x62, 0x61, 0x73, 0x65, 0x46, 0x65, 0x65, 0x22, 0x16, 0x0a, 0x14, 0x51, 0x75,
+	0x63, 0x52, 0x07, 0x62, 0x61, 0x73, 0x65, 0x46, 0x65, 0x65, 0x22, 0x1b, 0x0a, 0x19, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x42, 0x61, 0x73, 0x65, 0x46, 0x65, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x58, 0x0a, 0x1a, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x42, 0x61, 0x73, 0x65, 0x46, 0x65, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3a, 0x0a, 0x08, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x66, 0x65,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1f, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c,
+	0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0x52, 0x07, 0x62, 0x61, 0x73, 0x65, 0x46, 0x65,
+	0x65, 0x22, 0x16, 0x0a, 0x14, 0x51, 0x75, 0x65, 0x72, 0x79, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x47,
+	0x61, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x29, 0x0a, 0x15, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x47, 0x61, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x67, 0x61, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x03, 0x67, 0x61, 0x73, 0x22, 0x18, 0x0a, 0x16, 0x51, 0x75, 0x65, 0x72, 0x79, 0x42, 0x75, 0x72,
+	0x6e, 0x65, 0x64, 0x46, 0x65, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x69,
+	0x0a, 0x17, 0x51, 0x75, 0x65, 0x72, 0x79, 0x42, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x46, 0x65, 0x65,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4e, 0x0a, 0x11, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x5f, 0x62, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x5f, 0x66, 0x65, 0x65, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x22, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e,
+	0x49, 0x6e, 0x74, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x42,
+	0x75, 0x72, 0x6e, 0x65, 0x64, 0x46, 0x65, 0x65, 0x73, 0x22, 0x3e, 0x0a, 0x18, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x4d, 0x73, 0x67, 0x46, 0x65, 0x65, 0x46, 0x6c, 0x6f, 0x6f, 0x72, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x22, 0x0a, 0x0d, 0x6d, 0x73, 0x67, 0x5f, 0x74, 0x79, 0x70,
+	0x65, 0x5f, 0x75, 0x72, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x6d, 0x73,
+	0x67, 0x54, 0x79, 0x70, 0x65, 0x55, 0x72, 0x6c, 0x73, 0x22, 0xc0, 0x01, 0x0a, 0x19, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x4d, 0x73, 0x67, 0x46, 0x65, 0x65, 0x46, 0x6c, 0x6f, 0x6f, 0x72, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x55, 0x0a, 0x0e, 0x6d, 0x73, 0x67, 0x5f, 0x66,
+	0x65, 0x65, 0x5f, 0x66, 0x6c, 0x6f, 0x6f, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x24, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x66, 0x65, 0x65,
+	0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x46, 0x65, 0x65,
+	0x46, 0x6c, 0x6f, 0x6f, 0x72, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01,
+	0x52, 0x0c, 0x6d, 0x73, 0x67, 0x46, 0x65, 0x65, 0x46, 0x6c, 0x6f, 0x6f, 0x72, 0x73, 0x12, 0x4c,
+	0x0a, 0x0d, 0x6d, 0x69, 0x6e, 0x5f, 0x67, 0x61, 0x73, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x28, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68,
+	0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52,
+	0x0b, 0x6d, 0x69, 0x6e, 0x47, 0x61, 0x73, 0x50, 0x72, 0x69, 0x63, 0x65, 0x32, 0xb8, 0x07, 0x0a,
+	0x05, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x8c, 0x01, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d,
+	0x73, 0x12, 0x2b, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x66,
+	0x65, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c,
+	0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x66, 0x65, 0x65, 0x6d,
+	0x61, 0x72, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61,
+	0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x27, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x21, 0x12, 0x1f, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x65, 0x76,
+	0x6d, 0x2f, 0x66, 0x65, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2f, 0x76, 0x31, 0x2f, 0x70,
+	0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x91, 0x01, 0x0a, 0x07, 0x42, 0x61, 0x73, 0x65, 0x46, 0x65,
+	0x65, 0x12, 0x2c, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x66,
+	0x65, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x42, 0x61, 0x73, 0x65, 0x46, 0x65, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x2d, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x66, 0x65, 0x65,
+	0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x42,
+	0x61, 0x73, 0x65, 0x46, 0x65, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x29,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x23, 0x12, 0x21, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f,
+	0x65, 0x76, 0x6d, 0x2f, 0x66, 0x65, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2f, 0x76, 0x31,
+	0x2f, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x66, 0x65, 0x65, 0x12, 0xaa, 0x01, 0x0a, 0x0d, 0x43, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x42, 0x61, 0x73, 0x65, 0x46, 0x65, 0x65, 0x12, 0x32, 0x2e, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x66, 0x65, 0x65, 0x6d, 0x61, 0x72, 0x6b,
+	0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x42, 0x61, 0x73, 0x65, 0x46, 0x65, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x33, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x66, 0x65, 0x65,
+	0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x42, 0x61, 0x73, 0x65, 0x46, 0x65, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x30, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2a, 0x12, 0x28, 0x2f, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x65, 0x76, 0x6d, 0x2f, 0x66, 0x65, 0x65, 0x6d, 0x61, 0x72,
+	0x6b, 0x65, 0x74, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5f, 0x62, 0x61,
+	0x73, 0x65, 0x5f, 0x66, 0x65, 0x65, 0x12, 0x95, 0x01, 0x0a, 0x08, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
+	0x47, 0x61, 0x73, 0x12, 0x2d, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d,
+	0x2e, 0x66, 0x65, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75,
 	0x65, 0x72, 0x79, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x47, 0x61, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x22, 0x29, 0x0a, 0x15, 0x51, 0x75, 0x65, 0x72, 0x79, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
-	0x47, 0x61, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x67,
-	0x61, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x67, 0x61, 0x73, 0x32, 0xc2, 0x03,
-	0x0a, 0x05, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x8c, 0x01, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61,
-	0x6d, 0x73, 0x12, 0x2b, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e,
+	0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e,
 	0x66, 0x65, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65,
-	0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x2c, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x66, 0x65, 0x65,
-	0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50,
-	0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x27, 0x82,
-	0xd3, 0xe4, 0x93, 0x02, 0x21, 0x12, 0x1f, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x65,
-	0x76, 0x6d, 0x2f, 0x66, 0x65, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2f, 0x76, 0x31, 0x2f,
-	0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x91, 0x01, 0x0a, 0x07, 0x42, 0x61, 0x73, 0x65, 0x46,
-	0x65, 0x65, 0x12, 0x2c, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e,
+	0x72, 0x79, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x47, 0x61, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x2a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x24, 0x12, 0x22, 0x2f, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2f, 0x65, 0x76, 0x6d, 0x2f, 0x66, 0x65, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x65,
+	0x74, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x67, 0x61, 0x73, 0x12, 0x9d,
+	0x01, 0x0a, 0x0a, 0x42, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x46, 0x65, 0x65, 0x73, 0x12, 0x2f, 0x2e,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x66, 0x65, 0x65, 0x6d, 0x61,
+	0x72, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x42, 0x75, 0x72,
+	0x6e, 0x65, 0x64, 0x46, 0x65, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30,
+	0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x66, 0x65, 0x65, 0x6d,
+	0x61, 0x72, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x42, 0x75,
+	0x72, 0x6e, 0x65, 0x64, 0x46, 0x65, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x2c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x26, 0x12, 0x24, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2f, 0x65, 0x76, 0x6d, 0x2f, 0x66, 0x65, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2f,
+	0x76, 0x31, 0x2f, 0x62, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x5f, 0x66, 0x65, 0x65, 0x73, 0x12, 0xa6,
+	0x01, 0x0a, 0x0c, 0x4d, 0x73, 0x67, 0x46, 0x65, 0x65, 0x46, 0x6c, 0x6f, 0x6f, 0x72, 0x73, 0x12,
+	0x31, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x66, 0x65, 0x65,
+	0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4d,
+	0x73, 0x67, 0x46, 0x65, 0x65, 0x46, 0x6c, 0x6f, 0x6f, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x32, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e,
 	0x66, 0x65, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65,
-	0x72, 0x79, 0x42, 0x61, 0x73, 0x65, 0x46, 0x65, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x2d, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x66, 0x65,
-	0x65, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79,
-	0x42, 0x61, 0x73, 0x65, 0x46, 0x65, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x29, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x23, 0x12, 0x21, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
-	0x2f, 0x65, 0x76, 0x6d, 0x2f, 0x66, 0x65, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2f, 0x76,
-	0x31, 0x2f, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x66, 0x65, 0x65, 0x12, 0x95, 0x01, 0x0a, 0x08, 0x42,
-	0x6c, 0x6f, 0x63, 0x6b, 0x47, 0x61, 0x73, 0x12, 0x2d, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
-	0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x66, 0x65, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2e, 0x76,
-	0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x47, 0x61, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
-	0x65, 0x76, 0x6d, 0x2e, 0x66, 0x65, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31,
-	0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x47, 0x61, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x24, 0x12, 0x22,
+	0x72, 0x79, 0x4d, 0x73, 0x67, 0x46, 0x65, 0x65, 0x46, 0x6c, 0x6f, 0x6f, 0x72, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x29, 0x12, 0x27,
 	0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x65, 0x76, 0x6d, 0x2f, 0x66, 0x65, 0x65, 0x6d,
-	0x61, 0x72, 0x6b, 0x65, 0x74, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x67,
-	0x61, 0x73, 0x42, 0xde, 0x01, 0x0a, 0x1b, 0x63, 0x6f, 0x6d, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
-	0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x66, 0x65, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2e,
-	0x76, 0x31, 0x42, 0x0a, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01,
-	0x5a, 0x34, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61,
-	0x70, 0x69, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x65, 0x76, 0x6d, 0x2f, 0x66, 0x65,
-	0x65, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2f, 0x76, 0x31, 0x3b, 0x66, 0x65, 0x65, 0x6d, 0x61,
-	0x72, 0x6b, 0x65, 0x74, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x43, 0x45, 0x46, 0xaa, 0x02, 0x17, 0x43,
-	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x45, 0x76, 0x6d, 0x2e, 0x46, 0x65, 0x65, 0x6d, 0x61, 0x72,
-	0x6b, 0x65, 0x74, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x17, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c,
-	0x45, 0x76, 0x6d, 0x5c, 0x46, 0x65, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x5c, 0x56, 0x31,
-	0xe2, 0x02, 0x23, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x45, 0x76, 0x6d, 0x5c, 0x46, 0x65,
-	0x65, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65,
-	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x1a, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x3a,
-	0x3a, 0x45, 0x76, 0x6d, 0x3a, 0x3a, 0x46, 0x65, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x3a,
-	0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x61, 0x72, 0x6b, 0x65, 0x74, 0x2f, 0x76, 0x31, 0x2f, 0x6d, 0x73, 0x67, 0x5f, 0x66, 0x65, 0x65,
+	0x5f, 0x66, 0x6c, 0x6f, 0x6f, 0x72, 0x73, 0x42, 0xde, 0x01, 0x0a, 0x1b, 0x63, 0x6f, 0x6d, 0x2e,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x66, 0x65, 0x65, 0x6d, 0x61,
+	0x72, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x42, 0x0a, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x72,
+	0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x34, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b,
+	0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x65,
+	0x76, 0x6d, 0x2f, 0x66, 0x65, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2f, 0x76, 0x31, 0x3b,
+	0x66, 0x65, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x43, 0x45,
+	0x46, 0xaa, 0x02, 0x17, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x45, 0x76, 0x6d, 0x2e, 0x46,
+	0x65, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x17, 0x43, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x45, 0x76, 0x6d, 0x5c, 0x46, 0x65, 0x65, 0x6d, 0x61, 0x72, 0x6b,
+	0x65, 0x74, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x23, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x45,
+	0x76, 0x6d, 0x5c, 0x46, 0x65, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x5c, 0x56, 0x31, 0x5c,
+	0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x1a, 0x43, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x3a, 0x3a, 0x45, 0x76, 0x6d, 0x3a, 0x3a, 0x46, 0x65, 0x65, 0x6d, 0x61,
+	0x72, 0x6b, 0x65, 0x74, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -2638,29 +5509,43 @@ func file_cosmos_evm_feemarket_v1_query_proto_rawDescGZIP() []byte {
 	return file_cosmos_evm_feemarket_v1_query_proto_rawDescData
 }
 
-var file_cosmos_evm_feemarket_v1_query_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_cosmos_evm_feemarket_v1_query_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
 var file_cosmos_evm_feemarket_v1_query_proto_goTypes = []interface{}{
-	(*QueryParamsRequest)(nil),    // 0: cosmos.evm.feemarket.v1.QueryParamsRequest
-	(*QueryParamsResponse)(nil),   // 1: cosmos.evm.feemarket.v1.QueryParamsResponse
-	(*QueryBaseFeeRequest)(nil),   // 2: cosmos.evm.feemarket.v1.QueryBaseFeeRequest
-	(*QueryBaseFeeResponse)(nil),  // 3: cosmos.evm.feemarket.v1.QueryBaseFeeResponse
-	(*QueryBlockGasRequest)(nil),  // 4: cosmos.evm.feemarket.v1.QueryBlockGasRequest
-	(*QueryBlockGasResponse)(nil), // 5: cosmos.evm.feemarket.v1.QueryBlockGasResponse
-	(*Params)(nil),                // 6: cosmos.evm.feemarket.v1.Params
+	(*QueryParamsRequest)(nil),         // 0: cosmos.evm.feemarket.v1.QueryParamsRequest
+	(*QueryParamsResponse)(nil),        // 1: cosmos.evm.feemarket.v1.QueryParamsResponse
+	(*QueryBaseFeeRequest)(nil),        // 2: cosmos.evm.feemarket.v1.QueryBaseFeeRequest
+	(*QueryBaseFeeResponse)(nil),       // 3: cosmos.evm.feemarket.v1.QueryBaseFeeResponse
+	(*QueryCosmosBaseFeeRequest)(nil),  // 4: cosmos.evm.feemarket.v1.QueryCosmosBaseFeeRequest
+	(*QueryCosmosBaseFeeResponse)(nil), // 5: cosmos.evm.feemarket.v1.QueryCosmosBaseFeeResponse
+	(*QueryBlockGasRequest)(nil),       // 6: cosmos.evm.feemarket.v1.QueryBlockGasRequest
+	(*QueryBlockGasResponse)(nil),      // 7: cosmos.evm.feemarket.v1.QueryBlockGasResponse
+	(*QueryBurnedFeesRequest)(nil),     // 8: cosmos.evm.feemarket.v1.QueryBurnedFeesRequest
+	(*QueryBurnedFeesResponse)(nil),    // 9: cosmos.evm.feemarket.v1.QueryBurnedFeesResponse
+	(*QueryMsgFeeFloorsRequest)(nil),   // 10: cosmos.evm.feemarket.v1.QueryMsgFeeFloorsRequest
+	(*QueryMsgFeeFloorsResponse)(nil),  // 11: cosmos.evm.feemarket.v1.QueryMsgFeeFloorsResponse
+	(*Params)(nil),                     // 12: cosmos.evm.feemarket.v1.Params
+	(*MsgFeeFloor)(nil),                // 13: cosmos.evm.feemarket.v1.MsgFeeFloor
 }
 var file_cosmos_evm_feemarket_v1_query_proto_depIdxs = []int32{
-	6, // 0: cosmos.evm.feemarket.v1.QueryParamsResponse.params:type_name -> cosmos.evm.feemarket.v1.Params
-	0, // 1: cosmos.evm.feemarket.v1.Query.Params:input_type -> cosmos.evm.feemarket.v1.QueryParamsRequest
-	2, // 2: cosmos.evm.feemarket.v1.Query.BaseFee:input_type -> cosmos.evm.feemarket.v1.QueryBaseFeeRequest
-	4, // 3: cosmos.evm.feemarket.v1.Query.BlockGas:input_type -> cosmos.evm.feemarket.v1.QueryBlockGasRequest
-	1, // 4: cosmos.evm.feemarket.v1.Query.Params:output_type -> cosmos.evm.feemarket.v1.QueryParamsResponse
-	3, // 5: cosmos.evm.feemarket.v1.Query.BaseFee:output_type -> cosmos.evm.feemarket.v1.QueryBaseFeeResponse
-	5, // 6: cosmos.evm.feemarket.v1.Query.BlockGas:output_type -> cosmos.evm.feemarket.v1.QueryBlockGasResponse
-	4, // [4:7] is the sub-list for method output_type
-	1, // [1:4] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	12, // 0: cosmos.evm.feemarket.v1.QueryParamsResponse.params:type_name -> cosmos.evm.feemarket.v1.Params
+	13, // 1: cosmos.evm.feemarket.v1.QueryMsgFeeFloorsResponse.msg_fee_floors:type_name -> cosmos.evm.feemarket.v1.MsgFeeFloor
+	0,  // 2: cosmos.evm.feemarket.v1.Query.Params:input_type -> cosmos.evm.feemarket.v1.QueryParamsRequest
+	2,  // 3: cosmos.evm.feemarket.v1.Query.BaseFee:input_type -> cosmos.evm.feemarket.v1.QueryBaseFeeRequest
+	4,  // 4: cosmos.evm.feemarket.v1.Query.CosmosBaseFee:input_type -> cosmos.evm.feemarket.v1.QueryCosmosBaseFeeRequest
+	6,  // 5: cosmos.evm.feemarket.v1.Query.BlockGas:input_type -> cosmos.evm.feemarket.v1.QueryBlockGasRequest
+	8,  // 6: cosmos.evm.feemarket.v1.Query.BurnedFees:input_type -> cosmos.evm.feemarket.v1.QueryBurnedFeesRequest
+	10, // 7: cosmos.evm.feemarket.v1.Query.MsgFeeFloors:input_type -> cosmos.evm.feemarket.v1.QueryMsgFeeFloorsRequest
+	1,  // 8: cosmos.evm.feemarket.v1.Query.Params:output_type -> cosmos.evm.feemarket.v1.QueryParamsResponse
+	3,  // 9: cosmos.evm.feemarket.v1.Query.BaseFee:output_type -> cosmos.evm.feemarket.v1.QueryBaseFeeResponse
+	5,  // 10: cosmos.evm.feemarket.v1.Query.CosmosBaseFee:output_type -> cosmos.evm.feemarket.v1.QueryCosmosBaseFeeResponse
+	7,  // 11: cosmos.evm.feemarket.v1.Query.BlockGas:output_type -> cosmos.evm.feemarket.v1.QueryBlockGasResponse
+	9,  // 12: cosmos.evm.feemarket.v1.Query.BurnedFees:output_type -> cosmos.evm.feemarket.v1.QueryBurnedFeesResponse
+	11, // 13: cosmos.evm.feemarket.v1.Query.MsgFeeFloors:output_type -> cosmos.evm.feemarket.v1.QueryMsgFeeFloorsResponse
+	8,  // [8:14] is the sub-list for method output_type
+	2,  // [2:8] is the sub-list for method input_type
+	2,  // [2:2] is the sub-list for extension type_name
+	2,  // [2:2] is the sub-list for extension extendee
+	0,  // [0:2] is the sub-list for field type_name
 }
 
 func init() { file_cosmos_evm_feemarket_v1_query_proto_init() }
@@ -2719,7 +5604,7 @@ func file_cosmos_evm_feemarket_v1_query_proto_init() {
 			}
 		}
 		file_cosmos_evm_feemarket_v1_query_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryBlockGasRequest); i {
+			switch v := v.(*QueryCosmosBaseFeeRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2731,6 +5616,30 @@ func file_cosmos_evm_feemarket_v1_query_proto_init() {
 			}
 		}
 		file_cosmos_evm_feemarket_v1_query_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryCosmosBaseFeeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_evm_feemarket_v1_query_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryBlockGasRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_evm_feemarket_v1_query_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*QueryBlockGasResponse); i {
 			case 0:
 				return &v.state
@@ -2742,6 +5651,54 @@ func file_cosmos_evm_feemarket_v1_query_proto_init() {
 				return nil
 			}
 		}
+		file_cosmos_evm_feemarket_v1_query_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryBurnedFeesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_evm_feemarket_v1_query_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryBurnedFeesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_evm_feemarket_v1_query_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryMsgFeeFloorsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_evm_feemarket_v1_query_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryMsgFeeFloorsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -2749,7 +5706,7 @@ func file_cosmos_evm_feemarket_v1_query_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_cosmos_evm_feemarket_v1_query_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   6,
+			NumMessages:   12,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -19,9 +19,12 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Query_Params_FullMethodName   = "/cosmos.evm.feemarket.v1.Query/Params"
-	Query_BaseFee_FullMethodName  = "/cosmos.evm.feemarket.v1.Query/BaseFee"
-	Query_BlockGas_FullMethodName = "/cosmos.evm.feemarket.v1.Query/BlockGas"
+	Query_Params_FullMethodName        = "/cosmos.evm.feemarket.v1.Query/Params"
+	Query_BaseFee_FullMethodName       = "/cosmos.evm.feemarket.v1.Query/BaseFee"
+	Query_CosmosBaseFee_FullMethodName = "/cosmos.evm.feemarket.v1.Query/CosmosBaseFee"
+	Query_BlockGas_FullMethodName      = "/cosmos.evm.feemarket.v1.Query/BlockGas"
+	Query_BurnedFees_FullMethodName    = "/cosmos.evm.feemarket.v1.Query/BurnedFees"
+	Query_MsgFeeFloors_FullMethodName  = "/cosmos.evm.feemarket.v1.Query/MsgFeeFloors"
 )
 
 // QueryClient is the client API for Query service.
@@ -34,8 +37,16 @@ type QueryClient interface {
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
 	// BaseFee queries the base fee of the parent block of the current block.
 	BaseFee(ctx context.Context, in *QueryBaseFeeRequest, opts ...grpc.CallOption) (*QueryBaseFeeResponse, error)
+	// CosmosBaseFee queries the base fee of the Cosmos lane of the current
+	// block. It is the same as the base fee when fee lanes are disabled.
+	CosmosBaseFee(ctx context.Context, in *QueryCosmosBaseFeeRequest, opts ...grpc.CallOption) (*QueryCosmosBaseFeeResponse, error)
 	// BlockGas queries the gas used at a given block height
 	BlockGas(ctx context.Context, in *QueryBlockGasRequest, opts ...grpc.CallOption) (*QueryBlockGasResponse, error)
+	// BurnedFees queries the cumulative amount of base fee revenue burned
+	BurnedFees(ctx context.Context, in *QueryBurnedFeesRequest, opts ...grpc.CallOption) (*QueryBurnedFeesResponse, error)
+	// MsgFeeFloors queries the fee floors of the message types, so that the fee
+	// of a cosmos transaction can be estimated from the messages it includes.
+	MsgFeeFloors(ctx context.Context, in *QueryMsgFeeFloorsRequest, opts ...grpc.CallOption) (*QueryMsgFeeFloorsResponse, error)
 }
 
 type queryClient struct {
@@ -66,6 +77,16 @@ func (c *queryClient) BaseFee(ctx context.Context, in *QueryBaseFeeRequest, opts
 	return out, nil
 }
 
+func (c *queryClient) CosmosBaseFee(ctx context.Context, in *QueryCosmosBaseFeeRequest, opts ...grpc.CallOption) (*QueryCosmosBaseFeeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryCosmosBaseFeeResponse)
+	err := c.cc.Invoke(ctx, Query_CosmosBaseFee_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) BlockGas(ctx context.Context, in *QueryBlockGasRequest, opts ...grpc.CallOption) (*QueryBlockGasResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(QueryBlockGasResponse)
@@ -76,6 +97,26 @@ func (c *queryClient) BlockGas(ctx context.Context, in *QueryBlockGasRequest, op
 	return out, nil
 }
 
+func (c *queryClient) BurnedFees(ctx context.Context, in *QueryBurnedFeesRequest, opts ...grpc.CallOption) (*QueryBurnedFeesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryBurnedFeesResponse)
+	err := c.cc.Invoke(ctx, Query_BurnedFees_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MsgFeeFloors(ctx context.Context, in *QueryMsgFeeFloorsRequest, opts ...grpc.CallOption) (*QueryMsgFeeFloorsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryMsgFeeFloorsResponse)
+	err := c.cc.Invoke(ctx, Query_MsgFeeFloors_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility.
@@ -86,8 +127,16 @@ type QueryServer interface {
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
 	// BaseFee queries the base fee of the parent block of the current block.
 	BaseFee(context.Context, *QueryBaseFeeRequest) (*QueryBaseFeeResponse, error)
+	// CosmosBaseFee queries the base fee of the Cosmos lane of the current
+	// block. It is the same as the base fee when fee lanes are disabled.
+	CosmosBaseFee(context.Context, *QueryCosmosBaseFeeRequest) (*QueryCosmosBaseFeeResponse, error)
 	// BlockGas queries the gas used at a given block height
 	BlockGas(context.Context, *QueryBlockGasRequest) (*QueryBlockGasResponse, error)
+	// BurnedFees queries the cumulative amount of base fee revenue burned
+	BurnedFees(context.Context, *QueryBurnedFeesRequest) (*QueryBurnedFeesResponse, error)
+	// MsgFeeFloors queries the fee floors of the message types, so that the fee
+	// of a cosmos transaction can be estimated from the messages it includes.
+	MsgFeeFloors(context.Context, *QueryMsgFeeFloorsRequest) (*QueryMsgFeeFloorsResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -104,9 +153,18 @@ func (UnimplementedQueryServer) Params(context.Context, *QueryParamsRequest) (*Q
 func (UnimplementedQueryServer) BaseFee(context.Context, *QueryBaseFeeRequest) (*QueryBaseFeeResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method BaseFee not implemented")
 }
+func (UnimplementedQueryServer) CosmosBaseFee(context.Context, *QueryCosmosBaseFeeRequest) (*QueryCosmosBaseFeeResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CosmosBaseFee not implemented")
+}
 func (UnimplementedQueryServer) BlockGas(context.Context, *QueryBlockGasRequest) (*QueryBlockGasResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method BlockGas not implemented")
 }
+func (UnimplementedQueryServer) BurnedFees(context.Context, *QueryBurnedFeesRequest) (*QueryBurnedFeesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method BurnedFees not implemented")
+}
+func (UnimplementedQueryServer) MsgFeeFloors(context.Context, *QueryMsgFeeFloorsRequest) (*QueryMsgFeeFloorsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method MsgFeeFloors not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 func (UnimplementedQueryServer) testEmbeddedByValue()               {}
 
@@ -164,6 +222,24 @@ func _Query_BaseFee_Handler(srv interface{}, ctx context.Context, dec func(inter
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_CosmosBaseFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCosmosBaseFeeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CosmosBaseFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_CosmosBaseFee_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CosmosBaseFee(ctx, req.(*QueryCosmosBaseFeeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_BlockGas_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryBlockGasRequest)
 	if err := dec(in); err != nil {
@@ -182,6 +258,42 @@ func _Query_BlockGas_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_BurnedFees_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBurnedFeesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BurnedFees(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_BurnedFees_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BurnedFees(ctx, req.(*QueryBurnedFeesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MsgFeeFloors_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMsgFeeFloorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MsgFeeFloors(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_MsgFeeFloors_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MsgFeeFloors(ctx, req.(*QueryMsgFeeFloorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -197,10 +309,22 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "BaseFee",
 			Handler:    _Query_BaseFee_Handler,
 		},
+		{
+			MethodName: "CosmosBaseFee",
+			Handler:    _Query_CosmosBaseFee_Handler,
+		},
 		{
 			MethodName: "BlockGas",
 			Handler:    _Query_BlockGas_Handler,
 		},
+		{
+			MethodName: "BurnedFees",
+			Handler:    _Query_BurnedFees_Handler,
+		},
+		{
+			MethodName: "MsgFeeFloors",
+			Handler:    _Query_MsgFeeFloors_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/evm/feemarket/v1/query.proto",
@@ -1,6 +1,6 @@
 // Code generated by protoc-gen-go-grpc. DO NOT EDIT.
 // versions:
-// - protoc-gen-go-grpc v1.3.0
+// - protoc-gen-go-grpc v1.6.0
 // - protoc             (unknown)
 // source: epixmint/v1/query.proto
 
@@ -15,8 +15,8 @@ import (
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the grpc package it is being compiled against.
-// Requires gRPC-Go v1.32.0 or later.
-const _ = grpc.SupportPackageIsVersion7
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
 
 const (
 	Query_Params_FullMethodName           = "/epixmint.v1.Query/Params"
@@ -25,11 +25,14 @@ const (
 	Query_CurrentSupply_FullMethodName    = "/epixmint.v1.Query/CurrentSupply"
 	Query_MaxSupply_FullMethodName        = "/epixmint.v1.Query/MaxSupply"
 	Query_SupplyOf_FullMethodName         = "/epixmint.v1.Query/SupplyOf"
+	Query_NetIssuance_FullMethodName      = "/epixmint.v1.Query/NetIssuance"
 )
 
 // QueryClient is the client API for Query service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Query defines the gRPC querier service.
 type QueryClient interface {
 	// Params queries the parameters of x/epixmint module.
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
@@ -43,6 +46,8 @@ type QueryClient interface {
 	MaxSupply(ctx context.Context, in *QueryMaxSupplyRequest, opts ...grpc.CallOption) (*QueryMaxSupplyResponse, error)
 	// SupplyOf queries the supply of a specific denomination.
 	SupplyOf(ctx context.Context, in *QuerySupplyOfRequest, opts ...grpc.CallOption) (*QuerySupplyOfResponse, error)
+	// NetIssuance queries the tokens minted by the epixmint module net of the base fees burned.
+	NetIssuance(ctx context.Context, in *QueryNetIssuanceRequest, opts ...grpc.CallOption) (*QueryNetIssuanceResponse, error)
 }
 
 type queryClient struct {
@@ -54,8 +59,9 @@ func NewQueryClient(cc grpc.ClientConnInterface) QueryClient {
 }
 
 func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, Query_Params_FullMethodName, in, out, opts...)
+	err := c.cc.Invoke(ctx, Query_Params_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -63,8 +69,9 @@ func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts .
 }
 
 func (c *queryClient) Inflation(ctx context.Context, in *QueryInflationRequest, opts ...grpc.CallOption) (*QueryInflationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(QueryInflationResponse)
-	err := c.cc.Invoke(ctx, Query_Inflation_FullMethodName, in, out, opts...)
+	err := c.cc.Invoke(ctx, Query_Inflation_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -72,8 +79,9 @@ func (c *queryClient) Inflation(ctx context.Context, in *QueryInflationRequest,
 }
 
 func (c *queryClient) AnnualProvisions(ctx context.Context, in *QueryAnnualProvisionsRequest, opts ...grpc.CallOption) (*QueryAnnualProvisionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(QueryAnnualProvisionsResponse)
-	err := c.cc.Invoke(ctx, Query_AnnualProvisions_FullMethodName, in, out, opts...)
+	err := c.cc.Invoke(ctx, Query_AnnualProvisions_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -81,8 +89,9 @@ func (c *queryClient) AnnualProvisions(ctx context.Context, in *QueryAnnualProvi
 }
 
 func (c *queryClient) CurrentSupply(ctx context.Context, in *QueryCurrentSupplyRequest, opts ...grpc.CallOption) (*QueryCurrentSupplyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(QueryCurrentSupplyResponse)
-	err := c.cc.Invoke(ctx, Query_CurrentSupply_FullMethodName, in, out, opts...)
+	err := c.cc.Invoke(ctx, Query_CurrentSupply_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -90,8 +99,9 @@ func (c *queryClient) CurrentSupply(ctx context.Context, in *QueryCurrentSupplyR
 }
 
 func (c *queryClient) MaxSupply(ctx context.Context, in *QueryMaxSupplyRequest, opts ...grpc.CallOption) (*QueryMaxSupplyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(QueryMaxSupplyResponse)
-	err := c.cc.Invoke(ctx, Query_MaxSupply_FullMethodName, in, out, opts...)
+	err := c.cc.Invoke(ctx, Query_MaxSupply_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -99,8 +109,19 @@ func (c *queryClient) MaxSupply(ctx context.Context, in *QueryMaxSupplyRequest,
 }
 
 func (c *queryClient) SupplyOf(ctx context.Context, in *QuerySupplyOfRequest, opts ...grpc.CallOption) (*QuerySupplyOfResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(QuerySupplyOfResponse)
-	err := c.cc.Invoke(ctx, Query_SupplyOf_FullMethodName, in, out, opts...)
+	err := c.cc.Invoke(ctx, Query_SupplyOf_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) NetIssuance(ctx context.Context, in *QueryNetIssuanceRequest, opts ...grpc.CallOption) (*QueryNetIssuanceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryNetIssuanceResponse)
+	err := c.cc.Invoke(ctx, Query_NetIssuance_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -109,7 +130,9 @@ func (c *queryClient) SupplyOf(ctx context.Context, in *QuerySupplyOfRequest, op
 
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
-// for forward compatibility
+// for forward compatibility.
+//
+// Query defines the gRPC querier service.
 type QueryServer interface {
 	// Params queries the parameters of x/epixmint module.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
@@ -123,32 +146,41 @@ type QueryServer interface {
 	MaxSupply(context.Context, *QueryMaxSupplyRequest) (*QueryMaxSupplyResponse, error)
 	// SupplyOf queries the supply of a specific denomination.
 	SupplyOf(context.Context, *QuerySupplyOfRequest) (*QuerySupplyOfResponse, error)
+	// NetIssuance queries the tokens minted by the epixmint module net of the base fees burned.
+	NetIssuance(context.Context, *QueryNetIssuanceRequest) (*QueryNetIssuanceResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
-// UnimplementedQueryServer must be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
-}
+// UnimplementedQueryServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedQueryServer struct{}
 
 func (UnimplementedQueryServer) Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+	return nil, status.Error(codes.Unimplemented, "method Params not implemented")
 }
 func (UnimplementedQueryServer) Inflation(context.Context, *QueryInflationRequest) (*QueryInflationResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Inflation not implemented")
+	return nil, status.Error(codes.Unimplemented, "method Inflation not implemented")
 }
 func (UnimplementedQueryServer) AnnualProvisions(context.Context, *QueryAnnualProvisionsRequest) (*QueryAnnualProvisionsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AnnualProvisions not implemented")
+	return nil, status.Error(codes.Unimplemented, "method AnnualProvisions not implemented")
 }
 func (UnimplementedQueryServer) CurrentSupply(context.Context, *QueryCurrentSupplyRequest) (*QueryCurrentSupplyResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CurrentSupply not implemented")
+	return nil, status.Error(codes.Unimplemented, "method CurrentSupply not implemented")
 }
 func (UnimplementedQueryServer) MaxSupply(context.Context, *QueryMaxSupplyRequest) (*QueryMaxSupplyResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method MaxSupply not implemented")
+	return nil, status.Error(codes.Unimplemented, "method MaxSupply not implemented")
 }
 func (UnimplementedQueryServer) SupplyOf(context.Context, *QuerySupplyOfRequest) (*QuerySupplyOfResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SupplyOf not implemented")
+	return nil, status.Error(codes.Unimplemented, "method SupplyOf not implemented")
+}
+func (UnimplementedQueryServer) NetIssuance(context.Context, *QueryNetIssuanceRequest) (*QueryNetIssuanceResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method NetIssuance not implemented")
 }
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
+func (UnimplementedQueryServer) testEmbeddedByValue()               {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to QueryServer will
@@ -158,6 +190,13 @@ type UnsafeQueryServer interface {
 }
 
 func RegisterQueryServer(s grpc.ServiceRegistrar, srv QueryServer) {
+	// If the following call panics, it indicates UnimplementedQueryServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
 	s.RegisterService(&Query_ServiceDesc, srv)
 }
 
@@ -269,6 +308,24 @@ func _Query_SupplyOf_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_NetIssuance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryNetIssuanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).NetIssuance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_NetIssuance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).NetIssuance(ctx, req.(*QueryNetIssuanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -300,6 +357,10 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SupplyOf",
 			Handler:    _Query_SupplyOf_Handler,
 		},
+		{
+			MethodName: "NetIssuance",
+			Handler:    _Query_NetIssuance_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "epixmint/v1/query.proto",
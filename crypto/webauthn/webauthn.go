@@ -0,0 +1,216 @@
+package webauthn
+
+import (
+	"bytes"
+	"crypto/elliptic"
+	"crypto/sha256"
+	"encoding/asn1"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math/big"
+
+	"github.com/cometbft/cometbft/crypto/tmhash"
+
+	"github.com/cosmos/evm/crypto/secp256r1"
+
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	// PubKeySize defines the size of the PubKey bytes
+	PubKeySize = 33
+	// KeyType is the string constant for the WebAuthn secp256r1 algorithm
+	KeyType = "webauthn_secp256r1"
+)
+
+// Amino encoding names
+const (
+	// PubKeyName defines the amino encoding name for the WebAuthn public key
+	PubKeyName = "os/PubKeyWebAuthn"
+)
+
+const (
+	// ClientDataTypeGet is the client data type of a WebAuthn assertion
+	ClientDataTypeGet = "webauthn.get"
+
+	// authenticatorDataMinSize is the size of the rpIdHash, flags and signCount
+	// fields of the authenticator data
+	authenticatorDataMinSize = 37
+	// flagsIndex is the index of the flags in the authenticator data
+	flagsIndex = 32
+	// flagUserPresent is the user present (UP) flag of the authenticator data
+	flagUserPresent = 0x01
+)
+
+var (
+	// secp256r1N is the order of the secp256r1 curve.
+	secp256r1N = elliptic.P256().Params().N
+	// secp256r1HalfN is half the order of the secp256r1 curve, the upper bound of
+	// the S value of a canonical signature.
+	secp256r1HalfN = new(big.Int).Rsh(secp256r1N, 1)
+)
+
+// ----------------------------------------------------------------------------
+// WebAuthn Public Key
+
+var (
+	_ cryptotypes.PubKey   = &PubKey{}
+	_ codec.AminoMarshaler = &PubKey{}
+)
+
+// Address returns the address of the WebAuthn public key, the first 20 bytes
+// of the SHA-256 hash of the compressed key.
+func (pubKey PubKey) Address() cryptotypes.Address {
+	return cryptotypes.Address(tmhash.SumTruncated(pubKey.Key))
+}
+
+// Bytes returns the raw bytes of the WebAuthn public key.
+func (pubKey PubKey) Bytes() []byte {
+	bz := make([]byte, len(pubKey.Key))
+	copy(bz, pubKey.Key)
+
+	return bz
+}
+
+// String implements the fmt.Stringer interface.
+func (pubKey PubKey) String() string {
+	return fmt.Sprintf("PubKeyWebAuthn{%X}", pubKey.Key)
+}
+
+// Type returns webauthn_secp256r1
+func (pubKey PubKey) Type() string {
+	return KeyType
+}
+
+// Equals returns true if the pubkey type is the same and their bytes are deeply equal.
+func (pubKey PubKey) Equals(other cryptotypes.PubKey) bool {
+	return pubKey.Type() == other.Type() && bytes.Equal(pubKey.Bytes(), other.Bytes())
+}
+
+// MarshalAmino overrides Amino binary marshaling.
+func (pubKey PubKey) MarshalAmino() ([]byte, error) {
+	return pubKey.Key, nil
+}
+
+// UnmarshalAmino overrides Amino binary marshaling.
+func (pubKey *PubKey) UnmarshalAmino(bz []byte) error {
+	if len(bz) != PubKeySize {
+		return errorsmod.Wrapf(errortypes.ErrInvalidPubKey, "invalid pubkey size, expected %d, got %d", PubKeySize, len(bz))
+	}
+	pubKey.Key = bz
+
+	return nil
+}
+
+// MarshalAminoJSON overrides Amino JSON marshaling.
+func (pubKey PubKey) MarshalAminoJSON() ([]byte, error) {
+	// When we marshal to Amino JSON, we don't marshal the "key" field itself,
+	// just its contents (i.e. the key bytes).
+	return pubKey.MarshalAmino()
+}
+
+// UnmarshalAminoJSON overrides Amino JSON marshaling.
+func (pubKey *PubKey) UnmarshalAminoJSON(bz []byte) error {
+	return pubKey.UnmarshalAmino(bz)
+}
+
+// VerifySignature verifies that the passkey of the public key created a WebAuthn
+// assertion over the provided message. The signature is the protobuf encoded
+// Signature of the assertion, which is valid if:
+// - the client data is of type webauthn.get and its challenge is the Challenge of the message
+// - the authenticator data has the user present flag set
+// - the ECDSA signature is valid over SHA-256(authenticatorData || SHA-256(clientDataJSON))
+//
+// The authenticators don't produce canonical signatures, the S value of the ECDSA
+// signature is normalized to the lower half of the curve order. A malleated
+// signature can't be replayed as the challenge commits to the account sequence.
+func (pubKey PubKey) VerifySignature(msg, sig []byte) bool {
+	var assertion Signature
+	if err := assertion.Unmarshal(sig); err != nil {
+		return false
+	}
+
+	if !verifyClientData(assertion.ClientDataJSON, msg) {
+		return false
+	}
+
+	authData := assertion.AuthenticatorData
+	if len(authData) < authenticatorDataMinSize || authData[flagsIndex]&flagUserPresent == 0 {
+		return false
+	}
+
+	r, s, ok := parseSignature(assertion.Signature)
+	if !ok {
+		return false
+	}
+
+	x, y := elliptic.UnmarshalCompressed(elliptic.P256(), pubKey.Key)
+	if x == nil {
+		return false
+	}
+
+	clientDataHash := sha256.Sum256(assertion.ClientDataJSON)
+	hash := sha256.Sum256(append(bytes.Clone(authData), clientDataHash[:]...))
+
+	return secp256r1.Verify(hash[:], r, s, x, y)
+}
+
+// Challenge returns the challenge a passkey must sign to create the WebAuthn
+// assertion of the provided message, the SHA-256 hash of the message.
+func Challenge(msg []byte) []byte {
+	challenge := sha256.Sum256(msg)
+	return challenge[:]
+}
+
+// clientData defines the fields of the WebAuthn client data that are verified.
+type clientData struct {
+	Type      string `json:"type"`
+	Challenge string `json:"challenge"`
+}
+
+// verifyClientData returns true if the client data is the one of an assertion
+// over the provided message.
+func verifyClientData(clientDataJSON, msg []byte) bool {
+	var data clientData
+	if err := json.Unmarshal(clientDataJSON, &data); err != nil {
+		return false
+	}
+	if data.Type != ClientDataTypeGet {
+		return false
+	}
+
+	challenge, err := base64.RawURLEncoding.DecodeString(data.Challenge)
+	if err != nil {
+		return false
+	}
+
+	return bytes.Equal(challenge, Challenge(msg))
+}
+
+// ecdsaSignature is the ASN.1 structure of an ECDSA signature.
+type ecdsaSignature struct {
+	R, S *big.Int
+}
+
+// parseSignature parses the R and S values of an ASN.1 DER encoded ECDSA signature,
+// a high S value being normalized to N - S.
+func parseSignature(sig []byte) (r, s *big.Int, ok bool) {
+	var parsed ecdsaSignature
+	rest, err := asn1.Unmarshal(sig, &parsed)
+	if err != nil || len(rest) != 0 {
+		return nil, nil, false
+	}
+	if parsed.R.Sign() <= 0 || parsed.S.Sign() <= 0 || parsed.S.Cmp(secp256r1N) >= 0 {
+		return nil, nil, false
+	}
+	if parsed.S.Cmp(secp256r1HalfN) > 0 {
+		parsed.S.Sub(secp256r1N, parsed.S)
+	}
+
+	return parsed.R, parsed.S, true
+}
@@ -0,0 +1,219 @@
+package webauthn
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/asn1"
+	"encoding/base64"
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+)
+
+// passkey signs WebAuthn assertions like an authenticator.
+type passkey struct {
+	key *ecdsa.PrivateKey
+}
+
+func newPasskey(t *testing.T) passkey {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	return passkey{key}
+}
+
+func (p passkey) pubKey() *PubKey {
+	return &PubKey{Key: elliptic.MarshalCompressed(elliptic.P256(), p.key.X, p.key.Y)}
+}
+
+// assertion returns the assertion of the passkey over the client data and
+// authenticator data, with the S value of the signature in the lower half.
+func (p passkey) assertion(t *testing.T, clientDataJSON, authData []byte) *Signature {
+	t.Helper()
+	clientDataHash := sha256.Sum256(clientDataJSON)
+	hash := sha256.Sum256(append(append([]byte{}, authData...), clientDataHash[:]...))
+	r, s, err := ecdsa.Sign(rand.Reader, p.key, hash[:])
+	require.NoError(t, err)
+	if s.Cmp(secp256r1HalfN) > 0 {
+		s.Sub(elliptic.P256().Params().N, s)
+	}
+	sig, err := asn1Signature(r, s)
+	require.NoError(t, err)
+	return &Signature{AuthenticatorData: authData, ClientDataJSON: clientDataJSON, Signature: sig}
+}
+
+func asn1Signature(r, s *big.Int) ([]byte, error) {
+	return asn1.Marshal(ecdsaSignature{R: r, S: s})
+}
+
+func clientDataJSON(typ string, challenge []byte) []byte {
+	return fmt.Appendf(nil,
+		`{"type":%q,"challenge":%q,"origin":"https://wallet.example","crossOrigin":false}`,
+		typ, base64.RawURLEncoding.EncodeToString(challenge))
+}
+
+func authenticatorData(flags byte) []byte {
+	authData := make([]byte, authenticatorDataMinSize)
+	rpIDHash := sha256.Sum256([]byte("wallet.example"))
+	copy(authData, rpIDHash[:])
+	authData[flagsIndex] = flags
+	return authData
+}
+
+func TestPubKey(t *testing.T) {
+	key := newPasskey(t)
+	pubKey := key.pubKey()
+	require.Implements(t, (*cryptotypes.PubKey)(nil), pubKey)
+	require.Len(t, pubKey.Address(), 20)
+	require.Equal(t, KeyType, pubKey.Type())
+	require.True(t, pubKey.Equals(key.pubKey()))
+	require.False(t, pubKey.Equals(newPasskey(t).pubKey()))
+
+	// validate amino encoding
+	cdc := codec.NewLegacyAmino()
+	cdc.RegisterConcrete(&PubKey{}, PubKeyName, nil)
+	bz, err := cdc.Marshal(pubKey)
+	require.NoError(t, err)
+	var decoded PubKey
+	require.NoError(t, cdc.Unmarshal(bz, &decoded))
+	require.Equal(t, *pubKey, decoded)
+	require.Error(t, decoded.UnmarshalAmino([]byte{1, 2, 3}))
+}
+
+func TestVerifySignature(t *testing.T) {
+	key := newPasskey(t)
+	msg := []byte("sign bytes")
+
+	testCases := []struct {
+		name     string
+		pubKey   *PubKey
+		sig      func() []byte
+		expValid bool
+	}{
+		{
+			"pass - valid assertion",
+			key.pubKey(),
+			func() []byte {
+				sig, err := key.assertion(t, clientDataJSON(ClientDataTypeGet, Challenge(msg)), authenticatorData(flagUserPresent)).Marshal()
+				require.NoError(t, err)
+				return sig
+			},
+			true,
+		},
+		{
+			"fail - other passkey",
+			newPasskey(t).pubKey(),
+			func() []byte {
+				sig, err := key.assertion(t, clientDataJSON(ClientDataTypeGet, Challenge(msg)), authenticatorData(flagUserPresent)).Marshal()
+				require.NoError(t, err)
+				return sig
+			},
+			false,
+		},
+		{
+			"fail - challenge of other msg",
+			key.pubKey(),
+			func() []byte {
+				sig, err := key.assertion(t, clientDataJSON(ClientDataTypeGet, Challenge([]byte("other"))), authenticatorData(flagUserPresent)).Marshal()
+				require.NoError(t, err)
+				return sig
+			},
+			false,
+		},
+		{
+			"fail - registration client data",
+			key.pubKey(),
+			func() []byte {
+				sig, err := key.assertion(t, clientDataJSON("webauthn.create", Challenge(msg)), authenticatorData(flagUserPresent)).Marshal()
+				require.NoError(t, err)
+				return sig
+			},
+			false,
+		},
+		{
+			"fail - user not present",
+			key.pubKey(),
+			func() []byte {
+				sig, err := key.assertion(t, clientDataJSON(ClientDataTypeGet, Challenge(msg)), authenticatorData(0)).Marshal()
+				require.NoError(t, err)
+				return sig
+			},
+			false,
+		},
+		{
+			"fail - tampered authenticator data",
+			key.pubKey(),
+			func() []byte {
+				assertion := key.assertion(t, clientDataJSON(ClientDataTypeGet, Challenge(msg)), authenticatorData(flagUserPresent))
+				assertion.AuthenticatorData[0] ^= 0xff
+				sig, err := assertion.Marshal()
+				require.NoError(t, err)
+				return sig
+			},
+			false,
+		},
+		{
+			"pass - high S value",
+			key.pubKey(),
+			func() []byte {
+				assertion := key.assertion(t, clientDataJSON(ClientDataTypeGet, Challenge(msg)), authenticatorData(flagUserPresent))
+				var parsed ecdsaSignature
+				_, err := asn1.Unmarshal(assertion.Signature, &parsed)
+				require.NoError(t, err)
+				highS := new(big.Int).Sub(elliptic.P256().Params().N, parsed.S)
+				require.Positive(t, highS.Cmp(secp256r1HalfN))
+				assertion.Signature, err = asn1Signature(parsed.R, highS)
+				require.NoError(t, err)
+				sig, err := assertion.Marshal()
+				require.NoError(t, err)
+				return sig
+			},
+			true,
+		},
+		{
+			"fail - S value not lower than the curve order",
+			key.pubKey(),
+			func() []byte {
+				assertion := key.assertion(t, clientDataJSON(ClientDataTypeGet, Challenge(msg)), authenticatorData(flagUserPresent))
+				var parsed ecdsaSignature
+				_, err := asn1.Unmarshal(assertion.Signature, &parsed)
+				require.NoError(t, err)
+				assertion.Signature, err = asn1Signature(parsed.R, new(big.Int).Add(elliptic.P256().Params().N, parsed.S))
+				require.NoError(t, err)
+				sig, err := assertion.Marshal()
+				require.NoError(t, err)
+				return sig
+			},
+			false,
+		},
+		{
+			"fail - invalid public key",
+			&PubKey{Key: make([]byte, PubKeySize)},
+			func() []byte {
+				sig, err := key.assertion(t, clientDataJSON(ClientDataTypeGet, Challenge(msg)), authenticatorData(flagUserPresent)).Marshal()
+				require.NoError(t, err)
+				return sig
+			},
+			false,
+		},
+		{
+			"fail - not an assertion",
+			key.pubKey(),
+			func() []byte { return []byte{0xff, 0xff} },
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expValid, tc.pubKey.VerifySignature(msg, tc.sig()))
+		})
+	}
+}
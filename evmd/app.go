@@ -272,7 +272,7 @@ func NewExampleApp(
 	}
 
 	keys := storetypes.NewKVStoreKeys(storeKeys...)
-	oKeys := storetypes.NewObjectStoreKeys(banktypes.ObjectStoreKey, evmtypes.ObjectKey)
+	oKeys := storetypes.NewObjectStoreKeys(banktypes.ObjectStoreKey, evmtypes.ObjectKey, feemarkettypes.ObjectKey)
 
 	var nonTransientKeys []storetypes.StoreKey
 	for _, k := range keys {
@@ -464,10 +464,6 @@ func NewExampleApp(
 	app.EvidenceKeeper = *evidenceKeeper
 
 	// Cosmos EVM keepers
-	app.FeeMarketKeeper = feemarketkeeper.NewKeeper(
-		appCodec, authtypes.NewModuleAddress(govtypes.ModuleName),
-		keys[feemarkettypes.StoreKey],
-	)
 
 	// Set up PreciseBank keeper
 	//
@@ -479,6 +475,13 @@ func NewExampleApp(
 		app.AccountKeeper,
 	)
 
+	app.FeeMarketKeeper = feemarketkeeper.NewKeeper(
+		appCodec, authtypes.NewModuleAddress(govtypes.ModuleName),
+		keys[feemarkettypes.StoreKey], oKeys[feemarkettypes.ObjectKey],
+		app.AccountKeeper,
+		app.PreciseBankKeeper, // Use PreciseBankKeeper for burning the base fees
+	)
+
 	// Set up EpixMint keeper
 	app.EpixMintKeeper = epixmintkeeper.NewKeeper(
 		appCodec,
@@ -487,6 +490,7 @@ func NewExampleApp(
 		app.AccountKeeper,
 		app.DistrKeeper,
 		app.StakingKeeper,
+		app.FeeMarketKeeper,
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 
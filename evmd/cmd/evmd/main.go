@@ -8,10 +8,13 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/evm/evmd/cmd/evmd/cmd"
 	"github.com/cosmos/evm/evmd/config"
+	"github.com/cosmos/evm/rpc"
+	"github.com/cosmos/evm/rpc/namespaces/epix"
 )
 
 func main() {
 	setupSDKConfig()
+	registerRPCNamespaces()
 
 	rootCmd := cmd.NewRootCmd()
 	if err := svrcmd.Execute(rootCmd, "epixd", config.MustGetDefaultNodeHome()); err != nil {
@@ -25,3 +28,11 @@ func setupSDKConfig() {
 	config.SetBech32Prefixes(cfg)
 	cfg.Seal()
 }
+
+// registerRPCNamespaces registers the EpixChain specific JSON-RPC namespaces,
+// they are served when listed in the json-rpc.api config.
+func registerRPCNamespaces() {
+	if err := rpc.RegisterAPINamespace(epix.Namespace, epix.CreateAPIs); err != nil {
+		panic(err)
+	}
+}
@@ -64,7 +64,7 @@ var maccPerms = map[string][]string{
 	// Cosmos EVM modules
 	epixminttypes.ModuleName:    {authtypes.Minter},
 	vmtypes.ModuleName:          {authtypes.Minter, authtypes.Burner},
-	feemarkettypes.ModuleName:   nil,
+	feemarkettypes.ModuleName:   {authtypes.Burner},
 	erc20types.ModuleName:       {authtypes.Minter, authtypes.Burner},
 	precisebanktypes.ModuleName: {authtypes.Minter, authtypes.Burner},
 }
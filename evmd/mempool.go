@@ -53,7 +53,7 @@ func (app *EVMD) configureEVMMempool(appOpts servertypes.AppOptions, logger log.
 			sdkmempool.NewDefaultSignerExtractionAdapter(),
 		),
 	)
-	app.SetPrepareProposal(abciProposalHandler.PrepareProposalHandler())
+	app.SetPrepareProposal(evmmempool.NewBundleProposalHandler(evmMempool, abciProposalHandler.PrepareProposalHandler()))
 
 	return nil
 }
@@ -61,10 +61,15 @@ func (app *EVMD) configureEVMMempool(appOpts servertypes.AppOptions, logger log.
 // createMempoolConfig creates a new EVMMempoolConfig with the default configuration
 // and overrides it with values from appOpts if they exist and are non-zero.
 func (app *EVMD) createMempoolConfig(appOpts servertypes.AppOptions, logger log.Logger) (*evmmempool.EVMMempoolConfig, error) {
+	gossipPeers, gossipMaxPerAccount := server.GetQueuedGossipConfig(appOpts, logger)
 	return &evmmempool.EVMMempoolConfig{
-		AnteHandler:      app.GetAnteHandler(),
-		LegacyPoolConfig: server.GetLegacyPoolConfig(appOpts, logger),
-		BlockGasLimit:    server.GetBlockGasLimit(appOpts, logger),
-		MinTip:           server.GetMinTip(appOpts, logger),
+		AnteHandler:               app.GetAnteHandler(),
+		LegacyPoolConfig:          server.GetLegacyPoolConfig(appOpts, logger),
+		BlockGasLimit:             server.GetBlockGasLimit(appOpts, logger),
+		MinTip:                    server.GetMinTip(appOpts, logger),
+		MaxBundles:                server.GetMaxBundles(appOpts, logger),
+		QueuedGossipPeers:         gossipPeers,
+		QueuedGossipMaxPerAccount: gossipMaxPerAccount,
+		ReservedGasShares:         server.GetReservedGasShares(appOpts, logger),
 	}, nil
 }
@@ -14,7 +14,7 @@ import (
 )
 
 // SupplyAPIHandler creates a handler for simple supply queries compatible with trackers
-// Supports query parameters like ?q=totalcoins and ?q=circulatingsupply
+// Supports query parameters like ?q=totalcoins, ?q=circulatingsupply, ?q=burned and ?q=netissuancesinceactivation
 func SupplyAPIHandler(clientCtx client.Context) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		// Get the query parameter
@@ -65,8 +65,33 @@ func SupplyAPIHandler(clientCtx client.Context) http.HandlerFunc {
 			w.WriteHeader(http.StatusOK)
 			fmt.Fprintf(w, "%s", epixMaxSupply.String())
 
+		case "burned", "netissuancesinceactivation":
+			// Get the tokens minted by epixmint and the base fees burned by the fee market, both
+			// counted since the tracking was activated rather than since genesis
+			req := &types.QueryNetIssuanceRequest{}
+
+			resp, err := queryClient.NetIssuance(context.Background(), req)
+			if err != nil {
+				writeTextErrorResponse(w, http.StatusInternalServerError, fmt.Sprintf("Failed to query net issuance: %s", err.Error()))
+				return
+			}
+
+			amount := resp.NetIssuance
+			if query == "burned" {
+				amount = resp.TotalBurned
+			}
+
+			// Convert from aepix to epix (divide by 10^18)
+			conversionFactor := math.NewInt(1000000000000000000) // 10^18
+			epixAmount := amount.Quo(conversionFactor)
+
+			// Return just the number as plain text
+			w.Header().Set("Content-Type", "text/plain")
+			w.WriteHeader(http.StatusOK)
+			fmt.Fprintf(w, "%s", epixAmount.String())
+
 		default:
-			writeTextErrorResponse(w, http.StatusBadRequest, fmt.Sprintf("Unsupported query: %s. Supported queries: totalcoins, circulatingsupply, maxsupply", query))
+			writeTextErrorResponse(w, http.StatusBadRequest, fmt.Sprintf("Unsupported query: %s. Supported queries: totalcoins, circulatingsupply, maxsupply, burned, netissuancesinceactivation", query))
 		}
 	}
 }
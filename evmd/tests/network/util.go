@@ -20,6 +20,8 @@ import (
 	"github.com/cometbft/cometbft/types"
 	cmttime "github.com/cometbft/cometbft/types/time"
 
+	"github.com/cosmos/evm/rpc/backend"
+	"github.com/cosmos/evm/rpc/policy"
 	"github.com/cosmos/evm/server"
 	evmtypes "github.com/cosmos/evm/x/vm/types"
 
@@ -131,6 +133,10 @@ func startInProcess(cfg Config, val *Validator) error {
 		if val.Ctx == nil || val.Ctx.Viper == nil {
 			return fmt.Errorf("validator %s context is nil", val.Moniker)
 		}
+		rpcPolicy, err := policy.New(val.AppConfig.JSONRPC)
+		if err != nil {
+			return err
+		}
 		val.jsonrpc, err = server.StartJSONRPC(
 			ctx,
 			val.Ctx,
@@ -140,6 +146,9 @@ func startInProcess(cfg Config, val *Validator) error {
 			nil,
 			app.(server.AppWithPendingTxStream),
 			nil,
+			rpcPolicy,
+			backend.NewResponseCache(int64(val.AppConfig.JSONRPC.ResponseCacheSize)*1024*1024),
+			backend.NewPendingStateCache(),
 		)
 		if err != nil {
 			return err
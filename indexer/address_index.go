@@ -0,0 +1,180 @@
+package indexer
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	cmttypes "github.com/cometbft/cometbft/types"
+
+	dbm "github.com/cosmos/cosmos-db"
+	servertypes "github.com/cosmos/evm/server/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// addressTxCursorLength is the length of the cursor of an address tx history page,
+// (block number, eth tx index) of the last returned entry.
+const addressTxCursorLength = 8 + 8
+
+// InternalTxTracer returns, for every eth tx of the block in eth tx index order, the addresses
+// of the contracts created and the recipients of value transfers of the internal calls.
+type InternalTxTracer func(block *cmttypes.Block) ([]map[common.Address]servertypes.AddressRole, error)
+
+// KVIndexerOption configures an optional feature of the KVIndexer.
+type KVIndexerOption func(*KVIndexer)
+
+// WithAddressIndex enables the `address -> txs` postings used to serve the transaction
+// history of an address.
+func WithAddressIndex() KVIndexerOption {
+	return func(kv *KVIndexer) {
+		kv.addressIndex = true
+	}
+}
+
+// WithInternalTxTracer sets the tracer used to add the contract creations and internal
+// transfer recipients to the address index. It has no effect if the address index is disabled.
+func WithInternalTxTracer(tracer InternalTxTracer) KVIndexerOption {
+	return func(kv *KVIndexer) {
+		kv.internalTxTracer = tracer
+	}
+}
+
+// addressTxs accumulates the address postings of a single eth tx.
+type addressTxs struct {
+	hash       common.Hash
+	ethTxIndex int32
+	roles      map[common.Address]servertypes.AddressRole
+}
+
+// newAddressTxs returns the address postings of the sender, the recipient and the
+// created contract of the eth tx.
+func newAddressTxs(msg *evmtypes.MsgEthereumTx, ethTxIndex int32, failed bool) *addressTxs {
+	tx := msg.AsTransaction()
+	from := msg.GetSender()
+	entry := &addressTxs{
+		hash:       tx.Hash(),
+		ethTxIndex: ethTxIndex,
+		roles:      map[common.Address]servertypes.AddressRole{from: servertypes.AddressRoleFrom},
+	}
+	switch {
+	case tx.To() != nil:
+		entry.roles[*tx.To()] |= servertypes.AddressRoleTo
+	case !failed:
+		entry.roles[crypto.CreateAddress(from, tx.Nonce())] |= servertypes.AddressRoleCreate
+	}
+	return entry
+}
+
+// indexAddressTxs traces the block if needed and stores the address postings into the kv db batch.
+func (kv *KVIndexer) indexAddressTxs(batch dbm.Batch, block *cmttypes.Block, entries []*addressTxs) error {
+	if len(entries) == 0 {
+		return nil
+	}
+
+	if kv.internalTxTracer != nil {
+		traces, err := kv.internalTxTracer(block)
+		switch {
+		case err != nil:
+			kv.logger.Error("Fail to trace internal txs", "err", err, "block", block.Height)
+		case len(traces) != len(entries):
+			kv.logger.Error("internal txs traces don't match", "expect", len(entries), "found", len(traces), "block", block.Height)
+		default:
+			for i, roles := range traces {
+				for address, role := range roles {
+					entries[i].roles[address] |= role
+				}
+			}
+		}
+	}
+
+	for _, entry := range entries {
+		for address, roles := range entry.roles {
+			value := append(entry.hash.Bytes(), byte(roles))
+			if err := batch.Set(AddressTxKey(address, block.Height, entry.ethTxIndex), value); err != nil {
+				return errorsmod.Wrap(err, "set address-tx key")
+			}
+		}
+	}
+	return nil
+}
+
+// GetTxsByAddress returns the page of the transaction history of the address within [from, to].
+func (kv *KVIndexer) GetTxsByAddress(
+	address common.Address, from, to int64, cursor []byte, limit int,
+) ([]*servertypes.AddressTxResult, []byte, error) {
+	if !kv.addressIndex {
+		return nil, nil, errors.New("address index is disabled")
+	}
+	if from > to {
+		return nil, nil, fmt.Errorf("invalid block range, from: %d, to: %d", from, to)
+	}
+	if limit <= 0 {
+		return nil, nil, fmt.Errorf("invalid limit %d", limit)
+	}
+
+	prefix := addressTxPrefix(address)
+	start := append(append([]byte{}, prefix...), sdk.Uint64ToBigEndian(uint64(from))...) //nolint:gosec // G115 // block number won't exceed uint64
+	end := append(append([]byte{}, prefix...), sdk.Uint64ToBigEndian(uint64(to+1))...)   //nolint:gosec // G115 // block number won't exceed uint64
+	if cursor != nil {
+		if len(cursor) != addressTxCursorLength {
+			return nil, nil, fmt.Errorf("invalid cursor length, expect: %d, got: %d", addressTxCursorLength, len(cursor))
+		}
+		// start strictly after the cursor position
+		after := append(append(append([]byte{}, prefix...), cursor...), 0)
+		if string(after) > string(start) {
+			start = after
+		}
+	}
+
+	it, err := kv.db.Iterator(start, end)
+	if err != nil {
+		return nil, nil, errorsmod.Wrap(err, "GetTxsByAddress")
+	}
+	defer it.Close()
+
+	results := make([]*servertypes.AddressTxResult, 0, limit)
+	var next []byte
+	for ; it.Valid(); it.Next() {
+		if len(results) == limit {
+			last := results[limit-1]
+			next = addressTxCursor(last.Height, last.EthTxIndex)
+			break
+		}
+		key, value := it.Key(), it.Value()
+		if len(value) != common.HashLength+1 {
+			return nil, nil, fmt.Errorf("wrong address tx value length, expect: %d, got: %d", common.HashLength+1, len(value))
+		}
+		suffix := key[len(prefix):]
+		results = append(results, &servertypes.AddressTxResult{
+			Hash:       common.BytesToHash(value[:common.HashLength]),
+			Height:     int64(sdk.BigEndianToUint64(suffix[:8])), //#nosec G115 -- block number won't exceed int64
+			EthTxIndex: int32(sdk.BigEndianToUint64(suffix[8:])), //#nosec G115 -- eth tx index won't exceed int32
+			Roles:      servertypes.AddressRole(value[common.HashLength]),
+		})
+	}
+	if err := it.Error(); err != nil {
+		return nil, nil, err
+	}
+	return results, next, nil
+}
+
+// AddressTxKey returns the key for db entry: `(address, block number, eth tx index) -> (tx hash, roles)`
+func AddressTxKey(address common.Address, blockNumber int64, ethTxIndex int32) []byte {
+	return append(addressTxPrefix(address), addressTxCursor(blockNumber, ethTxIndex)...)
+}
+
+func addressTxPrefix(address common.Address) []byte {
+	return append([]byte{KeyPrefixAddressTx}, address.Bytes()...)
+}
+
+func addressTxCursor(blockNumber int64, ethTxIndex int32) []byte {
+	bz1 := sdk.Uint64ToBigEndian(uint64(blockNumber)) //nolint:gosec // G115 // block number won't exceed uint64
+	bz2 := sdk.Uint64ToBigEndian(uint64(ethTxIndex))  //nolint:gosec // G115 // index won't exceed uint64
+	return append(bz1, bz2...)
+}
@@ -0,0 +1,91 @@
+package indexer
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	cmttypes "github.com/cometbft/cometbft/types"
+
+	dbm "github.com/cosmos/cosmos-db"
+	servertypes "github.com/cosmos/evm/server/types"
+
+	"cosmossdk.io/log"
+
+	"github.com/cosmos/cosmos-sdk/client"
+)
+
+func TestGetTxsByAddress(t *testing.T) {
+	sender := common.HexToAddress("0xa")
+	recipient := common.HexToAddress("0xb")
+	internal := common.HexToAddress("0xc")
+
+	tracer := func(block *cmttypes.Block) ([]map[common.Address]servertypes.AddressRole, error) {
+		traces := make([]map[common.Address]servertypes.AddressRole, 2)
+		traces[0] = map[common.Address]servertypes.AddressRole{}
+		traces[1] = map[common.Address]servertypes.AddressRole{internal: servertypes.AddressRoleInternal}
+		return traces, nil
+	}
+
+	db := dbm.NewMemDB()
+	kv := NewKVIndexer(db, log.NewNopLogger(), client.Context{}, WithAddressIndex(), WithInternalTxTracer(tracer))
+
+	for height := int64(1); height <= 3; height++ {
+		batch := db.NewBatch()
+		entries := make([]*addressTxs, 2)
+		for i := range entries {
+			entries[i] = &addressTxs{
+				hash:       common.BigToHash(big.NewInt(height*10 + int64(i))),
+				ethTxIndex: int32(i), //#nosec G115
+				roles: map[common.Address]servertypes.AddressRole{
+					sender:    servertypes.AddressRoleFrom,
+					recipient: servertypes.AddressRoleTo,
+				},
+			}
+		}
+		block := &cmttypes.Block{Header: cmttypes.Header{Height: height}}
+		require.NoError(t, kv.indexAddressTxs(batch, block, entries))
+		require.NoError(t, batch.Write())
+		require.NoError(t, batch.Close())
+	}
+
+	// all the sender txs, in pages of 4
+	page, next, err := kv.GetTxsByAddress(sender, 1, 3, nil, 4)
+	require.NoError(t, err)
+	require.Len(t, page, 4)
+	require.NotNil(t, next)
+	require.Equal(t, int64(1), page[0].Height)
+	require.Equal(t, int64(2), page[3].Height)
+	require.Equal(t, int32(1), page[3].EthTxIndex)
+	require.Equal(t, servertypes.AddressRoleFrom, page[0].Roles)
+
+	page, next, err = kv.GetTxsByAddress(sender, 1, 3, next, 4)
+	require.NoError(t, err)
+	require.Len(t, page, 2)
+	require.Nil(t, next)
+	require.Equal(t, int64(3), page[0].Height)
+	require.Equal(t, int32(0), page[0].EthTxIndex)
+
+	// block range
+	page, _, err = kv.GetTxsByAddress(recipient, 2, 2, nil, 10)
+	require.NoError(t, err)
+	require.Len(t, page, 2)
+	require.Equal(t, []string{"to"}, page[0].Roles.Strings())
+
+	// internal transfers come from the tracer
+	page, _, err = kv.GetTxsByAddress(internal, 1, 3, nil, 10)
+	require.NoError(t, err)
+	require.Len(t, page, 3)
+	for _, entry := range page {
+		require.Equal(t, int32(1), entry.EthTxIndex)
+		require.Equal(t, servertypes.AddressRoleInternal, entry.Roles)
+	}
+
+	_, _, err = kv.GetTxsByAddress(sender, 1, 3, []byte{1}, 10)
+	require.ErrorContains(t, err, "invalid cursor length")
+
+	_, _, err = NewKVIndexer(db, log.NewNopLogger(), client.Context{}).GetTxsByAddress(sender, 1, 3, nil, 10)
+	require.ErrorContains(t, err, "address index is disabled")
+}
@@ -0,0 +1,152 @@
+package indexer
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"sort"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/rlp"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+
+	rpctypes "github.com/cosmos/evm/rpc/types"
+	servertypes "github.com/cosmos/evm/server/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/log"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// newBlockFees builds the fee record of a block the same way eth_feeHistory processes the block:
+// - the base fee is parsed from the evm begin block event
+// - the gas used of the block sums the gas used of its txs, the gas used of the eth txs is also
+// accounted to the EVM fee lane
+// - the effective tips of the eth txs are sorted along with the gas used by their cosmos tx
+//
+// A max gas of -1 means that the block gas is unlimited, it's reported as max uint32 like the
+// gas limit of the eth blocks.
+func newBlockFees(
+	clientCtx client.Context,
+	logger log.Logger,
+	block *cmttypes.Block,
+	txResults []*abci.ExecTxResult,
+	events []abci.Event,
+	maxGas int64,
+) *servertypes.BlockFees {
+	baseFee := rpctypes.BaseFeeFromEvents(events)
+	if baseFee == nil {
+		baseFee = big.NewInt(0)
+	}
+	if maxGas == -1 {
+		maxGas = int64(math.MaxUint32)
+	}
+	fees := &servertypes.BlockFees{
+		Time:     uint64(block.Time.Unix()), //#nosec G115 -- block time is never negative
+		BaseFee:  baseFee,
+		GasLimit: uint64(maxGas), //#nosec G115 -- max gas is never negative
+	}
+
+	var (
+		tips          []servertypes.TxTip
+		blockGasSpent bool
+	)
+	for txIndex, txBz := range block.Txs {
+		result := txResults[txIndex]
+		gasUsed := uint64(result.GasUsed) //#nosec G115 -- gas used is never negative
+		// workaround for cosmos-sdk bug. https://github.com/cosmos/cosmos-sdk/issues/10832
+		// block gas limit has exceeded, other txs must have failed with same reason.
+		if result.Code == 11 && strings.Contains(result.Log, "no block gas left to run tx: out of gas") {
+			blockGasSpent = true
+		}
+		if !blockGasSpent {
+			fees.GasUsed += gasUsed
+		}
+
+		tx, err := clientCtx.TxConfig.TxDecoder()(txBz)
+		if err != nil {
+			logger.Debug("failed to decode transaction in block", "height", block.Height, "error", err.Error())
+			continue
+		}
+		isEthTx := false
+		for _, msg := range tx.GetMsgs() {
+			ethMsg, ok := msg.(*evmtypes.MsgEthereumTx)
+			if !ok {
+				continue
+			}
+			tip, err := ethMsg.AsTransaction().EffectiveGasTip(baseFee)
+			if err != nil || tip == nil || tip.Sign() < 0 {
+				tip = big.NewInt(0)
+			}
+			tips = append(tips, servertypes.TxTip{Tip: tip, GasUsed: gasUsed})
+			isEthTx = true
+		}
+		if isEthTx {
+			fees.EVMGasUsed += gasUsed
+		}
+	}
+
+	sort.SliceStable(tips, func(i, j int) bool { return tips[i].Tip.Cmp(tips[j].Tip) < 0 })
+	for _, tip := range tips {
+		if n := len(fees.Tips); n > 0 && fees.Tips[n-1].Tip.Cmp(tip.Tip) == 0 {
+			fees.Tips[n-1].GasUsed += tip.GasUsed
+			continue
+		}
+		fees.Tips = append(fees.Tips, tip)
+	}
+	return fees
+}
+
+// IndexBlockFees stores the fee record of the block: `block number -> fee record`
+func (kv *KVIndexer) IndexBlockFees(block *cmttypes.Block, txResults []*abci.ExecTxResult, events []abci.Event, maxGas int64) error {
+	fees := newBlockFees(kv.clientCtx, kv.logger, block, txResults, events, maxGas)
+	bz, err := rlp.EncodeToBytes(fees)
+	if err != nil {
+		return errorsmod.Wrapf(err, "IndexBlockFees %d", block.Height)
+	}
+	if err := kv.db.Set(BlockFeesKey(block.Height), bz); err != nil {
+		return errorsmod.Wrapf(err, "IndexBlockFees %d, set block-fees key", block.Height)
+	}
+	return nil
+}
+
+// GetBlockFees returns the fee records of the blocks within [from, to], it returns false if a
+// block of the range has no fee record.
+func (kv *KVIndexer) GetBlockFees(from, to int64) ([]*servertypes.BlockFees, bool, error) {
+	if from > to {
+		return nil, false, fmt.Errorf("invalid block range, from: %d, to: %d", from, to)
+	}
+	it, err := kv.db.Iterator(BlockFeesKey(from), BlockFeesKey(to+1))
+	if err != nil {
+		return nil, false, errorsmod.Wrap(err, "GetBlockFees")
+	}
+	defer it.Close()
+
+	records := make([]*servertypes.BlockFees, 0, to-from+1)
+	for next := from; it.Valid(); it.Next() {
+		key := it.Key()
+		if int64(sdk.BigEndianToUint64(key[1:])) != next { //#nosec G115 -- block number won't exceed int64
+			return nil, false, nil
+		}
+		var fees servertypes.BlockFees
+		if err := rlp.DecodeBytes(it.Value(), &fees); err != nil {
+			return nil, false, errorsmod.Wrapf(err, "GetBlockFees, decode block %d", next)
+		}
+		records = append(records, &fees)
+		next++
+	}
+	if err := it.Error(); err != nil {
+		return nil, false, err
+	}
+	return records, int64(len(records)) == to-from+1, nil
+}
+
+// BlockFeesKey returns the key for db entry: `block number -> fee record`
+func BlockFeesKey(blockNumber int64) []byte {
+	return append([]byte{KeyPrefixBlockFees}, sdk.Uint64ToBigEndian(uint64(blockNumber))...) //nolint:gosec // G115 // block number won't exceed uint64
+}
@@ -0,0 +1,95 @@
+package indexer
+
+import (
+	"math"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+
+	dbm "github.com/cosmos/cosmos-db"
+	servertypes "github.com/cosmos/evm/server/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	"cosmossdk.io/log"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+)
+
+func TestBlockFees(t *testing.T) {
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	clientCtx := client.Context{Codec: cdc}
+	blockTime := time.Unix(1700000000, 0)
+	baseFeeEvents := []abci.Event{{
+		Type:       evmtypes.EventTypeFeeMarket,
+		Attributes: []abci.EventAttribute{{Key: evmtypes.AttributeKeyBaseFee, Value: "1000"}},
+	}}
+
+	indexers := map[string]servertypes.EVMFeeHistoryIndexer{
+		"kv":  NewKVIndexer(dbm.NewMemDB(), log.NewNopLogger(), clientCtx),
+		"sql": newTestSQLIndexer(t),
+	}
+	for name, feeIndexer := range indexers {
+		t.Run(name, func(t *testing.T) {
+			for height := int64(1); height <= 3; height++ {
+				block := &cmttypes.Block{Header: cmttypes.Header{Height: height, Time: blockTime}}
+				events := baseFeeEvents
+				maxGas := int64(30_000_000)
+				if height == 3 {
+					// no base fee and unlimited block gas
+					events = nil
+					maxGas = -1
+				}
+				require.NoError(t, feeIndexer.IndexBlockFees(block, nil, events, maxGas))
+			}
+			// re-indexing a block overwrites its record
+			block := &cmttypes.Block{Header: cmttypes.Header{Height: 2, Time: blockTime}}
+			require.NoError(t, feeIndexer.IndexBlockFees(block, nil, baseFeeEvents, 20_000_000))
+
+			records, ok, err := feeIndexer.GetBlockFees(1, 3)
+			require.NoError(t, err)
+			require.True(t, ok)
+			require.Len(t, records, 3)
+			require.Equal(t, uint64(blockTime.Unix()), records[0].Time) //#nosec G115
+			require.Equal(t, big.NewInt(1000), records[0].BaseFee)
+			require.Equal(t, uint64(30_000_000), records[0].GasLimit)
+			require.Zero(t, records[0].GasUsed)
+			require.Empty(t, records[0].Tips)
+			require.Equal(t, uint64(20_000_000), records[1].GasLimit)
+			require.Zero(t, records[2].BaseFee.Sign())
+			require.Equal(t, uint64(math.MaxUint32), records[2].GasLimit)
+
+			_, ok, err = feeIndexer.GetBlockFees(2, 4)
+			require.NoError(t, err)
+			require.False(t, ok, "block 4 has no fee record")
+
+			_, _, err = feeIndexer.GetBlockFees(3, 2)
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestKVIndexerPruneBlockFees(t *testing.T) {
+	kv := NewKVIndexer(dbm.NewMemDB(), log.NewNopLogger(), client.Context{})
+	for height := int64(1); height <= 4; height++ {
+		block := &cmttypes.Block{Header: cmttypes.Header{Height: height}}
+		require.NoError(t, kv.IndexBlockFees(block, nil, nil, 1))
+	}
+
+	require.NoError(t, kv.PruneBlocks(3))
+
+	_, ok, err := kv.GetBlockFees(1, 4)
+	require.NoError(t, err)
+	require.False(t, ok, "pruned blocks have no fee record")
+
+	records, ok, err := kv.GetBlockFees(3, 4)
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Len(t, records, 2)
+}
@@ -9,9 +9,7 @@ import (
 	cmttypes "github.com/cometbft/cometbft/types"
 
 	dbm "github.com/cosmos/cosmos-db"
-	rpctypes "github.com/cosmos/evm/rpc/types"
 	servertypes "github.com/cosmos/evm/server/types"
-	evmtypes "github.com/cosmos/evm/x/vm/types"
 
 	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/log"
@@ -23,107 +21,82 @@ import (
 )
 
 const (
-	KeyPrefixTxHash  = 1
-	KeyPrefixTxIndex = 2
+	KeyPrefixTxHash       = 1
+	KeyPrefixTxIndex      = 2
+	KeyPrefixLogAddress   = 3
+	KeyPrefixLogTopic     = 4
+	KeyPrefixIndexedBlock = 5
+	KeyPrefixAddressTx    = 6
+	KeyPrefixBlockFees    = 7
 
 	// TxIndexKeyLength is the length of tx-index key
 	TxIndexKeyLength = 1 + 8 + 8
 )
 
-var _ servertypes.EVMTxIndexer = &KVIndexer{}
+var (
+	_ servertypes.EVMTxIndexer         = &KVIndexer{}
+	_ servertypes.EVMLogIndexer        = &KVIndexer{}
+	_ servertypes.EVMAddressIndexer    = &KVIndexer{}
+	_ servertypes.EVMIndexPruner       = &KVIndexer{}
+	_ servertypes.EVMFeeHistoryIndexer = &KVIndexer{}
+)
 
 // KVIndexer implements a eth tx indexer on a KV db.
 type KVIndexer struct {
 	db        dbm.DB
 	logger    log.Logger
 	clientCtx client.Context
+
+	addressIndex     bool
+	internalTxTracer InternalTxTracer
 }
 
 // NewKVIndexer creates the KVIndexer
-func NewKVIndexer(db dbm.DB, logger log.Logger, clientCtx client.Context) *KVIndexer {
-	return &KVIndexer{db, logger, clientCtx}
+func NewKVIndexer(db dbm.DB, logger log.Logger, clientCtx client.Context, opts ...KVIndexerOption) *KVIndexer {
+	kv := &KVIndexer{db: db, logger: logger, clientCtx: clientCtx}
+	for _, opt := range opts {
+		opt(kv)
+	}
+	return kv
 }
 
 // IndexBlock index all the eth txs in a block through the following steps:
-// - Iterates over all of the Txs in Block
-// - Parses eth Tx infos from cosmos-sdk events for every TxResult
-// - Iterates over all the messages of the Tx
-// - Builds and stores a indexer.TxResult based on parsed events for every message
+// - Parses the eth txs of the block, see parseBlockTxs
+// - Stores a indexer.TxResult for every eth tx
+// - Stores the address and topic postings of the emitted logs and marks the block as indexed
+// - Stores the sender, recipient and created contract postings if the address index is enabled
 func (kv *KVIndexer) IndexBlock(block *cmttypes.Block, txResults []*abci.ExecTxResult) error {
 	height := block.Height
 
 	batch := kv.db.NewBatch()
 	defer batch.Close()
 
-	// record index of valid eth tx during the iteration
-	var ethTxIndex int32
-	for txIndex, tx := range block.Txs {
-		result := txResults[txIndex]
-		if !rpctypes.TxSucessOrExpectedFailure(result) {
-			continue
+	var addressEntries []*addressTxs
+	for _, ethTx := range parseBlockTxs(kv.clientCtx, kv.logger, block, txResults) {
+		if err := indexLogs(batch, height, ethTx.Logs); err != nil {
+			return errorsmod.Wrapf(err, "IndexBlock %d", height)
 		}
-
-		tx, err := kv.clientCtx.TxConfig.TxDecoder()(tx)
-		if err != nil {
-			kv.logger.Error("Fail to decode tx", "err", err, "block", height, "txIndex", txIndex)
-			continue
-		}
-
-		if !isEthTx(tx) {
-			continue
-		}
-
-		txs, err := rpctypes.ParseTxResult(result, tx)
-		if err != nil {
-			kv.logger.Error("Fail to parse event", "err", err, "block", height, "txIndex", txIndex)
-			continue
+		if kv.addressIndex {
+			addressEntries = append(addressEntries, newAddressTxs(ethTx.Msg, ethTx.Result.EthTxIndex, ethTx.Result.Failed))
 		}
-
-		var cumulativeGasUsed uint64
-		for msgIndex, msg := range tx.GetMsgs() {
-			ethMsg := msg.(*evmtypes.MsgEthereumTx)
-			txHash := ethMsg.Hash()
-
-			txResult := servertypes.TxResult{
-				Height:     height,
-				TxIndex:    uint32(txIndex),  //#nosec G115 -- int overflow is not a concern here
-				MsgIndex:   uint32(msgIndex), //#nosec G115 -- int overflow is not a concern here
-				EthTxIndex: ethTxIndex,
-			}
-			if result.Code != abci.CodeTypeOK {
-				// exceeds block gas limit scenario, set gas used to gas limit because that's what's charged by ante handler.
-				// some old versions don't emit any events, so workaround here directly.
-				txResult.GasUsed = ethMsg.GetGas()
-				txResult.Failed = true
-			} else {
-				parsedTx := txs.GetTxByMsgIndex(msgIndex)
-				if parsedTx == nil {
-					kv.logger.Error("msg index not found in events", "msgIndex", msgIndex)
-					continue
-				}
-				if parsedTx.EthTxIndex >= 0 && parsedTx.EthTxIndex != ethTxIndex {
-					kv.logger.Error("eth tx index don't match", "expect", ethTxIndex, "found", parsedTx.EthTxIndex)
-				}
-				txResult.GasUsed = parsedTx.GasUsed
-				txResult.Failed = parsedTx.Failed
-			}
-
-			cumulativeGasUsed += txResult.GasUsed
-			txResult.CumulativeGasUsed = cumulativeGasUsed
-			ethTxIndex++
-
-			if err := saveTxResult(kv.clientCtx.Codec, batch, txHash, &txResult); err != nil {
-				return errorsmod.Wrapf(err, "IndexBlock %d", height)
-			}
+		if err := saveTxResult(kv.clientCtx.Codec, batch, ethTx.Msg.Hash(), &ethTx.Result); err != nil {
+			return errorsmod.Wrapf(err, "IndexBlock %d", height)
 		}
 	}
+	if err := kv.indexAddressTxs(batch, block, addressEntries); err != nil {
+		return errorsmod.Wrapf(err, "IndexBlock %d", height)
+	}
+	if err := batch.Set(IndexedBlockKey(height), []byte{}); err != nil {
+		return errorsmod.Wrapf(err, "IndexBlock %d, set indexed-block key", height)
+	}
 	if err := batch.Write(); err != nil {
 		return errorsmod.Wrapf(err, "IndexBlock %d, write batch", block.Height)
 	}
 	return nil
 }
 
-// LastIndexedBlock returns the latest indexed block number, returns -1 if db is empty
+// LastIndexedBlock returns the last block of the contiguous range of indexed blocks starting
+// at the first one, returns -1 if db is empty
 func (kv *KVIndexer) LastIndexedBlock() (int64, error) {
 	return LoadLastBlock(kv.db)
 }
@@ -133,6 +106,11 @@ func (kv *KVIndexer) FirstIndexedBlock() (int64, error) {
 	return LoadFirstBlock(kv.db)
 }
 
+// isBlockIndexed returns true if the block is marked as indexed.
+func (kv *KVIndexer) isBlockIndexed(height int64) (bool, error) {
+	return kv.db.Has(IndexedBlockKey(height))
+}
+
 // GetByTxHash finds eth tx by eth tx hash
 func (kv *KVIndexer) GetByTxHash(hash common.Hash) (*servertypes.TxResult, error) {
 	bz, err := kv.db.Get(TxHashKey(hash))
@@ -173,26 +151,69 @@ func TxIndexKey(blockNumber int64, txIndex int32) []byte {
 	return append(append([]byte{KeyPrefixTxIndex}, bz1...), bz2...)
 }
 
-// LoadLastBlock returns the latest indexed block number, returns -1 if db is empty
+// LoadLastBlock returns the last block of the contiguous range of indexed blocks starting at the
+// first one, returns -1 if db is empty. The blocks are read from the indexed block markers, which
+// are set for the blocks without eth txs too, so that the indexing resumes at the first gap, e.g.
+// one left by an interrupted parallel reindex. The dbs written before the markers fall back to the
+// tx-index keys.
 func LoadLastBlock(db dbm.DB) (int64, error) {
-	it, err := db.ReverseIterator([]byte{KeyPrefixTxIndex}, []byte{KeyPrefixTxIndex + 1})
+	it, err := db.Iterator([]byte{KeyPrefixIndexedBlock}, []byte{KeyPrefixIndexedBlock + 1})
 	if err != nil {
 		return 0, errorsmod.Wrap(err, "LoadLastBlock")
 	}
 	defer it.Close()
 	if !it.Valid() {
-		return -1, nil
+		return loadTxIndexBlock(db, true)
 	}
-	return parseBlockNumberFromKey(it.Key())
+
+	last := int64(-1)
+	for ; it.Valid(); it.Next() {
+		height, err := parseBlockNumberFromMarker(it.Key())
+		if err != nil {
+			return 0, errorsmod.Wrap(err, "LoadLastBlock")
+		}
+		if last != -1 && height != last+1 {
+			break
+		}
+		last = height
+	}
+	if err := it.Error(); err != nil {
+		return 0, errorsmod.Wrap(err, "LoadLastBlock")
+	}
+	return last, nil
 }
 
-// LoadFirstBlock loads the first indexed block, returns -1 if db is empty
+// LoadFirstBlock loads the first indexed block from the indexed block markers, returns -1 if db
+// is empty. The dbs written before the markers fall back to the tx-index keys.
 func LoadFirstBlock(db dbm.DB) (int64, error) {
-	it, err := db.Iterator([]byte{KeyPrefixTxIndex}, []byte{KeyPrefixTxIndex + 1})
+	it, err := db.Iterator([]byte{KeyPrefixIndexedBlock}, []byte{KeyPrefixIndexedBlock + 1})
 	if err != nil {
 		return 0, errorsmod.Wrap(err, "LoadFirstBlock")
 	}
 	defer it.Close()
+	if !it.Valid() {
+		return loadTxIndexBlock(db, false)
+	}
+	return parseBlockNumberFromMarker(it.Key())
+}
+
+// loadTxIndexBlock returns the first or the last block with an indexed eth tx, returns -1 if db
+// is empty.
+func loadTxIndexBlock(db dbm.DB, last bool) (int64, error) {
+	start, end := []byte{KeyPrefixTxIndex}, []byte{KeyPrefixTxIndex + 1}
+	var (
+		it  dbm.Iterator
+		err error
+	)
+	if last {
+		it, err = db.ReverseIterator(start, end)
+	} else {
+		it, err = db.Iterator(start, end)
+	}
+	if err != nil {
+		return 0, errorsmod.Wrap(err, "loadTxIndexBlock")
+	}
+	defer it.Close()
 	if !it.Valid() {
 		return -1, nil
 	}
@@ -224,6 +245,14 @@ func saveTxResult(codec codec.Codec, batch dbm.Batch, txHash common.Hash, txResu
 	return nil
 }
 
+func parseBlockNumberFromMarker(key []byte) (int64, error) {
+	if len(key) != 1+8 {
+		return 0, fmt.Errorf("wrong indexed block key length, expect: %d, got: %d", 1+8, len(key))
+	}
+
+	return int64(sdk.BigEndianToUint64(key[1:])), nil //#nosec G115 -- block number won't exceed int64
+}
+
 func parseBlockNumberFromKey(key []byte) (int64, error) {
 	if len(key) != TxIndexKeyLength {
 		return 0, fmt.Errorf("wrong tx index key length, expect: %d, got: %d", TxIndexKeyLength, len(key))
@@ -0,0 +1,167 @@
+package indexer
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	dbm "github.com/cosmos/cosmos-db"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MaxIndexedTopics is the number of topic positions covered by the log index,
+// it matches the maximum number of topics an EVM log can carry (LOG4).
+const MaxIndexedTopics = 4
+
+// indexLogs stores the log postings of a block into the kv db batch:
+// - `address -> block number` for every log emitter
+// - `(topic position, topic) -> block number` for every indexed topic
+//
+// Postings are kept at block granularity, the exact log matching is done by the
+// caller on the block results of the candidate blocks.
+func indexLogs(batch dbm.Batch, height int64, logs []*ethtypes.Log) error {
+	for _, log := range logs {
+		if err := batch.Set(LogAddressKey(log.Address, height), []byte{}); err != nil {
+			return errorsmod.Wrap(err, "set log-address key")
+		}
+		for i, topic := range log.Topics {
+			if i >= MaxIndexedTopics {
+				break
+			}
+			if err := batch.Set(LogTopicKey(i, topic, height), []byte{}); err != nil {
+				return errorsmod.Wrap(err, "set log-topic key")
+			}
+		}
+	}
+	return nil
+}
+
+// GetLogBlocks returns the sorted list of block numbers within [from, to] that contain at
+// least one log matching the given addresses and topics, following the eth_getLogs semantics:
+// the addresses are OR-ed, topics are OR-ed within a position and AND-ed across positions.
+//
+// The returned boolean is false if the log index can't answer the query, either because the
+// range is not fully covered by the indexer or because the criteria has no indexed clause.
+func (kv *KVIndexer) GetLogBlocks(from, to int64, addresses []common.Address, topics [][]common.Hash) ([]int64, bool, error) {
+	if from > to {
+		return nil, false, fmt.Errorf("invalid block range, from: %d, to: %d", from, to)
+	}
+
+	var clauses [][][]byte
+	if len(addresses) > 0 {
+		prefixes := make([][]byte, len(addresses))
+		for i, address := range addresses {
+			prefixes[i] = logAddressPrefix(address)
+		}
+		clauses = append(clauses, prefixes)
+	}
+	for i, sub := range topics {
+		// empty rule set == wildcard, positions beyond the index can't be used
+		if len(sub) == 0 || i >= MaxIndexedTopics {
+			continue
+		}
+		prefixes := make([][]byte, len(sub))
+		for j, topic := range sub {
+			prefixes[j] = logTopicPrefix(i, topic)
+		}
+		clauses = append(clauses, prefixes)
+	}
+	if len(clauses) == 0 {
+		return nil, false, nil
+	}
+
+	covered, err := kv.isRangeIndexed(from, to)
+	if err != nil || !covered {
+		return nil, false, err
+	}
+
+	var result map[int64]struct{}
+	for _, clause := range clauses {
+		heights := make(map[int64]struct{})
+		for _, prefix := range clause {
+			if err := kv.collectPostings(prefix, from, to, heights); err != nil {
+				return nil, false, err
+			}
+		}
+		if result == nil {
+			result = heights
+		} else {
+			for height := range result {
+				if _, ok := heights[height]; !ok {
+					delete(result, height)
+				}
+			}
+		}
+		if len(result) == 0 {
+			break
+		}
+	}
+
+	blocks := make([]int64, 0, len(result))
+	for height := range result {
+		blocks = append(blocks, height)
+	}
+	sort.Slice(blocks, func(i, j int) bool { return blocks[i] < blocks[j] })
+	return blocks, true, nil
+}
+
+// collectPostings adds the block numbers within [from, to] stored under the posting prefix to heights.
+func (kv *KVIndexer) collectPostings(prefix []byte, from, to int64, heights map[int64]struct{}) error {
+	start := append(append([]byte{}, prefix...), sdk.Uint64ToBigEndian(uint64(from))...) //nolint:gosec // G115 // block number won't exceed uint64
+	end := append(append([]byte{}, prefix...), sdk.Uint64ToBigEndian(uint64(to+1))...)   //nolint:gosec // G115 // block number won't exceed uint64
+	it, err := kv.db.Iterator(start, end)
+	if err != nil {
+		return errorsmod.Wrap(err, "GetLogBlocks")
+	}
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		key := it.Key()
+		heights[int64(sdk.BigEndianToUint64(key[len(key)-8:]))] = struct{}{} //#nosec G115 -- block number won't exceed int64
+	}
+	return it.Error()
+}
+
+// isRangeIndexed returns true if every block within [from, to] has been processed by the indexer.
+func (kv *KVIndexer) isRangeIndexed(from, to int64) (bool, error) {
+	it, err := kv.db.Iterator(IndexedBlockKey(from), IndexedBlockKey(to+1))
+	if err != nil {
+		return false, errorsmod.Wrap(err, "isRangeIndexed")
+	}
+	defer it.Close()
+	var count int64
+	for ; it.Valid(); it.Next() {
+		count++
+	}
+	if err := it.Error(); err != nil {
+		return false, err
+	}
+	return count == to-from+1, nil
+}
+
+// LogAddressKey returns the key for db entry: `(address, block number) -> nil`
+func LogAddressKey(address common.Address, blockNumber int64) []byte {
+	return append(logAddressPrefix(address), sdk.Uint64ToBigEndian(uint64(blockNumber))...) //nolint:gosec // G115 // block number won't exceed uint64
+}
+
+// LogTopicKey returns the key for db entry: `(topic position, topic, block number) -> nil`
+func LogTopicKey(position int, topic common.Hash, blockNumber int64) []byte {
+	return append(logTopicPrefix(position, topic), sdk.Uint64ToBigEndian(uint64(blockNumber))...) //nolint:gosec // G115 // block number won't exceed uint64
+}
+
+// IndexedBlockKey returns the key for db entry: `block number -> nil`, it marks the block as indexed.
+func IndexedBlockKey(blockNumber int64) []byte {
+	return append([]byte{KeyPrefixIndexedBlock}, sdk.Uint64ToBigEndian(uint64(blockNumber))...) //nolint:gosec // G115 // block number won't exceed uint64
+}
+
+func logAddressPrefix(address common.Address) []byte {
+	return append([]byte{KeyPrefixLogAddress}, address.Bytes()...)
+}
+
+func logTopicPrefix(position int, topic common.Hash) []byte {
+	return append([]byte{KeyPrefixLogTopic, byte(position)}, topic.Bytes()...) //#nosec G115 -- position is lower than MaxIndexedTopics
+}
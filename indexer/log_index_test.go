@@ -0,0 +1,69 @@
+package indexer
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+
+	dbm "github.com/cosmos/cosmos-db"
+
+	"cosmossdk.io/log"
+
+	"github.com/cosmos/cosmos-sdk/client"
+)
+
+func TestGetLogBlocks(t *testing.T) {
+	addrA := common.HexToAddress("0xa")
+	addrB := common.HexToAddress("0xb")
+	topicX := common.HexToHash("0x1")
+	topicY := common.HexToHash("0x2")
+
+	db := dbm.NewMemDB()
+	batch := db.NewBatch()
+	blockLogs := map[int64][]*ethtypes.Log{
+		1: {{Address: addrA, Topics: []common.Hash{topicX}}},
+		2: {},
+		3: {{Address: addrB, Topics: []common.Hash{topicX, topicY}}},
+		4: {{Address: addrA, Topics: []common.Hash{topicY}}},
+	}
+	for height, logs := range blockLogs {
+		require.NoError(t, indexLogs(batch, height, logs))
+		require.NoError(t, batch.Set(IndexedBlockKey(height), []byte{}))
+	}
+	require.NoError(t, batch.Write())
+	require.NoError(t, batch.Close())
+
+	kv := NewKVIndexer(db, log.NewNopLogger(), client.Context{})
+
+	testCases := []struct {
+		name      string
+		from, to  int64
+		addresses []common.Address
+		topics    [][]common.Hash
+		expBlocks []int64
+		expOk     bool
+	}{
+		{"no indexed clause", 1, 4, nil, [][]common.Hash{{}}, nil, false},
+		{"range not fully indexed", 1, 5, []common.Address{addrA}, nil, nil, false},
+		{"single address", 1, 4, []common.Address{addrA}, nil, []int64{1, 4}, true},
+		{"addresses are or-ed", 1, 4, []common.Address{addrA, addrB}, nil, []int64{1, 3, 4}, true},
+		{"sub range", 2, 4, []common.Address{addrA}, nil, []int64{4}, true},
+		{"topic position", 1, 4, nil, [][]common.Hash{{topicY}}, []int64{4}, true},
+		{"wildcard topic position", 1, 4, nil, [][]common.Hash{{}, {topicY}}, []int64{3}, true},
+		{"address and topic are and-ed", 1, 4, []common.Address{addrB}, [][]common.Hash{{topicX}}, []int64{3}, true},
+		{"no match", 1, 4, []common.Address{addrB}, [][]common.Hash{{topicY}}, []int64{}, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			blocks, ok, err := kv.GetLogBlocks(tc.from, tc.to, tc.addresses, tc.topics)
+			require.NoError(t, err)
+			require.Equal(t, tc.expOk, ok)
+			if tc.expOk {
+				require.Equal(t, tc.expBlocks, blocks)
+			}
+		})
+	}
+}
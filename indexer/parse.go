@@ -0,0 +1,106 @@
+package indexer
+
+import (
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+
+	rpctypes "github.com/cosmos/evm/rpc/types"
+	servertypes "github.com/cosmos/evm/server/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	"cosmossdk.io/log"
+
+	"github.com/cosmos/cosmos-sdk/client"
+)
+
+// indexedTx is an eth tx of a block along with the result to index.
+type indexedTx struct {
+	Msg    *evmtypes.MsgEthereumTx
+	Result servertypes.TxResult
+	// Logs emitted by the eth tx, empty if the tx failed.
+	Logs []*ethtypes.Log
+}
+
+// parseBlockTxs extracts the eth txs of a block through the following steps:
+// - Iterates over all of the Txs in Block
+// - Parses eth Tx infos from cosmos-sdk events for every TxResult
+// - Iterates over all the messages of the Tx
+// - Builds a indexer.TxResult based on parsed events for every message
+//
+// The txs that can't be parsed are logged and skipped.
+func parseBlockTxs(clientCtx client.Context, logger log.Logger, block *cmttypes.Block, txResults []*abci.ExecTxResult) []*indexedTx {
+	height := block.Height
+
+	var ethTxs []*indexedTx
+	// record index of valid eth tx during the iteration
+	var ethTxIndex int32
+	for txIndex, tx := range block.Txs {
+		result := txResults[txIndex]
+		if !rpctypes.TxSucessOrExpectedFailure(result) {
+			continue
+		}
+
+		tx, err := clientCtx.TxConfig.TxDecoder()(tx)
+		if err != nil {
+			logger.Error("Fail to decode tx", "err", err, "block", height, "txIndex", txIndex)
+			continue
+		}
+
+		if !isEthTx(tx) {
+			continue
+		}
+
+		txs, err := rpctypes.ParseTxResult(result, tx)
+		if err != nil {
+			logger.Error("Fail to parse event", "err", err, "block", height, "txIndex", txIndex)
+			continue
+		}
+
+		var cumulativeGasUsed uint64
+		for msgIndex, msg := range tx.GetMsgs() {
+			ethMsg := msg.(*evmtypes.MsgEthereumTx)
+
+			ethTx := &indexedTx{
+				Msg: ethMsg,
+				Result: servertypes.TxResult{
+					Height:     height,
+					TxIndex:    uint32(txIndex),  //#nosec G115 -- int overflow is not a concern here
+					MsgIndex:   uint32(msgIndex), //#nosec G115 -- int overflow is not a concern here
+					EthTxIndex: ethTxIndex,
+				},
+			}
+			if result.Code != abci.CodeTypeOK {
+				// exceeds block gas limit scenario, set gas used to gas limit because that's what's charged by ante handler.
+				// some old versions don't emit any events, so workaround here directly.
+				ethTx.Result.GasUsed = ethMsg.GetGas()
+				ethTx.Result.Failed = true
+			} else {
+				parsedTx := txs.GetTxByMsgIndex(msgIndex)
+				if parsedTx == nil {
+					logger.Error("msg index not found in events", "msgIndex", msgIndex)
+					continue
+				}
+				if parsedTx.EthTxIndex >= 0 && parsedTx.EthTxIndex != ethTxIndex {
+					logger.Error("eth tx index don't match", "expect", ethTxIndex, "found", parsedTx.EthTxIndex)
+				}
+				ethTx.Result.GasUsed = parsedTx.GasUsed
+				ethTx.Result.Failed = parsedTx.Failed
+
+				logs, err := evmtypes.DecodeMsgLogs(result.Data, msgIndex, uint64(height)) //#nosec G115 -- block height is never negative
+				if err != nil {
+					logger.Error("Fail to decode tx logs", "err", err, "block", height, "txIndex", txIndex, "msgIndex", msgIndex)
+				}
+				ethTx.Logs = logs
+			}
+
+			cumulativeGasUsed += ethTx.Result.GasUsed
+			ethTx.Result.CumulativeGasUsed = cumulativeGasUsed
+			ethTxIndex++
+
+			ethTxs = append(ethTxs, ethTx)
+		}
+	}
+	return ethTxs
+}
@@ -0,0 +1,112 @@
+package indexer
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// pruneBatchSize is the maximum number of keys deleted in a single batch while pruning.
+const pruneBatchSize = 10_000
+
+// PruneBlocks removes the indexed data of the blocks below the height, it's used to follow
+// the pruning of the block store.
+func (kv *KVIndexer) PruneBlocks(height int64) error {
+	// `(block number, eth tx index) -> tx hash`, along with the `tx hash -> tx result` entries
+	if err := kv.pruneRange(TxIndexKey(0, 0), TxIndexKey(height, 0), func(key, value []byte) [][]byte {
+		return [][]byte{key, TxHashKey(common.BytesToHash(value))}
+	}); err != nil {
+		return errorsmod.Wrap(err, "PruneBlocks")
+	}
+
+	// `block number -> nil` markers
+	if err := kv.pruneRange(IndexedBlockKey(0), IndexedBlockKey(height), func(key, _ []byte) [][]byte {
+		return [][]byte{key}
+	}); err != nil {
+		return errorsmod.Wrap(err, "PruneBlocks")
+	}
+
+	// `block number -> fee record` entries
+	if err := kv.pruneRange(BlockFeesKey(0), BlockFeesKey(height), func(key, _ []byte) [][]byte {
+		return [][]byte{key}
+	}); err != nil {
+		return errorsmod.Wrap(err, "PruneBlocks")
+	}
+
+	// postings are keyed by address or topic first, so the whole prefix is scanned
+	for _, prefix := range []byte{KeyPrefixLogAddress, KeyPrefixLogTopic, KeyPrefixAddressTx} {
+		if err := kv.pruneRange([]byte{prefix}, []byte{prefix + 1}, func(key, _ []byte) [][]byte {
+			if postingHeight(key) >= height {
+				return nil
+			}
+			return [][]byte{key}
+		}); err != nil {
+			return errorsmod.Wrap(err, "PruneBlocks")
+		}
+	}
+	return nil
+}
+
+// pruneRange deletes the keys returned by fn for the entries within [start, end). The keys are
+// deleted in batches, the iterator is released before every batch write.
+func (kv *KVIndexer) pruneRange(start, end []byte, fn func(key, value []byte) [][]byte) error {
+	for start != nil {
+		var (
+			keys [][]byte
+			err  error
+		)
+		keys, start, err = kv.collectPruneKeys(start, end, fn)
+		if err != nil {
+			return err
+		}
+		if len(keys) == 0 {
+			continue
+		}
+		batch := kv.db.NewBatch()
+		for _, key := range keys {
+			if err := batch.Delete(key); err != nil {
+				batch.Close()
+				return err
+			}
+		}
+		err = batch.Write()
+		batch.Close()
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// collectPruneKeys returns up to pruneBatchSize keys to delete, and the start key of the next
+// batch if the range is not exhausted.
+func (kv *KVIndexer) collectPruneKeys(start, end []byte, fn func(key, value []byte) [][]byte) ([][]byte, []byte, error) {
+	it, err := kv.db.Iterator(start, end)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer it.Close()
+
+	var keys [][]byte
+	for ; it.Valid(); it.Next() {
+		if len(keys) >= pruneBatchSize {
+			return keys, append([]byte{}, it.Key()...), nil
+		}
+		for _, key := range fn(it.Key(), it.Value()) {
+			keys = append(keys, append([]byte{}, key...))
+		}
+	}
+	return keys, nil, it.Error()
+}
+
+// postingHeight returns the block number of a log or address posting key.
+func postingHeight(key []byte) int64 {
+	offset := len(key) - 8
+	if key[0] == KeyPrefixAddressTx {
+		// the eth tx index follows the block number
+		offset -= 8
+	}
+	return int64(sdk.BigEndianToUint64(key[offset : offset+8])) //#nosec G115 -- block number won't exceed int64
+}
@@ -0,0 +1,236 @@
+package indexer
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+
+	cmttypes "github.com/cometbft/cometbft/types"
+
+	dbm "github.com/cosmos/cosmos-db"
+	servertypes "github.com/cosmos/evm/server/types"
+
+	"cosmossdk.io/log"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+)
+
+func TestKVIndexerPruneBlocks(t *testing.T) {
+	sender := common.HexToAddress("0xa")
+	recipient := common.HexToAddress("0xb")
+	topic := common.HexToHash("0x1")
+
+	db := dbm.NewMemDB()
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	kv := NewKVIndexer(db, log.NewNopLogger(), client.Context{Codec: cdc}, WithAddressIndex())
+
+	for height := int64(1); height <= 4; height++ {
+		tx := newTestIndexedTx(sender, &recipient, uint64(height), height, 0) //#nosec G115
+		batch := db.NewBatch()
+		require.NoError(t, saveTxResult(cdc, batch, tx.Msg.Hash(), &tx.Result))
+		require.NoError(t, indexLogs(batch, height, []*ethtypes.Log{{Address: recipient, Topics: []common.Hash{topic}}}))
+		block := &cmttypes.Block{Header: cmttypes.Header{Height: height}}
+		require.NoError(t, kv.indexAddressTxs(batch, block, []*addressTxs{newAddressTxs(tx.Msg, 0, false)}))
+		require.NoError(t, batch.Set(IndexedBlockKey(height), []byte{}))
+		require.NoError(t, batch.Write())
+		require.NoError(t, batch.Close())
+	}
+
+	pruned := newTestIndexedTx(sender, &recipient, 2, 2, 0)
+	kept := newTestIndexedTx(sender, &recipient, 3, 3, 0)
+
+	require.NoError(t, kv.PruneBlocks(3))
+
+	first, err := kv.FirstIndexedBlock()
+	require.NoError(t, err)
+	require.Equal(t, int64(3), first)
+	last, err := kv.LastIndexedBlock()
+	require.NoError(t, err)
+	require.Equal(t, int64(4), last)
+
+	_, err = kv.GetByTxHash(pruned.Msg.Hash())
+	require.Error(t, err)
+	res, err := kv.GetByTxHash(kept.Msg.Hash())
+	require.NoError(t, err)
+	require.Equal(t, kept.Result, *res)
+
+	_, ok, err := kv.GetLogBlocks(1, 4, []common.Address{recipient}, nil)
+	require.NoError(t, err)
+	require.False(t, ok, "pruned blocks are not indexed anymore")
+
+	blocks, ok, err := kv.GetLogBlocks(3, 4, nil, [][]common.Hash{{topic}})
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, []int64{3, 4}, blocks)
+
+	page, _, err := kv.GetTxsByAddress(sender, 1, 4, nil, 10)
+	require.NoError(t, err)
+	require.Len(t, page, 2)
+	require.Equal(t, int64(3), page[0].Height)
+	require.Equal(t, servertypes.AddressRoleFrom, page[0].Roles)
+
+	// pruning everything empties the db
+	require.NoError(t, kv.PruneBlocks(5))
+	first, err = kv.FirstIndexedBlock()
+	require.NoError(t, err)
+	require.Equal(t, int64(-1), first)
+	last, err = kv.LastIndexedBlock()
+	require.NoError(t, err)
+	require.Equal(t, int64(-1), last)
+}
+
+func TestSQLIndexerPruneBlocks(t *testing.T) {
+	si := newTestSQLIndexer(t)
+	sender := common.HexToAddress("0xa")
+	recipient := common.HexToAddress("0xb")
+
+	for height := int64(1); height <= 4; height++ {
+		block := &cmttypes.Block{Header: cmttypes.Header{Height: height}}
+		tx := newTestIndexedTx(sender, &recipient, uint64(height), height, 0) //#nosec G115
+		require.NoError(t, si.storeBlock(block, []*indexedTx{tx}))
+	}
+
+	require.NoError(t, si.PruneBlocks(3))
+
+	first, err := si.FirstIndexedBlock()
+	require.NoError(t, err)
+	require.Equal(t, int64(3), first)
+
+	_, ok, err := si.GetLogBlocks(1, 4, []common.Address{recipient}, nil)
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	page, _, err := si.GetTxsByAddress(sender, 1, 4, nil, 10)
+	require.NoError(t, err)
+	require.Len(t, page, 2)
+}
+
+func TestVerifyTxs(t *testing.T) {
+	si := newTestSQLIndexer(t)
+	sender := common.HexToAddress("0xa")
+	recipient := common.HexToAddress("0xb")
+	block := &cmttypes.Block{Header: cmttypes.Header{Height: 1}}
+
+	topic := common.HexToHash("0x1")
+
+	txs := []*indexedTx{
+		newTestIndexedTx(sender, &recipient, 0, 1, 0),
+		newTestIndexedTx(sender, &recipient, 1, 1, 1, &ethtypes.Log{Address: recipient, Topics: []common.Hash{topic}}),
+	}
+
+	// nothing indexed, nor the block
+	require.Len(t, verifyTxs(si, 1, txs), 3)
+
+	require.NoError(t, si.storeBlock(block, txs))
+	require.Empty(t, verifyTxs(si, 1, txs))
+
+	// result mismatch
+	changed := newTestIndexedTx(sender, &recipient, 1, 1, 1)
+	changed.Result.GasUsed++
+	require.Len(t, verifyTxs(si, 1, []*indexedTx{txs[0], changed}), 1)
+
+	// indexed tx missing from the block results
+	require.Len(t, verifyTxs(si, 1, txs[:1]), 1)
+
+	// log postings missing
+	_, err := si.db.Exec("DELETE FROM logs")
+	require.NoError(t, err)
+	require.Len(t, verifyTxs(si, 1, txs), 2)
+}
+
+func TestVerifyTxsKV(t *testing.T) {
+	db := dbm.NewMemDB()
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	kv := NewKVIndexer(db, log.NewNopLogger(), client.Context{Codec: cdc})
+	recipient := common.HexToAddress("0xb")
+	topics := []common.Hash{common.HexToHash("0x1"), common.HexToHash("0x2")}
+	ethTx := newTestIndexedTx(common.HexToAddress("0xa"), &recipient, 0, 1, 0, &ethtypes.Log{Address: recipient, Topics: topics})
+
+	batch := db.NewBatch()
+	require.NoError(t, saveTxResult(cdc, batch, ethTx.Msg.Hash(), &ethTx.Result))
+	require.NoError(t, batch.Write())
+	require.NoError(t, batch.Close())
+
+	// the block marker is missing, the log postings can't be checked
+	require.Equal(t, []string{"block is not marked as indexed"}, verifyTxs(kv, 1, []*indexedTx{ethTx}))
+
+	// the log postings are missing
+	require.NoError(t, db.Set(IndexedBlockKey(1), []byte{}))
+	require.Len(t, verifyTxs(kv, 1, []*indexedTx{ethTx}), 3)
+
+	batch = db.NewBatch()
+	require.NoError(t, indexLogs(batch, 1, ethTx.Logs))
+	require.NoError(t, batch.Write())
+	require.NoError(t, batch.Close())
+	require.Empty(t, verifyTxs(kv, 1, []*indexedTx{ethTx}))
+}
+
+func TestKVIndexerIndexedBlockRange(t *testing.T) {
+	sender := common.HexToAddress("0xa")
+	recipient := common.HexToAddress("0xb")
+
+	db := dbm.NewMemDB()
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	kv := NewKVIndexer(db, log.NewNopLogger(), client.Context{Codec: cdc})
+
+	// the blocks are indexed out of order, as by the workers of a parallel reindex,
+	// and only block 5 has an eth tx
+	for _, height := range []int64{4, 1, 3, 7, 5} {
+		batch := db.NewBatch()
+		if height == 5 {
+			tx := newTestIndexedTx(sender, &recipient, 0, height, 0)
+			require.NoError(t, saveTxResult(cdc, batch, tx.Msg.Hash(), &tx.Result))
+		}
+		require.NoError(t, batch.Set(IndexedBlockKey(height), []byte{}))
+		require.NoError(t, batch.Write())
+		require.NoError(t, batch.Close())
+	}
+
+	first, err := kv.FirstIndexedBlock()
+	require.NoError(t, err)
+	require.Equal(t, int64(1), first)
+	last, err := kv.LastIndexedBlock()
+	require.NoError(t, err)
+	require.Equal(t, int64(1), last, "the indexing resumes at the first gap")
+
+	// filling the gaps extends the range up to the next one
+	require.NoError(t, kv.IndexBlock(&cmttypes.Block{Header: cmttypes.Header{Height: 2}}, nil))
+	last, err = kv.LastIndexedBlock()
+	require.NoError(t, err)
+	require.Equal(t, int64(5), last)
+
+	// the pruned range starts at the prune height, not at the first block with an eth tx
+	require.NoError(t, kv.PruneBlocks(3))
+	first, err = kv.FirstIndexedBlock()
+	require.NoError(t, err)
+	require.Equal(t, int64(3), first)
+	last, err = kv.LastIndexedBlock()
+	require.NoError(t, err)
+	require.Equal(t, int64(5), last)
+}
+
+func TestKVIndexerLegacyBlockRange(t *testing.T) {
+	db := dbm.NewMemDB()
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	kv := NewKVIndexer(db, log.NewNopLogger(), client.Context{Codec: cdc})
+
+	// the dbs written before the indexed block markers only have the tx-index keys
+	for _, height := range []int64{2, 5} {
+		tx := newTestIndexedTx(common.HexToAddress("0xa"), nil, uint64(height), height, 0) //#nosec G115
+		batch := db.NewBatch()
+		require.NoError(t, saveTxResult(cdc, batch, tx.Msg.Hash(), &tx.Result))
+		require.NoError(t, batch.Write())
+		require.NoError(t, batch.Close())
+	}
+
+	first, err := kv.FirstIndexedBlock()
+	require.NoError(t, err)
+	require.Equal(t, int64(2), first)
+	last, err := kv.LastIndexedBlock()
+	require.NoError(t, err)
+	require.Equal(t, int64(5), last)
+}
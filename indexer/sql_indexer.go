@@ -0,0 +1,609 @@
+package indexer
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+
+	servertypes "github.com/cosmos/evm/server/types"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/log"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// sqlSchema defines the relational schema of the SQL indexer. It only uses types and
+// statements supported by both SQLite and PostgreSQL. Hashes and addresses are stored as
+// lower case 0x-prefixed hex strings and big integers as decimal strings.
+var sqlSchema = []string{
+	`CREATE TABLE IF NOT EXISTS blocks (
+		height BIGINT PRIMARY KEY,
+		hash TEXT NOT NULL,
+		parent_hash TEXT NOT NULL,
+		time BIGINT NOT NULL,
+		proposer TEXT NOT NULL,
+		eth_tx_count INTEGER NOT NULL,
+		gas_used BIGINT NOT NULL
+	)`,
+	`CREATE TABLE IF NOT EXISTS transactions (
+		hash TEXT PRIMARY KEY,
+		height BIGINT NOT NULL,
+		tx_index INTEGER NOT NULL,
+		msg_index INTEGER NOT NULL,
+		eth_tx_index INTEGER NOT NULL,
+		type INTEGER NOT NULL,
+		from_address TEXT NOT NULL,
+		to_address TEXT,
+		nonce BIGINT NOT NULL,
+		value TEXT NOT NULL,
+		gas_limit BIGINT NOT NULL,
+		gas_fee_cap TEXT NOT NULL,
+		gas_tip_cap TEXT NOT NULL,
+		input TEXT NOT NULL
+	)`,
+	`CREATE UNIQUE INDEX IF NOT EXISTS transactions_height_idx ON transactions (height, eth_tx_index)`,
+	`CREATE INDEX IF NOT EXISTS transactions_from_idx ON transactions (from_address, height)`,
+	`CREATE INDEX IF NOT EXISTS transactions_to_idx ON transactions (to_address, height)`,
+	`CREATE TABLE IF NOT EXISTS receipts (
+		tx_hash TEXT PRIMARY KEY,
+		height BIGINT NOT NULL,
+		status INTEGER NOT NULL,
+		gas_used BIGINT NOT NULL,
+		cumulative_gas_used BIGINT NOT NULL,
+		contract_address TEXT
+	)`,
+	`CREATE INDEX IF NOT EXISTS receipts_height_idx ON receipts (height)`,
+	`CREATE INDEX IF NOT EXISTS receipts_contract_idx ON receipts (contract_address)`,
+	`CREATE TABLE IF NOT EXISTS logs (
+		height BIGINT NOT NULL,
+		log_index INTEGER NOT NULL,
+		tx_hash TEXT NOT NULL,
+		address TEXT NOT NULL,
+		topic0 TEXT,
+		topic1 TEXT,
+		topic2 TEXT,
+		topic3 TEXT,
+		data TEXT NOT NULL,
+		PRIMARY KEY (tx_hash, log_index)
+	)`,
+	`CREATE INDEX IF NOT EXISTS logs_height_idx ON logs (height)`,
+	`CREATE INDEX IF NOT EXISTS logs_address_idx ON logs (address, height)`,
+	`CREATE INDEX IF NOT EXISTS logs_topic0_idx ON logs (topic0, height)`,
+	`CREATE TABLE IF NOT EXISTS block_fees (
+		height BIGINT PRIMARY KEY,
+		time BIGINT NOT NULL,
+		base_fee TEXT NOT NULL,
+		gas_limit BIGINT NOT NULL,
+		gas_used BIGINT NOT NULL,
+		evm_gas_used BIGINT NOT NULL,
+		tips TEXT NOT NULL
+	)`,
+}
+
+var (
+	_ servertypes.EVMTxIndexer         = &SQLIndexer{}
+	_ servertypes.EVMLogIndexer        = &SQLIndexer{}
+	_ servertypes.EVMAddressIndexer    = &SQLIndexer{}
+	_ servertypes.EVMIndexPruner       = &SQLIndexer{}
+	_ servertypes.EVMFeeHistoryIndexer = &SQLIndexer{}
+)
+
+// SQLIndexer implements a eth tx indexer on a relational database through database/sql.
+// Blocks, transactions, receipts and logs are stored in plain tables, so the indexed data
+// can also be queried directly with SQL. The database driver is pluggable, the statements
+// are compatible with SQLite and PostgreSQL.
+type SQLIndexer struct {
+	db         *sql.DB
+	driverName string
+	logger     log.Logger
+	clientCtx  client.Context
+}
+
+// NewSQLIndexer creates the SQLIndexer and the database schema if it doesn't exist yet.
+// The driver name must be the one used to open the database.
+func NewSQLIndexer(db *sql.DB, driverName string, logger log.Logger, clientCtx client.Context) (*SQLIndexer, error) {
+	for _, stmt := range sqlSchema {
+		if _, err := db.Exec(stmt); err != nil {
+			return nil, errorsmod.Wrap(err, "create sql indexer schema")
+		}
+	}
+	return &SQLIndexer{db: db, driverName: driverName, logger: logger, clientCtx: clientCtx}, nil
+}
+
+// IndexBlock stores the block and its eth txs, receipts and logs in a single database
+// transaction. Indexing a block again replaces its previous rows.
+func (si *SQLIndexer) IndexBlock(block *cmttypes.Block, txResults []*abci.ExecTxResult) error {
+	return si.storeBlock(block, parseBlockTxs(si.clientCtx, si.logger, block, txResults))
+}
+
+// storeBlock replaces the rows of the block with the parsed eth txs.
+func (si *SQLIndexer) storeBlock(block *cmttypes.Block, ethTxs []*indexedTx) (err error) {
+	height := block.Height
+	dbTx, err := si.db.Begin()
+	if err != nil {
+		return errorsmod.Wrapf(err, "IndexBlock %d, begin", height)
+	}
+	defer func() {
+		if err != nil {
+			_ = dbTx.Rollback()
+		}
+	}()
+
+	for _, table := range []string{"logs", "receipts", "transactions", "blocks"} {
+		if _, err := dbTx.Exec(si.rebind("DELETE FROM "+table+" WHERE height = ?"), height); err != nil {
+			return errorsmod.Wrapf(err, "IndexBlock %d, delete %s", height, table)
+		}
+	}
+
+	var gasUsed uint64
+	for _, ethTx := range ethTxs {
+		if err := si.insertTx(dbTx, ethTx); err != nil {
+			return errorsmod.Wrapf(err, "IndexBlock %d", height)
+		}
+		gasUsed += ethTx.Result.GasUsed
+	}
+
+	if _, err := dbTx.Exec(
+		si.rebind(`INSERT INTO blocks (height, hash, parent_hash, time, proposer, eth_tx_count, gas_used) VALUES (?, ?, ?, ?, ?, ?, ?)`),
+		height,
+		hexutil.Encode(block.Hash()),
+		hexutil.Encode(block.LastBlockID.Hash),
+		block.Time.Unix(),
+		hexutil.Encode(block.ProposerAddress),
+		len(ethTxs),
+		int64(gasUsed), //#nosec G115 -- gas used won't exceed int64
+	); err != nil {
+		return errorsmod.Wrapf(err, "IndexBlock %d, insert block", height)
+	}
+
+	if err := dbTx.Commit(); err != nil {
+		return errorsmod.Wrapf(err, "IndexBlock %d, commit", height)
+	}
+	return nil
+}
+
+// insertTx stores the transaction, the receipt and the logs of an eth tx.
+func (si *SQLIndexer) insertTx(dbTx *sql.Tx, ethTx *indexedTx) error {
+	tx := ethTx.Msg.AsTransaction()
+	from := ethTx.Msg.GetSender()
+	res := ethTx.Result
+
+	var to, contractAddress *string
+	if tx.To() != nil {
+		to = hexAddress(*tx.To())
+	} else if !res.Failed {
+		contractAddress = hexAddress(crypto.CreateAddress(from, tx.Nonce()))
+	}
+
+	if _, err := dbTx.Exec(
+		si.rebind(`INSERT INTO transactions (hash, height, tx_index, msg_index, eth_tx_index, type, from_address, to_address,
+			nonce, value, gas_limit, gas_fee_cap, gas_tip_cap, input) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`),
+		tx.Hash().Hex(),
+		res.Height,
+		res.TxIndex,
+		res.MsgIndex,
+		res.EthTxIndex,
+		tx.Type(),
+		*hexAddress(from),
+		to,
+		int64(tx.Nonce()), //#nosec G115 -- nonce won't exceed int64
+		tx.Value().String(),
+		int64(tx.Gas()), //#nosec G115 -- gas limit won't exceed int64
+		tx.GasFeeCap().String(),
+		tx.GasTipCap().String(),
+		hexutil.Encode(tx.Data()),
+	); err != nil {
+		return errorsmod.Wrap(err, "insert transaction")
+	}
+
+	status := 1
+	if res.Failed {
+		status = 0
+	}
+	if _, err := dbTx.Exec(
+		si.rebind(`INSERT INTO receipts (tx_hash, height, status, gas_used, cumulative_gas_used, contract_address) VALUES (?, ?, ?, ?, ?, ?)`),
+		tx.Hash().Hex(),
+		res.Height,
+		status,
+		int64(res.GasUsed),           //#nosec G115 -- gas used won't exceed int64
+		int64(res.CumulativeGasUsed), //#nosec G115 -- gas used won't exceed int64
+		contractAddress,
+	); err != nil {
+		return errorsmod.Wrap(err, "insert receipt")
+	}
+
+	for _, log := range ethTx.Logs {
+		topics := make([]*string, MaxIndexedTopics)
+		for i, topic := range log.Topics {
+			if i >= MaxIndexedTopics {
+				break
+			}
+			hex := topic.Hex()
+			topics[i] = &hex
+		}
+		if _, err := dbTx.Exec(
+			si.rebind(`INSERT INTO logs (height, log_index, tx_hash, address, topic0, topic1, topic2, topic3, data) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)`),
+			res.Height,
+			int64(log.Index), //#nosec G115 -- log index won't exceed int64
+			tx.Hash().Hex(),
+			*hexAddress(log.Address),
+			topics[0], topics[1], topics[2], topics[3],
+			hexutil.Encode(log.Data),
+		); err != nil {
+			return errorsmod.Wrap(err, "insert log")
+		}
+	}
+	return nil
+}
+
+// PruneBlocks removes the indexed data of the blocks below the height, it's used to follow
+// the pruning of the block store.
+func (si *SQLIndexer) PruneBlocks(height int64) (err error) {
+	dbTx, err := si.db.Begin()
+	if err != nil {
+		return errorsmod.Wrap(err, "PruneBlocks, begin")
+	}
+	defer func() {
+		if err != nil {
+			_ = dbTx.Rollback()
+		}
+	}()
+
+	for _, table := range []string{"logs", "receipts", "transactions", "blocks", "block_fees"} {
+		if _, err := dbTx.Exec(si.rebind("DELETE FROM "+table+" WHERE height < ?"), height); err != nil {
+			return errorsmod.Wrapf(err, "PruneBlocks, delete %s", table)
+		}
+	}
+	if err := dbTx.Commit(); err != nil {
+		return errorsmod.Wrap(err, "PruneBlocks, commit")
+	}
+	return nil
+}
+
+// IndexBlockFees stores the fee record of the block, the tips are stored as a JSON array.
+// Indexing a block again replaces its previous record.
+func (si *SQLIndexer) IndexBlockFees(block *cmttypes.Block, txResults []*abci.ExecTxResult, events []abci.Event, maxGas int64) (err error) {
+	height := block.Height
+	fees := newBlockFees(si.clientCtx, si.logger, block, txResults, events, maxGas)
+	tips, err := json.Marshal(fees.Tips)
+	if err != nil {
+		return errorsmod.Wrapf(err, "IndexBlockFees %d", height)
+	}
+
+	dbTx, err := si.db.Begin()
+	if err != nil {
+		return errorsmod.Wrapf(err, "IndexBlockFees %d, begin", height)
+	}
+	defer func() {
+		if err != nil {
+			_ = dbTx.Rollback()
+		}
+	}()
+
+	if _, err := dbTx.Exec(si.rebind("DELETE FROM block_fees WHERE height = ?"), height); err != nil {
+		return errorsmod.Wrapf(err, "IndexBlockFees %d, delete block_fees", height)
+	}
+	if _, err := dbTx.Exec(
+		si.rebind(`INSERT INTO block_fees (height, time, base_fee, gas_limit, gas_used, evm_gas_used, tips) VALUES (?, ?, ?, ?, ?, ?, ?)`),
+		height,
+		int64(fees.Time), //#nosec G115 -- block time won't exceed int64
+		fees.BaseFee.String(),
+		int64(fees.GasLimit),   //#nosec G115 -- gas limit won't exceed int64
+		int64(fees.GasUsed),    //#nosec G115 -- gas used won't exceed int64
+		int64(fees.EVMGasUsed), //#nosec G115 -- gas used won't exceed int64
+		string(tips),
+	); err != nil {
+		return errorsmod.Wrapf(err, "IndexBlockFees %d, insert block fees", height)
+	}
+
+	if err := dbTx.Commit(); err != nil {
+		return errorsmod.Wrapf(err, "IndexBlockFees %d, commit", height)
+	}
+	return nil
+}
+
+// GetBlockFees returns the fee records of the blocks within [from, to], it returns false if a
+// block of the range has no fee record.
+func (si *SQLIndexer) GetBlockFees(from, to int64) ([]*servertypes.BlockFees, bool, error) {
+	if from > to {
+		return nil, false, fmt.Errorf("invalid block range, from: %d, to: %d", from, to)
+	}
+	rows, err := si.db.Query(
+		si.rebind(`SELECT time, base_fee, gas_limit, gas_used, evm_gas_used, tips FROM block_fees
+			WHERE height >= ? AND height <= ? ORDER BY height`),
+		from, to,
+	)
+	if err != nil {
+		return nil, false, errorsmod.Wrap(err, "GetBlockFees")
+	}
+	defer rows.Close()
+
+	records := make([]*servertypes.BlockFees, 0, to-from+1)
+	for rows.Next() {
+		var (
+			baseFee, tips                            string
+			blockTime, gasLimit, gasUsed, evmGasUsed int64
+		)
+		if err := rows.Scan(&blockTime, &baseFee, &gasLimit, &gasUsed, &evmGasUsed, &tips); err != nil {
+			return nil, false, err
+		}
+		fees := &servertypes.BlockFees{
+			Time:       uint64(blockTime),  //#nosec G115 -- block time is never negative
+			GasLimit:   uint64(gasLimit),   //#nosec G115 -- gas limit is never negative
+			GasUsed:    uint64(gasUsed),    //#nosec G115 -- gas used is never negative
+			EVMGasUsed: uint64(evmGasUsed), //#nosec G115 -- gas used is never negative
+		}
+		var ok bool
+		if fees.BaseFee, ok = new(big.Int).SetString(baseFee, 10); !ok {
+			return nil, false, fmt.Errorf("GetBlockFees, invalid base fee %s", baseFee)
+		}
+		if err := json.Unmarshal([]byte(tips), &fees.Tips); err != nil {
+			return nil, false, errorsmod.Wrap(err, "GetBlockFees, decode tips")
+		}
+		records = append(records, fees)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, false, err
+	}
+	return records, int64(len(records)) == to-from+1, nil
+}
+
+// LastIndexedBlock returns the last block of the contiguous range of indexed blocks starting
+// at the first one, i.e. the first block whose next block isn't indexed, returns -1 if db is empty
+func (si *SQLIndexer) LastIndexedBlock() (int64, error) {
+	return si.queryHeight("SELECT MIN(height) FROM blocks b WHERE NOT EXISTS (SELECT 1 FROM blocks n WHERE n.height = b.height + 1)")
+}
+
+// FirstIndexedBlock returns the first indexed block number, returns -1 if db is empty
+func (si *SQLIndexer) FirstIndexedBlock() (int64, error) {
+	return si.queryHeight("SELECT MIN(height) FROM blocks")
+}
+
+// isBlockIndexed returns true if the block is stored in the blocks table.
+func (si *SQLIndexer) isBlockIndexed(height int64) (bool, error) {
+	var count int64
+	if err := si.db.QueryRow(si.rebind("SELECT COUNT(*) FROM blocks WHERE height = ?"), height).Scan(&count); err != nil {
+		return false, errorsmod.Wrapf(err, "isBlockIndexed %d", height)
+	}
+	return count > 0, nil
+}
+
+// GetByTxHash finds eth tx by eth tx hash
+func (si *SQLIndexer) GetByTxHash(hash common.Hash) (*servertypes.TxResult, error) {
+	res, err := si.queryTxResult("t.hash = ?", hash.Hex())
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("tx not found, hash: %s", hash.Hex())
+	}
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "GetByTxHash %s", hash.Hex())
+	}
+	return res, nil
+}
+
+// GetByBlockAndIndex finds eth tx by block number and eth tx index
+func (si *SQLIndexer) GetByBlockAndIndex(blockNumber int64, txIndex int32) (*servertypes.TxResult, error) {
+	res, err := si.queryTxResult("t.height = ? AND t.eth_tx_index = ?", blockNumber, txIndex)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("tx not found, block: %d, eth-index: %d", blockNumber, txIndex)
+	}
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "GetByBlockAndIndex %d %d", blockNumber, txIndex)
+	}
+	return res, nil
+}
+
+// GetLogBlocks returns the sorted list of block numbers within [from, to] that contain at
+// least one log matching the given addresses and topics. It returns false if the range is not
+// fully indexed or if the criteria has no indexed clause.
+func (si *SQLIndexer) GetLogBlocks(from, to int64, addresses []common.Address, topics [][]common.Hash) ([]int64, bool, error) {
+	if from > to {
+		return nil, false, fmt.Errorf("invalid block range, from: %d, to: %d", from, to)
+	}
+
+	conditions := []string{"height >= ?", "height <= ?"}
+	args := []interface{}{from, to}
+	if len(addresses) > 0 {
+		values := make([]string, len(addresses))
+		for i, address := range addresses {
+			values[i] = *hexAddress(address)
+		}
+		conditions = append(conditions, inCondition("address", len(values)))
+		args = appendStrings(args, values)
+	}
+	for i, sub := range topics {
+		// empty rule set == wildcard, positions beyond the index can't be used
+		if len(sub) == 0 || i >= MaxIndexedTopics {
+			continue
+		}
+		values := make([]string, len(sub))
+		for j, topic := range sub {
+			values[j] = topic.Hex()
+		}
+		conditions = append(conditions, inCondition("topic"+strconv.Itoa(i), len(values)))
+		args = appendStrings(args, values)
+	}
+	if len(conditions) == 2 {
+		return nil, false, nil
+	}
+
+	var indexed int64
+	if err := si.db.QueryRow(si.rebind("SELECT COUNT(*) FROM blocks WHERE height >= ? AND height <= ?"), from, to).Scan(&indexed); err != nil {
+		return nil, false, errorsmod.Wrap(err, "GetLogBlocks")
+	}
+	if indexed != to-from+1 {
+		return nil, false, nil
+	}
+
+	rows, err := si.db.Query(si.rebind("SELECT DISTINCT height FROM logs WHERE "+strings.Join(conditions, " AND ")+" ORDER BY height"), args...)
+	if err != nil {
+		return nil, false, errorsmod.Wrap(err, "GetLogBlocks")
+	}
+	defer rows.Close()
+
+	blocks := []int64{}
+	for rows.Next() {
+		var height int64
+		if err := rows.Scan(&height); err != nil {
+			return nil, false, err
+		}
+		blocks = append(blocks, height)
+	}
+	return blocks, true, rows.Err()
+}
+
+// GetTxsByAddress returns the page of the txs sent from or to the address, or creating
+// it, within [from, to]. The cursor has the same format as the KVIndexer one.
+func (si *SQLIndexer) GetTxsByAddress(
+	address common.Address, from, to int64, cursor []byte, limit int,
+) ([]*servertypes.AddressTxResult, []byte, error) {
+	if from > to {
+		return nil, nil, fmt.Errorf("invalid block range, from: %d, to: %d", from, to)
+	}
+	if limit <= 0 {
+		return nil, nil, fmt.Errorf("invalid limit %d", limit)
+	}
+
+	afterHeight, afterIndex := from, int64(-1)
+	if cursor != nil {
+		if len(cursor) != addressTxCursorLength {
+			return nil, nil, fmt.Errorf("invalid cursor length, expect: %d, got: %d", addressTxCursorLength, len(cursor))
+		}
+		cursorHeight := int64(sdk.BigEndianToUint64(cursor[:8])) //#nosec G115 -- block number won't exceed int64
+		if cursorHeight >= from {
+			afterHeight = cursorHeight
+			afterIndex = int64(sdk.BigEndianToUint64(cursor[8:])) //#nosec G115 -- eth tx index won't exceed int64
+		}
+	}
+
+	hex := *hexAddress(address)
+	rows, err := si.db.Query(
+		si.rebind(`SELECT t.hash, t.height, t.eth_tx_index, t.from_address, t.to_address, r.contract_address
+			FROM transactions t JOIN receipts r ON r.tx_hash = t.hash
+			WHERE (t.from_address = ? OR t.to_address = ? OR r.contract_address = ?)
+			AND (t.height > ? OR (t.height = ? AND t.eth_tx_index > ?)) AND t.height <= ?
+			ORDER BY t.height, t.eth_tx_index LIMIT ?`),
+		hex, hex, hex, afterHeight, afterHeight, afterIndex, to, limit+1,
+	)
+	if err != nil {
+		return nil, nil, errorsmod.Wrap(err, "GetTxsByAddress")
+	}
+	defer rows.Close()
+
+	results := make([]*servertypes.AddressTxResult, 0, limit)
+	var next []byte
+	for rows.Next() {
+		if len(results) == limit {
+			last := results[limit-1]
+			next = addressTxCursor(last.Height, last.EthTxIndex)
+			break
+		}
+		var (
+			hash, fromAddr   string
+			toAddr, contract sql.NullString
+			height           int64
+			ethTxIndex       int32
+		)
+		if err := rows.Scan(&hash, &height, &ethTxIndex, &fromAddr, &toAddr, &contract); err != nil {
+			return nil, nil, err
+		}
+		entry := &servertypes.AddressTxResult{Hash: common.HexToHash(hash), Height: height, EthTxIndex: ethTxIndex}
+		if fromAddr == hex {
+			entry.Roles |= servertypes.AddressRoleFrom
+		}
+		if toAddr.Valid && toAddr.String == hex {
+			entry.Roles |= servertypes.AddressRoleTo
+		}
+		if contract.Valid && contract.String == hex {
+			entry.Roles |= servertypes.AddressRoleCreate
+		}
+		results = append(results, entry)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, nil, err
+	}
+	return results, next, nil
+}
+
+// queryTxResult returns the TxResult of the eth tx matching the condition.
+func (si *SQLIndexer) queryTxResult(condition string, args ...interface{}) (*servertypes.TxResult, error) {
+	var (
+		res                        servertypes.TxResult
+		status                     int
+		gasUsed, cumulativeGasUsed int64
+	)
+	err := si.db.QueryRow(
+		si.rebind(`SELECT t.height, t.tx_index, t.msg_index, t.eth_tx_index, r.status, r.gas_used, r.cumulative_gas_used
+			FROM transactions t JOIN receipts r ON r.tx_hash = t.hash WHERE `+condition),
+		args...,
+	).Scan(&res.Height, &res.TxIndex, &res.MsgIndex, &res.EthTxIndex, &status, &gasUsed, &cumulativeGasUsed)
+	if err != nil {
+		return nil, err
+	}
+	res.Failed = status == 0
+	res.GasUsed = uint64(gasUsed)                     //#nosec G115 -- gas used is never negative
+	res.CumulativeGasUsed = uint64(cumulativeGasUsed) //#nosec G115 -- gas used is never negative
+	return &res, nil
+}
+
+// queryHeight runs an aggregate query on a height column, returns -1 if there are no rows.
+func (si *SQLIndexer) queryHeight(query string) (int64, error) {
+	var height sql.NullInt64
+	if err := si.db.QueryRow(query).Scan(&height); err != nil {
+		return 0, errorsmod.Wrap(err, "query indexed height")
+	}
+	if !height.Valid {
+		return -1, nil
+	}
+	return height.Int64, nil
+}
+
+// rebind converts the `?` placeholders of the query to the driver bind variables.
+func (si *SQLIndexer) rebind(query string) string {
+	switch si.driverName {
+	case "postgres", "pgx":
+		var sb strings.Builder
+		n := 0
+		for _, r := range query {
+			if r == '?' {
+				n++
+				sb.WriteString("$" + strconv.Itoa(n))
+				continue
+			}
+			sb.WriteRune(r)
+		}
+		return sb.String()
+	default:
+		return query
+	}
+}
+
+// inCondition returns the `column IN (?, ...)` condition with n bind variables.
+func inCondition(column string, n int) string {
+	return column + " IN (" + strings.TrimSuffix(strings.Repeat("?, ", n), ", ") + ")"
+}
+
+func appendStrings(args []interface{}, values []string) []interface{} {
+	for _, value := range values {
+		args = append(args, value)
+	}
+	return args
+}
+
+// hexAddress returns the lower case hex representation of the address.
+func hexAddress(address common.Address) *string {
+	hex := strings.ToLower(address.Hex())
+	return &hex
+}
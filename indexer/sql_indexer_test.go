@@ -0,0 +1,171 @@
+package indexer
+
+import (
+	"database/sql"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+	_ "modernc.org/sqlite"
+
+	cmttypes "github.com/cometbft/cometbft/types"
+
+	servertypes "github.com/cosmos/evm/server/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	"cosmossdk.io/log"
+
+	"github.com/cosmos/cosmos-sdk/client"
+)
+
+func newTestSQLIndexer(t *testing.T) *SQLIndexer {
+	t.Helper()
+	db, err := sql.Open("sqlite", ":memory:")
+	require.NoError(t, err)
+	// every connection has its own in-memory database
+	db.SetMaxOpenConns(1)
+	t.Cleanup(func() { _ = db.Close() })
+
+	si, err := NewSQLIndexer(db, "sqlite", log.NewNopLogger(), client.Context{})
+	require.NoError(t, err)
+	return si
+}
+
+func newTestIndexedTx(from common.Address, to *common.Address, nonce uint64, height int64, ethTxIndex int32, logs ...*ethtypes.Log) *indexedTx {
+	msg := &evmtypes.MsgEthereumTx{From: from.Bytes()}
+	msg.FromEthereumTx(ethtypes.NewTx(&ethtypes.LegacyTx{
+		Nonce:    nonce,
+		To:       to,
+		Value:    big.NewInt(1),
+		Gas:      21000,
+		GasPrice: big.NewInt(10),
+	}))
+	return &indexedTx{
+		Msg: msg,
+		Result: servertypes.TxResult{
+			Height:            height,
+			TxIndex:           uint32(ethTxIndex), //#nosec G115
+			EthTxIndex:        ethTxIndex,
+			GasUsed:           21000,
+			CumulativeGasUsed: 21000,
+		},
+		Logs: logs,
+	}
+}
+
+func TestSQLIndexer(t *testing.T) {
+	si := newTestSQLIndexer(t)
+
+	sender := common.HexToAddress("0xa")
+	recipient := common.HexToAddress("0xb")
+	topic := common.HexToHash("0x1")
+
+	last, err := si.LastIndexedBlock()
+	require.NoError(t, err)
+	require.Equal(t, int64(-1), last)
+
+	for height := int64(1); height <= 3; height++ {
+		block := &cmttypes.Block{Header: cmttypes.Header{Height: height}}
+		nonce := uint64(height * 2) //#nosec G115
+		txs := []*indexedTx{
+			newTestIndexedTx(sender, &recipient, nonce, height, 0),
+			newTestIndexedTx(sender, nil, nonce+1, height, 1),
+		}
+		if height == 2 {
+			txs[0].Logs = []*ethtypes.Log{{Address: recipient, Topics: []common.Hash{topic}, Index: 0}}
+		}
+		require.NoError(t, si.storeBlock(block, txs))
+	}
+	// indexing a block again replaces its rows
+	require.NoError(t, si.storeBlock(
+		&cmttypes.Block{Header: cmttypes.Header{Height: 3}},
+		[]*indexedTx{newTestIndexedTx(sender, &recipient, 6, 3, 0)},
+	))
+
+	first, err := si.FirstIndexedBlock()
+	require.NoError(t, err)
+	require.Equal(t, int64(1), first)
+	last, err = si.LastIndexedBlock()
+	require.NoError(t, err)
+	require.Equal(t, int64(3), last)
+
+	// the blocks without eth txs are indexed too
+	require.NoError(t, si.storeBlock(&cmttypes.Block{Header: cmttypes.Header{Height: 4}}, nil))
+	last, err = si.LastIndexedBlock()
+	require.NoError(t, err)
+	require.Equal(t, int64(4), last)
+
+	// the indexed blocks stop at the first gap, e.g. left by an interrupted parallel reindex
+	require.NoError(t, si.storeBlock(&cmttypes.Block{Header: cmttypes.Header{Height: 6}}, nil))
+	last, err = si.LastIndexedBlock()
+	require.NoError(t, err)
+	require.Equal(t, int64(4), last)
+
+	// lookups
+	tx := newTestIndexedTx(sender, &recipient, 4, 2, 0)
+	res, err := si.GetByTxHash(tx.Msg.AsTransaction().Hash())
+	require.NoError(t, err)
+	require.Equal(t, tx.Result, *res)
+
+	res, err = si.GetByBlockAndIndex(2, 1)
+	require.NoError(t, err)
+	require.Equal(t, int64(2), res.Height)
+	require.Equal(t, int32(1), res.EthTxIndex)
+
+	_, err = si.GetByBlockAndIndex(3, 1)
+	require.Error(t, err)
+
+	// log blocks
+	blocks, ok, err := si.GetLogBlocks(1, 3, []common.Address{recipient}, nil)
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, []int64{2}, blocks)
+
+	blocks, ok, err = si.GetLogBlocks(1, 3, nil, [][]common.Hash{{}, {topic}})
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Empty(t, blocks)
+
+	_, ok, err = si.GetLogBlocks(1, 5, []common.Address{recipient}, nil)
+	require.NoError(t, err)
+	require.False(t, ok, "range not fully indexed")
+
+	_, ok, err = si.GetLogBlocks(1, 3, nil, nil)
+	require.NoError(t, err)
+	require.False(t, ok, "no indexed clause")
+
+	// address history
+	page, next, err := si.GetTxsByAddress(sender, 1, 3, nil, 3)
+	require.NoError(t, err)
+	require.Len(t, page, 3)
+	require.NotNil(t, next)
+	require.Equal(t, servertypes.AddressRoleFrom, page[0].Roles)
+
+	page, next, err = si.GetTxsByAddress(sender, 1, 3, next, 3)
+	require.NoError(t, err)
+	require.Len(t, page, 2)
+	require.Nil(t, next)
+	require.Equal(t, int64(3), page[1].Height)
+
+	contract := crypto.CreateAddress(sender, 3)
+	page, _, err = si.GetTxsByAddress(contract, 1, 3, nil, 10)
+	require.NoError(t, err)
+	require.Len(t, page, 1)
+	require.Equal(t, []string{"create"}, page[0].Roles.Strings())
+
+	page, _, err = si.GetTxsByAddress(recipient, 2, 3, nil, 10)
+	require.NoError(t, err)
+	require.Len(t, page, 2)
+	require.Equal(t, servertypes.AddressRoleTo, page[0].Roles)
+}
+
+func TestSQLRebind(t *testing.T) {
+	si := &SQLIndexer{driverName: "postgres"}
+	require.Equal(t, "SELECT a FROM t WHERE b = $1 AND c IN ($2, $3)", si.rebind("SELECT a FROM t WHERE b = ? AND "+inCondition("c", 2)))
+
+	si = &SQLIndexer{driverName: "sqlite"}
+	require.Equal(t, "SELECT a FROM t WHERE b = ?", si.rebind("SELECT a FROM t WHERE b = ?"))
+}
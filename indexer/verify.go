@@ -0,0 +1,106 @@
+package indexer
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+
+	servertypes "github.com/cosmos/evm/server/types"
+
+	"cosmossdk.io/log"
+
+	"github.com/cosmos/cosmos-sdk/client"
+)
+
+// VerifyBlock compares the indexed eth txs, the indexed block marker and the log postings
+// of a block with the ones parsed from the block results. It returns a description of every inconsistency found, the block should be
+// reindexed if any.
+func VerifyBlock(
+	idxer servertypes.EVMTxIndexer,
+	clientCtx client.Context,
+	logger log.Logger,
+	block *cmttypes.Block,
+	txResults []*abci.ExecTxResult,
+) []string {
+	return verifyTxs(idxer, block.Height, parseBlockTxs(clientCtx, logger, block, txResults))
+}
+
+// indexedBlockChecker is implemented by the indexers marking the indexed blocks.
+type indexedBlockChecker interface {
+	isBlockIndexed(height int64) (bool, error)
+}
+
+// verifyTxs checks that every expected eth tx is indexed with the expected result, and that
+// no other eth tx is indexed for the block. If the indexer supports it, it also checks that
+// the block is marked as indexed and that the log index holds the postings of the logs.
+func verifyTxs(idxer servertypes.EVMTxIndexer, height int64, expected []*indexedTx) []string {
+	var issues []string
+	for _, ethTx := range expected {
+		hash := ethTx.Msg.Hash()
+		res, err := idxer.GetByTxHash(hash)
+		if err != nil || res == nil {
+			issues = append(issues, fmt.Sprintf("tx %s is not indexed", hash.Hex()))
+			continue
+		}
+		if *res != ethTx.Result {
+			issues = append(issues, fmt.Sprintf("tx %s result mismatch, expect: %+v, got: %+v", hash.Hex(), ethTx.Result, *res))
+		}
+		if res, err := idxer.GetByBlockAndIndex(height, ethTx.Result.EthTxIndex); err != nil || res == nil {
+			issues = append(issues, fmt.Sprintf("eth tx index %d is not indexed", ethTx.Result.EthTxIndex))
+		}
+	}
+
+	extra := int32(len(expected)) //#nosec G115 -- the number of txs in a block won't exceed int32
+	if res, err := idxer.GetByBlockAndIndex(height, extra); err == nil && res != nil {
+		issues = append(issues, fmt.Sprintf("unexpected eth tx indexed at index %d", extra))
+	}
+
+	if checker, ok := idxer.(indexedBlockChecker); ok {
+		indexed, err := checker.isBlockIndexed(height)
+		if err != nil {
+			return append(issues, fmt.Sprintf("failed to check the indexed block: %s", err))
+		}
+		if !indexed {
+			// the log index doesn't answer for the blocks not marked as indexed
+			return append(issues, "block is not marked as indexed")
+		}
+	}
+	if logIdxer, ok := idxer.(servertypes.EVMLogIndexer); ok {
+		for _, ethTx := range expected {
+			issues = append(issues, verifyLogPostings(logIdxer, height, ethTx.Logs)...)
+		}
+	}
+	return issues
+}
+
+// verifyLogPostings checks that the log index returns the block for the address and
+// for every indexed topic of each log.
+func verifyLogPostings(idxer servertypes.EVMLogIndexer, height int64, logs []*ethtypes.Log) []string {
+	var issues []string
+	for _, log := range logs {
+		if !hasLogBlock(idxer, height, []common.Address{log.Address}, nil) {
+			issues = append(issues, fmt.Sprintf("log %d address %s is not indexed", log.Index, log.Address.Hex()))
+		}
+		for i, topic := range log.Topics {
+			if i >= MaxIndexedTopics {
+				break
+			}
+			topics := make([][]common.Hash, i+1)
+			topics[i] = []common.Hash{topic}
+			if !hasLogBlock(idxer, height, nil, topics) {
+				issues = append(issues, fmt.Sprintf("log %d topic %d %s is not indexed", log.Index, i, topic.Hex()))
+			}
+		}
+	}
+	return issues
+}
+
+// hasLogBlock returns true if the log index returns the block for the criteria.
+func hasLogBlock(idxer servertypes.EVMLogIndexer, height int64, addresses []common.Address, topics [][]common.Hash) bool {
+	blocks, ok, err := idxer.GetLogBlocks(height, height, addresses, topics)
+	return err == nil && ok && len(blocks) == 1 && blocks[0] == height
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/ethereum/go-ethereum/core/vm"
 	"github.com/ethereum/go-ethereum/event"
 	"github.com/ethereum/go-ethereum/params"
+	"github.com/holiman/uint256"
 
 	"github.com/cosmos/evm/mempool/txpool"
 	"github.com/cosmos/evm/mempool/txpool/legacypool"
@@ -72,6 +73,24 @@ func NewBlockchain(ctx func(height int64, prove bool) (sdk.Context, error), logg
 	}
 }
 
+// CosmosBaseFee returns the base fee charged to the Cosmos transactions in the
+// extended EVM denom, which is the base fee of the Cosmos lane when fee lanes are
+// enabled. It returns nil if the base fee is disabled.
+func (b *Blockchain) CosmosBaseFee(ctx sdk.Context) *uint256.Int {
+	baseFee := b.feeMarketKeeper.GetCosmosBaseFee(ctx)
+	if baseFee.IsNil() {
+		return nil
+	}
+	coinInfo := b.vmKeeper.GetEvmCoinInfo(ctx)
+	converted := baseFee.MulInt(evmtypes.Decimals(coinInfo.Decimals).ConversionFactor()).TruncateInt()
+	fee, overflow := uint256.FromBig(converted.BigInt())
+	if overflow {
+		b.logger.Debug("cosmos base fee overflow when converting to uint256")
+		return nil
+	}
+	return fee
+}
+
 // Config returns the Ethereum chain configuration. It should only be called after the chain is initialized.
 // This provides the necessary parameters for EVM execution and transaction validation.
 func (b *Blockchain) Config() *params.ChainConfig {
@@ -224,7 +243,28 @@ func (b *Blockchain) StateAt(hash common.Hash) (vm.StateDB, error) {
 	stateDB := statedb.New(cacheCtx, b.vmKeeper, statedb.NewEmptyTxConfig())
 
 	b.logger.Debug("StateDB created successfully", "app_hash", common.Hash(appHash).Hex())
-	return stateDB, nil
+	return &feeTokenStateDB{StateDB: stateDB, ctx: cacheCtx, vmKeeper: b.vmKeeper}, nil
+}
+
+// feeTokenStateDB is the state of the transaction pool, which reports the fees
+// the accounts can pay in a whitelisted fee token, so that the pool checks the
+// transaction costs as the ante handler does.
+type feeTokenStateDB struct {
+	vm.StateDB
+	ctx      sdk.Context
+	vmKeeper VMKeeperI
+}
+
+var _ txpool.FeeTokenState = (*feeTokenStateDB)(nil)
+
+// GetFeeTokenBalance returns the value in the EVM denom of the largest balance
+// the address holds of a fee token, or zero if it overflows.
+func (s *feeTokenStateDB) GetFeeTokenBalance(addr common.Address) *uint256.Int {
+	value, overflow := uint256.FromBig(s.vmKeeper.GetFeeTokenBalance(s.ctx, addr).BigInt())
+	if overflow {
+		return new(uint256.Int)
+	}
+	return value
 }
 
 // BeginCommit acquires an exclusive lock to prevent mempool state reads during Commit.
@@ -273,6 +313,19 @@ func (b *Blockchain) GetLatestContext() (sdk.Context, error) {
 	return b.newLatestContext()
 }
 
+// LatestHeight returns the height of the latest context as updated by the block,
+// zero if it is unavailable. Unlike GetLatestContext, it never retrieves the
+// context again.
+func (b *Blockchain) LatestHeight() int64 {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	if b.latestCtx.Context() == nil {
+		return 0
+	}
+	return b.latestCtx.BlockHeight()
+}
+
 // newLatestContext retrieves the most recent query context from the application.
 // This provides access to the current blockchain state for transaction validation and execution.
 func (b *Blockchain) newLatestContext() (sdk.Context, error) {
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/holiman/uint256"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
@@ -14,11 +15,13 @@ import (
 
 	"github.com/cosmos/evm/mempool"
 	"github.com/cosmos/evm/mempool/mocks"
+	"github.com/cosmos/evm/mempool/txpool"
 	"github.com/cosmos/evm/testutil/constants"
 	"github.com/cosmos/evm/x/vm/statedb"
 	vmtypes "github.com/cosmos/evm/x/vm/types"
 
 	"cosmossdk.io/log"
+	"cosmossdk.io/math"
 	storetypes "cosmossdk.io/store/types"
 
 	"github.com/cosmos/cosmos-sdk/testutil"
@@ -132,3 +135,33 @@ func TestBlockchainRaceCondition(t *testing.T) {
 	require.NoError(t, err)
 	require.NotNil(t, stateDB)
 }
+
+// TestBlockchainStateAtFeeTokenBalance tests that the balances of the state of the
+// transaction pool include the fees the accounts can pay in a fee token.
+func TestBlockchainStateAtFeeTokenBalance(t *testing.T) {
+	mockVMKeeper := mocks.NewVMKeeper(t)
+	mockFeeMarketKeeper := mocks.NewFeeMarketKeeper(t)
+
+	funded := common.HexToAddress("0x1000000000000000000000000000000000000001")
+	unfunded := common.HexToAddress("0x2000000000000000000000000000000000000002")
+	mockVMKeeper.On("GetAccount", mock.Anything, mock.Anything).Return(&statedb.Account{Balance: uint256.NewInt(100)})
+	mockVMKeeper.On("GetFeeTokenBalance", mock.Anything, funded).Return(math.NewInt(50))
+	mockVMKeeper.On("GetFeeTokenBalance", mock.Anything, unfunded).Return(math.ZeroInt())
+
+	blockchain := mempool.NewBlockchain(
+		func(height int64, prove bool) (sdk.Context, error) { return createMockContext(), nil },
+		log.NewNopLogger(),
+		mockVMKeeper,
+		mockFeeMarketKeeper,
+		21000000,
+	)
+
+	stateDB, err := blockchain.StateAt(common.HexToHash("0x1234"))
+	require.NoError(t, err)
+	// the fee token balance is reported on its own, not added to the balance
+	require.Equal(t, uint256.NewInt(100), stateDB.GetBalance(funded))
+	feeTokenState, ok := stateDB.(txpool.FeeTokenState)
+	require.True(t, ok)
+	require.Equal(t, uint256.NewInt(50), feeTokenState.GetFeeTokenBalance(funded))
+	require.Equal(t, uint256.NewInt(0), feeTokenState.GetFeeTokenBalance(unfunded))
+}
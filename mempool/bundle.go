@@ -0,0 +1,546 @@
+package mempool
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"math/big"
+	"slices"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkmempool "github.com/cosmos/cosmos-sdk/types/mempool"
+)
+
+// Bundle is a list of EVM transactions included in a block contiguously and in
+// order, or not at all.
+type Bundle struct {
+	// Txs are the transactions of the bundle, in the inclusion order.
+	Txs []*ethtypes.Transaction
+	// BlockNumber is the number of the block the bundle targets.
+	BlockNumber uint64
+	// MinTimestamp and MaxTimestamp bound the time of the block, in seconds.
+	// Zero disables the bound.
+	MinTimestamp uint64
+	MaxTimestamp uint64
+	// RevertingTxHashes are the transactions allowed to revert without dropping
+	// the bundle.
+	RevertingTxHashes []common.Hash
+}
+
+// Hash returns the hash of the bundle, the keccak256 of its transaction hashes.
+func (b *Bundle) Hash() common.Hash {
+	hashes := make([]byte, 0, len(b.Txs)*common.HashLength)
+	for _, tx := range b.Txs {
+		hashes = append(hashes, tx.Hash().Bytes()...)
+	}
+	return crypto.Keccak256Hash(hashes)
+}
+
+// Validate performs the stateless validation of the bundle.
+func (b *Bundle) Validate() error {
+	if len(b.Txs) == 0 {
+		return ErrBundleEmpty
+	}
+	seen := make(map[common.Hash]struct{}, len(b.Txs))
+	for _, tx := range b.Txs {
+		if _, ok := seen[tx.Hash()]; ok {
+			return fmt.Errorf("%w: %s", ErrBundleDuplicate, tx.Hash())
+		}
+		seen[tx.Hash()] = struct{}{}
+	}
+	if b.MaxTimestamp != 0 && b.MaxTimestamp < b.MinTimestamp {
+		return fmt.Errorf("bundle max timestamp %d is lower than min timestamp %d", b.MaxTimestamp, b.MinTimestamp)
+	}
+	return nil
+}
+
+// eligible returns true if the bundle can be included in the block of the given
+// height and time.
+func (b *Bundle) eligible(height int64, time uint64) bool {
+	if height < 0 || b.BlockNumber != uint64(height) {
+		return false
+	}
+	if b.MinTimestamp != 0 && time < b.MinTimestamp {
+		return false
+	}
+	return b.MaxTimestamp == 0 || time <= b.MaxTimestamp
+}
+
+// canRevert returns true if the transaction is allowed to revert.
+func (b *Bundle) canRevert(hash common.Hash) bool {
+	return slices.Contains(b.RevertingTxHashes, hash)
+}
+
+// gasPrice returns the average effective gas price of the bundle.
+func (b *Bundle) gasPrice(baseFee *big.Int) *big.Int {
+	fees, gas := new(big.Int), new(big.Int)
+	for _, tx := range b.Txs {
+		txGas := new(big.Int).SetUint64(tx.Gas())
+		price := tx.GasPrice()
+		if baseFee != nil {
+			// the tip is negative if the fee cap is below the base fee, the
+			// price is then the fee cap
+			tip, _ := tx.EffectiveGasTip(baseFee)
+			price = new(big.Int).Add(baseFee, tip)
+		}
+		fees.Add(fees, new(big.Int).Mul(price, txGas))
+		gas.Add(gas, txGas)
+	}
+	if gas.Sign() == 0 {
+		return gas
+	}
+	return fees.Quo(fees, gas)
+}
+
+// BundleTxResult is the execution result of a bundle transaction.
+type BundleTxResult struct {
+	Tx *ethtypes.Transaction
+	// Response is nil if the transaction couldn't be executed.
+	Response *evmtypes.MsgEthereumTxResponse
+	Err      error
+}
+
+// bundlePool is the sub-pool of the bundles, kept apart from the transactions of
+// the txpool.
+type bundlePool struct {
+	mtx     sync.Mutex
+	max     int
+	bundles map[common.Hash]*Bundle
+}
+
+func newBundlePool(maxBundles int) *bundlePool {
+	return &bundlePool{
+		max:     maxBundles,
+		bundles: make(map[common.Hash]*Bundle),
+	}
+}
+
+// add adds a bundle targeting at least the given height.
+func (p *bundlePool) add(bundle *Bundle, height int64) (common.Hash, error) {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
+	if height > 0 && bundle.BlockNumber < uint64(height) {
+		return common.Hash{}, fmt.Errorf("%w: %d, next block is %d", ErrBundleTargetPast, bundle.BlockNumber, height)
+	}
+	p.prune(height)
+
+	hash := bundle.Hash()
+	if _, ok := p.bundles[hash]; ok {
+		return hash, ErrBundleKnown
+	}
+	if len(p.bundles) >= p.max {
+		return common.Hash{}, ErrBundlePoolFull
+	}
+	p.bundles[hash] = bundle
+	return hash, nil
+}
+
+// eligible returns the bundles that can be included in the block of the given
+// height and time, by decreasing gas price.
+func (p *bundlePool) eligible(height int64, time uint64, baseFee *big.Int) []*Bundle {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
+	p.prune(height)
+
+	type pricedBundle struct {
+		bundle *Bundle
+		hash   common.Hash
+		price  *big.Int
+	}
+	var priced []pricedBundle
+	for hash, bundle := range p.bundles {
+		if bundle.eligible(height, time) {
+			priced = append(priced, pricedBundle{bundle, hash, bundle.gasPrice(baseFee)})
+		}
+	}
+	// the hash breaks the ties, so that the order doesn't depend on the map
+	slices.SortFunc(priced, func(a, b pricedBundle) int {
+		if c := b.price.Cmp(a.price); c != 0 {
+			return c
+		}
+		return bytes.Compare(a.hash.Bytes(), b.hash.Bytes())
+	})
+
+	bundles := make([]*Bundle, len(priced))
+	for i, pb := range priced {
+		bundles[i] = pb.bundle
+	}
+	return bundles
+}
+
+// prune removes the bundles targeting a block below the given height.
+func (p *bundlePool) prune(height int64) {
+	if height <= 0 {
+		return
+	}
+	for hash, bundle := range p.bundles {
+		if bundle.BlockNumber < uint64(height) {
+			delete(p.bundles, hash)
+		}
+	}
+}
+
+// SendBundle adds a bundle to the bundle pool. The bundle must target the next
+// block or a later one.
+func (m *ExperimentalEVMMempool) SendBundle(bundle *Bundle) (common.Hash, error) {
+	if m.bundles == nil {
+		return common.Hash{}, ErrBundlesDisabled
+	}
+	if err := bundle.Validate(); err != nil {
+		return common.Hash{}, err
+	}
+	signer := ethtypes.LatestSignerForChainID(m.blockchain.Config().ChainID)
+	for _, tx := range bundle.Txs {
+		if _, err := ethtypes.Sender(signer, tx); err != nil {
+			return common.Hash{}, fmt.Errorf("invalid bundle transaction %s: %w", tx.Hash(), err)
+		}
+	}
+
+	hash, err := m.bundles.add(bundle, m.blockchain.CurrentBlock().Number.Int64()+1)
+	if err != nil {
+		return hash, err
+	}
+	m.logger.Debug("bundle inserted", "bundle_hash", hash, "block_number", bundle.BlockNumber, "tx_count", len(bundle.Txs))
+	m.revision.Add(1)
+	return hash, nil
+}
+
+// CallBundle executes the transactions of a bundle in order on top of the latest
+// state, without inserting it. The transactions that fail don't stop the
+// execution of the next ones.
+func (m *ExperimentalEVMMempool) CallBundle(ctx context.Context, bundle *Bundle) ([]*BundleTxResult, error) {
+	if m.bundles == nil {
+		return nil, ErrBundlesDisabled
+	}
+	if err := bundle.Validate(); err != nil {
+		return nil, err
+	}
+	keeper := m.pendingKeeper()
+	if keeper == nil {
+		return nil, ErrPendingStateNotSupported
+	}
+
+	latestCtx, err := m.blockchain.GetLatestContext()
+	if err != nil {
+		return nil, err
+	}
+	simCtx, _ := latestCtx.CacheContext()
+	simCtx = simCtx.WithIsCheckTx(false).WithContext(ctx)
+	denom := m.vmKeeper.GetEvmCoinInfo(simCtx).Denom
+
+	results := make([]*BundleTxResult, len(bundle.Txs))
+	for i, ethTx := range bundle.Txs {
+		result := &BundleTxResult{Tx: ethTx}
+		results[i] = result
+
+		tx, msg, err := m.buildSDKTx(ethTx, denom)
+		if err != nil {
+			result.Err = err
+			continue
+		}
+		txCtx, write := simCtx.CacheContext()
+		result.Response, result.Err = m.applyTx(txCtx, keeper, tx, msg)
+		if result.Err == nil {
+			write()
+		}
+	}
+	return results, nil
+}
+
+// placedBundlesKey is the context key of the placedBundles of a proposal.
+type placedBundlesKey struct{}
+
+// placedBundles collects the bundles lockAndSelectBundles places at the start of a
+// proposal, the only ones which atomicity the proposal handler enforces.
+type placedBundles struct {
+	bundles []*Bundle
+}
+
+// maxBundleSelections is the number of times the bundles are executed outside
+// the mempool lock before being executed under it, if the mempool keeps changing
+// during their execution.
+const maxBundleSelections = 3
+
+// lockAndSelectBundles locks the mempool and returns the transactions of the
+// bundles selected for the block of the context. The bundles are executed before
+// locking the mempool, as the execution would block the insertions, and executed
+// again if the mempool changed meanwhile, so that the selection is never made on
+// a stale snapshot. The placed bundles are recorded in the placedBundles of the
+// context, if any.
+func (m *ExperimentalEVMMempool) lockAndSelectBundles(ctx sdk.Context) []sdk.Tx {
+	var (
+		txs      []sdk.Tx
+		placed   []*Bundle
+		selected bool
+	)
+	for range maxBundleSelections {
+		revision := m.revision.Load()
+		txs, placed = m.selectBundles(ctx)
+		m.mtx.Lock()
+		if selected = m.revision.Load() == revision; selected {
+			break
+		}
+		m.mtx.Unlock()
+	}
+	if !selected {
+		// the mempool keeps changing, the bundles are executed under the lock
+		m.mtx.Lock()
+		txs, placed = m.selectBundles(ctx)
+	}
+
+	if collector, ok := ctx.Value(placedBundlesKey{}).(*placedBundles); ok {
+		collector.bundles = append(collector.bundles, placed...)
+	}
+	return txs
+}
+
+// selectBundles returns the transactions of the bundles eligible for the block of
+// the context, and the placed bundles. Each bundle is executed on top of the
+// previous ones and dropped as a whole if one of its transactions fails. No bundle
+// is selected if the mempool can't execute them, as their transactions could then
+// fail in the block.
+func (m *ExperimentalEVMMempool) selectBundles(ctx sdk.Context) ([]sdk.Tx, []*Bundle) {
+	if m.bundles == nil {
+		return nil, nil
+	}
+	keeper := m.pendingKeeper()
+	if keeper == nil {
+		return nil, nil
+	}
+	bundles := m.bundles.eligible(ctx.BlockHeight(), uint64(ctx.BlockTime().Unix()), m.vmKeeper.GetBaseFee(ctx)) //#nosec G115 -- block time is positive
+	if len(bundles) == 0 {
+		return nil, nil
+	}
+
+	simCtx, _ := ctx.CacheContext()
+	simCtx = simCtx.WithIsCheckTx(false)
+	denom := m.vmKeeper.GetEvmCoinInfo(ctx).Denom
+
+	var (
+		txs    []sdk.Tx
+		placed []*Bundle
+	)
+	for _, bundle := range bundles {
+		bundleCtx, write := simCtx.CacheContext()
+		bundleTxs, err := m.applyBundle(bundleCtx, keeper, bundle, denom)
+		if err != nil {
+			m.logger.Debug("dropping bundle", "bundle_hash", bundle.Hash(), "error", err)
+			continue
+		}
+		write()
+		txs = append(txs, bundleTxs...)
+		placed = append(placed, bundle)
+	}
+	return txs, placed
+}
+
+// applyBundle executes the transactions of a bundle on the context and returns
+// them as SDK transactions, it fails if a transaction fails or reverts without
+// being allowed to.
+func (m *ExperimentalEVMMempool) applyBundle(ctx sdk.Context, keeper PendingKeeperI, bundle *Bundle, denom string) ([]sdk.Tx, error) {
+	txs := make([]sdk.Tx, 0, len(bundle.Txs))
+	for _, ethTx := range bundle.Txs {
+		tx, msg, err := m.buildSDKTx(ethTx, denom)
+		if err != nil {
+			return nil, err
+		}
+		txs = append(txs, tx)
+
+		res, err := m.applyTx(ctx, keeper, tx, msg)
+		if err != nil {
+			return nil, fmt.Errorf("transaction %s failed: %w", ethTx.Hash(), err)
+		}
+		if res.Failed() && !bundle.canRevert(ethTx.Hash()) {
+			return nil, fmt.Errorf("transaction %s reverted: %s", ethTx.Hash(), res.VmError)
+		}
+	}
+	return txs, nil
+}
+
+// buildSDKTx wraps an EVM transaction in an SDK transaction.
+func (m *ExperimentalEVMMempool) buildSDKTx(ethTx *ethtypes.Transaction, denom string) (sdk.Tx, *evmtypes.MsgEthereumTx, error) {
+	msg := &evmtypes.MsgEthereumTx{}
+	if err := msg.FromSignedEthereumTx(ethTx, ethtypes.LatestSignerForChainID(m.blockchain.Config().ChainID)); err != nil {
+		return nil, nil, fmt.Errorf("failed to convert transaction %s: %w", ethTx.Hash(), err)
+	}
+	tx, err := msg.BuildTx(m.txConfig.NewTxBuilder(), denom)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to build transaction %s: %w", ethTx.Hash(), err)
+	}
+	return tx, msg, nil
+}
+
+var _ sdkmempool.Iterator = &bundleIterator{}
+
+// bundleIterator iterates over the transactions of the bundles, then over the
+// transactions of the mempool that are not part of a bundle.
+type bundleIterator struct {
+	txs      []sdk.Tx
+	idx      int
+	bundled  map[common.Hash]struct{}
+	iterator sdkmempool.Iterator
+	mempool  *ExperimentalEVMMempool
+}
+
+// newBundleIterator returns an iterator placing the bundle transactions before
+// the ones of the mempool iterator, which may be nil.
+func (m *ExperimentalEVMMempool) newBundleIterator(txs []sdk.Tx, iterator sdkmempool.Iterator) sdkmempool.Iterator {
+	if len(txs) == 0 {
+		return iterator
+	}
+	it := &bundleIterator{
+		txs:      txs,
+		bundled:  make(map[common.Hash]struct{}, len(txs)),
+		iterator: iterator,
+		mempool:  m,
+	}
+	for _, tx := range txs {
+		if msg, err := m.getEVMMessage(tx); err == nil {
+			it.bundled[msg.Hash()] = struct{}{}
+		}
+	}
+	it.skipBundled()
+	return it
+}
+
+// Tx returns the current transaction.
+func (it *bundleIterator) Tx() sdk.Tx {
+	if it.idx < len(it.txs) {
+		return it.txs[it.idx]
+	}
+	if it.iterator == nil {
+		return nil
+	}
+	return it.iterator.Tx()
+}
+
+// Next advances to the next transaction, it returns nil at the end.
+func (it *bundleIterator) Next() sdkmempool.Iterator {
+	if it.idx < len(it.txs) {
+		it.idx++
+	} else if it.iterator != nil {
+		it.iterator = it.iterator.Next()
+	}
+	it.skipBundled()
+	if it.idx >= len(it.txs) && it.iterator == nil {
+		return nil
+	}
+	return it
+}
+
+// skipBundled skips the mempool transactions already selected in a bundle.
+func (it *bundleIterator) skipBundled() {
+	if it.idx < len(it.txs) {
+		return
+	}
+	for it.iterator != nil {
+		tx := it.iterator.Tx()
+		if tx == nil {
+			return
+		}
+		msg, err := it.mempool.getEVMMessage(tx)
+		if err != nil {
+			return
+		}
+		if _, ok := it.bundled[msg.Hash()]; !ok {
+			return
+		}
+		it.iterator = it.iterator.Next()
+	}
+}
+
+// NewBundleProposalHandler wraps a PrepareProposal handler to keep the bundles
+// atomic: the transactions of a bundle placed in the proposal by the mempool that
+// is not included as a whole, contiguously and in order, are removed from the
+// proposal, along with the later transactions of their senders which nonces would
+// no longer follow.
+//
+// The bundles the mempool didn't place, e.g. because one of their transactions
+// fails, are not enforced: their transactions may still be included on their own
+// from the mempool.
+func NewBundleProposalHandler(m *ExperimentalEVMMempool, handler sdk.PrepareProposalHandler) sdk.PrepareProposalHandler {
+	return func(ctx sdk.Context, req *abci.RequestPrepareProposal) (*abci.ResponsePrepareProposal, error) {
+		placed := &placedBundles{}
+		res, err := handler(ctx.WithValue(placedBundlesKey{}, placed), req)
+		if err != nil || res == nil || len(placed.bundles) == 0 {
+			return res, err
+		}
+		res.Txs = m.dropPartialBundles(res.Txs, placed.bundles)
+		return res, nil
+	}
+}
+
+// dropPartialBundles removes the partially included bundles from the proposal,
+// the bundles must be the ones placed by lockAndSelectBundles.
+func (m *ExperimentalEVMMempool) dropPartialBundles(txs [][]byte, bundles []*Bundle) [][]byte {
+	decoder := m.txConfig.TxDecoder()
+	positions := make(map[common.Hash]int, len(txs))
+	senders := make([]common.Address, len(txs))
+	for i, txBz := range txs {
+		tx, err := decoder(txBz)
+		if err != nil {
+			continue
+		}
+		msg, err := m.getEVMMessage(tx)
+		if err != nil {
+			continue
+		}
+		positions[msg.Hash()] = i
+		senders[i] = msg.GetSender()
+	}
+
+	// dropFrom is the position of the first dropped transaction of each sender
+	dropFrom := make(map[common.Address]int)
+	for _, bundle := range bundles {
+		first, found := -1, 0
+		contiguous := true
+		for j, tx := range bundle.Txs {
+			pos, ok := positions[tx.Hash()]
+			if !ok {
+				continue
+			}
+			if found == 0 {
+				first = pos
+			}
+			contiguous = contiguous && pos == first+j
+			found++
+		}
+		if found == 0 || (found == len(bundle.Txs) && contiguous) {
+			continue
+		}
+
+		m.logger.Debug("dropping partially included bundle", "bundle_hash", bundle.Hash(), "included", found, "tx_count", len(bundle.Txs))
+		for _, tx := range bundle.Txs {
+			pos, ok := positions[tx.Hash()]
+			if !ok {
+				continue
+			}
+			if from, ok := dropFrom[senders[pos]]; !ok || pos < from {
+				dropFrom[senders[pos]] = pos
+			}
+		}
+	}
+	if len(dropFrom) == 0 {
+		return txs
+	}
+
+	kept := make([][]byte, 0, len(txs))
+	for i, txBz := range txs {
+		if from, ok := dropFrom[senders[i]]; ok && i >= from {
+			continue
+		}
+		kept = append(kept, txBz)
+	}
+	return kept
+}
@@ -0,0 +1,373 @@
+package mempool
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+	protov2 "google.golang.org/protobuf/proto"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	"github.com/cosmos/evm/encoding"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkmempool "github.com/cosmos/cosmos-sdk/types/mempool"
+)
+
+func newBundleTx(t *testing.T, nonce uint64, tip int64) *ethtypes.Transaction {
+	t.Helper()
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	return newBundleTxFrom(t, key, nonce, tip)
+}
+
+func newBundleTxFrom(t *testing.T, key *ecdsa.PrivateKey, nonce uint64, tip int64) *ethtypes.Transaction {
+	t.Helper()
+	signer := ethtypes.LatestSignerForChainID(big.NewInt(9001))
+	tx, err := ethtypes.SignNewTx(key, signer, &ethtypes.DynamicFeeTx{
+		ChainID:   big.NewInt(9001),
+		Nonce:     nonce,
+		GasTipCap: big.NewInt(tip),
+		GasFeeCap: big.NewInt(tip + 100),
+		Gas:       21000,
+		To:        &common.Address{},
+	})
+	require.NoError(t, err)
+	return tx
+}
+
+func TestBundleValidate(t *testing.T) {
+	tx := newBundleTx(t, 0, 1)
+	require.ErrorIs(t, (&Bundle{}).Validate(), ErrBundleEmpty)
+	require.ErrorIs(t, (&Bundle{Txs: []*ethtypes.Transaction{tx, tx}}).Validate(), ErrBundleDuplicate)
+	require.Error(t, (&Bundle{Txs: []*ethtypes.Transaction{tx}, MinTimestamp: 10, MaxTimestamp: 5}).Validate())
+	require.NoError(t, (&Bundle{Txs: []*ethtypes.Transaction{tx}, MinTimestamp: 5, MaxTimestamp: 10}).Validate())
+
+	bundle := &Bundle{Txs: []*ethtypes.Transaction{tx}, BlockNumber: 10, MinTimestamp: 5, MaxTimestamp: 10}
+	require.True(t, bundle.eligible(10, 7))
+	require.False(t, bundle.eligible(11, 7))
+	require.False(t, bundle.eligible(10, 4))
+	require.False(t, bundle.eligible(10, 11))
+}
+
+func TestBundlePool(t *testing.T) {
+	pool := newBundlePool(2)
+	low := &Bundle{Txs: []*ethtypes.Transaction{newBundleTx(t, 0, 1)}, BlockNumber: 10}
+	high := &Bundle{Txs: []*ethtypes.Transaction{newBundleTx(t, 0, 50), newBundleTx(t, 0, 10)}, BlockNumber: 10}
+	later := &Bundle{Txs: []*ethtypes.Transaction{newBundleTx(t, 0, 1)}, BlockNumber: 11}
+
+	_, err := pool.add(low, 11)
+	require.ErrorIs(t, err, ErrBundleTargetPast)
+
+	hash, err := pool.add(low, 10)
+	require.NoError(t, err)
+	require.Equal(t, low.Hash(), hash)
+	_, err = pool.add(low, 10)
+	require.ErrorIs(t, err, ErrBundleKnown)
+	_, err = pool.add(high, 10)
+	require.NoError(t, err)
+	_, err = pool.add(later, 10)
+	require.ErrorIs(t, err, ErrBundlePoolFull)
+
+	// the bundles are ordered by decreasing gas price
+	require.Equal(t, []*Bundle{high, low}, pool.eligible(10, 0, big.NewInt(100)))
+	require.Empty(t, pool.eligible(9, 0, nil))
+
+	// the bundles of the past blocks are pruned
+	require.Empty(t, pool.eligible(11, 0, nil))
+	_, err = pool.add(later, 11)
+	require.NoError(t, err)
+	require.Len(t, pool.bundles, 1)
+}
+
+type bundleTestTx struct {
+	msg sdk.Msg
+}
+
+func (tx bundleTestTx) GetMsgs() []sdk.Msg                    { return []sdk.Msg{tx.msg} }
+func (tx bundleTestTx) GetMsgsV2() ([]protov2.Message, error) { return nil, nil }
+
+type sliceIterator struct {
+	txs []sdk.Tx
+}
+
+func (it *sliceIterator) Tx() sdk.Tx { return it.txs[0] }
+
+func (it *sliceIterator) Next() sdkmempool.Iterator {
+	if len(it.txs) == 1 {
+		return nil
+	}
+	return &sliceIterator{txs: it.txs[1:]}
+}
+
+func TestBundleIterator(t *testing.T) {
+	newTx := func(tx *ethtypes.Transaction) sdk.Tx {
+		msg := &evmtypes.MsgEthereumTx{}
+		msg.FromEthereumTx(tx)
+		return bundleTestTx{msg: msg}
+	}
+	bundled, other := newTx(newBundleTx(t, 0, 1)), newTx(newBundleTx(t, 0, 1))
+	cosmosTx := bundleTestTx{msg: &evmtypes.MsgUpdateParams{}}
+
+	m := &ExperimentalEVMMempool{}
+	require.Nil(t, m.newBundleIterator(nil, nil))
+
+	it := m.newBundleIterator([]sdk.Tx{bundled}, &sliceIterator{txs: []sdk.Tx{bundled, other, cosmosTx}})
+	var txs []sdk.Tx
+	for ; it != nil; it = it.Next() {
+		txs = append(txs, it.Tx())
+	}
+	require.Equal(t, []sdk.Tx{bundled, other, cosmosTx}, txs)
+
+	it = m.newBundleIterator([]sdk.Tx{bundled}, nil)
+	require.Equal(t, bundled, it.Tx())
+	require.Nil(t, it.Next())
+}
+
+// bundleTestKeeper executes the bundle transactions, the ones listed in reverted
+// revert.
+type bundleTestKeeper struct {
+	VMKeeperI
+	reverted map[common.Hash]bool
+}
+
+func (k bundleTestKeeper) GetBaseFee(sdk.Context) *big.Int { return nil }
+
+func (k bundleTestKeeper) GetEvmCoinInfo(sdk.Context) evmtypes.EvmCoinInfo { return testCoinInfo }
+
+func (k bundleTestKeeper) EthereumTx(_ context.Context, msg *evmtypes.MsgEthereumTx) (*evmtypes.MsgEthereumTxResponse, error) {
+	if k.reverted[msg.Hash()] {
+		return &evmtypes.MsgEthereumTxResponse{VmError: "execution reverted"}, nil
+	}
+	return &evmtypes.MsgEthereumTxResponse{}, nil
+}
+
+func (k bundleTestKeeper) Balance(context.Context, *evmtypes.QueryBalanceRequest) (*evmtypes.QueryBalanceResponse, error) {
+	return nil, nil
+}
+
+func (k bundleTestKeeper) Storage(context.Context, *evmtypes.QueryStorageRequest) (*evmtypes.QueryStorageResponse, error) {
+	return nil, nil
+}
+
+func (k bundleTestKeeper) Code(context.Context, *evmtypes.QueryCodeRequest) (*evmtypes.QueryCodeResponse, error) {
+	return nil, nil
+}
+
+func (k bundleTestKeeper) EthCall(context.Context, *evmtypes.EthCallRequest) (*evmtypes.MsgEthereumTxResponse, error) {
+	return nil, nil
+}
+
+// newBundleTestMempool returns a mempool executing the bundles with the test
+// keeper, the transactions listed in failing fail the ante handler.
+func newBundleTestMempool(t *testing.T, reverted, failing map[common.Hash]bool) *ExperimentalEVMMempool {
+	t.Helper()
+	configurator := evmtypes.NewEVMConfigurator()
+	configurator.ResetTestConfig()
+	t.Cleanup(configurator.ResetTestConfig)
+	require.NoError(t, evmtypes.SetChainConfig(evmtypes.DefaultChainConfig(9001)))
+	require.NoError(t, configurator.WithEVMCoinInfo(evmtypes.EvmCoinInfo{
+		Denom:         testCoinInfo.Denom,
+		ExtendedDenom: testCoinInfo.ExtendedDenom,
+		DisplayDenom:  "atom",
+		Decimals:      testCoinInfo.Decimals,
+	}).Configure())
+	encodingConfig := encoding.MakeConfig(9001)
+	evmtypes.RegisterInterfaces(encodingConfig.InterfaceRegistry)
+
+	m := &ExperimentalEVMMempool{
+		vmKeeper:   bundleTestKeeper{reverted: reverted},
+		bundles:    newBundlePool(10),
+		logger:     log.NewNopLogger(),
+		txConfig:   encodingConfig.TxConfig,
+		blockchain: &Blockchain{},
+	}
+	m.anteHandler = func(ctx sdk.Context, tx sdk.Tx, _ bool) (sdk.Context, error) {
+		msg, err := m.getEVMMessage(tx)
+		if err != nil {
+			return ctx, err
+		}
+		if failing[msg.Hash()] {
+			return ctx, errors.New("insufficient funds")
+		}
+		return ctx, nil
+	}
+	return m
+}
+
+func newBundleTestContext(height int64) sdk.Context {
+	ctx := testutil.DefaultContext(storetypes.NewKVStoreKey("test"), storetypes.NewTransientStoreKey("transient_test"))
+	return ctx.WithBlockHeight(height).WithBlockTime(time.Unix(100, 0))
+}
+
+// hashesOf returns the hashes of the EVM transactions.
+func hashesOf(t *testing.T, m *ExperimentalEVMMempool, txs []sdk.Tx) []common.Hash {
+	t.Helper()
+	hashes := make([]common.Hash, len(txs))
+	for i, tx := range txs {
+		msg, err := m.getEVMMessage(tx)
+		require.NoError(t, err)
+		hashes[i] = msg.Hash()
+	}
+	return hashes
+}
+
+func TestSelectBundles(t *testing.T) {
+	placedTx1, placedTx2 := newBundleTx(t, 0, 50), newBundleTx(t, 0, 50)
+	failingTx, okTx := newBundleTx(t, 0, 40), newBundleTx(t, 0, 40)
+	revertingTx := newBundleTx(t, 0, 30)
+	allowedRevertTx := newBundleTx(t, 0, 20)
+
+	m := newBundleTestMempool(t,
+		map[common.Hash]bool{revertingTx.Hash(): true, allowedRevertTx.Hash(): true},
+		map[common.Hash]bool{failingTx.Hash(): true},
+	)
+	bundles := []*Bundle{
+		{Txs: []*ethtypes.Transaction{placedTx1, placedTx2}, BlockNumber: 10},
+		{Txs: []*ethtypes.Transaction{okTx, failingTx}, BlockNumber: 10},
+		{Txs: []*ethtypes.Transaction{revertingTx}, BlockNumber: 10},
+		{Txs: []*ethtypes.Transaction{allowedRevertTx}, BlockNumber: 10, RevertingTxHashes: []common.Hash{allowedRevertTx.Hash()}},
+		{Txs: []*ethtypes.Transaction{newBundleTx(t, 0, 100)}, BlockNumber: 11},
+	}
+	for _, bundle := range bundles {
+		_, err := m.bundles.add(bundle, 10)
+		require.NoError(t, err)
+	}
+
+	ctx := newBundleTestContext(10)
+	txs, placed := m.selectBundles(ctx)
+	require.Equal(t, []common.Hash{placedTx1.Hash(), placedTx2.Hash(), allowedRevertTx.Hash()}, hashesOf(t, m, txs))
+	require.Equal(t, []*Bundle{bundles[0], bundles[3]}, placed)
+
+	// the bundles that can't be executed are not selected, as their txs could fail in the block
+	m.anteHandler = nil
+	txs, placed = m.selectBundles(ctx)
+	require.Empty(t, txs)
+	require.Empty(t, placed)
+}
+
+func TestLockAndSelectBundles(t *testing.T) {
+	bundleTx := newBundleTx(t, 0, 50)
+	m := newBundleTestMempool(t, nil, nil)
+	bundle := &Bundle{Txs: []*ethtypes.Transaction{bundleTx}, BlockNumber: 10}
+	_, err := m.bundles.add(bundle, 10)
+	require.NoError(t, err)
+
+	// the mempool changes during the first executions of the bundles
+	var changes, executions int
+	anteHandler := m.anteHandler
+	m.anteHandler = func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
+		executions++
+		if executions <= changes {
+			m.revision.Add(1)
+		}
+		return anteHandler(ctx, tx, simulate)
+	}
+
+	testCases := []struct {
+		name          string
+		changes       int
+		expExecutions int
+	}{
+		{"mempool unchanged - executed once", 0, 1},
+		{"mempool changed - executed again", 1, 2},
+		{"mempool changing - executed under the lock", maxBundleSelections, maxBundleSelections + 1},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			changes, executions = tc.changes, 0
+			collector := &placedBundles{}
+			txs := m.lockAndSelectBundles(newBundleTestContext(10).WithValue(placedBundlesKey{}, collector))
+			require.False(t, m.mtx.TryLock(), "the mempool is locked")
+			m.mtx.Unlock()
+
+			require.Equal(t, tc.expExecutions, executions)
+			require.Equal(t, []common.Hash{bundleTx.Hash()}, hashesOf(t, m, txs))
+			require.Equal(t, []*Bundle{bundle}, collector.bundles, "the bundles are recorded once")
+		})
+	}
+}
+
+func TestDropPartialBundles(t *testing.T) {
+	keyA, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	keyB, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	a0, a1 := newBundleTxFrom(t, keyA, 0, 10), newBundleTxFrom(t, keyA, 1, 10)
+	b0, b1 := newBundleTxFrom(t, keyB, 0, 10), newBundleTxFrom(t, keyB, 1, 10)
+	other := newBundleTx(t, 0, 10)
+
+	m := newBundleTestMempool(t, nil, nil)
+	encode := func(txs ...*ethtypes.Transaction) [][]byte {
+		bzs := make([][]byte, len(txs))
+		for i, ethTx := range txs {
+			tx, _, err := m.buildSDKTx(ethTx, testCoinInfo.Denom)
+			require.NoError(t, err)
+			bzs[i], err = m.txConfig.TxEncoder()(tx)
+			require.NoError(t, err)
+		}
+		return bzs
+	}
+	bundle := &Bundle{Txs: []*ethtypes.Transaction{a0, b0}, BlockNumber: 10}
+
+	testCases := []struct {
+		name     string
+		proposal [][]byte
+		expected [][]byte
+	}{
+		{"bundle included as a whole", encode(a0, b0, a1, other), encode(a0, b0, a1, other)},
+		{"bundle not included", encode(other, a1), encode(other, a1)},
+		{"bundle cut, the later txs of its senders are dropped", encode(a0, other, a1, b1), encode(other, b1)},
+		{"bundle not contiguous", encode(a0, other, b0), encode(other)},
+		{"bundle out of order", encode(b0, a0, other), encode(other)},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, m.dropPartialBundles(tc.proposal, []*Bundle{bundle}))
+		})
+	}
+}
+
+func TestBundleProposalHandler(t *testing.T) {
+	keyVictim, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	victimTx, victimNextTx := newBundleTxFrom(t, keyVictim, 0, 10), newBundleTxFrom(t, keyVictim, 1, 10)
+	failingTx := newBundleTx(t, 0, 10)
+
+	m := newBundleTestMempool(t, nil, map[common.Hash]bool{failingTx.Hash(): true})
+	// a bundle wrapping a public mempool tx and built to fail is not placed
+	_, err = m.bundles.add(&Bundle{Txs: []*ethtypes.Transaction{victimTx, failingTx}, BlockNumber: 10}, 10)
+	require.NoError(t, err)
+
+	proposal := make([][]byte, 0, 2)
+	for _, ethTx := range []*ethtypes.Transaction{victimTx, victimNextTx} {
+		tx, _, err := m.buildSDKTx(ethTx, testCoinInfo.Denom)
+		require.NoError(t, err)
+		bz, err := m.txConfig.TxEncoder()(tx)
+		require.NoError(t, err)
+		proposal = append(proposal, bz)
+	}
+	handler := NewBundleProposalHandler(m, func(ctx sdk.Context, _ *abci.RequestPrepareProposal) (*abci.ResponsePrepareProposal, error) {
+		// the proposal has the mempool txs of the victim, the bundle isn't placed
+		txs, _ := m.selectBundles(ctx)
+		require.Empty(t, txs)
+		return &abci.ResponsePrepareProposal{Txs: proposal}, nil
+	})
+
+	res, err := handler(newBundleTestContext(10), &abci.RequestPrepareProposal{Height: 10, Time: time.Unix(100, 0)})
+	require.NoError(t, err)
+	require.Equal(t, proposal, res.Txs, "the txs of a bundle that isn't placed are kept")
+}
@@ -24,6 +24,7 @@ func NewCheckTxHandler(mempool *ExperimentalEVMMempool) types.CheckTxHandler {
 				// send it to the mempool for further triage
 				err := mempool.InsertInvalidNonce(request.Tx)
 				if err != nil {
+					mempool.recordCheckTxFailure(request.Tx, request.Type == abci.CheckTxType_Recheck, err)
 					return sdkerrors.ResponseCheckTxWithEvents(err, gInfo.GasWanted, gInfo.GasUsed, anteEvents, false), nil
 				}
 			}
@@ -34,7 +35,10 @@ func NewCheckTxHandler(mempool *ExperimentalEVMMempool) types.CheckTxHandler {
 				return sdkerrors.ResponseCheckTxWithEvents(nil, gInfo.GasWanted, gInfo.GasUsed, anteEvents, false), nil
 			}
 
-			// anything else, return regular error
+			// anything else, record why the transaction is dropped and return regular error
+			if !errors.Is(err, ErrNonceGap) && !errors.Is(err, ErrNonceLow) {
+				mempool.recordCheckTxFailure(request.Tx, request.Type == abci.CheckTxType_Recheck, err)
+			}
 			return sdkerrors.ResponseCheckTxWithEvents(err, gInfo.GasWanted, gInfo.GasUsed, anteEvents, false), nil
 		}
 
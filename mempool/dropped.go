@@ -0,0 +1,123 @@
+package mempool
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/lru"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/metrics"
+
+	"github.com/cosmos/evm/mempool/txpool"
+	"github.com/cosmos/evm/mempool/txpool/legacypool"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	sdkmempool "github.com/cosmos/cosmos-sdk/types/mempool"
+)
+
+// droppedTxsSize is the number of the dropped transactions remembered.
+const droppedTxsSize = 4096
+
+const (
+	evmPoolName    = "evm"
+	cosmosPoolName = "cosmos"
+)
+
+// DropReason is the reason a transaction was rejected by or removed from the
+// mempool without being included in a block.
+type DropReason string
+
+const (
+	DropReasonUnderpriced       DropReason = "underpriced"
+	DropReasonNonceTooLow       DropReason = "nonce_too_low"
+	DropReasonInsufficientFunds DropReason = "insufficient_funds"
+	DropReasonReplaced          DropReason = "replaced"
+	DropReasonEvicted           DropReason = "evicted"
+	DropReasonPoolFull          DropReason = "pool_full"
+	DropReasonAnteFailure       DropReason = "ante_failure"
+)
+
+// DroppedTx records why a transaction was rejected by or removed from the mempool.
+// The hash of an EVM transaction is its Ethereum hash, the one of a Cosmos
+// transaction its CometBFT hash.
+type DroppedTx struct {
+	Hash     common.Hash `json:"hash"`
+	Pool     string      `json:"pool"`
+	Reason   DropReason  `json:"reason"`
+	Error    string      `json:"error"`
+	Rejected bool        `json:"rejected"` // rejected on insertion rather than removed from the pool
+	Time     time.Time   `json:"time"`
+}
+
+// dropReason classifies the error a transaction was rejected or removed with.
+// The errors not raised by the pools are raised by the ante handler.
+func dropReason(err error) DropReason {
+	switch {
+	case errors.Is(err, legacypool.ErrReplaced):
+		return DropReasonReplaced
+	case errors.Is(err, legacypool.ErrEvicted):
+		return DropReasonEvicted
+	case errors.Is(err, txpool.ErrUnderpriced), errors.Is(err, txpool.ErrReplaceUnderpriced),
+		errors.Is(err, txpool.ErrTxGasPriceTooLow), errors.Is(err, sdkerrors.ErrInsufficientFee):
+		return DropReasonUnderpriced
+	case errors.Is(err, ErrNonceLow), errors.Is(err, core.ErrNonceTooLow):
+		return DropReasonNonceTooLow
+	case errors.Is(err, core.ErrInsufficientFunds), errors.Is(err, sdkerrors.ErrInsufficientFunds):
+		return DropReasonInsufficientFunds
+	case errors.Is(err, legacypool.ErrTxPoolOverflow), errors.Is(err, txpool.ErrAccountLimitExceeded),
+		errors.Is(err, sdkmempool.ErrMempoolTxMaxCapacity):
+		return DropReasonPoolFull
+	default:
+		return DropReasonAnteFailure
+	}
+}
+
+// dropRecorder remembers the reasons of the recently dropped transactions and
+// counts them per pool and reason.
+type dropRecorder struct {
+	mtx sync.Mutex
+	txs lru.BasicLRU[common.Hash, DroppedTx]
+}
+
+func newDropRecorder() *dropRecorder {
+	return &dropRecorder{txs: lru.NewBasicLRU[common.Hash, DroppedTx](droppedTxsSize)}
+}
+
+// record records a transaction of the given pool rejected on insertion or
+// removed from the pool with the given error.
+func (r *dropRecorder) record(hash common.Hash, pool string, rejected bool, err error) {
+	reason := dropReason(err)
+	stage := "dropped"
+	if rejected {
+		stage = "rejected"
+	}
+	metrics.GetOrRegisterMeter(fmt.Sprintf("mempool/%s/%s/%s", pool, stage, reason), nil).Mark(1)
+
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+	r.txs.Add(hash, DroppedTx{
+		Hash:     hash,
+		Pool:     pool,
+		Reason:   reason,
+		Error:    err.Error(),
+		Rejected: rejected,
+		Time:     time.Now(),
+	})
+}
+
+// forget removes the record of a transaction inserted again.
+func (r *dropRecorder) forget(hash common.Hash) {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+	r.txs.Remove(hash)
+}
+
+// get returns the record of a dropped transaction.
+func (r *dropRecorder) get(hash common.Hash) (DroppedTx, bool) {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+	return r.txs.Get(hash)
+}
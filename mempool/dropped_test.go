@@ -0,0 +1,78 @@
+package mempool
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/evm/mempool/txpool"
+	"github.com/cosmos/evm/mempool/txpool/legacypool"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	sdkmempool "github.com/cosmos/cosmos-sdk/types/mempool"
+)
+
+func TestDropReason(t *testing.T) {
+	testCases := []struct {
+		err      error
+		expected DropReason
+	}{
+		{txpool.ErrUnderpriced, DropReasonUnderpriced},
+		{txpool.ErrReplaceUnderpriced, DropReasonUnderpriced},
+		{errorsmod.Wrap(sdkerrors.ErrInsufficientFee, "gas prices too low"), DropReasonUnderpriced},
+		{fmt.Errorf("%w: next nonce 2, tx nonce 1", ErrNonceLow), DropReasonNonceTooLow},
+		{core.ErrNonceTooLow, DropReasonNonceTooLow},
+		{core.ErrInsufficientFunds, DropReasonInsufficientFunds},
+		{errorsmod.Wrap(sdkerrors.ErrInsufficientFunds, "spendable balance 0"), DropReasonInsufficientFunds},
+		{legacypool.ErrReplaced, DropReasonReplaced},
+		{legacypool.ErrEvicted, DropReasonEvicted},
+		{legacypool.ErrTxPoolOverflow, DropReasonPoolFull},
+		{sdkmempool.ErrMempoolTxMaxCapacity, DropReasonPoolFull},
+		{errorsmod.Wrap(sdkerrors.ErrUnauthorized, "signature verification failed"), DropReasonAnteFailure},
+		{errors.New("unknown"), DropReasonAnteFailure},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.err.Error(), func(t *testing.T) {
+			require.Equal(t, tc.expected, dropReason(tc.err))
+		})
+	}
+}
+
+func TestDropRecorder(t *testing.T) {
+	recorder := newDropRecorder()
+	hash := common.Hash{1}
+
+	_, ok := recorder.get(hash)
+	require.False(t, ok)
+
+	recorder.record(hash, evmPoolName, true, txpool.ErrUnderpriced)
+	dropped, ok := recorder.get(hash)
+	require.True(t, ok)
+	require.Equal(t, hash, dropped.Hash)
+	require.Equal(t, evmPoolName, dropped.Pool)
+	require.Equal(t, DropReasonUnderpriced, dropped.Reason)
+	require.Equal(t, txpool.ErrUnderpriced.Error(), dropped.Error)
+	require.True(t, dropped.Rejected)
+
+	// the record of a transaction inserted again is forgotten
+	recorder.forget(hash)
+	_, ok = recorder.get(hash)
+	require.False(t, ok)
+
+	// only the most recent drops are remembered
+	for i := 0; i <= droppedTxsSize; i++ {
+		recorder.record(common.BigToHash(big.NewInt(int64(i+2))), cosmosPoolName, false, legacypool.ErrEvicted)
+	}
+	_, ok = recorder.get(common.BigToHash(big.NewInt(2)))
+	require.False(t, ok)
+	dropped, ok = recorder.get(common.BigToHash(big.NewInt(droppedTxsSize + 2)))
+	require.True(t, ok)
+	require.Equal(t, DropReasonEvicted, dropped.Reason)
+}
@@ -9,4 +9,13 @@ var (
 	ErrNotEVMTransaction  = errors.New("transaction is not an EVM transaction")
 	ErrNonceGap           = errors.New("tx nonce is higher than account nonce")
 	ErrNonceLow           = errors.New("tx nonce is lower than account nonce")
+
+	ErrPendingStateNotSupported = errors.New("pending state requires a vm keeper executing transactions and an ante handler")
+
+	ErrBundlesDisabled  = errors.New("bundles are disabled, set evm.mempool.max-bundles to enable them")
+	ErrBundlePoolFull   = errors.New("bundle pool is full")
+	ErrBundleKnown      = errors.New("bundle already known")
+	ErrBundleEmpty      = errors.New("bundle has no transactions")
+	ErrBundleDuplicate  = errors.New("bundle has duplicate transactions")
+	ErrBundleTargetPast = errors.New("bundle targets a past block")
 )
@@ -0,0 +1,241 @@
+package mempool
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/common/lru"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
+
+	"cosmossdk.io/log"
+)
+
+const (
+	// gossipSeenSize is the number of the hashes of the gossiped transactions
+	// remembered, to avoid sending the same transaction twice.
+	gossipSeenSize = 8192
+	// gossipQueueSize is the number of the transactions waiting to be gossiped, in
+	// total and to each peer, the transactions are dropped when a queue is full.
+	gossipQueueSize = 1024
+	// gossipTimeout bounds the submission of a transaction to a peer.
+	gossipTimeout = 5 * time.Second
+	// gossipPruneInterval is the interval of the removal of the transactions the
+	// pool no longer holds from the per-account counts.
+	gossipPruneInterval = time.Minute
+)
+
+// gossipSendFn submits a raw transaction to the JSON-RPC endpoint of a peer.
+type gossipSendFn func(ctx context.Context, peer string, rawTx []byte) error
+
+// queuedGossiper propagates the queued (nonce-gapped) EVM transactions, that are
+// not broadcast through CometBFT, to the JSON-RPC endpoints of the configured
+// peers with eth_sendRawTransaction. The peers queue the transactions in turn,
+// so that the validator eventually receiving the parent transaction has them.
+type queuedGossiper struct {
+	peers         []string
+	maxPerAccount int
+	signer        ethtypes.Signer
+	has           func(hash common.Hash) bool // whether the pool still holds a transaction
+	send          gossipSendFn
+	logger        log.Logger
+
+	mtx      sync.Mutex
+	seen     lru.BasicLRU[common.Hash, struct{}]
+	accounts map[common.Address]map[common.Hash]struct{} // gossiped transactions still in the pool
+
+	queue      chan *ethtypes.Transaction
+	peerQueues []chan gossipTx // the transactions waiting to be sent to each peer, created by start
+	quit       chan struct{}
+	wg         sync.WaitGroup
+}
+
+// gossipTx is a transaction encoded for the gossip.
+type gossipTx struct {
+	hash  common.Hash
+	rawTx []byte
+}
+
+// newQueuedGossiper creates a gossiper of the queued transactions to the given
+// peers, sending at most maxPerAccount transactions held by the pool per account.
+func newQueuedGossiper(peers []string, maxPerAccount int, signer ethtypes.Signer, has func(common.Hash) bool, logger log.Logger) *queuedGossiper {
+	g := &queuedGossiper{
+		peers:         peers,
+		maxPerAccount: maxPerAccount,
+		signer:        signer,
+		has:           has,
+		logger:        logger,
+		seen:          lru.NewBasicLRU[common.Hash, struct{}](gossipSeenSize),
+		accounts:      make(map[common.Address]map[common.Hash]struct{}),
+		queue:         make(chan *ethtypes.Transaction, gossipQueueSize),
+		quit:          make(chan struct{}),
+	}
+	g.send = newRPCGossipSendFn()
+	return g
+}
+
+// newRPCGossipSendFn returns a send function dialing the peers lazily and
+// reusing the connections.
+func newRPCGossipSendFn() gossipSendFn {
+	var (
+		mtx     sync.Mutex
+		clients = make(map[string]*rpc.Client)
+	)
+	return func(ctx context.Context, peer string, rawTx []byte) error {
+		mtx.Lock()
+		client, ok := clients[peer]
+		if !ok {
+			var err error
+			if client, err = rpc.DialContext(ctx, peer); err != nil {
+				mtx.Unlock()
+				return err
+			}
+			clients[peer] = client
+		}
+		mtx.Unlock()
+
+		var hash common.Hash
+		return client.CallContext(ctx, &hash, "eth_sendRawTransaction", hexutil.Bytes(rawTx))
+	}
+}
+
+// start spawns the goroutine scheduling the transactions, and a goroutine per
+// peer sending them, so that a slow or unreachable peer doesn't delay the others.
+func (g *queuedGossiper) start() {
+	g.peerQueues = make([]chan gossipTx, len(g.peers))
+	for i, peer := range g.peers {
+		g.peerQueues[i] = make(chan gossipTx, gossipQueueSize)
+		g.wg.Add(1)
+		go g.peerLoop(peer, g.peerQueues[i])
+	}
+	g.wg.Add(1)
+	go g.loop()
+}
+
+// stop terminates the gossip, the pending transactions are dropped.
+func (g *queuedGossiper) stop() {
+	close(g.quit)
+	g.wg.Wait()
+}
+
+// onTx is the TxListener of the mempool, it schedules the queued transactions
+// for the gossip.
+func (g *queuedGossiper) onTx(tx *ethtypes.Transaction, queued bool) {
+	if !queued || !g.accept(tx) {
+		return
+	}
+	select {
+	case g.queue <- tx:
+	default:
+		g.logger.Debug("gossip queue full, dropping queued transaction", "tx_hash", tx.Hash())
+	}
+}
+
+// accept returns whether a transaction should be gossiped: it must not have been
+// gossiped already, and its sender must be under the per-account cap.
+func (g *queuedGossiper) accept(tx *ethtypes.Transaction) bool {
+	from, err := ethtypes.Sender(g.signer, tx)
+	if err != nil {
+		return false
+	}
+
+	g.mtx.Lock()
+	defer g.mtx.Unlock()
+
+	hash := tx.Hash()
+	if g.seen.Contains(hash) {
+		return false
+	}
+	gossiped := g.accounts[from]
+	g.pruneAccount(gossiped)
+	if len(gossiped) >= g.maxPerAccount {
+		g.logger.Debug("queued transactions cap of the account reached, skipping gossip", "tx_hash", hash, "from", from)
+		return false
+	}
+	if gossiped == nil {
+		gossiped = make(map[common.Hash]struct{})
+		g.accounts[from] = gossiped
+	}
+	gossiped[hash] = struct{}{}
+	g.seen.Add(hash, struct{}{})
+	return true
+}
+
+// pruneAccount removes the transactions the pool no longer holds from the
+// gossiped transactions of an account.
+func (g *queuedGossiper) pruneAccount(gossiped map[common.Hash]struct{}) {
+	for hash := range gossiped {
+		if !g.has(hash) {
+			delete(gossiped, hash)
+		}
+	}
+}
+
+// prune forgets the accounts without gossiped transactions left in the pool.
+func (g *queuedGossiper) prune() {
+	g.mtx.Lock()
+	defer g.mtx.Unlock()
+
+	for addr, gossiped := range g.accounts {
+		g.pruneAccount(gossiped)
+		if len(gossiped) == 0 {
+			delete(g.accounts, addr)
+		}
+	}
+}
+
+func (g *queuedGossiper) loop() {
+	defer g.wg.Done()
+
+	ticker := time.NewTicker(gossipPruneInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-g.quit:
+			return
+		case tx := <-g.queue:
+			g.gossip(tx)
+		case <-ticker.C:
+			g.prune()
+		}
+	}
+}
+
+// gossip schedules the sending of a transaction to all the peers.
+func (g *queuedGossiper) gossip(tx *ethtypes.Transaction) {
+	rawTx, err := tx.MarshalBinary()
+	if err != nil {
+		g.logger.Error("failed to encode queued transaction", "tx_hash", tx.Hash(), "error", err)
+		return
+	}
+	item := gossipTx{hash: tx.Hash(), rawTx: rawTx}
+	for i, queue := range g.peerQueues {
+		select {
+		case queue <- item:
+		default:
+			g.logger.Debug("peer gossip queue full, dropping queued transaction", "tx_hash", item.hash, "peer", g.peers[i])
+		}
+	}
+}
+
+// peerLoop sends the scheduled transactions to a peer.
+func (g *queuedGossiper) peerLoop(peer string, queue <-chan gossipTx) {
+	defer g.wg.Done()
+
+	for {
+		select {
+		case <-g.quit:
+			return
+		case item := <-queue:
+			ctx, cancel := context.WithTimeout(context.Background(), gossipTimeout)
+			// the peers already holding the transaction reject it, which is expected
+			if err := g.send(ctx, peer, item.rawTx); err != nil {
+				g.logger.Debug("failed to gossip queued transaction", "tx_hash", item.hash, "peer", peer, "error", err)
+			}
+			cancel()
+		}
+	}
+}
@@ -0,0 +1,220 @@
+package mempool
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log"
+)
+
+var gossipSigner = ethtypes.LatestSignerForChainID(big.NewInt(9001))
+
+// gossipNode is an in-process node queuing the transactions received through
+// eth_sendRawTransaction and gossiping them to its peers.
+type gossipNode struct {
+	mtx      sync.Mutex
+	pool     map[common.Hash]bool
+	received int
+
+	gossiper *queuedGossiper
+	server   *httptest.Server
+}
+
+type gossipNodeAPI struct {
+	node *gossipNode
+}
+
+func (api *gossipNodeAPI) SendRawTransaction(data hexutil.Bytes) (common.Hash, error) {
+	tx := new(ethtypes.Transaction)
+	if err := tx.UnmarshalBinary(data); err != nil {
+		return common.Hash{}, err
+	}
+	if !api.node.insert(tx) {
+		return common.Hash{}, errors.New("already known")
+	}
+	return tx.Hash(), nil
+}
+
+func newGossipNode(t *testing.T) *gossipNode {
+	t.Helper()
+	node := &gossipNode{pool: make(map[common.Hash]bool)}
+	node.gossiper = newQueuedGossiper(nil, 2, gossipSigner, node.has, log.NewNopLogger())
+
+	server := rpc.NewServer()
+	require.NoError(t, server.RegisterName("eth", &gossipNodeAPI{node: node}))
+	node.server = httptest.NewServer(server)
+	t.Cleanup(func() {
+		node.server.Close()
+		server.Stop()
+	})
+	return node
+}
+
+func (n *gossipNode) has(hash common.Hash) bool {
+	n.mtx.Lock()
+	defer n.mtx.Unlock()
+	return n.pool[hash]
+}
+
+// insert queues a transaction as the mempool does for the nonce-gapped ones,
+// notifying the gossiper.
+func (n *gossipNode) insert(tx *ethtypes.Transaction) bool {
+	n.mtx.Lock()
+	n.received++
+	if n.pool[tx.Hash()] {
+		n.mtx.Unlock()
+		return false
+	}
+	n.pool[tx.Hash()] = true
+	n.mtx.Unlock()
+
+	n.gossiper.onTx(tx, true)
+	return true
+}
+
+func (n *gossipNode) receivedCount() int {
+	n.mtx.Lock()
+	defer n.mtx.Unlock()
+	return n.received
+}
+
+func newGossipTx(t *testing.T, key []byte, nonce uint64) *ethtypes.Transaction {
+	t.Helper()
+	privKey, err := crypto.ToECDSA(key)
+	require.NoError(t, err)
+	tx, err := ethtypes.SignNewTx(privKey, gossipSigner, &ethtypes.DynamicFeeTx{
+		ChainID:   big.NewInt(9001),
+		Nonce:     nonce,
+		GasTipCap: big.NewInt(1),
+		GasFeeCap: big.NewInt(100),
+		Gas:       21000,
+		To:        &common.Address{},
+	})
+	require.NoError(t, err)
+	return tx
+}
+
+func TestQueuedGossipBetweenNodes(t *testing.T) {
+	nodeA, nodeB := newGossipNode(t), newGossipNode(t)
+	nodeA.gossiper.peers = []string{nodeB.server.URL}
+	nodeB.gossiper.peers = []string{nodeA.server.URL}
+	nodeA.gossiper.start()
+	nodeB.gossiper.start()
+	defer nodeA.gossiper.stop()
+	defer nodeB.gossiper.stop()
+
+	// a queued transaction of node A reaches node B, which gossips it back once
+	key := common.FromHex("b71c71a67e1177ad4e901695e1b4b9ee17ae16c6668d313eac2f96dbcda3f291")
+	tx := newGossipTx(t, key, 5)
+	require.True(t, nodeA.insert(tx))
+	require.Eventually(t, func() bool {
+		return nodeB.has(tx.Hash()) && nodeA.receivedCount() == 2
+	}, 5*time.Second, 10*time.Millisecond)
+
+	// the gossip stops there, node A rejects the transaction it already holds
+	time.Sleep(100 * time.Millisecond)
+	require.Equal(t, 2, nodeA.receivedCount())
+	require.Equal(t, 1, nodeB.receivedCount())
+}
+
+func TestQueuedGossipAccept(t *testing.T) {
+	pool := make(map[common.Hash]bool)
+	g := newQueuedGossiper([]string{"peer"}, 2, gossipSigner, func(hash common.Hash) bool { return pool[hash] }, log.NewNopLogger())
+	peerQueue := make(chan gossipTx, gossipQueueSize)
+	g.peerQueues = []chan gossipTx{peerQueue}
+
+	key := common.FromHex("8f2a55949038a9610f50fb23b5883af3b4ecb3c3bb792cbcefbd1542c692be63")
+	txs := []*ethtypes.Transaction{newGossipTx(t, key, 1), newGossipTx(t, key, 2), newGossipTx(t, key, 3)}
+	for _, tx := range txs {
+		pool[tx.Hash()] = true
+	}
+
+	// the executable transactions are broadcast through CometBFT
+	g.onTx(txs[0], false)
+	require.Empty(t, g.queue)
+
+	// the queued transactions are gossiped once, up to the cap of the account
+	g.onTx(txs[0], true)
+	g.onTx(txs[0], true)
+	g.onTx(txs[1], true)
+	g.onTx(txs[2], true)
+	require.Len(t, g.queue, 2)
+
+	// the cap is freed by the transactions leaving the pool
+	delete(pool, txs[0].Hash())
+	g.onTx(txs[2], true)
+	require.Len(t, g.queue, 3)
+
+	for len(g.queue) > 0 {
+		g.gossip(<-g.queue)
+	}
+	var sent []common.Hash
+	for len(peerQueue) > 0 {
+		item := <-peerQueue
+		tx := new(ethtypes.Transaction)
+		require.NoError(t, tx.UnmarshalBinary(item.rawTx))
+		require.Equal(t, item.hash, tx.Hash())
+		sent = append(sent, item.hash)
+	}
+	require.Equal(t, []common.Hash{txs[0].Hash(), txs[1].Hash(), txs[2].Hash()}, sent)
+
+	delete(pool, txs[1].Hash())
+	delete(pool, txs[2].Hash())
+	g.prune()
+	require.Empty(t, g.accounts)
+}
+
+func TestQueuedGossipSlowPeer(t *testing.T) {
+	g := newQueuedGossiper([]string{"slow", "fast"}, 2, gossipSigner, func(common.Hash) bool { return true }, log.NewNopLogger())
+	release := make(chan struct{})
+	var (
+		mtx  sync.Mutex
+		sent []common.Hash
+	)
+	g.send = func(ctx context.Context, peer string, rawTx []byte) error {
+		if peer == "slow" {
+			select {
+			case <-release:
+			case <-ctx.Done():
+			}
+			return ctx.Err()
+		}
+		tx := new(ethtypes.Transaction)
+		if err := tx.UnmarshalBinary(rawTx); err != nil {
+			return err
+		}
+		mtx.Lock()
+		defer mtx.Unlock()
+		sent = append(sent, tx.Hash())
+		return nil
+	}
+	g.start()
+	defer g.stop()
+	defer close(release)
+
+	key := common.FromHex("8f2a55949038a9610f50fb23b5883af3b4ecb3c3bb792cbcefbd1542c692be63")
+	txs := []*ethtypes.Transaction{newGossipTx(t, key, 1), newGossipTx(t, key, 2)}
+	for _, tx := range txs {
+		g.onTx(tx, true)
+	}
+
+	// the fast peer receives every transaction while the slow one holds its first
+	require.Eventually(t, func() bool {
+		mtx.Lock()
+		defer mtx.Unlock()
+		return len(sent) == len(txs)
+	}, time.Second, 10*time.Millisecond)
+	require.Equal(t, []common.Hash{txs[0].Hash(), txs[1].Hash()}, sent)
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/cosmos/evm/x/vm/statedb"
 	vmtypes "github.com/cosmos/evm/x/vm/types"
 
+	"cosmossdk.io/math"
 	storetypes "cosmossdk.io/store/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -17,6 +18,8 @@ type VMKeeperI interface {
 	GetBaseFee(ctx sdk.Context) *big.Int
 	GetParams(ctx sdk.Context) (params vmtypes.Params)
 	GetEvmCoinInfo(ctx sdk.Context) (coinInfo vmtypes.EvmCoinInfo)
+	GetFeeTokenRates(ctx sdk.Context) map[string]math.LegacyDec
+	GetFeeTokenBalance(ctx sdk.Context, addr common.Address) math.Int
 	GetAccount(ctx sdk.Context, addr common.Address) *statedb.Account
 	GetState(ctx sdk.Context, addr common.Address, key common.Hash) common.Hash
 	GetCode(ctx sdk.Context, codeHash common.Hash) []byte
@@ -34,4 +37,5 @@ type VMKeeperI interface {
 
 type FeeMarketKeeperI interface {
 	GetBlockGasWanted(ctx sdk.Context) uint64
+	GetCosmosBaseFee(ctx sdk.Context) math.LegacyDec
 }
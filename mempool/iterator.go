@@ -22,8 +22,8 @@ var _ mempool.Iterator = &EVMMempoolIterator{}
 
 // EVMMempoolIterator provides a unified iterator over both EVM and Cosmos transactions in the mempool.
 // It implements priority-based transaction selection, choosing between EVM and Cosmos transactions
-// based on their fee values. The iterator maintains state to track transaction types and ensures
-// proper sequencing during block building.
+// based on their effective tip per gas in the EVM denom, within the block gas shares reserved to
+// each pool. The per-sender nonce order is kept by each of the underlying iterators.
 type EVMMempoolIterator struct {
 	/** Mempool Iterators **/
 	evmIterator    *miner.TransactionsByPriceAndNonce
@@ -34,8 +34,13 @@ type EVMMempoolIterator struct {
 	txConfig client.TxConfig
 
 	/** Chain Params **/
-	bondDenom string
-	chainID   *big.Int
+	coinInfo      msgtypes.EvmCoinInfo
+	feeTokenRates map[string]math.LegacyDec
+	cosmosBaseFee *uint256.Int // nil to use the base fee of the current block
+	chainID       *big.Int
+
+	/** Block Gas Reservation **/
+	reservation *gasReservation // nil if no block gas is reserved
 
 	/** Blockchain Access **/
 	blockchain *Blockchain
@@ -43,9 +48,12 @@ type EVMMempoolIterator struct {
 
 // NewEVMMempoolIterator creates a new unified iterator over EVM and Cosmos transactions.
 // It combines iterators from both transaction pools and selects transactions based on fee priority.
-// Returns nil if both iterators are empty or nil. The bondDenom parameter specifies the native
-// token denomination for fee comparisons, and chainId is used for EVM transaction conversion.
-func NewEVMMempoolIterator(evmIterator *miner.TransactionsByPriceAndNonce, cosmosIterator mempool.Iterator, logger log.Logger, txConfig client.TxConfig, bondDenom string, chainID *big.Int, blockchain *Blockchain) mempool.Iterator {
+// Returns nil if both iterators are empty or nil. The coinInfo parameter specifies the EVM coin
+// the fees of the Cosmos transactions are normalized to, at the feeTokenRates for the fees paid
+// in fee tokens, and their tips computed over the cosmosBaseFee, the base fee of the Cosmos
+// lane. chainId is used for EVM transaction conversion, and the shares of the
+// blockGasLimit reserved to each pool are enforced.
+func NewEVMMempoolIterator(evmIterator *miner.TransactionsByPriceAndNonce, cosmosIterator mempool.Iterator, logger log.Logger, txConfig client.TxConfig, coinInfo msgtypes.EvmCoinInfo, feeTokenRates map[string]math.LegacyDec, cosmosBaseFee *uint256.Int, chainID *big.Int, blockchain *Blockchain, blockGasLimit uint64, shares ReservedGasShares) mempool.Iterator {
 	// Check if we have any transactions at all
 	hasEVM := evmIterator != nil && !evmIterator.Empty()
 	hasCosmos := cosmosIterator != nil && cosmosIterator.Tx() != nil
@@ -63,9 +71,12 @@ func NewEVMMempoolIterator(evmIterator *miner.TransactionsByPriceAndNonce, cosmo
 		cosmosIterator: cosmosIterator,
 		logger:         logger,
 		txConfig:       txConfig,
-		bondDenom:      bondDenom,
+		coinInfo:       coinInfo,
+		feeTokenRates:  feeTokenRates,
+		cosmosBaseFee:  cosmosBaseFee,
 		chainID:        chainID,
 		blockchain:     blockchain,
+		reservation:    newGasReservation(blockGasLimit, shares),
 	}
 }
 
@@ -132,6 +143,8 @@ func (i *EVMMempoolIterator) Tx() sdk.Tx {
 // 4. Cosmos transaction fee denomination doesn't match bond denom
 // 5. Cosmos transaction fee is lower than the EVM transaction fee
 // 6. Cosmos transaction fee overflows when converted to uint256
+// The fee comparison is overridden when the preferred transaction would use the block gas
+// reserved to the other pool while the other pool still has transactions.
 func (i *EVMMempoolIterator) shouldUseEVM() bool {
 	// Get next transactions from both iterators
 	nextEVMTx, evmFee := i.getNextEVMTx()
@@ -149,18 +162,35 @@ func (i *EVMMempoolIterator) shouldUseEVM() bool {
 
 	// Both have transactions - compare fees
 	// cosmosFee can never be nil, but can be zero if no valid fee found
+	useEVM := true
 	if cosmosFee.IsZero() {
 		i.logger.Debug("Cosmos transaction has no valid fee, preferring EVM", "evm_fee", evmFee.String())
-		return true // Use EVM if Cosmos transaction has no valid fee
+	} else {
+		// Compare fees - prefer EVM unless Cosmos has higher fee
+		useEVM = !cosmosFee.Gt(evmFee)
+		i.logger.Debug("comparing transaction fees",
+			"evm_fee", evmFee.String(),
+			"cosmos_fee", cosmosFee.String())
 	}
 
-	// Compare fees - prefer EVM unless Cosmos has higher fee
-	cosmosHigher := cosmosFee.Gt(evmFee)
-	i.logger.Debug("comparing transaction fees",
-		"evm_fee", evmFee.String(),
-		"cosmos_fee", cosmosFee.String())
+	// Keep the block gas reserved to the other pool available
+	if useEVM && i.reservation.mustYield(true, nextEVMTx.Gas) {
+		i.logger.Debug("EVM transaction would use the block gas reserved to Cosmos transactions, preferring Cosmos")
+		return false
+	}
+	if !useEVM && i.reservation.mustYield(false, cosmosGas(nextCosmosTx)) {
+		i.logger.Debug("Cosmos transaction would use the block gas reserved to EVM transactions, preferring EVM")
+		return true
+	}
+	return useEVM
+}
 
-	return !cosmosHigher
+// cosmosGas returns the gas limit of a Cosmos transaction, zero if unknown.
+func cosmosGas(tx sdk.Tx) uint64 {
+	if feeTx, ok := tx.(sdk.FeeTx); ok {
+		return feeTx.GetGas()
+	}
+	return 0
 }
 
 // getNextEVMTx retrieves the next EVM transaction and its fee
@@ -231,6 +261,9 @@ func (i *EVMMempoolIterator) advanceCurrentIterator() {
 		// NOTE: EVM transactions are automatically removed by the maintenance loop in the txpool
 		// so we shift instead of popping
 		if i.evmIterator != nil {
+			if nextEVMTx, _ := i.getNextEVMTx(); nextEVMTx != nil {
+				i.reservation.consume(true, nextEVMTx.Gas)
+			}
 			i.evmIterator.Shift()
 		} else {
 			i.logger.Error("EVM iterator is nil but shouldUseEVM returned true")
@@ -239,6 +272,9 @@ func (i *EVMMempoolIterator) advanceCurrentIterator() {
 		i.logger.Debug("advancing Cosmos iterator")
 		// We used Cosmos transaction (or EVM failed), advance Cosmos iterator
 		if i.cosmosIterator != nil {
+			if nextCosmosTx, _ := i.getNextCosmosTx(); nextCosmosTx != nil {
+				i.reservation.consume(false, cosmosGas(nextCosmosTx))
+			}
 			i.cosmosIterator = i.cosmosIterator.Next()
 		} else {
 			i.logger.Error("Cosmos iterator is nil but shouldUseEVM returned false")
@@ -247,7 +283,8 @@ func (i *EVMMempoolIterator) advanceCurrentIterator() {
 }
 
 // extractCosmosEffectiveTip extracts the effective gas tip from a Cosmos transaction
-// This aligns with EVM transaction prioritization by calculating: gas_price - base_fee
+// This aligns with EVM transaction prioritization by calculating: gas_price - base_fee,
+// with the gas price normalized to the extended EVM denom
 func (i *EVMMempoolIterator) extractCosmosEffectiveTip(tx sdk.Tx) *uint256.Int {
 	feeTx, ok := tx.(sdk.FeeTx)
 	if !ok {
@@ -255,40 +292,22 @@ func (i *EVMMempoolIterator) extractCosmosEffectiveTip(tx sdk.Tx) *uint256.Int {
 		return nil // Transaction doesn't implement FeeTx interface
 	}
 
-	bondDenomFeeAmount := math.ZeroInt()
-	fees := feeTx.GetFee()
-	for _, coin := range fees {
-		if coin.Denom == i.bondDenom {
-			i.logger.Debug("found fee in bond denomination", "denom", coin.Denom, "amount", coin.Amount.String())
-			bondDenomFeeAmount = coin.Amount
-		}
-	}
-
-	// Calculate gas price: fee_amount / gas_limit
-	gasPrice, overflow := uint256.FromBig(bondDenomFeeAmount.Quo(math.NewIntFromUint64(feeTx.GetGas())).BigInt())
-	if overflow {
-		i.logger.Debug("overflowed on gas price calculation")
-		return nil
-	}
+	// Calculate gas price: fee_amount / gas_limit, in the extended EVM denom
+	gasPrice := cosmosGasPrice(feeTx, i.coinInfo, i.feeTokenRates)
 
-	// Get current base fee from blockchain StateDB
-	baseFee := i.getCurrentBaseFee()
+	// the Cosmos transactions pay the base fee of the Cosmos lane, which differs
+	// from the base fee of the block when fee lanes are enabled
+	baseFee := i.cosmosBaseFee
 	if baseFee == nil {
-		// No base fee, return gas price as effective tip
-		i.logger.Debug("no base fee available, using gas price as effective tip", "gas_price", gasPrice.String())
-		return gasPrice
+		baseFee = i.getCurrentBaseFee()
 	}
-
-	// Calculate effective tip: gas_price - base_fee
-	if gasPrice.Cmp(baseFee) < 0 {
-		// Gas price is lower than base fee, return zero effective tip
-		i.logger.Debug("gas price lower than base fee, effective tip is zero", "gas_price", gasPrice.String(), "base_fee", baseFee.String())
-		return uint256.NewInt(0)
+	tip, ok := effectiveTip(gasPrice, baseFee)
+	if !ok {
+		i.logger.Debug("overflowed on gas price calculation")
+		return nil
 	}
-
-	effectiveTip := new(uint256.Int).Sub(gasPrice, baseFee)
-	i.logger.Debug("calculated effective tip", "gas_price", gasPrice.String(), "base_fee", baseFee.String(), "effective_tip", effectiveTip.String())
-	return effectiveTip
+	i.logger.Debug("calculated effective tip", "gas_price", gasPrice.String(), "effective_tip", tip.String())
+	return tip
 }
 
 // getCurrentBaseFee retrieves the current base fee from the blockchain StateDB
@@ -346,7 +365,7 @@ func (i *EVMMempoolIterator) convertEVMToSDKTx(nextEVMTx *txpool.LazyTransaction
 		return nil // Return nil for invalid tx instead of panicking
 	}
 
-	cosmosTx, err := msgEthereumTx.BuildTx(i.txConfig.NewTxBuilder(), i.bondDenom)
+	cosmosTx, err := msgEthereumTx.BuildTx(i.txConfig.NewTxBuilder(), i.coinInfo.Denom)
 	if err != nil {
 		i.logger.Error("failed to build Cosmos transaction from EVM transaction", "error", err, "tx_hash", hash)
 		return nil
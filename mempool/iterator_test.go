@@ -0,0 +1,247 @@
+package mempool
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/holiman/uint256"
+	"github.com/stretchr/testify/require"
+	protov2 "google.golang.org/protobuf/proto"
+
+	"github.com/cosmos/evm/mempool/miner"
+	"github.com/cosmos/evm/mempool/txpool"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	"cosmossdk.io/log"
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkmempool "github.com/cosmos/cosmos-sdk/types/mempool"
+)
+
+var testCoinInfo = evmtypes.EvmCoinInfo{Denom: "aatom", ExtendedDenom: "aatom", Decimals: 18}
+
+type feeTestTx struct {
+	name string
+	gas  uint64
+	fee  sdk.Coins
+}
+
+func (tx feeTestTx) GetMsgs() []sdk.Msg                    { return nil }
+func (tx feeTestTx) GetMsgsV2() ([]protov2.Message, error) { return nil, nil }
+func (tx feeTestTx) GetGas() uint64                        { return tx.gas }
+func (tx feeTestTx) GetFee() sdk.Coins                     { return tx.fee }
+func (tx feeTestTx) FeePayer() []byte                      { return nil }
+func (tx feeTestTx) FeeGranter() []byte                    { return nil }
+
+// newCosmosTestTxs returns Cosmos transactions of the given gas and gas price, in
+// the order of the Cosmos pool.
+func newCosmosTestTxs(n int, gas uint64, gasPrice int64) []sdk.Tx {
+	txs := make([]sdk.Tx, n)
+	for i := range txs {
+		fee := sdk.NewCoins(sdk.NewCoin(testCoinInfo.Denom, sdkmath.NewIntFromUint64(gas).MulRaw(gasPrice)))
+		txs[i] = feeTestTx{name: fmt.Sprintf("cosmos%d", i), gas: gas, fee: fee}
+	}
+	return txs
+}
+
+// newEVMTestTxs returns an iterator over EVM transactions of the given gas and
+// tips, one list of increasing nonces per sender.
+func newEVMTestTxs(gas uint64, tips ...[]uint64) *miner.TransactionsByPriceAndNonce {
+	txs := make(map[common.Address][]*txpool.LazyTransaction)
+	for sender, senderTips := range tips {
+		addr := common.Address{byte(sender + 1)}
+		for nonce, tip := range senderTips {
+			txs[addr] = append(txs[addr], &txpool.LazyTransaction{
+				Hash:      common.Hash{byte(sender + 1), byte(nonce)},
+				GasFeeCap: uint256.NewInt(tip),
+				GasTipCap: uint256.NewInt(tip),
+				Gas:       gas,
+			})
+		}
+	}
+	return miner.NewTransactionsByPriceAndNonce(nil, txs, nil)
+}
+
+// selectionOrder returns the order the iterator selects the transactions in, the
+// EVM transactions are named after their sender and nonce.
+func selectionOrder(t *testing.T, evmTxs *miner.TransactionsByPriceAndNonce, cosmosTxs []sdk.Tx, blockGasLimit uint64, shares ReservedGasShares) []string {
+	t.Helper()
+	var cosmosIterator sdkmempool.Iterator
+	if len(cosmosTxs) > 0 {
+		cosmosIterator = &sliceIterator{txs: cosmosTxs}
+	}
+	it := NewEVMMempoolIterator(evmTxs, cosmosIterator, log.NewNopLogger(), nil, testCoinInfo, nil, nil, nil, nil, blockGasLimit, shares).(*EVMMempoolIterator)
+	return iteratorOrder(it)
+}
+
+// iteratorOrder returns the order an iterator selects the transactions in.
+func iteratorOrder(it *EVMMempoolIterator) []string {
+	var order []string
+	for it.hasMoreTransactions() {
+		if it.shouldUseEVM() {
+			tx, _ := it.getNextEVMTx()
+			order = append(order, fmt.Sprintf("evm%d/%d", tx.Hash[0]-1, tx.Hash[1]))
+		} else {
+			tx, _ := it.getNextCosmosTx()
+			order = append(order, tx.(feeTestTx).name)
+		}
+		it.advanceCurrentIterator()
+	}
+	return order
+}
+
+func TestCosmosGasPrice(t *testing.T) {
+	sixDecimals := evmtypes.EvmCoinInfo{Denom: "uatom", ExtendedDenom: "aatom", Decimals: 6}
+	testCases := []struct {
+		name     string
+		coinInfo evmtypes.EvmCoinInfo
+		tx       feeTestTx
+		expected sdkmath.Int
+	}{
+		{
+			name:     "18 decimals",
+			coinInfo: testCoinInfo,
+			tx:       feeTestTx{gas: 100_000, fee: sdk.NewCoins(sdk.NewInt64Coin("aatom", 300_000))},
+			expected: sdkmath.NewInt(3),
+		},
+		{
+			name:     "base denom scaled to 18 decimals",
+			coinInfo: sixDecimals,
+			tx:       feeTestTx{gas: 100_000, fee: sdk.NewCoins(sdk.NewInt64Coin("uatom", 200_000))},
+			expected: sdkmath.NewInt(2_000_000_000_000),
+		},
+		{
+			name:     "base and extended denoms",
+			coinInfo: sixDecimals,
+			tx:       feeTestTx{gas: 100_000, fee: sdk.NewCoins(sdk.NewInt64Coin("uatom", 100_000), sdk.NewInt64Coin("aatom", 100_000))},
+			expected: sdkmath.NewInt(1_000_000_000_001),
+		},
+		{
+			name:     "other denoms ignored",
+			coinInfo: sixDecimals,
+			tx:       feeTestTx{gas: 100_000, fee: sdk.NewCoins(sdk.NewInt64Coin("stake", 100_000_000))},
+			expected: sdkmath.ZeroInt(),
+		},
+		{
+			name:     "fee token converted",
+			coinInfo: sixDecimals,
+			tx:       feeTestTx{gas: 100_000, fee: sdk.NewCoins(sdk.NewInt64Coin("ibc/usdc", 400_000))},
+			expected: sdkmath.NewInt(2_000_000_000_000),
+		},
+		{
+			name:     "fee token along other denoms ignored",
+			coinInfo: sixDecimals,
+			tx:       feeTestTx{gas: 100_000, fee: sdk.NewCoins(sdk.NewInt64Coin("ibc/usdc", 400_000), sdk.NewInt64Coin("uatom", 100_000))},
+			expected: sdkmath.NewInt(1_000_000_000_000),
+		},
+		{
+			name:     "no gas",
+			coinInfo: testCoinInfo,
+			tx:       feeTestTx{fee: sdk.NewCoins(sdk.NewInt64Coin("aatom", 100))},
+			expected: sdkmath.ZeroInt(),
+		},
+	}
+	// a unit of the fee token is worth half a unit of the EVM denom
+	feeTokenRates := map[string]sdkmath.LegacyDec{"ibc/usdc": sdkmath.LegacyNewDecWithPrec(5, 1)}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected.String(), cosmosGasPrice(tc.tx, tc.coinInfo, feeTokenRates).String())
+		})
+	}
+}
+
+func TestIteratorFeeOrdering(t *testing.T) {
+	// the transactions are selected by effective tip, the EVM transactions of a
+	// sender in nonce order, whatever their tips
+	evmTxs := newEVMTestTxs(21_000, []uint64{10, 50}, []uint64{20})
+	cosmosTxs := []sdk.Tx{newCosmosTestTxs(1, 100_000, 30)[0], feeTestTx{name: "cheap", gas: 100_000, fee: sdk.NewCoins(sdk.NewInt64Coin("aatom", 500_000))}}
+
+	order := selectionOrder(t, evmTxs, cosmosTxs, 30_000_000, ReservedGasShares{})
+	require.Equal(t, []string{"cosmos0", "evm1/0", "evm0/0", "evm0/1", "cheap"}, order)
+}
+
+func TestIteratorCosmosLaneBaseFee(t *testing.T) {
+	newIterator := func(cosmosBaseFee *uint256.Int) *EVMMempoolIterator {
+		evmTxs := newEVMTestTxs(21_000, []uint64{20})
+		cosmosIterator := &sliceIterator{txs: newCosmosTestTxs(1, 100_000, 30)}
+		return NewEVMMempoolIterator(evmTxs, cosmosIterator, log.NewNopLogger(), nil, testCoinInfo, nil, cosmosBaseFee, nil, nil, 30_000_000, ReservedGasShares{}).(*EVMMempoolIterator)
+	}
+
+	// without a base fee the Cosmos transaction tips its whole gas price
+	require.Equal(t, []string{"cosmos0", "evm0/0"}, iteratorOrder(newIterator(nil)))
+
+	// the Cosmos transaction tips its gas price over the base fee of the Cosmos
+	// lane, the EVM one over the base fee of the block
+	require.Equal(t, []string{"evm0/0", "cosmos0"}, iteratorOrder(newIterator(uint256.NewInt(15))))
+}
+
+func TestIteratorReservedGasShares(t *testing.T) {
+	evmBurst := func() *miner.TransactionsByPriceAndNonce {
+		// distinct tips, for a deterministic order of the senders
+		tips := make([][]uint64, 20)
+		for i := range tips {
+			tips[i] = []uint64{uint64(200 - i)}
+		}
+		return newEVMTestTxs(100_000, tips...)
+	}
+	evmNames := func(from, to int) []string {
+		var names []string
+		for i := from; i < to; i++ {
+			names = append(names, fmt.Sprintf("evm%d/0", i))
+		}
+		return names
+	}
+	concat := func(lists ...[]string) []string {
+		var all []string
+		for _, list := range lists {
+			all = append(all, list...)
+		}
+		return all
+	}
+
+	t.Run("without reservation a burst starves the other pool", func(t *testing.T) {
+		order := selectionOrder(t, evmBurst(), newCosmosTestTxs(5, 100_000, 1), 1_000_000, ReservedGasShares{})
+		require.Equal(t, concat(evmNames(0, 20), []string{"cosmos0", "cosmos1", "cosmos2", "cosmos3", "cosmos4"}), order)
+	})
+
+	t.Run("the reserved share of the cosmos pool is used first", func(t *testing.T) {
+		order := selectionOrder(t, evmBurst(), newCosmosTestTxs(5, 100_000, 1), 1_000_000, ReservedGasShares{Cosmos: 30})
+		// the EVM transactions use the 70% not reserved, then the Cosmos ones their
+		// 30%, then the transactions are ordered by fee again
+		require.Equal(t, concat(evmNames(0, 7), []string{"cosmos0", "cosmos1", "cosmos2"}, evmNames(7, 20), []string{"cosmos3", "cosmos4"}), order)
+	})
+
+	t.Run("the reserved share of the EVM pool is used first", func(t *testing.T) {
+		evmTxs := newEVMTestTxs(100_000, []uint64{2, 2}, []uint64{1})
+		order := selectionOrder(t, evmTxs, newCosmosTestTxs(10, 100_000, 100), 1_000_000, ReservedGasShares{EVM: 20})
+		require.Equal(t, []string{
+			"cosmos0", "cosmos1", "cosmos2", "cosmos3", "cosmos4", "cosmos5", "cosmos6", "cosmos7",
+			"evm0/0", "evm0/1", "cosmos8", "cosmos9", "evm1/0",
+		}, order)
+	})
+
+	t.Run("a pool uses the reserved share of an empty pool", func(t *testing.T) {
+		order := selectionOrder(t, evmBurst(), nil, 1_000_000, ReservedGasShares{Cosmos: 50})
+		require.Equal(t, evmNames(0, 20), order)
+	})
+}
+
+func TestReservedGasShares(t *testing.T) {
+	require.NoError(t, ReservedGasShares{}.Validate())
+	require.NoError(t, ReservedGasShares{EVM: 40, Cosmos: 60}.Validate())
+	require.Error(t, ReservedGasShares{EVM: 50, Cosmos: 51}.Validate())
+	require.Error(t, ReservedGasShares{EVM: math.MaxUint64, Cosmos: 2}.Validate())
+
+	require.Nil(t, newGasReservation(1_000_000, ReservedGasShares{}))
+	require.Nil(t, newGasReservation(0, ReservedGasShares{EVM: 10}))
+
+	// an unbounded block gas limit doesn't overflow
+	reservation := newGasReservation(math.MaxUint64, ReservedGasShares{EVM: 50, Cosmos: 50})
+	require.False(t, reservation.mustYield(true, math.MaxUint64/4))
+	reservation.consume(true, math.MaxUint64/2)
+	require.True(t, reservation.mustYield(true, math.MaxUint64/4))
+	require.False(t, reservation.mustYield(false, math.MaxUint64/4))
+}
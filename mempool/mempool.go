@@ -5,6 +5,8 @@ import (
 	"errors"
 	"fmt"
 	"sync"
+	"sync/atomic"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
@@ -15,6 +17,7 @@ import (
 	"github.com/cosmos/evm/mempool/miner"
 	"github.com/cosmos/evm/mempool/txpool"
 	"github.com/cosmos/evm/mempool/txpool/legacypool"
+	"github.com/cosmos/evm/mempool/txpool/locals"
 	"github.com/cosmos/evm/rpc/stream"
 	evmtypes "github.com/cosmos/evm/x/vm/types"
 
@@ -54,6 +57,9 @@ type (
 		txPool       *txpool.TxPool
 		legacyTxPool *legacypool.LegacyPool
 		cosmosPool   sdkmempool.ExtMempool
+		bundles      *bundlePool       // nil if the bundles are disabled
+		localTxs     *locals.TxTracker // nil if the local transactions journal is disabled
+		gossiper     *queuedGossiper   // nil if the gossip of the queued transactions is disabled
 
 		/** Utils **/
 		logger        log.Logger
@@ -62,6 +68,13 @@ type (
 		blockchain    *Blockchain
 		blockGasLimit uint64 // Block gas limit from consensus parameters
 		minTip        *uint256.Int
+		gasShares     ReservedGasShares  // Block gas reserved to the EVM and Cosmos transactions
+		feeTokenRates *feeTokenRateCache // Rates of the fee tokens the Cosmos fees may be paid in
+
+		/** Observability **/
+		dropped           *dropRecorder
+		signerExtractor   sdkmempool.SignerExtractionAdapter
+		cosmosInsertTimes map[string]time.Time // Insertion times of the Cosmos transactions by signer and sequence
 
 		/** Verification **/
 		anteHandler sdk.AnteHandler
@@ -70,7 +83,17 @@ type (
 		mtx sync.Mutex
 
 		eventBus *cmttypes.EventBus
+
+		/** Listeners **/
+		txListeners []TxListener
+
+		// revision is bumped on every change of the mempool or of the latest block
+		revision atomic.Uint64
 	}
+
+	// TxListener is notified of the EVM transactions inserted into the mempool,
+	// queued is true for the nonce-gapped transactions.
+	TxListener func(tx *ethtypes.Transaction, queued bool)
 )
 
 // EVMMempoolConfig contains configuration options for creating an EVMsdkmempool.
@@ -83,6 +106,16 @@ type EVMMempoolConfig struct {
 	BroadCastTxFn    func(txs []*ethtypes.Transaction) error
 	BlockGasLimit    uint64 // Block gas limit from consensus parameters
 	MinTip           *uint256.Int
+	MaxBundles       int // Maximum number of bundles in the bundle pool, zero disables the bundles
+	// QueuedGossipPeers are the JSON-RPC endpoints the queued (nonce-gapped) EVM
+	// transactions are sent to, empty disables the gossip
+	QueuedGossipPeers []string
+	// QueuedGossipMaxPerAccount is the maximum number of queued transactions of an
+	// account gossiped and still held by the pool
+	QueuedGossipMaxPerAccount int
+	// ReservedGasShares are the percentages of the block gas reserved to the EVM and
+	// Cosmos transactions during the selection, none by default
+	ReservedGasShares ReservedGasShares
 }
 
 // NewExperimentalEVMMempool creates a new unified mempool for EVM and Cosmos transactions.
@@ -111,6 +144,9 @@ func NewExperimentalEVMMempool(
 	if config == nil {
 		panic("config must not be nil")
 	}
+	if err := config.ReservedGasShares.Validate(); err != nil {
+		panic(err)
+	}
 
 	if config.BlockGasLimit == 0 {
 		logger.Warn("block gas limit is 0, setting to fallback", "fallback_limit", fallbackBlockGasLimit)
@@ -141,6 +177,7 @@ func NewExperimentalEVMMempool(
 
 	// TODO: move this logic to evmd.createMempoolConfig and set the max tx there
 	// Create Cosmos Mempool from configuration
+	feeTokenRates := newFeeTokenRateCache(vmKeeper)
 	cosmosPoolConfig := config.CosmosPoolConfig
 	if cosmosPoolConfig == nil {
 		// Default configuration
@@ -152,14 +189,8 @@ func NewExperimentalEVMMempool(
 				if !ok {
 					return math.ZeroInt()
 				}
-				found, coin := cosmosTxFee.GetFee().Find(vmKeeper.GetEvmCoinInfo(ctx).Denom)
-				if !found {
-					return math.ZeroInt()
-				}
-
-				gasPrice := coin.Amount.Quo(math.NewIntFromUint64(cosmosTxFee.GetGas()))
-
-				return gasPrice
+				// the gas price in the extended EVM denom, as for the EVM transactions
+				return cosmosGasPrice(cosmosTxFee, vmKeeper.GetEvmCoinInfo(ctx), feeTokenRates.get(ctx))
 			},
 			Compare: func(a, b math.Int) int {
 				return a.BigInt().Cmp(b.BigInt())
@@ -168,6 +199,9 @@ func NewExperimentalEVMMempool(
 		}
 		cosmosPoolConfig = &defaultConfig
 	}
+	if cosmosPoolConfig.SignerExtractor == nil {
+		cosmosPoolConfig.SignerExtractor = sdkmempool.NewDefaultSignerExtractionAdapter()
+	}
 
 	cosmosPoolConfig.MaxTx = cosmosPoolMaxTx
 	cosmosPool = sdkmempool.NewPriorityMempool(*cosmosPoolConfig)
@@ -183,7 +217,16 @@ func NewExperimentalEVMMempool(
 		blockchain:    blockchain,
 		blockGasLimit: config.BlockGasLimit,
 		minTip:        config.MinTip,
+		gasShares:     config.ReservedGasShares,
+		feeTokenRates: feeTokenRates,
 		anteHandler:   config.AnteHandler,
+
+		dropped:           newDropRecorder(),
+		signerExtractor:   cosmosPoolConfig.SignerExtractor,
+		cosmosInsertTimes: make(map[string]time.Time),
+	}
+	if config.MaxBundles > 0 {
+		evmMempool.bundles = newBundlePool(config.MaxBundles)
 	}
 
 	// Set up broadcast function
@@ -196,6 +239,32 @@ func NewExperimentalEVMMempool(
 		legacyPool.BroadcastTxFn = evmMempool.defaultBroadcastTxFn
 	}
 
+	// Record the reasons of the transactions dropped by the EVM pool
+	legacyPool.DropTxFn = func(txs []*ethtypes.Transaction, reason error) {
+		for _, tx := range txs {
+			evmMempool.dropped.record(tx.Hash(), evmPoolName, false, reason)
+		}
+	}
+
+	// Set up the journal of the local transactions, the journaled transactions are
+	// resubmitted through the broadcast function so that they are revalidated
+	if legacyConfig.Journal != "" && !legacyConfig.NoLocals {
+		evmMempool.localTxs = locals.New(legacyConfig.Journal, legacyConfig.Rejournal, blockchain.Config(), txPool, func(tx *ethtypes.Transaction) error {
+			return legacyPool.BroadcastTxFn([]*ethtypes.Transaction{tx})
+		})
+		if err := evmMempool.localTxs.Start(); err != nil {
+			panic(err)
+		}
+	}
+
+	// Set up the gossip of the queued transactions, which are not broadcast
+	// through CometBFT until their nonce gap is filled
+	if len(config.QueuedGossipPeers) > 0 {
+		evmMempool.gossiper = newQueuedGossiper(config.QueuedGossipPeers, config.QueuedGossipMaxPerAccount, ethtypes.LatestSigner(blockchain.Config()), txPool.Has, logger)
+		evmMempool.RegisterTxListener(evmMempool.gossiper.onTx)
+		evmMempool.gossiper.start()
+	}
+
 	vmKeeper.SetEvmMempool(evmMempool)
 
 	return evmMempool
@@ -242,6 +311,10 @@ func (m *ExperimentalEVMMempool) Insert(goCtx context.Context, tx sdk.Tx) error
 			return errs[0]
 		}
 		m.logger.Debug("EVM transaction inserted successfully", "tx_hash", hash)
+		evmInsertMeter.Mark(1)
+		m.dropped.forget(hash)
+		m.revision.Add(1)
+		m.notifyTxListeners(ethTxs, false)
 		return nil
 	}
 
@@ -252,6 +325,12 @@ func (m *ExperimentalEVMMempool) Insert(goCtx context.Context, tx sdk.Tx) error
 		m.logger.Error("failed to insert Cosmos transaction", "error", err)
 	} else {
 		m.logger.Debug("Cosmos transaction inserted successfully")
+		cosmosInsertMeter.Mark(1)
+		if key, ok := m.cosmosTxKey(tx); ok {
+			m.cosmosInsertTimes[key] = time.Now()
+		}
+		cosmosSizeGauge.Update(int64(m.cosmosPool.CountTx()))
+		m.revision.Add(1)
 	}
 	return err
 }
@@ -283,24 +362,49 @@ func (m *ExperimentalEVMMempool) InsertInvalidNonce(txBytes []byte) error {
 		if len(errs) != 1 {
 			return fmt.Errorf("%w, got %d", ErrExpectedOneError, len(errs))
 		}
-		return errs[0]
+		if errs[0] != nil {
+			return errs[0]
+		}
 	}
+	evmQueuedInsertMeter.Mark(int64(len(ethTxs)))
+	for _, tx := range ethTxs {
+		m.dropped.forget(tx.Hash())
+	}
+	m.revision.Add(1)
+	m.notifyTxListeners(ethTxs, true)
 	return nil
 }
 
+// RegisterTxListener registers a listener of the EVM transactions inserted into
+// the mempool. The listeners must be registered before the mempool is used.
+func (m *ExperimentalEVMMempool) RegisterTxListener(listener TxListener) {
+	m.txListeners = append(m.txListeners, listener)
+}
+
+// notifyTxListeners notifies the listeners of the inserted EVM transactions.
+func (m *ExperimentalEVMMempool) notifyTxListeners(txs []*ethtypes.Transaction, queued bool) {
+	for _, listener := range m.txListeners {
+		for _, tx := range txs {
+			listener(tx, queued)
+		}
+	}
+}
+
 // Select returns a unified iterator over both EVM and Cosmos transactions.
 // The iterator prioritizes transactions based on their fees and manages proper
 // sequencing. The i parameter contains transaction hashes to exclude from selection.
+// The transactions of the bundles targeting the block come first, each bundle
+// contiguously and only if all its transactions succeed.
 func (m *ExperimentalEVMMempool) Select(goCtx context.Context, i [][]byte) sdkmempool.Iterator {
-	m.mtx.Lock()
-	defer m.mtx.Unlock()
 	ctx := sdk.UnwrapSDKContext(goCtx)
+	bundleTxs := m.lockAndSelectBundles(ctx)
+	defer m.mtx.Unlock()
 
 	evmIterator, cosmosIterator := m.getIterators(goCtx, i)
 
-	combinedIterator := NewEVMMempoolIterator(evmIterator, cosmosIterator, m.logger, m.txConfig, m.vmKeeper.GetEvmCoinInfo(ctx).Denom, m.blockchain.Config().ChainID, m.blockchain)
+	combinedIterator := NewEVMMempoolIterator(evmIterator, cosmosIterator, m.logger, m.txConfig, m.vmKeeper.GetEvmCoinInfo(ctx), m.feeTokenRates.get(ctx), m.blockchain.CosmosBaseFee(ctx), m.blockchain.Config().ChainID, m.blockchain, m.blockGasLimit, m.gasShares)
 
-	return combinedIterator
+	return m.newBundleIterator(bundleTxs, combinedIterator)
 }
 
 // CountTx returns the total number of transactions in both EVM and Cosmos pools.
@@ -330,9 +434,11 @@ func (m *ExperimentalEVMMempool) Remove(tx sdk.Tx) error {
 		// be dequeued as temporarily invalid, only to be requeued a block later.
 		// The EVM mempool handles removal based on account nonce automatically.
 		hash := msg.Hash()
-		if m.shouldRemoveFromEVMPool(tx) {
+		if remove, anteErr := m.shouldRemoveFromEVMPool(tx); remove {
 			m.logger.Debug("manually removing EVM transaction", "tx_hash", hash)
 			m.legacyTxPool.RemoveTx(hash, false, true)
+			m.dropped.record(hash, evmPoolName, false, anteErr)
+			m.revision.Add(1)
 		} else {
 			m.logger.Debug("skipping manual removal of EVM transaction, leaving to mempool to handle", "tx_hash", hash)
 		}
@@ -349,6 +455,20 @@ func (m *ExperimentalEVMMempool) Remove(tx sdk.Tx) error {
 		m.logger.Error("failed to remove Cosmos transaction", "error", err)
 	} else {
 		m.logger.Debug("Cosmos transaction removed successfully")
+		cosmosRemoveMeter.Mark(1)
+		if key, ok := m.cosmosTxKey(tx); ok {
+			if inserted, ok := m.cosmosInsertTimes[key]; ok {
+				cosmosTimeInPoolHistogram.Update(time.Since(inserted).Milliseconds())
+				delete(m.cosmosInsertTimes, key)
+			}
+		}
+		size := m.cosmosPool.CountTx()
+		if size == 0 {
+			// forget the transactions the pool dropped without their removal
+			clear(m.cosmosInsertTimes)
+		}
+		cosmosSizeGauge.Update(int64(size))
+		m.revision.Add(1)
 	}
 	return err
 }
@@ -356,10 +476,11 @@ func (m *ExperimentalEVMMempool) Remove(tx sdk.Tx) error {
 // shouldRemoveFromEVMPool determines whether an EVM transaction should be manually removed.
 // It uses the AnteHandler to check if the transaction failed for reasons
 // other than nonce gaps or successful execution, in which case manual removal is needed.
-func (m *ExperimentalEVMMempool) shouldRemoveFromEVMPool(tx sdk.Tx) bool {
+// It also returns the error of the AnteHandler, the reason of the removal.
+func (m *ExperimentalEVMMempool) shouldRemoveFromEVMPool(tx sdk.Tx) (bool, error) {
 	if m.anteHandler == nil {
 		m.logger.Debug("no ante handler available, keeping transaction")
-		return false
+		return false, nil
 	}
 
 	// If it was a successful transaction or a sequence error, we let the mempool handle the cleaning.
@@ -367,14 +488,14 @@ func (m *ExperimentalEVMMempool) shouldRemoveFromEVMPool(tx sdk.Tx) bool {
 	ctx, err := m.blockchain.GetLatestContext()
 	if err != nil {
 		m.logger.Debug("cannot get latest context for validation, keeping transaction", "error", err)
-		return false // Cannot validate, keep transaction
+		return false, nil // Cannot validate, keep transaction
 	}
 
 	_, err = m.anteHandler(ctx, tx, true)
 	// Keep nonce gap transactions, remove others that fail validation
 	if errors.Is(err, ErrNonceGap) || errors.Is(err, sdkerrors.ErrInvalidSequence) || errors.Is(err, sdkerrors.ErrOutOfGas) {
 		m.logger.Debug("nonce gap detected, keeping transaction", "error", err)
-		return false
+		return false, nil
 	}
 
 	if err != nil {
@@ -383,20 +504,20 @@ func (m *ExperimentalEVMMempool) shouldRemoveFromEVMPool(tx sdk.Tx) bool {
 		m.logger.Debug("transaction validation succeeded, should be kept")
 	}
 
-	return err != nil
+	return err != nil, err
 }
 
 // SelectBy iterates through transactions until the provided filter function returns false.
 // It uses the same unified iterator as Select but allows early termination based on
 // custom criteria defined by the filter function.
 func (m *ExperimentalEVMMempool) SelectBy(goCtx context.Context, i [][]byte, f func(sdk.Tx) bool) {
-	m.mtx.Lock()
-	defer m.mtx.Unlock()
 	ctx := sdk.UnwrapSDKContext(goCtx)
+	bundleTxs := m.lockAndSelectBundles(ctx)
+	defer m.mtx.Unlock()
 
 	evmIterator, cosmosIterator := m.getIterators(goCtx, i)
 
-	combinedIterator := NewEVMMempoolIterator(evmIterator, cosmosIterator, m.logger, m.txConfig, m.vmKeeper.GetEvmCoinInfo(ctx).Denom, m.blockchain.Config().ChainID, m.blockchain)
+	combinedIterator := m.newBundleIterator(bundleTxs, NewEVMMempoolIterator(evmIterator, cosmosIterator, m.logger, m.txConfig, m.vmKeeper.GetEvmCoinInfo(ctx), m.feeTokenRates.get(ctx), m.blockchain.CosmosBaseFee(ctx), m.blockchain.Config().ChainID, m.blockchain, m.blockGasLimit, m.gasShares))
 
 	for combinedIterator != nil && f(combinedIterator.Tx()) {
 		combinedIterator = combinedIterator.Next()
@@ -416,6 +537,7 @@ func (m *ExperimentalEVMMempool) SetEventBus(eventBus *cmttypes.EventBus) {
 	go func() {
 		for range sub.Out() {
 			m.GetBlockchain().NotifyNewBlock()
+			m.revision.Add(1)
 		}
 	}()
 }
@@ -435,6 +557,70 @@ func (m *ExperimentalEVMMempool) Has(hash common.Hash) bool {
 	return m.txPool.Has(hash)
 }
 
+// DroppedTx returns why the transaction with the given hash, the Ethereum hash of
+// an EVM transaction or the CometBFT hash of a Cosmos transaction, was recently
+// rejected by or removed from the mempool without being included.
+func (m *ExperimentalEVMMempool) DroppedTx(hash common.Hash) (DroppedTx, bool) {
+	return m.dropped.get(hash)
+}
+
+// recordCheckTxFailure records the reason of a transaction failing CheckTx, which
+// is rejected, or removed from the CometBFT mempool on recheck. The transactions
+// already known are still in the pool.
+func (m *ExperimentalEVMMempool) recordCheckTxFailure(txBytes []byte, recheck bool, err error) {
+	if errors.Is(err, txpool.ErrAlreadyKnown) {
+		return
+	}
+	hash, pool := common.BytesToHash(cmttypes.Tx(txBytes).Hash()), cosmosPoolName
+	if tx, decodeErr := m.txConfig.TxDecoder()(txBytes); decodeErr == nil {
+		if ethMsg, msgErr := m.getEVMMessage(tx); msgErr == nil {
+			hash, pool = ethMsg.Hash(), evmPoolName
+		}
+	}
+	m.dropped.record(hash, pool, !recheck, err)
+}
+
+// CosmosContent returns the transactions of the Cosmos pool, grouped by signer and
+// indexed by sequence.
+func (m *ExperimentalEVMMempool) CosmosContent(goCtx context.Context) map[string]map[uint64]sdk.Tx {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	content := make(map[string]map[uint64]sdk.Tx)
+	m.cosmosPool.SelectBy(goCtx, nil, func(tx sdk.Tx) bool {
+		signers, err := m.signerExtractor.GetSigners(tx)
+		if err != nil || len(signers) == 0 {
+			return true
+		}
+		signer := signers[0].Signer.String()
+		if content[signer] == nil {
+			content[signer] = make(map[uint64]sdk.Tx)
+		}
+		content[signer][signers[0].Sequence] = tx
+		return true
+	})
+	return content
+}
+
+// cosmosTxKey identifies a Cosmos transaction as the Cosmos pool does, by its
+// first signer and sequence.
+func (m *ExperimentalEVMMempool) cosmosTxKey(tx sdk.Tx) (string, bool) {
+	signers, err := m.signerExtractor.GetSigners(tx)
+	if err != nil || len(signers) == 0 {
+		return "", false
+	}
+	return fmt.Sprintf("%s/%d", signers[0].Signer, signers[0].Sequence), true
+}
+
+// TrackLocalTx records a transaction submitted through the JSON-RPC of this node
+// in the local transactions journal, so that it survives node restarts and is
+// resubmitted if the pool drops it. It is a no-op if the journal is disabled.
+func (m *ExperimentalEVMMempool) TrackLocalTx(tx *ethtypes.Transaction) {
+	if m.localTxs != nil {
+		m.localTxs.Track(tx)
+	}
+}
+
 // Close unsubscribes from the CometBFT event bus and shuts down the mempool.
 func (m *ExperimentalEVMMempool) Close() error {
 	var errs []error
@@ -444,6 +630,16 @@ func (m *ExperimentalEVMMempool) Close() error {
 		}
 	}
 
+	if m.gossiper != nil {
+		m.gossiper.stop()
+	}
+
+	if m.localTxs != nil {
+		if err := m.localTxs.Stop(); err != nil {
+			errs = append(errs, fmt.Errorf("failed to stop local transactions tracker: %w", err))
+		}
+	}
+
 	if err := m.txPool.Close(); err != nil {
 		errs = append(errs, fmt.Errorf("failed to close txpool: %w", err))
 	}
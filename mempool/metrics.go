@@ -0,0 +1,19 @@
+package mempool
+
+import (
+	"github.com/ethereum/go-ethereum/metrics"
+)
+
+// The metrics of the EVM pool (sizes, replacements, evictions, promotions and
+// time in pool) are reported by the legacy pool under txpool/.
+var (
+	evmInsertMeter       = metrics.NewRegisteredMeter("mempool/evm/inserted", nil)
+	evmQueuedInsertMeter = metrics.NewRegisteredMeter("mempool/evm/queued", nil) // Nonce-gapped transactions
+
+	cosmosInsertMeter = metrics.NewRegisteredMeter("mempool/cosmos/inserted", nil)
+	cosmosRemoveMeter = metrics.NewRegisteredMeter("mempool/cosmos/removed", nil)
+	cosmosSizeGauge   = metrics.NewRegisteredGauge("mempool/cosmos/size", nil)
+	// cosmosTimeInPoolHistogram measures how long (in milliseconds) the Cosmos
+	// transactions stay in the pool, until removed.
+	cosmosTimeInPoolHistogram = metrics.NewRegisteredHistogram("mempool/cosmos/timeinpool", nil, metrics.NewExpDecaySample(1028, 0.015))
+)
@@ -3,8 +3,11 @@
 package mocks
 
 import (
-	types "github.com/cosmos/cosmos-sdk/types"
+	math "cosmossdk.io/math"
+
 	mock "github.com/stretchr/testify/mock"
+
+	types "github.com/cosmos/cosmos-sdk/types"
 )
 
 // FeeMarketKeeper is an autogenerated mock type for the FeeMarketKeeperI type
@@ -30,6 +33,24 @@ func (_m *FeeMarketKeeper) GetBlockGasWanted(ctx types.Context) uint64 {
 	return r0
 }
 
+// GetCosmosBaseFee provides a mock function with given fields: ctx
+func (_m *FeeMarketKeeper) GetCosmosBaseFee(ctx types.Context) math.LegacyDec {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCosmosBaseFee")
+	}
+
+	var r0 math.LegacyDec
+	if rf, ok := ret.Get(0).(func(types.Context) math.LegacyDec); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(math.LegacyDec)
+	}
+
+	return r0
+}
+
 // NewFeeMarketKeeper creates a new instance of FeeMarketKeeper. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewFeeMarketKeeper(t interface {
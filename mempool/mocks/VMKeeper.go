@@ -8,6 +8,8 @@ import (
 	mempool "github.com/cosmos/evm/mempool"
 	common "github.com/ethereum/go-ethereum/common"
 
+	math "cosmossdk.io/math"
+
 	mock "github.com/stretchr/testify/mock"
 
 	statedb "github.com/cosmos/evm/x/vm/statedb"
@@ -155,6 +157,44 @@ func (_m *VMKeeper) GetEvmCoinInfo(ctx types.Context) vmtypes.EvmCoinInfo {
 	return r0
 }
 
+// GetFeeTokenRates provides a mock function with given fields: ctx
+func (_m *VMKeeper) GetFeeTokenRates(ctx types.Context) map[string]math.LegacyDec {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetFeeTokenRates")
+	}
+
+	var r0 map[string]math.LegacyDec
+	if rf, ok := ret.Get(0).(func(types.Context) map[string]math.LegacyDec); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]math.LegacyDec)
+		}
+	}
+
+	return r0
+}
+
+// GetFeeTokenBalance provides a mock function with given fields: ctx, addr
+func (_m *VMKeeper) GetFeeTokenBalance(ctx types.Context, addr common.Address) math.Int {
+	ret := _m.Called(ctx, addr)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetFeeTokenBalance")
+	}
+
+	var r0 math.Int
+	if rf, ok := ret.Get(0).(func(types.Context, common.Address) math.Int); ok {
+		r0 = rf(ctx, addr)
+	} else {
+		r0 = ret.Get(0).(math.Int)
+	}
+
+	return r0
+}
+
 // GetParams provides a mock function with given fields: ctx
 func (_m *VMKeeper) GetParams(ctx types.Context) vmtypes.Params {
 	ret := _m.Called(ctx)
@@ -0,0 +1,168 @@
+package mempool
+
+import (
+	"context"
+
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	vmtypes "github.com/cosmos/evm/x/vm/types"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// PendingKeeperI executes the transactions of the pending state and serves the
+// queries on it.
+type PendingKeeperI interface {
+	EthereumTx(goCtx context.Context, msg *vmtypes.MsgEthereumTx) (*vmtypes.MsgEthereumTxResponse, error)
+	Balance(c context.Context, req *vmtypes.QueryBalanceRequest) (*vmtypes.QueryBalanceResponse, error)
+	Storage(c context.Context, req *vmtypes.QueryStorageRequest) (*vmtypes.QueryStorageResponse, error)
+	Code(c context.Context, req *vmtypes.QueryCodeRequest) (*vmtypes.QueryCodeResponse, error)
+	EthCall(c context.Context, req *vmtypes.EthCallRequest) (*vmtypes.MsgEthereumTxResponse, error)
+}
+
+// PendingState is a view of the latest state with the executable transactions of
+// the mempool applied on top of it, in the Select order.
+type PendingState struct {
+	ctx    sdk.Context
+	keeper PendingKeeperI
+
+	// Height is the height of the latest block the pending state is built on.
+	Height int64
+	// Txs are the applied transactions.
+	Txs []*ethtypes.Transaction
+	// Results are the execution results of the applied transactions.
+	Results []*vmtypes.MsgEthereumTxResponse
+	// GasUsed is the gas used by the applied transactions.
+	GasUsed uint64
+}
+
+// Revision returns a number that changes whenever the content of the mempool or
+// the latest block changes, the pending state can be cached until then.
+func (m *ExperimentalEVMMempool) Revision() uint64 {
+	return m.revision.Load()
+}
+
+// LatestHeight returns the height of the latest block of the mempool, the pending
+// state built on this block is only outdated by the mempool changes.
+func (m *ExperimentalEVMMempool) LatestHeight() int64 {
+	return m.blockchain.LatestHeight()
+}
+
+// PendingState builds the pending state by applying the executable EVM
+// transactions of the mempool on top of the latest state, in a cached context
+// that is never committed. At most maxTxs transactions are applied, and the
+// transactions that don't fit in maxGas are skipped; zero disables the tx limit
+// and means the block gas limit for the gas.
+func (m *ExperimentalEVMMempool) PendingState(maxTxs int, maxGas uint64) (*PendingState, error) {
+	keeper := m.pendingKeeper()
+	if keeper == nil {
+		return nil, ErrPendingStateNotSupported
+	}
+
+	latestCtx, err := m.blockchain.GetLatestContext()
+	if err != nil {
+		return nil, err
+	}
+	ctx, _ := latestCtx.CacheContext()
+	ctx = ctx.WithIsCheckTx(false)
+	if ctx.ConsensusParams().Block == nil {
+		// the query contexts don't hold the consensus params the ante handler
+		// reads the block gas limit from
+		ctx = ctx.WithConsensusParams(cmtproto.ConsensusParams{
+			Block: &cmtproto.BlockParams{MaxGas: int64(m.blockGasLimit)}, //#nosec G115 -- the block gas limit comes from the int64 consensus max gas
+		})
+	}
+
+	if maxGas == 0 {
+		maxGas = m.blockGasLimit
+	}
+
+	state := &PendingState{
+		ctx:    ctx,
+		keeper: keeper,
+		Height: ctx.BlockHeight(),
+	}
+
+	for it := m.Select(ctx, nil); it != nil; it = it.Next() {
+		if maxTxs > 0 && len(state.Txs) >= maxTxs {
+			break
+		}
+
+		tx := it.Tx()
+		msg, err := m.getEVMMessage(tx)
+		if err != nil {
+			// only the EVM transactions are applied
+			continue
+		}
+		ethTx := msg.AsTransaction()
+		if state.GasUsed+ethTx.Gas() > maxGas {
+			continue
+		}
+
+		txCtx, write := ctx.CacheContext()
+		res, err := m.applyTx(txCtx, keeper, tx, msg)
+		if err != nil {
+			m.logger.Debug("skipping pending transaction", "tx_hash", ethTx.Hash(), "error", err)
+			continue
+		}
+		write()
+
+		state.Txs = append(state.Txs, ethTx)
+		state.Results = append(state.Results, res)
+		state.GasUsed += res.GasUsed
+	}
+
+	return state, nil
+}
+
+// pendingKeeper returns the vm keeper if the mempool can execute the
+// transactions, nil otherwise.
+func (m *ExperimentalEVMMempool) pendingKeeper() PendingKeeperI {
+	keeper, ok := m.vmKeeper.(PendingKeeperI)
+	if !ok || m.anteHandler == nil {
+		return nil
+	}
+	return keeper
+}
+
+// applyTx runs the ante handler and executes an EVM transaction on the context,
+// without block gas accounting. The context should be cached, the ante handler
+// changes are not reverted if the execution fails.
+func (m *ExperimentalEVMMempool) applyTx(ctx sdk.Context, keeper PendingKeeperI, tx sdk.Tx, msg *vmtypes.MsgEthereumTx) (*vmtypes.MsgEthereumTxResponse, error) {
+	ctx, err := m.anteHandler(ctx.WithGasMeter(storetypes.NewInfiniteGasMeter()), tx, false)
+	if err != nil {
+		return nil, err
+	}
+	return keeper.EthereumTx(ctx, msg)
+}
+
+// queryCtx returns the context of a query on the pending state, the queries
+// must not write to the shared pending state.
+func (s *PendingState) queryCtx() sdk.Context {
+	ctx, _ := s.ctx.CacheContext()
+	return ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+}
+
+// Balance returns the balance of an account in the pending state.
+func (s *PendingState) Balance(req *vmtypes.QueryBalanceRequest) (*vmtypes.QueryBalanceResponse, error) {
+	return s.keeper.Balance(s.queryCtx(), req)
+}
+
+// Storage returns the storage of an account in the pending state.
+func (s *PendingState) Storage(req *vmtypes.QueryStorageRequest) (*vmtypes.QueryStorageResponse, error) {
+	return s.keeper.Storage(s.queryCtx(), req)
+}
+
+// Code returns the code of an account in the pending state.
+func (s *PendingState) Code(req *vmtypes.QueryCodeRequest) (*vmtypes.QueryCodeResponse, error) {
+	return s.keeper.Code(s.queryCtx(), req)
+}
+
+// EthCall executes a call on the pending state.
+func (s *PendingState) EthCall(ctx context.Context, req *vmtypes.EthCallRequest) (*vmtypes.MsgEthereumTxResponse, error) {
+	return s.keeper.EthCall(s.queryCtx().WithContext(ctx), req)
+}
@@ -0,0 +1,30 @@
+package mempool
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestLatestHeight(t *testing.T) {
+	var retrieved int
+	blockchain := &Blockchain{
+		logger: log.NewNopLogger(),
+		getCtxCallback: func(int64, bool) (sdk.Context, error) {
+			retrieved++
+			return newBundleTestContext(10), nil
+		},
+	}
+	m := &ExperimentalEVMMempool{blockchain: blockchain}
+
+	// the height is unknown until the latest context is updated by a block,
+	// it is never retrieved for the height alone
+	require.Zero(t, m.LatestHeight())
+	blockchain.setLatestContext(newBundleTestContext(10))
+	require.Equal(t, int64(10), m.LatestHeight())
+	require.Zero(t, retrieved)
+}
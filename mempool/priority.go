@@ -0,0 +1,162 @@
+package mempool
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/holiman/uint256"
+
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ReservedGasShares are the percentages of the block gas reserved to the EVM and
+// Cosmos transactions. A pool can only use the reserved share of the other pool
+// once the other pool has no transaction left, so that a burst of transactions of
+// one kind, even with higher fees, can't starve the other kind.
+type ReservedGasShares struct {
+	EVM    uint64
+	Cosmos uint64
+}
+
+// Validate returns an error if the shares exceed the block gas.
+func (s ReservedGasShares) Validate() error {
+	if s.EVM > 100 || s.Cosmos > 100 || s.EVM+s.Cosmos > 100 {
+		return fmt.Errorf("reserved gas shares must sum up to at most 100%%, got %d%% for EVM and %d%% for Cosmos", s.EVM, s.Cosmos)
+	}
+	return nil
+}
+
+// gasReservation tracks the gas used by the transactions of each pool during the
+// selection of the transactions of a block, against the reserved shares.
+type gasReservation struct {
+	limit          uint64 // block gas limit
+	evmReserved    uint64
+	cosmosReserved uint64
+	evmUsed        uint64
+	cosmosUsed     uint64
+}
+
+// newGasReservation returns the reservation of the shares of the given block gas
+// limit, or nil if nothing is reserved.
+func newGasReservation(blockGasLimit uint64, shares ReservedGasShares) *gasReservation {
+	if blockGasLimit == 0 || (shares.EVM == 0 && shares.Cosmos == 0) {
+		return nil
+	}
+	// divide first, as the block gas limit may be unbounded (math.MaxUint64)
+	return &gasReservation{
+		limit:          blockGasLimit,
+		evmReserved:    blockGasLimit / 100 * shares.EVM,
+		cosmosReserved: blockGasLimit / 100 * shares.Cosmos,
+	}
+}
+
+// mustYield returns whether the next transaction of a pool, using the given gas,
+// must give way to the transaction of the other pool, as it would use gas of the
+// share reserved to the other pool, which is not used up yet.
+func (r *gasReservation) mustYield(evm bool, gas uint64) bool {
+	if r == nil {
+		return false
+	}
+	used, otherUsed, otherReserved := r.evmUsed, r.cosmosUsed, r.cosmosReserved
+	if !evm {
+		used, otherUsed, otherReserved = r.cosmosUsed, r.evmUsed, r.evmReserved
+	}
+	if otherUsed >= otherReserved {
+		return false
+	}
+	available := r.limit - otherReserved
+	return used > available || gas > available-used
+}
+
+// consume records the gas of a selected transaction.
+func (r *gasReservation) consume(evm bool, gas uint64) {
+	if r == nil {
+		return
+	}
+	if evm {
+		r.evmUsed = saturatingAdd(r.evmUsed, gas)
+	} else {
+		r.cosmosUsed = saturatingAdd(r.cosmosUsed, gas)
+	}
+}
+
+func saturatingAdd(a, b uint64) uint64 {
+	if sum := a + b; sum >= a {
+		return sum
+	}
+	return ^uint64(0)
+}
+
+// cosmosGasPrice returns the gas price of a Cosmos transaction in the extended
+// EVM denom (18 decimals), the unit of the gas prices and tips of the EVM
+// transactions. The fees in the base EVM denom are scaled to 18 decimals, the
+// fees paid in a whitelisted fee token are converted at the given rates, the
+// fees in other denoms are ignored. It returns zero if the transaction has no
+// fees in the EVM denoms or in a fee token.
+func cosmosGasPrice(tx sdk.FeeTx, coinInfo evmtypes.EvmCoinInfo, feeTokenRates map[string]math.LegacyDec) math.Int {
+	gas := tx.GetGas()
+	if gas == 0 {
+		return math.ZeroInt()
+	}
+
+	fees := tx.GetFee()
+	amount := fees.AmountOf(coinInfo.Denom)
+	if len(fees) == 1 {
+		if rate, ok := feeTokenRates[fees[0].Denom]; ok {
+			amount = evmtypes.ConvertFeeTokenToEVMDenom(fees[0].Amount, rate)
+		}
+	}
+	if coinInfo.ExtendedDenom != "" && coinInfo.ExtendedDenom != coinInfo.Denom {
+		factor := evmtypes.Decimals(coinInfo.Decimals).ConversionFactor()
+		amount = amount.Mul(factor).Add(fees.AmountOf(coinInfo.ExtendedDenom))
+	}
+	return amount.Quo(math.NewIntFromUint64(gas))
+}
+
+// effectiveTip returns the effective tip per gas of a gas price, given the base
+// fee, with the same semantics as the EVM transactions.
+func effectiveTip(gasPrice math.Int, baseFee *uint256.Int) (*uint256.Int, bool) {
+	price, overflow := uint256.FromBig(gasPrice.BigInt())
+	if overflow {
+		return nil, false
+	}
+	if baseFee == nil {
+		return price, true
+	}
+	if price.Cmp(baseFee) < 0 {
+		return uint256.NewInt(0), true
+	}
+	return price.Sub(price, baseFee), true
+}
+
+// feeTokenRateCache caches the rates of the whitelisted fee tokens for a block
+// height, so that the TWAP oracles are consulted once per block rather than for
+// every Cosmos transaction prioritized.
+type feeTokenRateCache struct {
+	mtx      sync.Mutex
+	vmKeeper VMKeeperI
+	height   int64
+	cached   bool
+	rates    map[string]math.LegacyDec
+}
+
+func newFeeTokenRateCache(vmKeeper VMKeeperI) *feeTokenRateCache {
+	return &feeTokenRateCache{vmKeeper: vmKeeper}
+}
+
+// get returns the rates of the fee tokens at the height of the given context.
+func (c *feeTokenRateCache) get(ctx sdk.Context) map[string]math.LegacyDec {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	if !c.cached || c.height != ctx.BlockHeight() {
+		c.rates = c.vmKeeper.GetFeeTokenRates(ctx)
+		c.height = ctx.BlockHeight()
+		c.cached = true
+	}
+	return c.rates
+}
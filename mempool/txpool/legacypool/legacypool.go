@@ -75,6 +75,14 @@ var (
 	// ErrFutureReplacePending is returned if a future transaction replaces a pending
 	// one. Future transactions should only be able to replace other future transactions.
 	ErrFutureReplacePending = errors.New("future transaction tries to replace pending")
+
+	// ErrReplaced is the reason of the removal of a transaction replaced by another
+	// one of the same sender and nonce paying a higher price.
+	ErrReplaced = errors.New("replaced by a higher priced transaction")
+
+	// ErrEvicted is the reason of the removal of the queued transactions of an
+	// account inactive for longer than the lifetime.
+	ErrEvicted = errors.New("evicted after the queue lifetime")
 )
 
 var (
@@ -117,6 +125,12 @@ var (
 	slotsGauge   = metrics.NewRegisteredGauge("txpool/slots", nil)
 
 	reheapTimer = metrics.NewRegisteredTimer("txpool/reheap", nil)
+
+	// promotedMeter counts the transactions moved from the queue to the pending pool.
+	promotedMeter = metrics.NewRegisteredMeter("txpool/queued/promote", nil)
+	// timeInPoolHistogram measures how long (in milliseconds) the transactions stay
+	// in the pool, until included or dropped.
+	timeInPoolHistogram = metrics.NewRegisteredHistogram("txpool/timeinpool", nil, metrics.NewExpDecaySample(1028, 0.015))
 )
 
 // BlockChain defines the minimal set of methods needed to back a tx pool with
@@ -155,7 +169,7 @@ type Config struct {
 
 // DefaultConfig contains the default configurations for the transaction pool.
 var DefaultConfig = Config{
-	Journal:   "transactions.rlp",
+	Journal:   "", // the journal is disabled unless configured by the node
 	Rejournal: time.Hour,
 
 	PriceLimit: 1,
@@ -256,6 +270,11 @@ type LegacyPool struct {
 	changesSinceReorg int // A counter for how many drops we've performed in-between reorg.
 
 	BroadcastTxFn func(txs []*types.Transaction) error
+
+	// DropTxFn is called with the transactions removed from the pool without being
+	// included in a block, and the reason of the removal. It is called with the
+	// pool lock held and must not call back into the pool.
+	DropTxFn func(txs []*types.Transaction, reason error)
 }
 
 type txpoolResetRequest struct {
@@ -380,6 +399,7 @@ func (pool *LegacyPool) loop() {
 						pool.removeTx(tx.Hash(), true, true)
 					}
 					queuedEvictionMeter.Mark(int64(len(list)))
+					pool.dropped(ErrEvicted, list...)
 				}
 			}
 			pool.mu.Unlock()
@@ -433,6 +453,7 @@ func (pool *LegacyPool) SetGasTip(tip *big.Int) {
 			pool.removeTx(tx.Hash(), false, true)
 		}
 		pool.priced.Removed(len(drop))
+		pool.dropped(txpool.ErrTxGasPriceTooLow, drop...)
 	}
 	log.Info("Legacy pool tip threshold updated", "tip", newTip)
 }
@@ -601,6 +622,12 @@ func (pool *LegacyPool) validateTx(tx *types.Transaction) error {
 			}
 			return nil
 		},
+		ExistingTxs: func(addr common.Address) types.Transactions {
+			if list := pool.pending[addr]; list != nil {
+				return list.Flatten()
+			}
+			return nil
+		},
 	}
 	if err := txpool.ValidateTransactionWithState(tx, pool.signer, opts); err != nil {
 		return err
@@ -608,6 +635,17 @@ func (pool *LegacyPool) validateTx(tx *types.Transaction) error {
 	return pool.validateAuth(tx)
 }
 
+// filterUnpayable removes the transactions of the list the account can't pay or
+// above the gas limit, the fees of which the account may pay in a fee token if
+// the state supports it.
+func (pool *LegacyPool) filterUnpayable(list *list, addr common.Address, gasLimit uint64) (types.Transactions, types.Transactions) {
+	balance := pool.currentState.GetBalance(addr)
+	if state, ok := pool.currentState.(txpool.FeeTokenState); ok {
+		return list.FilterFeeToken(balance, state.GetFeeTokenBalance(addr), gasLimit)
+	}
+	return list.Filter(balance, gasLimit)
+}
+
 // checkDelegationLimit determines if the tx sender is delegated or has a
 // pending delegation, and if so, ensures they have at most one in-flight
 // **executable** transaction, e.g. disallow stacked and gapped transactions
@@ -771,6 +809,7 @@ func (pool *LegacyPool) add(tx *types.Transaction) (replaced bool, err error) {
 
 			sender, _ := types.Sender(pool.signer, tx)
 			dropped := pool.removeTx(tx.Hash(), false, sender != from) // Don't unreserve the sender of the tx being added if last from the acc
+			pool.dropped(txpool.ErrUnderpriced, tx)
 
 			pool.changesSinceReorg += dropped
 		}
@@ -789,6 +828,7 @@ func (pool *LegacyPool) add(tx *types.Transaction) (replaced bool, err error) {
 			pool.all.Remove(old.Hash())
 			pool.priced.Removed(1)
 			pendingReplaceMeter.Mark(1)
+			pool.dropped(ErrReplaced, old)
 		}
 		pool.all.Add(tx)
 		pool.priced.Put(tx)
@@ -853,6 +893,7 @@ func (pool *LegacyPool) enqueueTx(hash common.Hash, tx *types.Transaction, addAl
 		pool.all.Remove(old.Hash())
 		pool.priced.Removed(1)
 		queuedReplaceMeter.Mark(1)
+		pool.dropped(ErrReplaced, old)
 	} else {
 		// Nothing was replaced, bump the queued counter
 		queuedGauge.Inc(1)
@@ -890,6 +931,7 @@ func (pool *LegacyPool) promoteTx(addr common.Address, hash common.Hash, tx *typ
 		pool.all.Remove(hash)
 		pool.priced.Removed(1)
 		pendingDiscardMeter.Mark(1)
+		pool.dropped(txpool.ErrReplaceUnderpriced, tx)
 		return false
 	}
 	// Otherwise discard any previous transaction and mark this
@@ -897,6 +939,7 @@ func (pool *LegacyPool) promoteTx(addr common.Address, hash common.Hash, tx *typ
 		pool.all.Remove(old.Hash())
 		pool.priced.Removed(1)
 		pendingReplaceMeter.Mark(1)
+		pool.dropped(ErrReplaced, old)
 	} else {
 		// Nothing was replaced, bump the pending counter
 		pendingGauge.Inc(1)
@@ -1401,13 +1444,15 @@ func (pool *LegacyPool) promoteExecutables(accounts []common.Address) []*types.T
 			pool.all.Remove(tx.Hash())
 		}
 		log.Trace("Removed old queued transactions", "count", len(forwards))
+		pool.dropped(core.ErrNonceTooLow, forwards...)
 		// Drop all transactions that are too costly (low balance or out of gas)
-		drops, _ := list.Filter(pool.currentState.GetBalance(addr), gasLimit)
+		drops, _ := pool.filterUnpayable(list, addr, gasLimit)
 		for _, tx := range drops {
 			pool.all.Remove(tx.Hash())
 		}
 		log.Trace("Removed unpayable queued transactions", "count", len(drops))
 		queuedNofundsMeter.Mark(int64(len(drops)))
+		pool.dropped(core.ErrInsufficientFunds, drops...)
 
 		// Gather all executable transactions and promote them
 		readies := list.Ready(pool.pendingNonces.get(addr))
@@ -1428,6 +1473,7 @@ func (pool *LegacyPool) promoteExecutables(accounts []common.Address) []*types.T
 			log.Trace("Removed cap-exceeding queued transaction", "hash", hash)
 		}
 		queuedRateLimitMeter.Mark(int64(len(caps)))
+		pool.dropped(txpool.ErrAccountLimitExceeded, caps...)
 		// Mark all the items dropped as removed
 		pool.priced.Removed(len(forwards) + len(drops) + len(caps))
 		queuedGauge.Dec(int64(len(forwards) + len(drops) + len(caps)))
@@ -1441,9 +1487,19 @@ func (pool *LegacyPool) promoteExecutables(accounts []common.Address) []*types.T
 			}
 		}
 	}
+	promotedMeter.Mark(int64(len(promoted)))
 	return promoted
 }
 
+// dropped notifies the transactions removed from the pool without being included
+// in a block. The pending transactions removed as their nonce was executed are
+// not notified, as they are most likely included.
+func (pool *LegacyPool) dropped(reason error, txs ...*types.Transaction) {
+	if pool.DropTxFn != nil && len(txs) > 0 {
+		pool.DropTxFn(txs, reason)
+	}
+}
+
 // truncatePending removes transactions from the pending queue if the pool is above the
 // pending limit. The algorithm tries to reduce transaction counts by an approximately
 // equal number for all for accounts with many pending transactions.
@@ -1492,6 +1548,7 @@ func (pool *LegacyPool) truncatePending() {
 						pool.pendingNonces.setIfLower(offenders[i], tx.Nonce())
 						log.Trace("Removed fairness-exceeding pending transaction", "hash", hash)
 					}
+					pool.dropped(ErrTxPoolOverflow, caps...)
 					pool.priced.Removed(len(caps))
 					pendingGauge.Dec(int64(len(caps)))
 
@@ -1517,6 +1574,7 @@ func (pool *LegacyPool) truncatePending() {
 					pool.pendingNonces.setIfLower(addr, tx.Nonce())
 					log.Trace("Removed fairness-exceeding pending transaction", "hash", hash)
 				}
+				pool.dropped(ErrTxPoolOverflow, caps...)
 				pool.priced.Removed(len(caps))
 				pendingGauge.Dec(int64(len(caps)))
 				pending--
@@ -1552,9 +1610,11 @@ func (pool *LegacyPool) truncateQueue() {
 
 		// Drop all transactions if they are less than the overflow
 		if size := uint64(list.Len()); size <= drop {
-			for _, tx := range list.Flatten() {
+			txs := list.Flatten()
+			for _, tx := range txs {
 				pool.removeTx(tx.Hash(), true, true)
 			}
+			pool.dropped(ErrTxPoolOverflow, txs...)
 			drop -= size
 			queuedRateLimitMeter.Mark(int64(size))
 			continue
@@ -1563,6 +1623,7 @@ func (pool *LegacyPool) truncateQueue() {
 		txs := list.Flatten()
 		for i := len(txs) - 1; i >= 0 && drop > 0; i-- {
 			pool.removeTx(txs[i].Hash(), true, true)
+			pool.dropped(ErrTxPoolOverflow, txs[i])
 			drop--
 			queuedRateLimitMeter.Mark(1)
 		}
@@ -1590,13 +1651,14 @@ func (pool *LegacyPool) demoteUnexecutables() {
 			log.Trace("Removed old pending transaction", "hash", hash)
 		}
 		// Drop all transactions that are too costly (low balance or out of gas), and queue any invalids back for later
-		drops, invalids := list.Filter(pool.currentState.GetBalance(addr), gasLimit)
+		drops, invalids := pool.filterUnpayable(list, addr, gasLimit)
 		for _, tx := range drops {
 			hash := tx.Hash()
 			pool.all.Remove(hash)
 			log.Trace("Removed unpayable pending transaction", "hash", hash)
 		}
 		pendingNofundsMeter.Mark(int64(len(drops)))
+		pool.dropped(core.ErrInsufficientFunds, drops...)
 
 		for _, tx := range invalids {
 			hash := tx.Hash()
@@ -1778,6 +1840,7 @@ func (t *lookup) Remove(hash common.Hash) {
 	t.removeAuthorities(tx)
 	t.slots -= numSlots(tx)
 	slotsGauge.Update(int64(t.slots))
+	timeInPoolHistogram.Update(time.Since(tx.Time()).Milliseconds())
 
 	delete(t.txs, hash)
 }
@@ -2765,3 +2765,130 @@ func BenchmarkMultiAccountBatchInsert(b *testing.B) {
 		pool.addRemotesSync([]*types.Transaction{tx})
 	}
 }
+
+// Tests that the transactions dropped without being included are notified with
+// the reason of the removal.
+func TestDropTxFn(t *testing.T) {
+	t.Parallel()
+
+	pool, key := setupPool()
+	defer pool.Close()
+
+	var (
+		mu      sync.Mutex
+		dropped = make(map[common.Hash]error)
+	)
+	pool.DropTxFn = func(txs []*types.Transaction, reason error) {
+		mu.Lock()
+		defer mu.Unlock()
+		for _, tx := range txs {
+			dropped[tx.Hash()] = reason
+		}
+	}
+	from := crypto.PubkeyToAddress(key.PublicKey)
+	testAddBalance(pool, from, big.NewInt(1000000000))
+
+	// A replaced pending transaction is dropped as replaced
+	original := pricedTransaction(0, 100000, big.NewInt(1), key)
+	if err := pool.addRemoteSync(original); err != nil {
+		t.Fatalf("failed to add pending transaction: %v", err)
+	}
+	if err := pool.addRemoteSync(pricedTransaction(0, 100000, big.NewInt(2), key)); err != nil {
+		t.Fatalf("failed to replace pending transaction: %v", err)
+	}
+
+	// A queued transaction below the account nonce is dropped as nonce too low
+	queued := pricedTransaction(3, 100000, big.NewInt(1), key)
+	if err := pool.addRemoteSync(queued); err != nil {
+		t.Fatalf("failed to add queued transaction: %v", err)
+	}
+	testSetNonce(pool, from, 4)
+	<-pool.requestPromoteExecutables(newAccountSet(pool.signer, from))
+
+	mu.Lock()
+	defer mu.Unlock()
+	if err := dropped[original.Hash()]; !errors.Is(err, ErrReplaced) {
+		t.Errorf("replaced transaction drop reason mismatch: have %v, want %v", err, ErrReplaced)
+	}
+	if err := dropped[queued.Hash()]; !errors.Is(err, core.ErrNonceTooLow) {
+		t.Errorf("stale queued transaction drop reason mismatch: have %v, want %v", err, core.ErrNonceTooLow)
+	}
+	// The pending transaction executed with the nonce is most likely included
+	if len(dropped) != 2 {
+		t.Errorf("dropped transactions mismatch: have %d, want %d", len(dropped), 2)
+	}
+}
+
+// feeTokenTestState is a pool state whose accounts can pay the fees in a fee token.
+type feeTokenTestState struct {
+	*state.StateDB
+
+	mu       sync.Mutex
+	balances map[common.Address]*uint256.Int
+}
+
+func (s *feeTokenTestState) GetFeeTokenBalance(addr common.Address) *uint256.Int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if balance, ok := s.balances[addr]; ok {
+		return balance.Clone()
+	}
+	return new(uint256.Int)
+}
+
+func (s *feeTokenTestState) setFeeTokenBalance(addr common.Address, balance uint64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.balances[addr] = uint256.NewInt(balance)
+}
+
+// Tests that the fees of the transactions are paid either from the balance or in
+// a fee token, never from both, and that the value is always paid from the balance.
+func TestFeeTokenFunds(t *testing.T) {
+	t.Parallel()
+
+	statedb, _ := state.New(types.EmptyRootHash, state.NewDatabaseForTesting())
+	feeTokenState := &feeTokenTestState{StateDB: statedb, balances: make(map[common.Address]*uint256.Int)}
+	blockchain := newTestBlockChain(params.TestChainConfig, 10000000, feeTokenState, new(event.Feed))
+	pool := New(testTxPoolConfig, blockchain)
+	if err := pool.Init(testTxPoolConfig.PriceLimit, blockchain.CurrentBlock(), newReserver()); err != nil {
+		t.Fatal(err)
+	}
+	<-pool.initDoneCh
+	defer pool.Close()
+
+	// every transaction transfers 100 and pays 100000 of fees
+	key, _ := crypto.GenerateKey()
+	from := crypto.PubkeyToAddress(key.PublicKey)
+	testAddBalance(pool, from, big.NewInt(200))
+	feeTokenState.setFeeTokenBalance(from, 250000)
+
+	// the balance covers the value of two transactions, the fee token their fees
+	for nonce := uint64(0); nonce < 2; nonce++ {
+		if err := pool.addRemoteSync(transaction(nonce, 100000, key)); err != nil {
+			t.Fatalf("failed to add transaction %d: %v", nonce, err)
+		}
+	}
+	if err, want := pool.addRemoteSync(transaction(2, 100000, key)), core.ErrInsufficientFunds; !errors.Is(err, want) {
+		t.Errorf("value above the balance: want %v have %v", want, err)
+	}
+
+	// the fees are not split between the balance and the fee token
+	splitKey, _ := crypto.GenerateKey()
+	split := crypto.PubkeyToAddress(splitKey.PublicKey)
+	testAddBalance(pool, split, big.NewInt(50100))
+	feeTokenState.setFeeTokenBalance(split, 60000)
+	if err, want := pool.addRemoteSync(transaction(0, 100000, splitKey)), core.ErrInsufficientFunds; !errors.Is(err, want) {
+		t.Errorf("split fees: want %v have %v", want, err)
+	}
+
+	// the transactions the fee token can't pay anymore are removed from the pending ones
+	feeTokenState.setFeeTokenBalance(from, 99999)
+	<-pool.requestReset(nil, nil)
+	if pending, _ := pool.Stats(); pending != 0 {
+		t.Errorf("pending transactions mismatch: have %d, want %d", pending, 0)
+	}
+	if err := validatePoolInternals(pool); err != nil {
+		t.Fatalf("pool internal state corrupted: %v", err)
+	}
+}
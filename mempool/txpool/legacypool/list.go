@@ -29,6 +29,8 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/holiman/uint256"
+
+	"github.com/cosmos/evm/mempool/txpool"
 )
 
 // nonceHeap is a heap.Interface implementation over 64bit unsigned integers for
@@ -371,9 +373,42 @@ func (l *list) Filter(costLimit *uint256.Int, gasLimit uint64) (types.Transactio
 	l.gascap = gasLimit
 
 	// Filter out all the transactions above the account's funds
-	removed := l.txs.Filter(func(tx *types.Transaction) bool {
+	return l.filter(func(tx *types.Transaction) bool {
 		return tx.Gas() > gasLimit || tx.Cost().Cmp(costLimit.ToBig()) > 0
 	})
+}
+
+// FilterFeeToken is Filter for the accounts which can pay the fees in a fee
+// token: a transaction is kept if the balance covers its cost, or its value with
+// the fee token balance covering its fees.
+func (l *list) FilterFeeToken(balance, feeTokenBalance *uint256.Int, gasLimit uint64) (types.Transactions, types.Transactions) {
+	if feeTokenBalance.IsZero() {
+		return l.Filter(balance, gasLimit)
+	}
+	// If all transactions are below the threshold, short circuit
+	if l.costcap.Cmp(balance) <= 0 && l.gascap <= gasLimit {
+		return nil, nil
+	}
+	l.gascap = gasLimit
+
+	removed, invalids := l.filter(func(tx *types.Transaction) bool {
+		return tx.Gas() > gasLimit || !txpool.CanPayCost(tx, balance.ToBig(), feeTokenBalance.ToBig())
+	})
+	// The transactions paying their fees in a fee token cost more than the
+	// balance, the cost cap is the highest cost left
+	l.costcap = new(uint256.Int)
+	for _, tx := range l.txs.Flatten() {
+		if cost := uint256.MustFromBig(tx.Cost()); l.costcap.Cmp(cost) < 0 {
+			l.costcap = cost
+		}
+	}
+	return removed, invalids
+}
+
+// filter removes the transactions matching the filter, and if the list is strict
+// the ones above the lowest removed nonce, which are returned as invalids.
+func (l *list) filter(filter func(tx *types.Transaction) bool) (types.Transactions, types.Transactions) {
+	removed := l.txs.Filter(filter)
 
 	if len(removed) == 0 {
 		return nil, nil
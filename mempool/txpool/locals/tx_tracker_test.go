@@ -0,0 +1,93 @@
+package locals
+
+import (
+	"math/big"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	key, _  = crypto.HexToECDSA("b71c71a67e1177ad4e901695e1b4b9ee17ae16c6668d313eac2f96dbcda3f291")
+	address = crypto.PubkeyToAddress(key.PublicKey)
+	signer  = types.LatestSigner(params.TestChainConfig)
+)
+
+type testPool struct {
+	nonce uint64
+	txs   map[common.Hash]bool
+}
+
+func (p *testPool) Nonce(common.Address) uint64 { return p.nonce }
+func (p *testPool) Has(hash common.Hash) bool   { return p.txs[hash] }
+
+func newTx(t *testing.T, nonce uint64) *types.Transaction {
+	t.Helper()
+	tx, err := types.SignTx(types.NewTransaction(nonce, common.Address{}, big.NewInt(1), params.TxGas, big.NewInt(params.InitialBaseFee), nil), signer, key)
+	require.NoError(t, err)
+	return tx
+}
+
+func TestTrackerRecheck(t *testing.T) {
+	pool := &testPool{txs: make(map[common.Hash]bool)}
+	tracker := New("", time.Hour, params.TestChainConfig, pool, nil)
+
+	txs := []*types.Transaction{newTx(t, 0), newTx(t, 1), newTx(t, 2)}
+	tracker.TrackAll(txs)
+	tracker.Track(txs[0])
+	require.Len(t, tracker.all, 3)
+
+	// the transactions missing from the pool are resubmitted
+	pool.txs[txs[1].Hash()] = true
+	resubmits, _ := tracker.recheck(false)
+	require.ElementsMatch(t, []*types.Transaction{txs[0], txs[2]}, resubmits)
+
+	// the transactions below the state nonce are stale
+	pool.nonce = 2
+	resubmits, rejournal := tracker.recheck(true)
+	require.Equal(t, []*types.Transaction{txs[2]}, resubmits)
+	require.Equal(t, map[common.Address]types.Transactions{address: {txs[2]}}, rejournal)
+
+	pool.nonce = 3
+	resubmits, _ = tracker.recheck(false)
+	require.Empty(t, resubmits)
+	require.Empty(t, tracker.all)
+	require.Empty(t, tracker.byAddr)
+}
+
+func TestTrackerJournal(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "transactions.rlp")
+	pool := &testPool{txs: make(map[common.Hash]bool)}
+	txs := []*types.Transaction{newTx(t, 0), newTx(t, 1), newTx(t, 2)}
+
+	var submitted []common.Hash
+	submit := func(tx *types.Transaction) error {
+		submitted = append(submitted, tx.Hash())
+		return nil
+	}
+	tracker := New(path, time.Hour, params.TestChainConfig, pool, submit)
+	require.NoError(t, tracker.Start())
+	tracker.TrackAll(txs)
+	require.NoError(t, tracker.Stop())
+
+	// the journaled transactions are loaded on restart, the stale ones are dropped
+	// and the others resubmitted in nonce order
+	pool.nonce = 1
+	tracker = New(path, time.Hour, params.TestChainConfig, pool, submit)
+	require.NoError(t, tracker.journal.load(func(txs []*types.Transaction) []error {
+		tracker.TrackAll(txs)
+		return nil
+	}))
+	require.Len(t, tracker.all, 3)
+
+	resubmits, rejournal := tracker.recheck(true)
+	tracker.resubmit(resubmits)
+	require.Equal(t, []common.Hash{txs[1].Hash(), txs[2].Hash()}, submitted)
+	require.Len(t, rejournal[address], 2)
+}
@@ -17,9 +17,11 @@
 package txpool
 
 import (
+	"cmp"
 	"errors"
 	"fmt"
 	"math/big"
+	"slices"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/consensus/misc/eip4844"
@@ -29,6 +31,7 @@ import (
 	"github.com/ethereum/go-ethereum/crypto/kzg4844"
 	"github.com/ethereum/go-ethereum/log"
 	"github.com/ethereum/go-ethereum/params"
+	"github.com/holiman/uint256"
 )
 
 // blobTxMinBlobGasPrice is the big.Int version of the configured protocol
@@ -206,6 +209,61 @@ type ValidationOptionsWithState struct {
 	// ExistingCost is a mandatory callback to retrieve an already pooled
 	// transaction's cost with the given nonce to check for overdrafts.
 	ExistingCost func(addr common.Address, nonce uint64) *big.Int
+
+	// ExistingTxs is an optional callback to retrieve the already pooled
+	// transactions of an account in nonce order, to check for overdrafts when
+	// the state supports the fees paid in a fee token.
+	ExistingTxs func(addr common.Address) types.Transactions
+}
+
+// FeeTokenState is implemented by the states whose accounts can pay the fees of
+// their transactions in a fee token rather than in the EVM denom.
+type FeeTokenState interface {
+	// GetFeeTokenBalance returns the value, in the EVM denom, of the largest fee
+	// token balance of the account, i.e. the most fees it can pay in a fee token.
+	GetFeeTokenBalance(addr common.Address) *uint256.Int
+}
+
+// CanPayCost returns true if the balance covers the cost of the transaction, or
+// its value with its fees paid in a fee token. The fees are paid from a single
+// source, never split between the balance and the fee token.
+func CanPayCost(tx *types.Transaction, balance, feeTokenBalance *big.Int) bool {
+	cost := tx.Cost()
+	if balance.Cmp(cost) >= 0 {
+		return true
+	}
+	fees := new(big.Int).Sub(cost, tx.Value())
+	return balance.Cmp(tx.Value()) >= 0 && feeTokenBalance.Cmp(fees) >= 0
+}
+
+// validateFeeTokenFunds checks that an account can pay the costs of its pooled
+// transactions, the new one replacing the pooled one of the same nonce. They are
+// charged in nonce order as the ante handler charges them: a transaction whose
+// cost is covered by the balance is paid from it, the others pay their value from
+// the balance and their fees in the fee token.
+func validateFeeTokenFunds(tx *types.Transaction, balance, feeTokenBalance *big.Int, pooled types.Transactions) error {
+	txs := make(types.Transactions, 0, len(pooled)+1)
+	for _, ptx := range pooled {
+		if ptx.Nonce() != tx.Nonce() {
+			txs = append(txs, ptx)
+		}
+	}
+	txs = append(txs, tx)
+	slices.SortFunc(txs, func(a, b *types.Transaction) int { return cmp.Compare(a.Nonce(), b.Nonce()) })
+
+	remaining, feeTokenRemaining := new(big.Int).Set(balance), new(big.Int).Set(feeTokenBalance)
+	for _, ptx := range txs {
+		if !CanPayCost(ptx, remaining, feeTokenRemaining) {
+			return fmt.Errorf("%w: balance %v, fee token balance %v, tx nonce %v cost %v", core.ErrInsufficientFunds, balance, feeTokenBalance, ptx.Nonce(), ptx.Cost())
+		}
+		if cost := ptx.Cost(); remaining.Cmp(cost) >= 0 {
+			remaining.Sub(remaining, cost)
+			continue
+		}
+		remaining.Sub(remaining, ptx.Value())
+		feeTokenRemaining.Sub(feeTokenRemaining, new(big.Int).Sub(ptx.Cost(), ptx.Value()))
+	}
+	return nil
 }
 
 // ValidateTransactionWithState is a helper method to check whether a transaction
@@ -236,6 +294,21 @@ func ValidateTransactionWithState(tx *types.Transaction, signer types.Signer, op
 		balance = opts.State.GetBalance(from).ToBig()
 		cost    = tx.Cost()
 	)
+	// The accounts holding a fee token may pay the fees with it, their pooled
+	// transactions are checked one by one as the ante handler charges them
+	if state, ok := opts.State.(FeeTokenState); ok && opts.ExistingTxs != nil {
+		if feeTokenBalance := state.GetFeeTokenBalance(from); !feeTokenBalance.IsZero() {
+			if err := validateFeeTokenFunds(tx, balance, feeTokenBalance.ToBig(), opts.ExistingTxs(from)); err != nil {
+				return err
+			}
+			if opts.ExistingCost(from, tx.Nonce()) == nil && opts.UsedAndLeftSlots != nil {
+				if used, left := opts.UsedAndLeftSlots(from); left <= 0 {
+					return fmt.Errorf("%w: pooled %d txs", ErrAccountLimitExceeded, used)
+				}
+			}
+			return nil
+		}
+	}
 	if balance.Cmp(cost) < 0 {
 		return fmt.Errorf("%w: balance %v, tx cost %v, overshot %v", core.ErrInsufficientFunds, balance, cost, new(big.Int).Sub(cost, balance))
 	}
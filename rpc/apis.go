@@ -7,6 +7,8 @@ import (
 
 	evmmempool "github.com/cosmos/evm/mempool"
 	"github.com/cosmos/evm/rpc/backend"
+	"github.com/cosmos/evm/rpc/namespaces/cosmos"
+	"github.com/cosmos/evm/rpc/namespaces/ethereum/admin"
 	"github.com/cosmos/evm/rpc/namespaces/ethereum/debug"
 	"github.com/cosmos/evm/rpc/namespaces/ethereum/eth"
 	"github.com/cosmos/evm/rpc/namespaces/ethereum/eth/filters"
@@ -37,11 +39,14 @@ const (
 	TxPoolNamespace   = "txpool"
 	DebugNamespace    = "debug"
 	MinerNamespace    = "miner"
+	// AdminNamespace is only served to the local clients, see the policy package.
+	AdminNamespace = "admin"
 
 	apiVersion = "1.0"
 )
 
-// APICreator creates the JSON-RPC API implementations.
+// APICreator creates the JSON-RPC API implementations. The backends of the
+// namespaces share the stream, the response cache and the pending state cache.
 type APICreator = func(
 	ctx *server.Context,
 	clientCtx client.Context,
@@ -49,6 +54,8 @@ type APICreator = func(
 	allowUnprotectedTxs bool,
 	indexer servertypes.EVMTxIndexer,
 	mempool *evmmempool.ExperimentalEVMMempool,
+	cache *backend.ResponseCache,
+	pending *backend.PendingStateCache,
 ) []rpc.API
 
 // apiCreators defines the JSON-RPC API namespaces.
@@ -56,14 +63,35 @@ var apiCreators map[string]APICreator
 
 func init() {
 	apiCreators = map[string]APICreator{
+		CosmosNamespace: func(ctx *server.Context,
+			clientCtx client.Context,
+			_ *stream.RPCStream,
+			allowUnprotectedTxs bool,
+			indexer servertypes.EVMTxIndexer,
+			mempool *evmmempool.ExperimentalEVMMempool,
+			cache *backend.ResponseCache,
+			pending *backend.PendingStateCache,
+		) []rpc.API {
+			evmBackend := backend.NewBackend(ctx, ctx.Logger, clientCtx, allowUnprotectedTxs, indexer, mempool, cache, pending)
+			return []rpc.API{
+				{
+					Namespace: CosmosNamespace,
+					Version:   apiVersion,
+					Service:   cosmos.NewPublicAPI(ctx.Logger, evmBackend),
+					Public:    true,
+				},
+			}
+		},
 		EthNamespace: func(ctx *server.Context,
 			clientCtx client.Context,
 			stream *stream.RPCStream,
 			allowUnprotectedTxs bool,
 			indexer servertypes.EVMTxIndexer,
 			mempool *evmmempool.ExperimentalEVMMempool,
+			cache *backend.ResponseCache,
+			pending *backend.PendingStateCache,
 		) []rpc.API {
-			evmBackend := backend.NewBackend(ctx, ctx.Logger, clientCtx, allowUnprotectedTxs, indexer, mempool)
+			evmBackend := backend.NewBackend(ctx, ctx.Logger, clientCtx, allowUnprotectedTxs, indexer, mempool, cache, pending)
 			return []rpc.API{
 				{
 					Namespace: EthNamespace,
@@ -79,7 +107,7 @@ func init() {
 				},
 			}
 		},
-		Web3Namespace: func(*server.Context, client.Context, *stream.RPCStream, bool, servertypes.EVMTxIndexer, *evmmempool.ExperimentalEVMMempool) []rpc.API {
+		Web3Namespace: func(*server.Context, client.Context, *stream.RPCStream, bool, servertypes.EVMTxIndexer, *evmmempool.ExperimentalEVMMempool, *backend.ResponseCache, *backend.PendingStateCache) []rpc.API {
 			return []rpc.API{
 				{
 					Namespace: Web3Namespace,
@@ -89,7 +117,7 @@ func init() {
 				},
 			}
 		},
-		NetNamespace: func(ctx *server.Context, clientCtx client.Context, _ *stream.RPCStream, _ bool, _ servertypes.EVMTxIndexer, _ *evmmempool.ExperimentalEVMMempool) []rpc.API {
+		NetNamespace: func(ctx *server.Context, clientCtx client.Context, _ *stream.RPCStream, _ bool, _ servertypes.EVMTxIndexer, _ *evmmempool.ExperimentalEVMMempool, _ *backend.ResponseCache, _ *backend.PendingStateCache) []rpc.API {
 			return []rpc.API{
 				{
 					Namespace: NetNamespace,
@@ -105,8 +133,10 @@ func init() {
 			allowUnprotectedTxs bool,
 			indexer servertypes.EVMTxIndexer,
 			mempool *evmmempool.ExperimentalEVMMempool,
+			cache *backend.ResponseCache,
+			pending *backend.PendingStateCache,
 		) []rpc.API {
-			evmBackend := backend.NewBackend(ctx, ctx.Logger, clientCtx, allowUnprotectedTxs, indexer, mempool)
+			evmBackend := backend.NewBackend(ctx, ctx.Logger, clientCtx, allowUnprotectedTxs, indexer, mempool, cache, pending)
 			return []rpc.API{
 				{
 					Namespace: PersonalNamespace,
@@ -122,8 +152,10 @@ func init() {
 			allowUnprotectedTxs bool,
 			indexer servertypes.EVMTxIndexer,
 			mempool *evmmempool.ExperimentalEVMMempool,
+			cache *backend.ResponseCache,
+			pending *backend.PendingStateCache,
 		) []rpc.API {
-			evmBackend := backend.NewBackend(ctx, ctx.Logger, clientCtx, allowUnprotectedTxs, indexer, mempool)
+			evmBackend := backend.NewBackend(ctx, ctx.Logger, clientCtx, allowUnprotectedTxs, indexer, mempool, cache, pending)
 			return []rpc.API{
 				{
 					Namespace: TxPoolNamespace,
@@ -139,8 +171,10 @@ func init() {
 			allowUnprotectedTxs bool,
 			indexer servertypes.EVMTxIndexer,
 			mempool *evmmempool.ExperimentalEVMMempool,
+			cache *backend.ResponseCache,
+			pending *backend.PendingStateCache,
 		) []rpc.API {
-			evmBackend := backend.NewBackend(ctx, ctx.Logger, clientCtx, allowUnprotectedTxs, indexer, mempool)
+			evmBackend := backend.NewBackend(ctx, ctx.Logger, clientCtx, allowUnprotectedTxs, indexer, mempool, cache, pending)
 			return []rpc.API{
 				{
 					Namespace: DebugNamespace,
@@ -156,8 +190,10 @@ func init() {
 			allowUnprotectedTxs bool,
 			indexer servertypes.EVMTxIndexer,
 			mempool *evmmempool.ExperimentalEVMMempool,
+			cache *backend.ResponseCache,
+			pending *backend.PendingStateCache,
 		) []rpc.API {
-			evmBackend := backend.NewBackend(ctx, ctx.Logger, clientCtx, allowUnprotectedTxs, indexer, mempool)
+			evmBackend := backend.NewBackend(ctx, ctx.Logger, clientCtx, allowUnprotectedTxs, indexer, mempool, cache, pending)
 			return []rpc.API{
 				{
 					Namespace: MinerNamespace,
@@ -167,6 +203,16 @@ func init() {
 				},
 			}
 		},
+		AdminNamespace: func(ctx *server.Context, clientCtx client.Context, _ *stream.RPCStream, _ bool, _ servertypes.EVMTxIndexer, _ *evmmempool.ExperimentalEVMMempool, _ *backend.ResponseCache, _ *backend.PendingStateCache) []rpc.API {
+			return []rpc.API{
+				{
+					Namespace: AdminNamespace,
+					Version:   apiVersion,
+					Service:   admin.NewAPI(ctx, clientCtx),
+					Public:    false,
+				},
+			}
+		},
 	}
 }
 
@@ -178,12 +224,14 @@ func GetRPCAPIs(ctx *server.Context,
 	indexer servertypes.EVMTxIndexer,
 	selectedAPIs []string,
 	mempool *evmmempool.ExperimentalEVMMempool,
+	cache *backend.ResponseCache,
+	pending *backend.PendingStateCache,
 ) []rpc.API {
 	var apis []rpc.API
 
 	for _, ns := range selectedAPIs {
 		if creator, ok := apiCreators[ns]; ok {
-			apis = append(apis, creator(ctx, clientCtx, stream, allowUnprotectedTxs, indexer, mempool)...)
+			apis = append(apis, creator(ctx, clientCtx, stream, allowUnprotectedTxs, indexer, mempool, cache, pending)...)
 		} else {
 			ctx.Logger.Error("invalid namespace value", "namespace", ns)
 		}
@@ -41,7 +41,17 @@ func (b *Backend) GetCode(ctx context.Context, address common.Address, blockNrOr
 		Address: address.String(),
 	}
 
-	res, err := b.QueryClient.Code(ctx, req)
+	pending, err := b.pendingState(ctx, blockNum)
+	if err != nil {
+		return nil, err
+	}
+
+	var res *evmtypes.QueryCodeResponse
+	if pending != nil {
+		res, err = pending.Code(req)
+	} else {
+		res, err = b.QueryClient.Code(ctx, req)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -150,7 +160,17 @@ func (b *Backend) GetStorageAt(ctx context.Context, address common.Address, key
 		Key:     key,
 	}
 
-	res, err := b.QueryClient.Storage(ctx, req)
+	pending, err := b.pendingState(ctx, blockNum)
+	if err != nil {
+		return nil, err
+	}
+
+	var res *evmtypes.QueryStorageResponse
+	if pending != nil {
+		res, err = pending.Storage(req)
+	} else {
+		res, err = b.QueryClient.Storage(ctx, req)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -179,7 +199,17 @@ func (b *Backend) GetBalance(ctx context.Context, address common.Address, blockN
 		return nil, err
 	}
 
-	res, err := b.QueryClient.Balance(ctx, req)
+	pending, err := b.pendingState(ctx, blockNum)
+	if err != nil {
+		return nil, err
+	}
+
+	var res *evmtypes.QueryBalanceResponse
+	if pending != nil {
+		res, err = pending.Balance(req)
+	} else {
+		res, err = b.QueryClient.Balance(ctx, req)
+	}
 	if err != nil {
 		return nil, err
 	}
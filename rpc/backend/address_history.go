@@ -0,0 +1,148 @@
+package backend
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	tmrpctypes "github.com/cometbft/cometbft/rpc/core/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+
+	rpctypes "github.com/cosmos/evm/rpc/types"
+	servertypes "github.com/cosmos/evm/server/types"
+	evmtrace "github.com/cosmos/evm/trace"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+)
+
+// callFrame is the subset of the callTracer output used to find the internal transfers.
+type callFrame struct {
+	Type  string       `json:"type"`
+	To    string       `json:"to"`
+	Value *hexutil.Big `json:"value"`
+	Error string       `json:"error"`
+	Calls []callFrame  `json:"calls"`
+}
+
+// GetTransactionsByAddress returns a page of the transactions sent from or to the address,
+// using the address index of the custom indexer.
+func (b *Backend) GetTransactionsByAddress(
+	ctx context.Context,
+	address common.Address,
+	fromBlock, toBlock rpctypes.BlockNumber,
+	cursor hexutil.Bytes,
+	limit int,
+) (result *rpctypes.AddressTransactionsResult, err error) {
+	ctx, span := tracer.Start(ctx, "GetTransactionsByAddress", trace.WithAttributes(attribute.String("address", address.Hex())))
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+
+	addressIndexer, ok := b.Indexer.(servertypes.EVMAddressIndexer)
+	if !ok {
+		return nil, errors.New("address index is not available, enable the custom indexer and its address index")
+	}
+
+	from, err := b.resolveBlockNumber(ctx, fromBlock)
+	if err != nil {
+		return nil, err
+	}
+	to, err := b.resolveBlockNumber(ctx, toBlock)
+	if err != nil {
+		return nil, err
+	}
+
+	entries, next, err := addressIndexer.GetTxsByAddress(address, from, to, cursor, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	result = &rpctypes.AddressTransactionsResult{
+		Transactions: make([]*rpctypes.AddressTransaction, len(entries)),
+	}
+	for i, entry := range entries {
+		result.Transactions[i] = &rpctypes.AddressTransaction{
+			Hash:             entry.Hash,
+			BlockNumber:      hexutil.Uint64(entry.Height),     //#nosec G115 -- block number is never negative
+			TransactionIndex: hexutil.Uint64(entry.EthTxIndex), //#nosec G115 -- eth tx index is never negative
+			Roles:            entry.Roles.Strings(),
+		}
+	}
+	if next != nil {
+		result.NextCursor = next
+	}
+	return result, nil
+}
+
+// InternalTxRecipients traces the eth txs of the block with the callTracer and returns, for
+// every eth tx, the contracts created and the recipients of internal value transfers.
+// It's used by the custom indexer to populate its address index.
+func (b *Backend) InternalTxRecipients(block *cmttypes.Block) ([]map[common.Address]servertypes.AddressRole, error) {
+	resBlock := &tmrpctypes.ResultBlock{
+		BlockID: cmttypes.BlockID{Hash: block.Hash()},
+		Block:   block,
+	}
+	config := &rpctypes.TraceConfig{TraceConfig: evmtypes.TraceConfig{Tracer: "callTracer"}}
+	results, err := b.TraceBlock(context.Background(), rpctypes.BlockNumber(block.Height), config, resBlock)
+	if err != nil {
+		return nil, err
+	}
+
+	recipients := make([]map[common.Address]servertypes.AddressRole, len(results))
+	for i, res := range results {
+		recipients[i] = make(map[common.Address]servertypes.AddressRole)
+		if res == nil || res.Error != "" {
+			continue
+		}
+		bz, err := json.Marshal(res.Result)
+		if err != nil {
+			return nil, err
+		}
+		var frame callFrame
+		if err := json.Unmarshal(bz, &frame); err != nil {
+			return nil, fmt.Errorf("failed to decode call frame: %w", err)
+		}
+		collectInternalRecipients(frame, true, recipients[i])
+	}
+	return recipients, nil
+}
+
+// collectInternalRecipients walks the call frames of a successful execution and records
+// the created contracts and the recipients of value transfers. The top level call recipient
+// is already indexed as the tx recipient.
+func collectInternalRecipients(frame callFrame, topLevel bool, recipients map[common.Address]servertypes.AddressRole) {
+	if frame.Error != "" || !common.IsHexAddress(frame.To) {
+		return
+	}
+	to := common.HexToAddress(frame.To)
+	switch frame.Type {
+	case "CREATE", "CREATE2":
+		recipients[to] |= servertypes.AddressRoleCreate
+	default:
+		if !topLevel && frame.Value != nil && frame.Value.ToInt().Sign() > 0 {
+			recipients[to] |= servertypes.AddressRoleInternal
+		}
+	}
+	for _, call := range frame.Calls {
+		collectInternalRecipients(call, false, recipients)
+	}
+}
+
+// resolveBlockNumber converts the block number tags to a block height.
+func (b *Backend) resolveBlockNumber(ctx context.Context, blockNum rpctypes.BlockNumber) (int64, error) {
+	switch blockNum {
+	case rpctypes.EthEarliestBlockNumber:
+		return 1, nil
+	case rpctypes.EthLatestBlockNumber, rpctypes.EthPendingBlockNumber, rpctypes.EthSafeBlockNumber, rpctypes.EthFinalizedBlockNumber:
+		latest, err := b.BlockNumber(ctx)
+		if err != nil {
+			return 0, err
+		}
+		return int64(latest), nil //#nosec G115 -- block number won't exceed int64
+	default:
+		return blockNum.Int64(), nil
+	}
+}
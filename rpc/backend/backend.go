@@ -100,6 +100,7 @@ type EVMBackend interface {
 	PendingTransactions(ctx context.Context) ([]*sdk.Tx, error)
 	GetCoinbase(ctx context.Context) (sdk.AccAddress, error)
 	FeeHistory(ctx context.Context, blockCount math.HexOrDecimal64, lastBlock rpc.BlockNumber, rewardPercentiles []float64) (*types.FeeHistoryResult, error)
+	FeeHistoryFromIndexer(ctx context.Context, blockStart, blockEnd int64, rewardPercentiles []float64, pendingRewards bool) (*types.FeeHistoryResult, bool, error)
 	SuggestGasTipCap(ctx context.Context, baseFee *big.Int) (*big.Int, error)
 
 	// Tx Info
@@ -116,6 +117,8 @@ type EVMBackend interface {
 	// Send Transaction
 	Resend(ctx context.Context, args evmtypes.TransactionArgs, gasPrice *hexutil.Big, gasLimit *hexutil.Uint64) (common.Hash, error)
 	SendRawTransaction(ctx context.Context, data hexutil.Bytes) (common.Hash, error)
+	SendBundle(ctx context.Context, args types.SendBundleArgs) (*types.SendBundleResult, error)
+	CallBundle(ctx context.Context, args types.CallBundleArgs) (*types.CallBundleResult, error)
 	SetTxDefaults(ctx context.Context, args evmtypes.TransactionArgs) (evmtypes.TransactionArgs, error)
 	EstimateGas(ctx context.Context, args evmtypes.TransactionArgs, blockNrOrHash *types.BlockNumberOrHash, overrides *json.RawMessage) (hexutil.Uint64, error)
 	DoCall(ctx context.Context, args evmtypes.TransactionArgs, blockNr types.BlockNumber, overrides *json.RawMessage) (*evmtypes.MsgEthereumTxResponse, error)
@@ -124,6 +127,7 @@ type EVMBackend interface {
 	// Filter API
 	GetLogs(ctx context.Context, hash common.Hash) ([][]*ethtypes.Log, error)
 	GetLogsByHeight(ctx context.Context, height *int64) ([][]*ethtypes.Log, error)
+	LogBlocksFromIndexer(ctx context.Context, from, to int64, addresses []common.Address, topics [][]common.Hash) ([]int64, bool, error)
 	BloomStatus() (uint64, uint64)
 
 	// TxPool API
@@ -131,6 +135,8 @@ type EVMBackend interface {
 	ContentFrom(ctx context.Context, address common.Address) (map[string]map[string]*types.RPCTransaction, error)
 	Inspect(ctx context.Context) (map[string]map[string]map[string]string, error)
 	Status(ctx context.Context) (map[string]hexutil.Uint, error)
+	CosmosContent(ctx context.Context) (map[string]map[string]*types.CosmosPoolTransaction, error)
+	Dropped(ctx context.Context, hash common.Hash) (*evmmempool.DroppedTx, error)
 
 	// Tracing
 	TraceTransaction(ctx context.Context, hash common.Hash, config *types.TraceConfig) (interface{}, error)
@@ -179,13 +185,19 @@ type Backend struct {
 	Indexer             servertypes.EVMTxIndexer
 	ProcessBlocker      ProcessBlocker
 	Mempool             *evmmempool.ExperimentalEVMMempool
+	Cache               *ResponseCache
+
+	gasOracle gasPriceOracle
+	pending   *PendingStateCache
 }
 
 func (b *Backend) GetConfig() config.Config {
 	return b.Cfg
 }
 
-// NewBackend creates a new Backend instance for cosmos and ethereum namespaces
+// NewBackend creates a new Backend instance for cosmos and ethereum namespaces.
+// The backends of a node share the response cache, which may be nil, and the
+// pending state cache, without which the latest state is served as pending.
 func NewBackend(
 	ctx *server.Context,
 	logger log.Logger,
@@ -193,6 +205,8 @@ func NewBackend(
 	allowUnprotectedTxs bool,
 	indexer servertypes.EVMTxIndexer,
 	mempool *evmmempool.ExperimentalEVMMempool,
+	cache *ResponseCache,
+	pending *PendingStateCache,
 ) *Backend {
 	appConf, err := config.GetConfig(ctx.Viper)
 	if err != nil {
@@ -214,6 +228,8 @@ func NewBackend(
 		AllowUnprotectedTxs: allowUnprotectedTxs,
 		Indexer:             indexer,
 		Mempool:             mempool,
+		Cache:               cache,
+		pending:             pending,
 	}
 	b.ProcessBlocker = b.ProcessBlock
 	return b
@@ -57,6 +57,14 @@ func (b *Backend) GetBlockByNumber(ctx context.Context, blockNum types.BlockNumb
 	ctx, span := tracer.Start(ctx, "GetBlockByNumber", trace.WithAttributes(attribute.Int64("blockNum", blockNum.Int64()), attribute.Bool("fullTx", fullTx)))
 	defer func() { evmtrace.EndSpanErr(span, err) }()
 
+	pending, err := b.pendingState(ctx, blockNum)
+	if err != nil {
+		return nil, err
+	}
+	if pending != nil {
+		return b.pendingBlock(ctx, pending, fullTx)
+	}
+
 	resBlock, err := b.CometBlockByNumber(ctx, blockNum)
 	if err != nil {
 		return nil, nil
@@ -67,7 +75,7 @@ func (b *Backend) GetBlockByNumber(ctx context.Context, blockNum types.BlockNumb
 		return nil, nil
 	}
 
-	blockRes, err := b.RPCClient.BlockResults(ctx, &resBlock.Block.Height)
+	blockRes, err := b.CometBlockResultByNumber(ctx, &resBlock.Block.Height)
 	if err != nil {
 		b.Logger.Debug("failed to fetch block result from CometBFT", "height", blockNum, "error", err.Error())
 		return nil, nil
@@ -98,7 +106,7 @@ func (b *Backend) GetBlockByHash(ctx context.Context, hash common.Hash, fullTx b
 		return nil, nil
 	}
 
-	blockRes, err := b.RPCClient.BlockResults(ctx, &resBlock.Block.Height)
+	blockRes, err := b.CometBlockResultByNumber(ctx, &resBlock.Block.Height)
 	if err != nil {
 		b.Logger.Debug("failed to fetch block result from CometBFT", "block-hash", hash.String(), "error", err.Error())
 		return nil, nil
@@ -156,7 +164,7 @@ func (b *Backend) GetBlockTransactionCountByNumber(ctx context.Context, blockNum
 func (b *Backend) getBlockTransactionCount(ctx context.Context, block *cmtrpctypes.ResultBlock) *hexutil.Uint {
 	ctx, span := tracer.Start(ctx, "getBlockTransactionCount")
 	defer span.End()
-	blockRes, err := b.RPCClient.BlockResults(ctx, &block.Block.Height)
+	blockRes, err := b.CometBlockResultByNumber(ctx, &block.Block.Height)
 	if err != nil {
 		return nil
 	}
@@ -181,7 +189,7 @@ func (b *Backend) EthBlockByNumber(ctx context.Context, blockNum types.BlockNumb
 		return nil, fmt.Errorf("block not found for height %d", blockNum)
 	}
 
-	blockRes, err := b.RPCClient.BlockResults(ctx, &resBlock.Block.Height)
+	blockRes, err := b.CometBlockResultByNumber(ctx, &resBlock.Block.Height)
 	if err != nil {
 		return nil, fmt.Errorf("block result not found for height %d", resBlock.Block.Height)
 	}
@@ -216,16 +224,16 @@ func (b *Backend) GetBlockReceipts(
 		return nil, fmt.Errorf("block not found for height %d", *blockNum.CmtHeight())
 	}
 
-	blockRes, err := b.RPCClient.BlockResults(ctx, blockNum.CmtHeight())
+	blockRes, err := b.CometBlockResultByNumber(ctx, &resBlock.Block.Height)
 	if err != nil {
 		return nil, fmt.Errorf("block result not found for height %d", resBlock.Block.Height)
 	}
 
 	msgs := b.EthMsgsFromCometBlock(ctx, resBlock, blockRes)
 
-	receipts, err := b.ReceiptsFromCometBlock(ctx, resBlock, blockRes, msgs)
+	receipts, err := b.EthReceiptsFromCometBlock(ctx, resBlock, blockRes)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get receipts from comet block: %w, ", err)
+		return nil, err
 	}
 
 	result = make([]map[string]interface{}, len(msgs))
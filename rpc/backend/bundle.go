@@ -0,0 +1,158 @@
+package backend
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	evmmempool "github.com/cosmos/evm/mempool"
+	rpctypes "github.com/cosmos/evm/rpc/types"
+	evmtrace "github.com/cosmos/evm/trace"
+)
+
+// SendBundle adds a bundle of transactions to the bundle pool of the mempool, the
+// transactions are included contiguously in the target block, or not at all.
+func (b *Backend) SendBundle(ctx context.Context, args rpctypes.SendBundleArgs) (result *rpctypes.SendBundleResult, err error) {
+	_, span := tracer.Start(ctx, "SendBundle")
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+
+	if b.Mempool == nil {
+		return nil, evmmempool.ErrBundlesDisabled
+	}
+	txs, err := b.decodeBundleTxs(args.Txs)
+	if err != nil {
+		return nil, err
+	}
+
+	bundle := &evmmempool.Bundle{
+		Txs:               txs,
+		BlockNumber:       uint64(args.BlockNumber),
+		RevertingTxHashes: args.RevertingTxHashes,
+	}
+	if args.MinTimestamp != nil {
+		bundle.MinTimestamp = *args.MinTimestamp
+	}
+	if args.MaxTimestamp != nil {
+		bundle.MaxTimestamp = *args.MaxTimestamp
+	}
+
+	hash, err := b.Mempool.SendBundle(bundle)
+	if err != nil {
+		return nil, err
+	}
+	return &rpctypes.SendBundleResult{BundleHash: hash}, nil
+}
+
+// CallBundle executes the transactions of a bundle in order on top of the latest
+// state and returns their results, without submitting the bundle.
+func (b *Backend) CallBundle(ctx context.Context, args rpctypes.CallBundleArgs) (result *rpctypes.CallBundleResult, err error) {
+	ctx, span := tracer.Start(ctx, "CallBundle")
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+
+	if b.Mempool == nil {
+		return nil, evmmempool.ErrBundlesDisabled
+	}
+	if args.StateBlockNumber != nil && *args.StateBlockNumber != rpctypes.EthLatestBlockNumber {
+		return nil, errors.New("bundles can only be executed on the latest state")
+	}
+	txs, err := b.decodeBundleTxs(args.Txs)
+	if err != nil {
+		return nil, err
+	}
+
+	header, err := b.CurrentHeader(ctx)
+	if err != nil {
+		return nil, err
+	}
+	bundle := &evmmempool.Bundle{Txs: txs, BlockNumber: uint64(args.BlockNumber)}
+	txResults, err := b.Mempool.CallBundle(ctx, bundle)
+	if err != nil {
+		return nil, err
+	}
+
+	signer := ethtypes.LatestSigner(b.ChainConfig())
+	totalFees := new(big.Int)
+	var totalGas uint64
+	result = &rpctypes.CallBundleResult{
+		BundleHash:       bundle.Hash(),
+		StateBlockNumber: hexutil.Uint64(header.Number.Uint64()),
+		Results:          make([]rpctypes.CallBundleTxResult, len(txResults)),
+	}
+	for i, txResult := range txResults {
+		tx := txResult.Tx
+		// the senders are verified when the transactions are decoded
+		from, _ := ethtypes.Sender(signer, tx)
+		gasPrice := tx.GasPrice()
+		if header.BaseFee != nil {
+			tip, _ := tx.EffectiveGasTip(header.BaseFee)
+			gasPrice = new(big.Int).Add(header.BaseFee, tip)
+		}
+
+		res := rpctypes.CallBundleTxResult{
+			TxHash:      tx.Hash(),
+			FromAddress: from,
+			ToAddress:   tx.To(),
+			GasPrice:    (*hexutil.Big)(gasPrice),
+		}
+		switch resp := txResult.Response; {
+		case txResult.Err != nil:
+			res.Error = txResult.Err.Error()
+		case resp.Failed():
+			res.Error = resp.VmError
+			if resp.VmError == vm.ErrExecutionReverted.Error() {
+				res.Revert, _ = abi.UnpackRevert(resp.Ret)
+			}
+		default:
+			res.Value = resp.Ret
+		}
+		if resp := txResult.Response; resp != nil {
+			res.GasUsed = hexutil.Uint64(resp.GasUsed)
+			fees := new(big.Int).Mul(gasPrice, new(big.Int).SetUint64(resp.GasUsed))
+			res.GasFees = (*hexutil.Big)(fees)
+			totalFees.Add(totalFees, fees)
+			totalGas += resp.GasUsed
+		}
+		result.Results[i] = res
+	}
+
+	result.TotalGasUsed = hexutil.Uint64(totalGas)
+	result.GasFees = (*hexutil.Big)(totalFees)
+	bundleGasPrice := new(big.Int)
+	if totalGas > 0 {
+		bundleGasPrice.Quo(totalFees, new(big.Int).SetUint64(totalGas))
+	}
+	result.BundleGasPrice = (*hexutil.Big)(bundleGasPrice)
+	return result, nil
+}
+
+// decodeBundleTxs decodes the raw transactions of a bundle, with the same checks
+// as SendRawTransaction.
+func (b *Backend) decodeBundleTxs(rawTxs []hexutil.Bytes) ([]*ethtypes.Transaction, error) {
+	signer := ethtypes.LatestSigner(b.ChainConfig())
+	txs := make([]*ethtypes.Transaction, len(rawTxs))
+	for i, data := range rawTxs {
+		tx := &ethtypes.Transaction{}
+		if err := tx.UnmarshalBinary(data); err != nil {
+			return nil, fmt.Errorf("failed to decode bundle transaction %d: %w", i, err)
+		}
+		if !b.UnprotectedAllowed() {
+			if !tx.Protected() {
+				return nil, errors.New("only replay-protected (EIP-155) transactions allowed over RPC")
+			}
+			if tx.ChainId().Uint64() != b.EvmChainID.Uint64() {
+				return nil, fmt.Errorf("incorrect chain-id; expected %d, got %d", b.EvmChainID, tx.ChainId())
+			}
+		}
+		if _, err := ethtypes.Sender(signer, tx); err != nil {
+			return nil, fmt.Errorf("invalid sender of bundle transaction %s: %w", tx.Hash(), err)
+		}
+		txs[i] = tx
+	}
+	return txs, nil
+}
@@ -0,0 +1,279 @@
+package backend
+
+import (
+	"container/list"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	gethmetrics "github.com/ethereum/go-ethereum/metrics"
+
+	cmtrpctypes "github.com/cometbft/cometbft/rpc/core/types"
+)
+
+// cacheKind identifies the type of the data stored in a ResponseCache entry.
+type cacheKind int
+
+const (
+	cacheCometBlock cacheKind = iota
+	cacheBlockResults
+	cacheEthBlock
+	cacheReceipts
+	cacheBloom
+	numCacheKinds
+)
+
+const (
+	// cacheEntryOverhead approximates the memory used by the bookkeeping of an entry.
+	cacheEntryOverhead = 128
+	// receiptOverhead approximates the memory used by a receipt without its logs.
+	receiptOverhead = ethtypes.BloomByteLength + 256
+	// logOverhead approximates the memory used by a log without its topics and data.
+	logOverhead = 160
+)
+
+var (
+	cacheKindNames = [numCacheKinds]string{"cometblock", "blockresults", "ethblock", "receipts", "bloom"}
+
+	cacheHitCounters  [numCacheKinds]*gethmetrics.Counter
+	cacheMissCounters [numCacheKinds]*gethmetrics.Counter
+	cacheSizeGauge    = gethmetrics.NewRegisteredGauge("rpc/cache/size", nil)
+)
+
+func init() {
+	for kind, name := range cacheKindNames {
+		cacheHitCounters[kind] = gethmetrics.NewRegisteredCounter("rpc/cache/"+name+"/hit", nil)
+		cacheMissCounters[kind] = gethmetrics.NewRegisteredCounter("rpc/cache/"+name+"/miss", nil)
+	}
+}
+
+type cacheKey struct {
+	kind   cacheKind
+	height int64
+}
+
+type cacheEntry struct {
+	key   cacheKey
+	value interface{}
+	size  int64
+}
+
+// ResponseCache is a least recently used cache of the CometBFT blocks and their
+// Ethereum representation, bounded by the approximate memory size of the entries.
+// CometBFT blocks have instant finality, so the entries never need to be invalidated.
+// A nil ResponseCache is valid and caches nothing.
+type ResponseCache struct {
+	mu      sync.Mutex
+	maxSize int64
+	size    int64
+	entries map[cacheKey]*list.Element
+	lru     *list.List
+	// heights maps the hashes of the cached CometBFT blocks to their height
+	heights map[common.Hash]int64
+}
+
+// NewResponseCache creates a cache bounded to maxSize bytes. It returns nil if
+// maxSize is not positive, which disables the cache.
+func NewResponseCache(maxSize int64) *ResponseCache {
+	if maxSize <= 0 {
+		return nil
+	}
+	return &ResponseCache{
+		maxSize: maxSize,
+		entries: make(map[cacheKey]*list.Element),
+		lru:     list.New(),
+		heights: make(map[common.Hash]int64),
+	}
+}
+
+// CometBlock returns the cached CometBFT block at the height.
+func (c *ResponseCache) CometBlock(height int64) (*cmtrpctypes.ResultBlock, bool) {
+	value, ok := c.get(cacheCometBlock, height)
+	if !ok {
+		return nil, false
+	}
+	return value.(*cmtrpctypes.ResultBlock), true
+}
+
+// CometBlockByHash returns the cached CometBFT block with the hash.
+func (c *ResponseCache) CometBlockByHash(hash common.Hash) (*cmtrpctypes.ResultBlock, bool) {
+	if c == nil {
+		return nil, false
+	}
+	c.mu.Lock()
+	height, ok := c.heights[hash]
+	c.mu.Unlock()
+	if !ok {
+		cacheMissCounters[cacheCometBlock].Inc(1)
+		return nil, false
+	}
+	return c.CometBlock(height)
+}
+
+// AddCometBlock caches the CometBFT block.
+func (c *ResponseCache) AddCometBlock(resBlock *cmtrpctypes.ResultBlock) {
+	if c == nil || resBlock == nil || resBlock.Block == nil {
+		return
+	}
+	c.add(cacheCometBlock, resBlock.Block.Height, resBlock, int64(resBlock.Block.Size()))
+}
+
+// BlockResults returns the cached CometBFT block results at the height.
+func (c *ResponseCache) BlockResults(height int64) (*cmtrpctypes.ResultBlockResults, bool) {
+	value, ok := c.get(cacheBlockResults, height)
+	if !ok {
+		return nil, false
+	}
+	return value.(*cmtrpctypes.ResultBlockResults), true
+}
+
+// AddBlockResults caches the CometBFT block results.
+func (c *ResponseCache) AddBlockResults(blockRes *cmtrpctypes.ResultBlockResults) {
+	if c == nil || blockRes == nil {
+		return
+	}
+	var size int64
+	for _, txResult := range blockRes.TxsResults {
+		size += int64(txResult.Size())
+	}
+	for _, event := range blockRes.FinalizeBlockEvents {
+		size += int64(event.Size())
+	}
+	c.add(cacheBlockResults, blockRes.Height, blockRes, size)
+}
+
+// EthBlock returns the cached Ethereum block at the height.
+func (c *ResponseCache) EthBlock(height int64) (*ethtypes.Block, bool) {
+	value, ok := c.get(cacheEthBlock, height)
+	if !ok {
+		return nil, false
+	}
+	return value.(*ethtypes.Block), true
+}
+
+// AddEthBlock caches the Ethereum block.
+func (c *ResponseCache) AddEthBlock(height int64, block *ethtypes.Block) {
+	if c == nil || block == nil {
+		return
+	}
+	c.add(cacheEthBlock, height, block, int64(block.Size())) //#nosec G115 -- block size won't exceed int64
+}
+
+// Receipts returns the cached receipts of all the Ethereum transactions of the block at the height.
+func (c *ResponseCache) Receipts(height int64) ([]*ethtypes.Receipt, bool) {
+	value, ok := c.get(cacheReceipts, height)
+	if !ok {
+		return nil, false
+	}
+	return value.([]*ethtypes.Receipt), true
+}
+
+// AddReceipts caches the receipts of all the Ethereum transactions of the block at the height.
+func (c *ResponseCache) AddReceipts(height int64, receipts []*ethtypes.Receipt) {
+	if c == nil {
+		return
+	}
+	var size int64
+	for _, receipt := range receipts {
+		size += receiptOverhead
+		for _, log := range receipt.Logs {
+			size += logOverhead + int64(len(log.Topics)*common.HashLength+len(log.Data))
+		}
+	}
+	c.add(cacheReceipts, height, receipts, size)
+}
+
+// Bloom returns the cached bloom filter of the block at the height.
+func (c *ResponseCache) Bloom(height int64) (ethtypes.Bloom, bool) {
+	value, ok := c.get(cacheBloom, height)
+	if !ok {
+		return ethtypes.Bloom{}, false
+	}
+	return value.(ethtypes.Bloom), true
+}
+
+// AddBloom caches the bloom filter of the block at the height.
+func (c *ResponseCache) AddBloom(height int64, bloom ethtypes.Bloom) {
+	if c == nil {
+		return
+	}
+	c.add(cacheBloom, height, bloom, ethtypes.BloomByteLength)
+}
+
+// Size returns the approximate memory size of the cached entries.
+func (c *ResponseCache) Size() int64 {
+	if c == nil {
+		return 0
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.size
+}
+
+// Len returns the number of cached entries.
+func (c *ResponseCache) Len() int {
+	if c == nil {
+		return 0
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.lru.Len()
+}
+
+func (c *ResponseCache) get(kind cacheKind, height int64) (interface{}, bool) {
+	if c == nil {
+		return nil, false
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	elem, ok := c.entries[cacheKey{kind, height}]
+	if !ok {
+		cacheMissCounters[kind].Inc(1)
+		return nil, false
+	}
+	cacheHitCounters[kind].Inc(1)
+	c.lru.MoveToFront(elem)
+	return elem.Value.(*cacheEntry).value, true
+}
+
+// add stores the value and evicts the least recently used entries until the cache
+// fits in its maximum size. Values larger than the maximum size are not cached.
+func (c *ResponseCache) add(kind cacheKind, height int64, value interface{}, size int64) {
+	size += cacheEntryOverhead
+	if size > c.maxSize {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	key := cacheKey{kind, height}
+	if elem, ok := c.entries[key]; ok {
+		c.removeElement(elem)
+	}
+	c.entries[key] = c.lru.PushFront(&cacheEntry{key: key, value: value, size: size})
+	c.size += size
+	cacheSizeGauge.Inc(size)
+	if kind == cacheCometBlock {
+		c.heights[common.BytesToHash(value.(*cmtrpctypes.ResultBlock).BlockID.Hash)] = height
+	}
+
+	for c.size > c.maxSize {
+		c.removeElement(c.lru.Back())
+	}
+}
+
+func (c *ResponseCache) removeElement(elem *list.Element) {
+	entry := c.lru.Remove(elem).(*cacheEntry)
+	delete(c.entries, entry.key)
+	c.size -= entry.size
+	cacheSizeGauge.Dec(entry.size)
+
+	if entry.key.kind == cacheCometBlock {
+		hash := common.BytesToHash(entry.value.(*cmtrpctypes.ResultBlock).BlockID.Hash)
+		if c.heights[hash] == entry.key.height {
+			delete(c.heights, hash)
+		}
+	}
+}
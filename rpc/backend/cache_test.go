@@ -0,0 +1,119 @@
+package backend
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+
+	tmrpctypes "github.com/cometbft/cometbft/rpc/core/types"
+	tmtypes "github.com/cometbft/cometbft/types"
+)
+
+func newTestResultBlock(height int64, hash common.Hash) *tmrpctypes.ResultBlock {
+	return &tmrpctypes.ResultBlock{
+		BlockID: tmtypes.BlockID{Hash: hash.Bytes()},
+		Block:   tmtypes.MakeBlock(height, nil, nil, nil),
+	}
+}
+
+func TestResponseCache(t *testing.T) {
+	t.Run("nil cache", func(t *testing.T) {
+		var cache *ResponseCache
+		require.Nil(t, NewResponseCache(0))
+
+		cache.AddBloom(1, ethtypes.Bloom{1})
+		_, ok := cache.Bloom(1)
+		require.False(t, ok)
+		require.Zero(t, cache.Len())
+		require.Zero(t, cache.Size())
+	})
+
+	t.Run("comet block by height and hash", func(t *testing.T) {
+		cache := NewResponseCache(1 << 20)
+		resBlock := newTestResultBlock(5, common.HexToHash("0x05"))
+		cache.AddCometBlock(resBlock)
+
+		got, ok := cache.CometBlock(5)
+		require.True(t, ok)
+		require.Equal(t, resBlock, got)
+
+		got, ok = cache.CometBlockByHash(common.HexToHash("0x05"))
+		require.True(t, ok)
+		require.Equal(t, resBlock, got)
+
+		_, ok = cache.CometBlockByHash(common.HexToHash("0x06"))
+		require.False(t, ok)
+	})
+
+	t.Run("evicts the least recently used entries", func(t *testing.T) {
+		entrySize := int64(ethtypes.BloomByteLength + cacheEntryOverhead)
+		cache := NewResponseCache(3 * entrySize)
+
+		for height := int64(1); height <= 3; height++ {
+			cache.AddBloom(height, ethtypes.Bloom{byte(height)})
+		}
+		require.Equal(t, 3, cache.Len())
+		require.Equal(t, 3*entrySize, cache.Size())
+
+		// height 1 becomes the most recently used entry
+		_, ok := cache.Bloom(1)
+		require.True(t, ok)
+
+		cache.AddBloom(4, ethtypes.Bloom{4})
+		require.Equal(t, 3, cache.Len())
+
+		_, ok = cache.Bloom(2)
+		require.False(t, ok)
+		for _, height := range []int64{1, 3, 4} {
+			bloom, ok := cache.Bloom(height)
+			require.True(t, ok)
+			require.Equal(t, ethtypes.Bloom{byte(height)}, bloom)
+		}
+	})
+
+	t.Run("evicted comet block is removed from the hash index", func(t *testing.T) {
+		resBlock := newTestResultBlock(7, common.HexToHash("0x07"))
+		size := int64(resBlock.Block.Size()) + cacheEntryOverhead
+		cache := NewResponseCache(size + ethtypes.BloomByteLength + cacheEntryOverhead - 1)
+
+		cache.AddCometBlock(resBlock)
+		cache.AddBloom(7, ethtypes.Bloom{7})
+
+		_, ok := cache.CometBlock(7)
+		require.False(t, ok)
+		require.Empty(t, cache.heights)
+		require.Equal(t, 1, cache.Len())
+	})
+
+	t.Run("replaces an entry", func(t *testing.T) {
+		cache := NewResponseCache(1 << 20)
+		cache.AddBloom(1, ethtypes.Bloom{1})
+		cache.AddBloom(1, ethtypes.Bloom{2})
+
+		require.Equal(t, 1, cache.Len())
+		bloom, ok := cache.Bloom(1)
+		require.True(t, ok)
+		require.Equal(t, ethtypes.Bloom{2}, bloom)
+	})
+
+	t.Run("skips entries larger than the cache", func(t *testing.T) {
+		cache := NewResponseCache(ethtypes.BloomByteLength)
+		cache.AddBloom(1, ethtypes.Bloom{1})
+		require.Zero(t, cache.Len())
+	})
+
+	t.Run("receipts", func(t *testing.T) {
+		cache := NewResponseCache(1 << 20)
+		receipts := []*ethtypes.Receipt{
+			{TxHash: common.HexToHash("0x01")},
+			{TxHash: common.HexToHash("0x02"), Logs: []*ethtypes.Log{{Topics: []common.Hash{{}}, Data: []byte{1}}}},
+		}
+		cache.AddReceipts(3, receipts)
+
+		require.Equal(t, receipts[1], cachedReceipt(cache, 3, common.HexToHash("0x02")))
+		require.Nil(t, cachedReceipt(cache, 3, common.HexToHash("0x03")))
+		require.Nil(t, cachedReceipt(cache, 4, common.HexToHash("0x01")))
+	})
+}
@@ -176,6 +176,7 @@ func (b *Backend) SendRawTransaction(ctx context.Context, data hexutil.Bytes) (r
 		if b.Mempool != nil && strings.Contains(err.Error(), mempool.ErrNonceGap.Error()) {
 			// Transaction was successfully queued due to nonce gap, return success to client
 			b.Logger.Debug("transaction queued due to nonce gap", "hash", txHash.Hex())
+			b.Mempool.TrackLocalTx(tx)
 			return txHash, nil
 		}
 		if b.Mempool != nil && strings.Contains(err.Error(), mempool.ErrNonceLow.Error()) {
@@ -201,6 +202,10 @@ func (b *Backend) SendRawTransaction(ctx context.Context, data hexutil.Bytes) (r
 		return txHash, fmt.Errorf("failed to broadcast transaction: %w", err)
 	}
 
+	// Journal the transaction, so that it survives a restart of the node
+	if b.Mempool != nil {
+		b.Mempool.TrackLocalTx(tx)
+	}
 	return txHash, nil
 }
 
@@ -454,7 +459,17 @@ func (b *Backend) DoCall(
 	// this makes sure resources are cleaned up.
 	defer cancel()
 
-	res, err := b.QueryClient.EthCall(ctx, &req)
+	pending, err := b.pendingState(ctx, blockNr)
+	if err != nil {
+		return nil, err
+	}
+
+	var res *evmtypes.MsgEthereumTxResponse
+	if pending != nil {
+		res, err = pending.EthCall(ctx, &req)
+	} else {
+		res, err = b.QueryClient.EthCall(ctx, &req)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -21,7 +21,6 @@ import (
 
 	rpctypes "github.com/cosmos/evm/rpc/types"
 	evmtrace "github.com/cosmos/evm/trace"
-	feemarkettypes "github.com/cosmos/evm/x/feemarket/types"
 	evmtypes "github.com/cosmos/evm/x/vm/types"
 
 	errorsmod "cosmossdk.io/errors"
@@ -215,10 +214,11 @@ func (b *Backend) FeeHistory(
 		return nil, fmt.Errorf("%w: requested %d, head %d", errRequestBeyondHead, blockEnd, blockNumber)
 	}
 
-	blocks := int64(userBlockCount)                     // #nosec G115 -- checked for int overflow already
-	maxBlockCount := int64(b.Cfg.JSONRPC.FeeHistoryCap) // #nosec G115 -- checked for int overflow already
-	if blocks > maxBlockCount {
-		return nil, fmt.Errorf("FeeHistory user block count %d higher than %d", blocks, maxBlockCount)
+	blocks := int64(userBlockCount)                                // #nosec G115 -- checked for int overflow already
+	maxBlockCount := int64(b.Cfg.JSONRPC.FeeHistoryCap)            // #nosec G115 -- checked for int overflow already
+	indexedBlockCount := int64(b.Cfg.JSONRPC.FeeHistoryIndexedCap) // #nosec G115 -- checked for int overflow already
+	if blocks > max(maxBlockCount, indexedBlockCount) {
+		return nil, fmt.Errorf("FeeHistory user block count %d higher than %d", blocks, max(maxBlockCount, indexedBlockCount))
 	}
 
 	if blockEnd < gomath.MaxInt64 && blockEnd+1 < blocks {
@@ -228,9 +228,28 @@ func (b *Backend) FeeHistory(
 	blockStart := blockEnd + 1 - blocks
 	oldestBlock := (*hexutil.Big)(big.NewInt(blockStart))
 
+	rewardCount := len(rewardPercentiles)
+	// rewards should only be calculated if reward percentiles were included
+	calculateRewards := rewardCount != 0
+	// the rewards of the newest block are weighted by the mempool congestion for the pending block,
+	// the same way as the tip suggested by the gas price oracle
+	pendingRewards := calculateRewards && lastBlock == rpc.PendingBlockNumber
+
+	// serve the window from the fee records of the indexer when they cover it
+	if blocks <= indexedBlockCount {
+		feeHistory, ok, err := b.FeeHistoryFromIndexer(ctx, blockStart, blockEnd, rewardPercentiles, pendingRewards)
+		if err != nil {
+			b.Logger.Debug("failed to serve fee history from indexer", "error", err.Error())
+		} else if ok {
+			return feeHistory, nil
+		}
+	}
+	if blocks > maxBlockCount {
+		return nil, fmt.Errorf("FeeHistory user block count %d higher than %d", blocks, maxBlockCount)
+	}
+
 	// prepare space
 	reward := make([][]*hexutil.Big, blocks)
-	rewardCount := len(rewardPercentiles)
 	for i := 0; i < int(blocks); i++ {
 		reward[i] = make([]*hexutil.Big, rewardCount)
 	}
@@ -240,8 +259,6 @@ func (b *Backend) FeeHistory(
 	thisBlobBaseFee := make([]*hexutil.Big, blocks+1)
 	thisBlobGasUsedRatio := make([]float64, blocks)
 
-	// rewards should only be calculated if reward percentiles were included
-	calculateRewards := rewardCount != 0
 	const maxBlockFetchers = 4
 	for blockID := blockStart; blockID <= blockEnd; blockID += maxBlockFetchers {
 		wg := sync.WaitGroup{}
@@ -289,8 +306,15 @@ func (b *Backend) FeeHistory(
 					return
 				}
 
+				percentiles := rewardPercentiles
+				if pendingRewards && int(index) == len(reward)-1 {
+					if gasLimit, ok := ethBlock["gasLimit"].(hexutil.Uint64); ok {
+						percentiles = congestionPercentiles(rewardPercentiles, b.pendingDepth(uint64(gasLimit)))
+					}
+				}
+
 				oneFeeHistory := rpctypes.OneFeeHistory{}
-				err = b.ProcessBlocker(ctx, cometBlock, &ethBlock, rewardPercentiles, cometBlockResult, &oneFeeHistory)
+				err = b.ProcessBlocker(ctx, cometBlock, &ethBlock, percentiles, cometBlockResult, &oneFeeHistory)
 				if err != nil {
 					chanErr <- err
 					return
@@ -344,9 +368,10 @@ func (b *Backend) FeeHistory(
 	return &feeHistory, nil
 }
 
-// SuggestGasTipCap returns the suggested tip cap
-// Although we don't support tx prioritization yet, but we return a positive value to help client to
-// mitigate the base fee changes.
+// SuggestGasTipCap returns the suggested tip cap. The EVM mempool orders the transactions by
+// effective tip, so the tip is sampled from the recent blocks by the gas price oracle. If the
+// recent blocks have no transactions, the maximum base fee change of the next block is returned
+// to help the clients to mitigate the base fee changes.
 func (b *Backend) SuggestGasTipCap(ctx context.Context, baseFee *big.Int) (_ *big.Int, err error) {
 	ctx, span := tracer.Start(ctx, "SuggestGasTipCap")
 	defer func() { evmtrace.EndSpanErr(span, err) }()
@@ -355,24 +380,12 @@ func (b *Backend) SuggestGasTipCap(ctx context.Context, baseFee *big.Int) (_ *bi
 		return big.NewInt(0), nil
 	}
 
-	params, err := b.QueryClient.FeeMarket.Params(ctx, &feemarkettypes.QueryParamsRequest{})
+	tip, err := b.suggestTipCap(ctx)
 	if err != nil {
 		return nil, err
 	}
-	// calculate the maximum base fee delta in current block, assuming all block gas limit is consumed
-	// ```
-	// GasTarget = GasLimit / ElasticityMultiplier
-	// Delta = BaseFee * (GasUsed - GasTarget) / GasTarget / Denominator
-	// ```
-	// The delta is at maximum when `GasUsed` is equal to `GasLimit`, which is:
-	// ```
-	// MaxDelta = BaseFee * (GasLimit - GasLimit / ElasticityMultiplier) / (GasLimit / ElasticityMultiplier) / Denominator
-	//          = BaseFee * (ElasticityMultiplier - 1) / Denominator
-	// ```t
-	maxDelta := baseFee.Int64() * (int64(params.Params.ElasticityMultiplier) - 1) / int64(params.Params.BaseFeeChangeDenominator) // #nosec G115
-	if maxDelta < 0 {
-		// impossible if the parameter validation passed.
-		maxDelta = 0
+	if tip != nil {
+		return tip, nil
 	}
-	return big.NewInt(maxDelta), nil
+	return b.maxBaseFeeDelta(ctx, baseFee)
 }
@@ -26,6 +26,9 @@ func (b *Backend) CometBlockByNumber(ctx context.Context, blockNum rpctypes.Bloc
 	if err != nil {
 		return nil, err
 	}
+	if resBlock, ok := b.Cache.CometBlock(height); ok {
+		return resBlock, nil
+	}
 	resBlock, err := b.RPCClient.Block(ctx, &height)
 	if err != nil {
 		b.Logger.Debug("cometbft client failed to get block", "height", height, "error", err.Error())
@@ -37,6 +40,7 @@ func (b *Backend) CometBlockByNumber(ctx context.Context, blockNum rpctypes.Bloc
 		return nil, nil
 	}
 
+	b.Cache.AddCometBlock(resBlock)
 	return resBlock, nil
 }
 
@@ -66,11 +70,17 @@ func (b *Backend) CometBlockResultByNumber(ctx context.Context, height *int64) (
 	if height != nil && *height == 0 {
 		height = nil
 	}
+	if height != nil {
+		if res, ok := b.Cache.BlockResults(*height); ok {
+			return res, nil
+		}
+	}
 	res, err := b.RPCClient.BlockResults(ctx, height)
 	if err != nil {
 		return nil, fmt.Errorf("failed to fetch block result from CometBFT %d: %w", *height, err)
 	}
 
+	b.Cache.AddBlockResults(res)
 	return res, nil
 }
 
@@ -79,6 +89,9 @@ func (b *Backend) CometBlockByHash(ctx context.Context, blockHash common.Hash) (
 	ctx, span := tracer.Start(ctx, "CometBlockByHash", trace.WithAttributes(attribute.String("blockHash", blockHash.Hex())))
 	defer func() { evmtrace.EndSpanErr(span, err) }()
 
+	if resBlock, ok := b.Cache.CometBlockByHash(blockHash); ok {
+		return resBlock, nil
+	}
 	resBlock, err := b.RPCClient.BlockByHash(ctx, blockHash.Bytes())
 	if err != nil {
 		b.Logger.Debug("CometBFT client failed to get block", "blockHash", blockHash.Hex(), "error", err.Error())
@@ -90,6 +103,7 @@ func (b *Backend) CometBlockByHash(ctx context.Context, blockHash common.Hash) (
 		return nil, fmt.Errorf("block not found for hash %s", blockHash.Hex())
 	}
 
+	b.Cache.AddCometBlock(resBlock)
 	return resBlock, nil
 }
 
@@ -154,12 +154,19 @@ func (b *Backend) EthBlockFromCometBlock(
 	defer func() { evmtrace.EndSpanErr(span, err) }()
 
 	cmtBlock := resBlock.Block
+	if ethBlock, ok := b.Cache.EthBlock(cmtBlock.Height); ok {
+		return ethBlock, nil
+	}
+
+	// the block is not cached if some of its fields could not be fetched
+	cacheable := true
 
 	// 1. get base fee
 	baseFee, err := b.BaseFee(ctx, blockRes)
 	if err != nil {
 		// handle the error for pruned node.
 		b.Logger.Error("failed to fetch Base Fee from prunned block. Check node prunning configuration", "height", cmtBlock.Height, "error", err)
+		cacheable = false
 	}
 
 	// 2. get miner
@@ -173,6 +180,7 @@ func (b *Backend) EthBlockFromCometBlock(
 	gasLimit, err := rpctypes.BlockMaxGasFromConsensusParams(ctx, b.ClientCtx, cmtBlock.Height)
 	if err != nil {
 		b.Logger.Error("failed to query consensus params", "error", err.Error())
+		cacheable = false
 	}
 
 	// 4. create blockHeader without transactions, receipts, withdrawals, ...
@@ -212,6 +220,10 @@ func (b *Backend) EthBlockFromCometBlock(
 
 	// 9. create eth block
 	ethBlock := ethtypes.NewBlock(ethHeader, body, receipts, trie.NewStackTrie(nil))
+	if cacheable {
+		b.Cache.AddEthBlock(cmtBlock.Height, ethBlock)
+		b.Cache.AddReceipts(cmtBlock.Height, receipts)
+	}
 	return ethBlock, nil
 }
 
@@ -251,6 +263,25 @@ func (b *Backend) MinerFromCometBlock(
 	return common.BytesToAddress(validatorAccAddr), nil
 }
 
+// EthReceiptsFromCometBlock returns the receipts of the EVM transactions of a
+// CometBFT block, from the response cache if available.
+func (b *Backend) EthReceiptsFromCometBlock(
+	ctx context.Context,
+	resBlock *cmtrpctypes.ResultBlock,
+	blockRes *cmtrpctypes.ResultBlockResults,
+) (result []*ethtypes.Receipt, err error) {
+	if receipts, ok := b.Cache.Receipts(resBlock.Block.Height); ok {
+		return receipts, nil
+	}
+	msgs := b.EthMsgsFromCometBlock(ctx, resBlock, blockRes)
+	receipts, err := b.ReceiptsFromCometBlock(ctx, resBlock, blockRes, msgs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get receipts from comet block: %w", err)
+	}
+	b.Cache.AddReceipts(resBlock.Block.Height, receipts)
+	return receipts, nil
+}
+
 func (b *Backend) ReceiptsFromCometBlock(
 	ctx context.Context,
 	resBlock *cmtrpctypes.ResultBlock,
@@ -365,6 +396,10 @@ func (b *Backend) BlockBloomFromCometBlock(ctx context.Context, blockRes *cmtrpc
 	_, span := tracer.Start(ctx, "BlockBloomFromCometBlock")
 	defer func() { evmtrace.EndSpanErr(span, err) }()
 
+	if bloom, ok := b.Cache.Bloom(blockRes.Height); ok {
+		return bloom, nil
+	}
+
 	for _, event := range blockRes.FinalizeBlockEvents {
 		if event.Type != evmtypes.EventTypeBlockBloom {
 			continue
@@ -372,7 +407,9 @@ func (b *Backend) BlockBloomFromCometBlock(ctx context.Context, blockRes *cmtrpc
 
 		for _, attr := range event.Attributes {
 			if attr.Key == evmtypes.AttributeKeyEthereumBloom {
-				return ethtypes.BytesToBloom([]byte(attr.Value)), nil
+				bloom := ethtypes.BytesToBloom([]byte(attr.Value))
+				b.Cache.AddBloom(blockRes.Height, bloom)
+				return bloom, nil
 			}
 		}
 	}
@@ -0,0 +1,218 @@
+package backend
+
+import (
+	"context"
+
+	"github.com/ethereum/go-ethereum/common"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	rpctypes "github.com/cosmos/evm/rpc/types"
+	evmtrace "github.com/cosmos/evm/trace"
+	epixminttypes "github.com/cosmos/evm/x/epixmint/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkquery "github.com/cosmos/cosmos-sdk/types/query"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// cosmosPageLimit is the page size used to fetch all the results of a paginated cosmos query.
+const cosmosPageLimit = 100
+
+// CosmosBalances returns the bank balances of the address in all the denominations.
+func (b *Backend) CosmosBalances(ctx context.Context, address common.Address) (result []rpctypes.CosmosCoin, err error) {
+	ctx, span := tracer.Start(ctx, "CosmosBalances", trace.WithAttributes(attribute.String("address", address.Hex())))
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+
+	var balances sdk.Coins
+	err = paginate(func(page *sdkquery.PageRequest) (*sdkquery.PageResponse, error) {
+		res, err := b.QueryClient.Bank.AllBalances(ctx, &banktypes.QueryAllBalancesRequest{
+			Address:    sdk.AccAddress(address.Bytes()).String(),
+			Pagination: page,
+		})
+		if err != nil {
+			return nil, err
+		}
+		balances = append(balances, res.Balances...)
+		return res.Pagination, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return rpctypes.NewCosmosCoins(balances), nil
+}
+
+// CosmosDelegations returns the staking delegations of the address.
+func (b *Backend) CosmosDelegations(ctx context.Context, address common.Address) (result []rpctypes.CosmosDelegation, err error) {
+	ctx, span := tracer.Start(ctx, "CosmosDelegations", trace.WithAttributes(attribute.String("address", address.Hex())))
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+
+	result = []rpctypes.CosmosDelegation{}
+	err = paginate(func(page *sdkquery.PageRequest) (*sdkquery.PageResponse, error) {
+		res, err := b.QueryClient.Staking.DelegatorDelegations(ctx, &stakingtypes.QueryDelegatorDelegationsRequest{
+			DelegatorAddr: sdk.AccAddress(address.Bytes()).String(),
+			Pagination:    page,
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, delegation := range res.DelegationResponses {
+			result = append(result, rpctypes.NewCosmosDelegation(delegation))
+		}
+		return res.Pagination, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// CosmosDelegationRewards returns the pending distribution rewards of the address delegations.
+func (b *Backend) CosmosDelegationRewards(ctx context.Context, address common.Address) (result *rpctypes.CosmosDelegationRewards, err error) {
+	ctx, span := tracer.Start(ctx, "CosmosDelegationRewards", trace.WithAttributes(attribute.String("address", address.Hex())))
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+
+	res, err := b.QueryClient.Distribution.DelegationTotalRewards(ctx, &distrtypes.QueryDelegationTotalRewardsRequest{
+		DelegatorAddress: sdk.AccAddress(address.Bytes()).String(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return rpctypes.NewCosmosDelegationRewards(res), nil
+}
+
+// CosmosUnbondingDelegations returns the unbonding entries of the address.
+func (b *Backend) CosmosUnbondingDelegations(ctx context.Context, address common.Address) (result []rpctypes.CosmosUnbondingDelegation, err error) {
+	ctx, span := tracer.Start(ctx, "CosmosUnbondingDelegations", trace.WithAttributes(attribute.String("address", address.Hex())))
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+
+	result = []rpctypes.CosmosUnbondingDelegation{}
+	err = paginate(func(page *sdkquery.PageRequest) (*sdkquery.PageResponse, error) {
+		res, err := b.QueryClient.Staking.DelegatorUnbondingDelegations(ctx, &stakingtypes.QueryDelegatorUnbondingDelegationsRequest{
+			DelegatorAddr: sdk.AccAddress(address.Bytes()).String(),
+			Pagination:    page,
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, ubd := range res.UnbondingResponses {
+			result = append(result, rpctypes.NewCosmosUnbondingDelegation(ubd))
+		}
+		return res.Pagination, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// CosmosProposals returns a page of the governance proposals with the status, newest first.
+func (b *Backend) CosmosProposals(ctx context.Context, status govv1.ProposalStatus, offset, limit uint64) (result []rpctypes.CosmosProposal, err error) {
+	ctx, span := tracer.Start(ctx, "CosmosProposals", trace.WithAttributes(attribute.String("status", status.String())))
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+
+	res, err := b.QueryClient.Gov.Proposals(ctx, &govv1.QueryProposalsRequest{
+		ProposalStatus: status,
+		Pagination: &sdkquery.PageRequest{
+			Offset:  offset,
+			Limit:   limit,
+			Reverse: true,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	result = make([]rpctypes.CosmosProposal, len(res.Proposals))
+	for i, proposal := range res.Proposals {
+		result[i] = rpctypes.NewCosmosProposal(proposal)
+	}
+	return result, nil
+}
+
+// CosmosProposal returns the governance proposal with the id.
+func (b *Backend) CosmosProposal(ctx context.Context, id uint64) (result *rpctypes.CosmosProposal, err error) {
+	ctx, span := tracer.Start(ctx, "CosmosProposal", trace.WithAttributes(attribute.Int64("id", int64(id)))) //#nosec G115 -- proposal id won't exceed int64
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+
+	res, err := b.QueryClient.Gov.Proposal(ctx, &govv1.QueryProposalRequest{ProposalId: id})
+	if err != nil {
+		return nil, err
+	}
+	proposal := rpctypes.NewCosmosProposal(res.Proposal)
+	return &proposal, nil
+}
+
+// CosmosInflation returns the current epixmint inflation rate and annual provisions.
+func (b *Backend) CosmosInflation(ctx context.Context) (result *rpctypes.CosmosInflation, err error) {
+	ctx, span := tracer.Start(ctx, "CosmosInflation")
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+
+	inflation, err := b.QueryClient.EpixMint.Inflation(ctx, &epixminttypes.QueryInflationRequest{})
+	if err != nil {
+		return nil, err
+	}
+	provisions, err := b.QueryClient.EpixMint.AnnualProvisions(ctx, &epixminttypes.QueryAnnualProvisionsRequest{})
+	if err != nil {
+		return nil, err
+	}
+	return &rpctypes.CosmosInflation{
+		Inflation:        inflation.Inflation.String(),
+		AnnualProvisions: provisions.AnnualProvisions.String(),
+	}, nil
+}
+
+// CosmosSupply returns the current and the maximum epixmint supply of the mint denomination.
+func (b *Backend) CosmosSupply(ctx context.Context) (result *rpctypes.CosmosSupply, err error) {
+	ctx, span := tracer.Start(ctx, "CosmosSupply")
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+
+	current, err := b.QueryClient.EpixMint.CurrentSupply(ctx, &epixminttypes.QueryCurrentSupplyRequest{})
+	if err != nil {
+		return nil, err
+	}
+	maxSupply, err := b.QueryClient.EpixMint.MaxSupply(ctx, &epixminttypes.QueryMaxSupplyRequest{})
+	if err != nil {
+		return nil, err
+	}
+	return &rpctypes.CosmosSupply{
+		CurrentSupply: current.CurrentSupply.String(),
+		MaxSupply:     maxSupply.MaxSupply.String(),
+	}, nil
+}
+
+// Bech32ToHex converts a bech32 address to its hex representation.
+func (b *Backend) Bech32ToHex(ctx context.Context, bech32 string) (common.Address, error) {
+	res, err := b.QueryClient.QueryClient.Bech32ToHex(ctx, &evmtypes.QueryBech32ToHexRequest{Bech32Address: bech32})
+	if err != nil {
+		return common.Address{}, err
+	}
+	return common.HexToAddress(res.HexAddress), nil
+}
+
+// HexToBech32 converts a hex address to its bech32 representation with the account prefix.
+func (b *Backend) HexToBech32(ctx context.Context, address common.Address) (string, error) {
+	res, err := b.QueryClient.QueryClient.HexToBech32(ctx, &evmtypes.QueryHexToBech32Request{HexAddress: address.Hex()})
+	if err != nil {
+		return "", err
+	}
+	return res.Bech32Address, nil
+}
+
+// paginate calls the query with the next page request until all the pages are fetched.
+func paginate(query func(page *sdkquery.PageRequest) (*sdkquery.PageResponse, error)) error {
+	page := &sdkquery.PageRequest{Limit: cosmosPageLimit}
+	for {
+		res, err := query(page)
+		if err != nil {
+			return err
+		}
+		if res == nil || len(res.NextKey) == 0 {
+			return nil
+		}
+		page = &sdkquery.PageRequest{Key: res.NextKey, Limit: cosmosPageLimit}
+	}
+}
@@ -0,0 +1,127 @@
+package backend
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	rpctypes "github.com/cosmos/evm/rpc/types"
+	servertypes "github.com/cosmos/evm/server/types"
+	evmtrace "github.com/cosmos/evm/trace"
+	feemarkettypes "github.com/cosmos/evm/x/feemarket/types"
+)
+
+// FeeHistoryFromIndexer builds the fee history of the blocks within [blockStart, blockEnd] from the
+// fee records persisted by the custom indexer, so that long windows are served without fetching
+// every block. The fee market params of the newest block are used for the whole window.
+// It returns false if the indexer is disabled or misses the record of a block of the window, in
+// which case the caller must fall back to processing the blocks.
+func (b *Backend) FeeHistoryFromIndexer(
+	ctx context.Context,
+	blockStart, blockEnd int64,
+	rewardPercentiles []float64,
+	pendingRewards bool,
+) (_ *rpctypes.FeeHistoryResult, ok bool, err error) {
+	ctx, span := tracer.Start(ctx, "FeeHistoryFromIndexer", trace.WithAttributes(attribute.Int64("from", blockStart), attribute.Int64("to", blockEnd)))
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+
+	feeIndexer, isFeeIndexer := b.Indexer.(servertypes.EVMFeeHistoryIndexer)
+	if !isFeeIndexer {
+		return nil, false, nil
+	}
+	records, ok, err := feeIndexer.GetBlockFees(blockStart, blockEnd)
+	if err != nil || !ok {
+		return nil, false, err
+	}
+
+	cfg := b.ChainConfig()
+	var feemarketParams *feemarkettypes.Params
+	if cfg.IsLondon(big.NewInt(blockEnd + 1)) {
+		res, err := b.QueryClient.FeeMarket.Params(rpctypes.ContextWithHeight(ctx, blockEnd), &feemarkettypes.QueryParamsRequest{})
+		if err != nil {
+			return nil, false, err
+		}
+		feemarketParams = &res.Params
+	}
+
+	blocks := len(records)
+	rewardCount := len(rewardPercentiles)
+	feeHistory := &rpctypes.FeeHistoryResult{
+		OldestBlock:      (*hexutil.Big)(big.NewInt(blockStart)),
+		BaseFee:          make([]*hexutil.Big, blocks+1),
+		GasUsedRatio:     make([]float64, blocks),
+		BlobBaseFee:      make([]*hexutil.Big, blocks+1),
+		BlobGasUsedRatio: make([]float64, blocks),
+	}
+	if rewardCount != 0 {
+		feeHistory.Reward = make([][]*hexutil.Big, blocks)
+	}
+
+	for i, fees := range records {
+		height := blockStart + int64(i)
+		header := ethtypes.Header{
+			Number:   big.NewInt(height),
+			GasLimit: fees.GasLimit,
+			GasUsed:  fees.GasUsed,
+			Time:     fees.Time,
+			BaseFee:  fees.BaseFee,
+		}
+
+		// the gas used ratio and the next base fee are reported for the EVM fee
+		// lane when fee lanes are enabled
+		laneHeader := header
+		if feemarketParams != nil && feemarketParams.EnableFeeLanes {
+			laneHeader.GasLimit = feemarketParams.EVMLaneGasLimit(fees.GasLimit)
+			laneHeader.GasUsed = fees.EVMGasUsed
+		}
+
+		oneFeeHistory := rpctypes.OneFeeHistory{
+			BaseFee:         fees.BaseFee,
+			NextBaseFee:     new(big.Int),
+			BlobBaseFee:     big.NewInt(0),
+			NextBlobBaseFee: big.NewInt(0),
+			GasUsedRatio:    safeRatio(laneHeader.GasUsed, laneHeader.GasLimit),
+		}
+		if i == blocks-1 && feemarketParams != nil {
+			nextBaseFee, err := rpctypes.CalcBaseFee(cfg, &laneHeader, *feemarketParams)
+			if err != nil {
+				return nil, false, err
+			}
+			oneFeeHistory.NextBaseFee = nextBaseFee
+		}
+		if cfg.IsCancun(header.Number, header.Time) {
+			setBlobFees(cfg, &header, 0, 0, &oneFeeHistory)
+		}
+
+		feeHistory.BaseFee[i] = (*hexutil.Big)(oneFeeHistory.BaseFee)
+		feeHistory.GasUsedRatio[i] = oneFeeHistory.GasUsedRatio
+		feeHistory.BlobBaseFee[i] = (*hexutil.Big)(oneFeeHistory.BlobBaseFee)
+		feeHistory.BlobGasUsedRatio[i] = oneFeeHistory.BlobGasUsedRatio
+		if i == blocks-1 {
+			feeHistory.BaseFee[i+1] = (*hexutil.Big)(oneFeeHistory.NextBaseFee)
+			feeHistory.BlobBaseFee[i+1] = (*hexutil.Big)(oneFeeHistory.NextBlobBaseFee)
+		}
+
+		if rewardCount == 0 {
+			continue
+		}
+		percentiles := rewardPercentiles
+		if pendingRewards && i == blocks-1 {
+			percentiles = congestionPercentiles(rewardPercentiles, b.pendingDepth(fees.GasLimit))
+		}
+		sorter := make(sortGasAndReward, len(fees.Tips))
+		for j, tip := range fees.Tips {
+			sorter[j] = txGasAndReward{gasUsed: tip.GasUsed, reward: tip.Tip}
+		}
+		rewards := blockRewards(sorter, laneHeader.GasUsed, percentiles)
+		feeHistory.Reward[i] = make([]*hexutil.Big, rewardCount)
+		for j, reward := range rewards {
+			feeHistory.Reward[i][j] = (*hexutil.Big)(reward)
+		}
+	}
+	return feeHistory, true, nil
+}
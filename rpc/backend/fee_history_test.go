@@ -0,0 +1,32 @@
+package backend
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestBlockRewards(t *testing.T) {
+	percentiles := []float64{0, 50, 100}
+
+	rewards := blockRewards(nil, 0, percentiles)
+	require.Equal(t, []*big.Int{big.NewInt(0), big.NewInt(0), big.NewInt(0)}, rewards)
+
+	// the tips of the fee records are merged by value, so they must weight the
+	// percentiles the same way as the individual txs of the block
+	txs := sortGasAndReward{
+		{gasUsed: 21000, reward: big.NewInt(3)},
+		{gasUsed: 21000, reward: big.NewInt(1)},
+		{gasUsed: 21000, reward: big.NewInt(1)},
+		{gasUsed: 21000, reward: big.NewInt(2)},
+	}
+	merged := sortGasAndReward{
+		{gasUsed: 42000, reward: big.NewInt(1)},
+		{gasUsed: 21000, reward: big.NewInt(2)},
+		{gasUsed: 21000, reward: big.NewInt(3)},
+	}
+	expected := []*big.Int{big.NewInt(1), big.NewInt(1), big.NewInt(3)}
+	require.Equal(t, expected, blockRewards(txs, 84000, percentiles))
+	require.Equal(t, expected, blockRewards(merged, 84000, percentiles))
+}
@@ -9,6 +9,7 @@ import (
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/trace"
 
+	servertypes "github.com/cosmos/evm/server/types"
 	evmtrace "github.com/cosmos/evm/trace"
 )
 
@@ -45,6 +46,21 @@ func (b *Backend) GetLogsByHeight(ctx context.Context, height *int64) (result []
 	return GetLogsFromBlockResults(blockRes)
 }
 
+// LogBlocksFromIndexer returns the block numbers within [from, to] that contain logs matching
+// the given addresses and topics, using the log index of the custom indexer.
+// It returns false if the indexer is disabled or can't answer the query, in which
+// case the caller must fall back to scanning the blocks.
+func (b *Backend) LogBlocksFromIndexer(ctx context.Context, from, to int64, addresses []common.Address, topics [][]common.Hash) (blocks []int64, ok bool, err error) {
+	_, span := tracer.Start(ctx, "LogBlocksFromIndexer", trace.WithAttributes(attribute.Int64("from", from), attribute.Int64("to", to)))
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+
+	logIndexer, isLogIndexer := b.Indexer.(servertypes.EVMLogIndexer)
+	if !isLogIndexer {
+		return nil, false, nil
+	}
+	return logIndexer.GetLogBlocks(from, to, addresses, topics)
+}
+
 // BloomStatus returns the BloomBitsBlocks and the number of processed sections maintained
 // by the chain indexer.
 func (b *Backend) BloomStatus() (uint64, uint64) {
@@ -0,0 +1,186 @@
+package backend
+
+import (
+	"context"
+	"math/big"
+	"sort"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"golang.org/x/sync/errgroup"
+
+	"github.com/cosmos/evm/mempool/txpool"
+	rpctypes "github.com/cosmos/evm/rpc/types"
+	feemarkettypes "github.com/cosmos/evm/x/feemarket/types"
+)
+
+const (
+	// oracleSampleNumber is the number of the lowest tips sampled from each block,
+	// a block is full when its cheapest transactions still had to pay these tips.
+	oracleSampleNumber = 3
+	// oracleBlockFetchers is the number of blocks fetched concurrently by the oracle.
+	oracleBlockFetchers = 4
+)
+
+// gasPriceOracle caches the tip suggested for the latest block, it is
+// recomputed once per block.
+type gasPriceOracle struct {
+	mu         sync.Mutex
+	lastHeight int64
+	lastTip    *big.Int
+}
+
+// suggestTipCap returns the tip at the configured percentile of the effective tips
+// paid in the recent blocks, raised when the pending transactions of the mempool
+// exceed the block gas limit. It returns nil if the recent blocks have no transactions.
+func (b *Backend) suggestTipCap(ctx context.Context) (*big.Int, error) {
+	blockNumber, err := b.BlockNumber(ctx)
+	if err != nil {
+		return nil, err
+	}
+	head := int64(blockNumber) //#nosec G115 -- block height won't exceed int64
+
+	b.gasOracle.mu.Lock()
+	defer b.gasOracle.mu.Unlock()
+	if b.gasOracle.lastTip != nil && b.gasOracle.lastHeight == head {
+		return new(big.Int).Set(b.gasOracle.lastTip), nil
+	}
+
+	blocks := int64(b.Cfg.JSONRPC.GasPriceOracleBlocks)
+	if blocks > head {
+		blocks = head
+	}
+	samples := make([][]*big.Int, blocks)
+	var headBlock *ethtypes.Block
+
+	var g errgroup.Group
+	g.SetLimit(oracleBlockFetchers)
+	for i := int64(0); i < blocks; i++ {
+		g.Go(func() error {
+			block, err := b.EthBlockByNumber(ctx, rpctypes.BlockNumber(head-i))
+			if err != nil {
+				// the block may be pruned, sample the remaining ones
+				b.Logger.Debug("gas price oracle failed to fetch block", "height", head-i, "error", err.Error())
+				return nil
+			}
+			if i == 0 {
+				headBlock = block
+			}
+			samples[i] = blockTipSamples(block)
+			return nil
+		})
+	}
+	_ = g.Wait()
+
+	var tips []*big.Int
+	for _, blockTips := range samples {
+		tips = append(tips, blockTips...)
+	}
+	if len(tips) == 0 {
+		return nil, nil
+	}
+	sort.Slice(tips, func(i, j int) bool { return tips[i].Cmp(tips[j]) < 0 })
+
+	percentile := float64(b.Cfg.JSONRPC.GasPriceOraclePercentile)
+	if headBlock != nil {
+		percentile = congestionPercentile(percentile, b.pendingDepth(headBlock.GasLimit()))
+	}
+	tip := new(big.Int).Set(tips[int(float64(len(tips)-1)*percentile/100)])
+	if maxTip := b.Cfg.JSONRPC.GasPriceOracleMaxTip; maxTip > 0 && tip.Cmp(new(big.Int).SetUint64(maxTip)) > 0 {
+		tip.SetUint64(maxTip)
+	}
+
+	b.gasOracle.lastHeight = head
+	b.gasOracle.lastTip = tip
+	return new(big.Int).Set(tip), nil
+}
+
+// maxBaseFeeDelta returns the maximum base fee increase of the next block, assuming
+// all the block gas limit is consumed. It is suggested as tip when the recent blocks
+// have no transactions to sample.
+func (b *Backend) maxBaseFeeDelta(ctx context.Context, baseFee *big.Int) (*big.Int, error) {
+	params, err := b.QueryClient.FeeMarket.Params(ctx, &feemarkettypes.QueryParamsRequest{})
+	if err != nil {
+		return nil, err
+	}
+	// calculate the maximum base fee delta in current block, assuming all block gas limit is consumed
+	// ```
+	// GasTarget = GasLimit / ElasticityMultiplier
+	// Delta = BaseFee * (GasUsed - GasTarget) / GasTarget / Denominator
+	// ```
+	// The delta is at maximum when `GasUsed` is equal to `GasLimit`, which is:
+	// ```
+	// MaxDelta = BaseFee * (GasLimit - GasLimit / ElasticityMultiplier) / (GasLimit / ElasticityMultiplier) / Denominator
+	//          = BaseFee * (ElasticityMultiplier - 1) / Denominator
+	// ```t
+	maxDelta := baseFee.Int64() * (int64(params.Params.ElasticityMultiplier) - 1) / int64(params.Params.BaseFeeChangeDenominator) // #nosec G115
+	if maxDelta < 0 {
+		// impossible if the parameter validation passed.
+		maxDelta = 0
+	}
+	return big.NewInt(maxDelta), nil
+}
+
+// pendingDepth returns the number of blocks of the given gas limit needed to include
+// the pending transactions of the mempool.
+func (b *Backend) pendingDepth(gasLimit uint64) float64 {
+	if b.Mempool == nil || gasLimit == 0 {
+		return 0
+	}
+	var pendingGas uint64
+	for _, txs := range b.Mempool.GetTxPool().Pending(txpool.PendingFilter{}) {
+		for _, tx := range txs {
+			pendingGas += tx.Gas
+		}
+	}
+	return float64(pendingGas) / float64(gasLimit)
+}
+
+// congestionPercentile raises the percentile towards 100 when more than one block
+// is needed to include the pending transactions: the pending transactions compete
+// for the block space, so the cheapest ones are left in the mempool.
+func congestionPercentile(percentile, depth float64) float64 {
+	if depth <= 1 {
+		return percentile
+	}
+	return percentile + (100-percentile)*(1-1/depth)
+}
+
+// congestionPercentiles applies congestionPercentile to each of the percentiles.
+func congestionPercentiles(percentiles []float64, depth float64) []float64 {
+	result := make([]float64, len(percentiles))
+	for i, p := range percentiles {
+		result[i] = congestionPercentile(p, depth)
+	}
+	return result
+}
+
+// blockTipSamples returns the lowest effective tips paid by the transactions of the
+// block, excluding the transactions of the block proposer.
+func blockTipSamples(block *ethtypes.Block) []*big.Int {
+	baseFee := block.BaseFee()
+	if baseFee == nil {
+		baseFee = common.Big0
+	}
+	var tips []*big.Int
+	for _, tx := range block.Transactions() {
+		var signer ethtypes.Signer = ethtypes.FrontierSigner{}
+		if tx.Protected() {
+			signer = ethtypes.LatestSignerForChainID(tx.ChainId())
+		}
+		if sender, err := ethtypes.Sender(signer, tx); err == nil && sender == block.Coinbase() {
+			continue
+		}
+		tip, err := tx.EffectiveGasTip(baseFee)
+		if err != nil || tip.Sign() < 0 {
+			continue
+		}
+		tips = append(tips, tip)
+	}
+	sort.Slice(tips, func(i, j int) bool { return tips[i].Cmp(tips[j]) < 0 })
+	if len(tips) > oracleSampleNumber {
+		tips = tips[:oracleSampleNumber]
+	}
+	return tips
+}
@@ -0,0 +1,79 @@
+package backend
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/trie"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCongestionPercentile(t *testing.T) {
+	testCases := []struct {
+		name       string
+		percentile float64
+		depth      float64
+		exp        float64
+	}{
+		{"empty mempool", 60, 0, 60},
+		{"mempool fits in a block", 60, 1, 60},
+		{"two blocks of pending txs", 60, 2, 80},
+		{"four blocks of pending txs", 60, 4, 90},
+		{"maximum percentile", 100, 10, 100},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.InDelta(t, tc.exp, congestionPercentile(tc.percentile, tc.depth), 1e-9)
+		})
+	}
+
+	require.Equal(t, []float64{60, 80}, congestionPercentiles([]float64{20, 60}, 2))
+}
+
+func TestBlockTipSamples(t *testing.T) {
+	chainID := big.NewInt(9001)
+	signer := ethtypes.LatestSignerForChainID(chainID)
+	baseFee := big.NewInt(100)
+
+	proposerKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	senderKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+
+	newTx := func(nonce uint64, tip int64, proposer bool) *ethtypes.Transaction {
+		key := senderKey
+		if proposer {
+			key = proposerKey
+		}
+		return ethtypes.MustSignNewTx(key, signer, &ethtypes.DynamicFeeTx{
+			ChainID:   chainID,
+			Nonce:     nonce,
+			GasTipCap: big.NewInt(tip),
+			GasFeeCap: new(big.Int).Add(baseFee, big.NewInt(tip)),
+			Gas:       21000,
+			To:        &common.Address{},
+		})
+	}
+
+	header := &ethtypes.Header{
+		Number:   big.NewInt(1),
+		BaseFee:  baseFee,
+		Coinbase: crypto.PubkeyToAddress(proposerKey.PublicKey),
+	}
+	txs := []*ethtypes.Transaction{
+		newTx(0, 50, false),
+		newTx(1, 10, false),
+		newTx(0, 0, true), // proposer tx is ignored
+		newTx(2, 40, false),
+		newTx(3, 20, false),
+	}
+	block := ethtypes.NewBlock(header, &ethtypes.Body{Transactions: txs}, nil, trie.NewStackTrie(nil))
+
+	require.Equal(t, []*big.Int{big.NewInt(10), big.NewInt(20), big.NewInt(40)}, blockTipSamples(block))
+
+	empty := ethtypes.NewBlock(header, &ethtypes.Body{}, nil, trie.NewStackTrie(nil))
+	require.Empty(t, blockTipSamples(empty))
+}
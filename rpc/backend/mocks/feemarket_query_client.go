@@ -173,6 +173,228 @@ func (_c *FeeMarketQueryClient_BlockGas_Call) RunAndReturn(run func(context.Cont
 	return _c
 }
 
+// BurnedFees provides a mock function with given fields: ctx, in, opts
+func (_m *FeeMarketQueryClient) BurnedFees(ctx context.Context, in *types.QueryBurnedFeesRequest, opts ...grpc.CallOption) (*types.QueryBurnedFeesResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BurnedFees")
+	}
+
+	var r0 *types.QueryBurnedFeesResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryBurnedFeesRequest, ...grpc.CallOption) (*types.QueryBurnedFeesResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryBurnedFeesRequest, ...grpc.CallOption) *types.QueryBurnedFeesResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.QueryBurnedFeesResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *types.QueryBurnedFeesRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FeeMarketQueryClient_BurnedFees_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BurnedFees'
+type FeeMarketQueryClient_BurnedFees_Call struct {
+	*mock.Call
+}
+
+// BurnedFees is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *types.QueryBurnedFeesRequest
+//   - opts ...grpc.CallOption
+func (_e *FeeMarketQueryClient_Expecter) BurnedFees(ctx interface{}, in interface{}, opts ...interface{}) *FeeMarketQueryClient_BurnedFees_Call {
+	return &FeeMarketQueryClient_BurnedFees_Call{Call: _e.mock.On("BurnedFees",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *FeeMarketQueryClient_BurnedFees_Call) Run(run func(ctx context.Context, in *types.QueryBurnedFeesRequest, opts ...grpc.CallOption)) *FeeMarketQueryClient_BurnedFees_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*types.QueryBurnedFeesRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *FeeMarketQueryClient_BurnedFees_Call) Return(_a0 *types.QueryBurnedFeesResponse, _a1 error) *FeeMarketQueryClient_BurnedFees_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *FeeMarketQueryClient_BurnedFees_Call) RunAndReturn(run func(context.Context, *types.QueryBurnedFeesRequest, ...grpc.CallOption) (*types.QueryBurnedFeesResponse, error)) *FeeMarketQueryClient_BurnedFees_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CosmosBaseFee provides a mock function with given fields: ctx, in, opts
+func (_m *FeeMarketQueryClient) CosmosBaseFee(ctx context.Context, in *types.QueryCosmosBaseFeeRequest, opts ...grpc.CallOption) (*types.QueryCosmosBaseFeeResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CosmosBaseFee")
+	}
+
+	var r0 *types.QueryCosmosBaseFeeResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryCosmosBaseFeeRequest, ...grpc.CallOption) (*types.QueryCosmosBaseFeeResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryCosmosBaseFeeRequest, ...grpc.CallOption) *types.QueryCosmosBaseFeeResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.QueryCosmosBaseFeeResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *types.QueryCosmosBaseFeeRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FeeMarketQueryClient_CosmosBaseFee_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CosmosBaseFee'
+type FeeMarketQueryClient_CosmosBaseFee_Call struct {
+	*mock.Call
+}
+
+// CosmosBaseFee is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *types.QueryCosmosBaseFeeRequest
+//   - opts ...grpc.CallOption
+func (_e *FeeMarketQueryClient_Expecter) CosmosBaseFee(ctx interface{}, in interface{}, opts ...interface{}) *FeeMarketQueryClient_CosmosBaseFee_Call {
+	return &FeeMarketQueryClient_CosmosBaseFee_Call{Call: _e.mock.On("CosmosBaseFee",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *FeeMarketQueryClient_CosmosBaseFee_Call) Run(run func(ctx context.Context, in *types.QueryCosmosBaseFeeRequest, opts ...grpc.CallOption)) *FeeMarketQueryClient_CosmosBaseFee_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*types.QueryCosmosBaseFeeRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *FeeMarketQueryClient_CosmosBaseFee_Call) Return(_a0 *types.QueryCosmosBaseFeeResponse, _a1 error) *FeeMarketQueryClient_CosmosBaseFee_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *FeeMarketQueryClient_CosmosBaseFee_Call) RunAndReturn(run func(context.Context, *types.QueryCosmosBaseFeeRequest, ...grpc.CallOption) (*types.QueryCosmosBaseFeeResponse, error)) *FeeMarketQueryClient_CosmosBaseFee_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MsgFeeFloors provides a mock function with given fields: ctx, in, opts
+func (_m *FeeMarketQueryClient) MsgFeeFloors(ctx context.Context, in *types.QueryMsgFeeFloorsRequest, opts ...grpc.CallOption) (*types.QueryMsgFeeFloorsResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MsgFeeFloors")
+	}
+
+	var r0 *types.QueryMsgFeeFloorsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryMsgFeeFloorsRequest, ...grpc.CallOption) (*types.QueryMsgFeeFloorsResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryMsgFeeFloorsRequest, ...grpc.CallOption) *types.QueryMsgFeeFloorsResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.QueryMsgFeeFloorsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *types.QueryMsgFeeFloorsRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FeeMarketQueryClient_MsgFeeFloors_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MsgFeeFloors'
+type FeeMarketQueryClient_MsgFeeFloors_Call struct {
+	*mock.Call
+}
+
+// MsgFeeFloors is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *types.QueryMsgFeeFloorsRequest
+//   - opts ...grpc.CallOption
+func (_e *FeeMarketQueryClient_Expecter) MsgFeeFloors(ctx interface{}, in interface{}, opts ...interface{}) *FeeMarketQueryClient_MsgFeeFloors_Call {
+	return &FeeMarketQueryClient_MsgFeeFloors_Call{Call: _e.mock.On("MsgFeeFloors",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *FeeMarketQueryClient_MsgFeeFloors_Call) Run(run func(ctx context.Context, in *types.QueryMsgFeeFloorsRequest, opts ...grpc.CallOption)) *FeeMarketQueryClient_MsgFeeFloors_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*types.QueryMsgFeeFloorsRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *FeeMarketQueryClient_MsgFeeFloors_Call) Return(_a0 *types.QueryMsgFeeFloorsResponse, _a1 error) *FeeMarketQueryClient_MsgFeeFloors_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *FeeMarketQueryClient_MsgFeeFloors_Call) RunAndReturn(run func(context.Context, *types.QueryMsgFeeFloorsRequest, ...grpc.CallOption) (*types.QueryMsgFeeFloorsResponse, error)) *FeeMarketQueryClient_MsgFeeFloors_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Params provides a mock function with given fields: ctx, in, opts
 func (_m *FeeMarketQueryClient) Params(ctx context.Context, in *types.QueryParamsRequest, opts ...grpc.CallOption) (*types.QueryParamsResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -305,7 +305,7 @@ func (b *Backend) GenerateMinGasCoin(gasPrice hexutil.Big, appConf config.Config
 
 // UnprotectedAllowed returns the node configuration value for allowing
 // unprotected transactions (i.e not replay-protected)
-func (b Backend) UnprotectedAllowed() bool {
+func (b *Backend) UnprotectedAllowed() bool {
 	return b.AllowUnprotectedTxs
 }
 
@@ -0,0 +1,159 @@
+package backend
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/trie"
+
+	evmmempool "github.com/cosmos/evm/mempool"
+	rpctypes "github.com/cosmos/evm/rpc/types"
+	evmtrace "github.com/cosmos/evm/trace"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+)
+
+// PendingStateCache caches the pending state of the mempool. It is rebuilt in
+// the background once the mempool content or the latest block changes, a single
+// rebuild runs at a time. The backends of a node share the cache, so that the
+// pending state is built once for all of them.
+type PendingStateCache struct {
+	mu       sync.Mutex
+	revision uint64
+	state    *evmmempool.PendingState
+	err      error
+	// building is closed once the rebuild in progress is done, nil if none runs.
+	building chan struct{}
+}
+
+// NewPendingStateCache creates an empty pending state cache.
+func NewPendingStateCache() *PendingStateCache {
+	return &PendingStateCache{}
+}
+
+// pendingState returns the state built by applying the executable transactions of
+// the mempool on top of the latest state if the block number is "pending". It
+// returns nil for the other block numbers, or if the mempool can't build the
+// pending state, in which case the latest state is used.
+//
+// While the pending state is rebuilt, the previous one is returned if it is built
+// on the latest block, the requests only wait for the rebuild after a new block.
+func (b *Backend) pendingState(ctx context.Context, blockNum rpctypes.BlockNumber) (result *evmmempool.PendingState, err error) {
+	if blockNum != rpctypes.EthPendingBlockNumber || b.Mempool == nil || b.pending == nil {
+		return nil, nil
+	}
+
+	_, span := tracer.Start(ctx, "pendingState")
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+
+	revision := b.Mempool.Revision()
+
+	b.pending.mu.Lock()
+	if b.pending.revision == revision && (b.pending.state != nil || b.pending.err != nil) {
+		state, err := b.pending.state, b.pending.err
+		b.pending.mu.Unlock()
+		return pendingStateResult(state, err)
+	}
+	if b.pending.building == nil {
+		b.pending.building = make(chan struct{})
+		go b.buildPendingState(revision, b.pending.building)
+	}
+	building, previous := b.pending.building, b.pending.state
+	b.pending.mu.Unlock()
+
+	if previous != nil && previous.Height == b.Mempool.LatestHeight() {
+		return previous, nil
+	}
+
+	select {
+	case <-building:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+
+	b.pending.mu.Lock()
+	defer b.pending.mu.Unlock()
+	return pendingStateResult(b.pending.state, b.pending.err)
+}
+
+// buildPendingState rebuilds the pending state of the mempool revision and closes
+// done once the cache is updated.
+func (b *Backend) buildPendingState(revision uint64, done chan struct{}) {
+	state, err := b.Mempool.PendingState(b.Cfg.JSONRPC.PendingStateMaxTxs, b.Cfg.JSONRPC.PendingStateMaxGas)
+	if err != nil && !errors.Is(err, evmmempool.ErrPendingStateNotSupported) {
+		b.Logger.Debug("failed to build the pending state", "error", err.Error())
+	}
+
+	b.pending.mu.Lock()
+	defer b.pending.mu.Unlock()
+	b.pending.revision = revision
+	b.pending.state = state
+	b.pending.err = err
+	b.pending.building = nil
+	close(done)
+}
+
+// pendingStateResult returns the cached pending state, the latest state is used
+// if the mempool doesn't support the pending state.
+func pendingStateResult(state *evmmempool.PendingState, err error) (*evmmempool.PendingState, error) {
+	if errors.Is(err, evmmempool.ErrPendingStateNotSupported) {
+		return nil, nil
+	}
+	return state, err
+}
+
+// pendingBlock returns the pending block built on top of the latest block with the
+// transactions of the pending state. Like geth, the hash, nonce and miner of the
+// pending block are null.
+func (b *Backend) pendingBlock(ctx context.Context, state *evmmempool.PendingState, fullTx bool) (map[string]interface{}, error) {
+	resBlock, err := b.CometBlockByNumber(ctx, rpctypes.BlockNumber(state.Height))
+	if err != nil || resBlock == nil || resBlock.Block == nil {
+		return nil, err
+	}
+	blockRes, err := b.CometBlockResultByNumber(ctx, &resBlock.Block.Height)
+	if err != nil {
+		return nil, err
+	}
+	latest, err := b.EthBlockFromCometBlock(ctx, resBlock, blockRes)
+	if err != nil {
+		return nil, err
+	}
+
+	header := ethtypes.CopyHeader(latest.Header())
+	header.Number = new(big.Int).Add(header.Number, big.NewInt(1))
+	header.ParentHash = common.BytesToHash(resBlock.BlockID.Hash)
+	header.GasUsed = state.GasUsed
+	header.Coinbase = common.Address{}
+
+	receipts := make([]*ethtypes.Receipt, len(state.Txs))
+	var cumulativeGasUsed uint64
+	for i, tx := range state.Txs {
+		res := state.Results[i]
+		cumulativeGasUsed += res.GasUsed
+
+		status := ethtypes.ReceiptStatusSuccessful
+		if res.Failed() {
+			status = ethtypes.ReceiptStatusFailed
+		}
+		receipts[i] = &ethtypes.Receipt{
+			Type:              tx.Type(),
+			Status:            status,
+			CumulativeGasUsed: cumulativeGasUsed,
+			Logs:              evmtypes.LogsToEthereum(res.Logs),
+			TxHash:            tx.Hash(),
+			GasUsed:           res.GasUsed,
+		}
+	}
+
+	body := &ethtypes.Body{
+		Transactions: state.Txs,
+		Uncles:       []*ethtypes.Header{},
+		Withdrawals:  []*ethtypes.Withdrawal{},
+	}
+	block := ethtypes.NewBlock(header, body, receipts, trie.NewStackTrie(nil))
+
+	return rpctypes.RPCMarshalPendingBlock(block, fullTx, b.ChainConfig()), nil
+}
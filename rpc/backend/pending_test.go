@@ -0,0 +1,23 @@
+package backend
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	rpctypes "github.com/cosmos/evm/rpc/types"
+)
+
+func TestPendingStateWithoutMempool(t *testing.T) {
+	b := &Backend{}
+
+	// the latest state is used if the mempool is not enabled
+	state, err := b.pendingState(context.Background(), rpctypes.EthPendingBlockNumber)
+	require.NoError(t, err)
+	require.Nil(t, state)
+
+	state, err = b.pendingState(context.Background(), rpctypes.EthLatestBlockNumber)
+	require.NoError(t, err)
+	require.Nil(t, state)
+}
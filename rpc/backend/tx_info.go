@@ -59,7 +59,7 @@ func (b *Backend) GetTransactionByHash(ctx context.Context, txHash common.Hash)
 		return nil, errors.New("invalid ethereum tx")
 	}
 
-	blockRes, err := b.RPCClient.BlockResults(ctx, &block.Block.Height)
+	blockRes, err := b.CometBlockResultByNumber(ctx, &block.Block.Height)
 	if err != nil {
 		b.Logger.Debug("block result not found", "height", block.Block.Height, "error", err.Error())
 		return nil, fmt.Errorf("block result not found: %w", err)
@@ -198,16 +198,20 @@ func (b *Backend) GetTransactionReceipt(ctx context.Context, hash common.Hash) (
 		return nil, fmt.Errorf("failed to decode tx: %w", err)
 	}
 
-	blockRes, err := b.RPCClient.BlockResults(ctx, &res.Height)
+	blockRes, err := b.CometBlockResultByNumber(ctx, &res.Height)
 	if err != nil {
 		b.Logger.Debug("failed to retrieve block results", "height", res.Height, "error", err.Error())
 		return nil, fmt.Errorf("block result not found at height %d: %w", res.Height, err)
 	}
 
 	ethMsg := tx.GetMsgs()[res.MsgIndex].(*evmtypes.MsgEthereumTx)
-	receipts, err := b.ReceiptsFromCometBlock(ctx, resBlock, blockRes, []*evmtypes.MsgEthereumTx{ethMsg})
-	if err != nil {
-		return nil, fmt.Errorf("failed to get receipts from comet block")
+	receipt := cachedReceipt(b.Cache, res.Height, hash)
+	if receipt == nil {
+		receipts, err := b.ReceiptsFromCometBlock(ctx, resBlock, blockRes, []*evmtypes.MsgEthereumTx{ethMsg})
+		if err != nil {
+			return nil, fmt.Errorf("failed to get receipts from comet block")
+		}
+		receipt = receipts[0]
 	}
 
 	var signer ethtypes.Signer
@@ -222,7 +226,22 @@ func (b *Backend) GetTransactionReceipt(ctx context.Context, hash common.Hash) (
 		return nil, fmt.Errorf("failed to get sender: %w", err)
 	}
 
-	return rpctypes.RPCMarshalReceipt(receipts[0], ethTx, from)
+	return rpctypes.RPCMarshalReceipt(receipt, ethTx, from)
+}
+
+// cachedReceipt returns the receipt of the transaction from the cached receipts of the
+// block, or nil if they are not cached.
+func cachedReceipt(cache *ResponseCache, height int64, hash common.Hash) *ethtypes.Receipt {
+	receipts, ok := cache.Receipts(height)
+	if !ok {
+		return nil
+	}
+	for _, receipt := range receipts {
+		if receipt.TxHash == hash {
+			return receipt
+		}
+	}
+	return nil
 }
 
 // GetTransactionLogs returns the transaction logs identified by hash.
@@ -243,7 +262,7 @@ func (b *Backend) GetTransactionLogs(ctx context.Context, hash common.Hash) (res
 		return nil, nil
 	}
 
-	resBlockResult, err := b.RPCClient.BlockResults(ctx, &res.Height)
+	resBlockResult, err := b.CometBlockResultByNumber(ctx, &res.Height)
 	if err != nil {
 		b.Logger.Debug("block result not found", "number", res.Height, "error", err.Error())
 		return nil, nil
@@ -396,7 +415,7 @@ func (b *Backend) GetTransactionByBlockAndIndex(ctx context.Context, block *cmtr
 	ctx, span := tracer.Start(ctx, "GetTransactionByBlockAndIndex", trace.WithAttributes(attribute.Int64("blockHeight", block.Block.Height), attribute.Int64("idx", int64(idx))))
 	defer func() { evmtrace.EndSpanErr(span, err) }()
 
-	blockRes, err := b.RPCClient.BlockResults(ctx, &block.Block.Height)
+	blockRes, err := b.CometBlockResultByNumber(ctx, &block.Block.Height)
 	if err != nil {
 		return nil, nil
 	}
@@ -68,7 +68,7 @@ func setupMockBackend(t *testing.T) *Backend {
 	allowUnprotectedTxs := false
 	idxer := indexer.NewKVIndexer(dbm.NewMemDB(), ctx.Logger, clientCtx)
 
-	backend := NewBackend(ctx, ctx.Logger, clientCtx, allowUnprotectedTxs, idxer, nil)
+	backend := NewBackend(ctx, ctx.Logger, clientCtx, allowUnprotectedTxs, idxer, nil, nil, nil)
 	backend.Cfg.JSONRPC.GasCap = 25000000
 	backend.Cfg.JSONRPC.EVMTimeout = 0
 	backend.Cfg.JSONRPC.AllowInsecureUnlock = true
@@ -430,6 +430,10 @@ func (m *MockIndexer) LastIndexedBlock() (int64, error) {
 	return 0, nil
 }
 
+func (m *MockIndexer) FirstIndexedBlock() (int64, error) {
+	return 0, nil
+}
+
 func (m *MockIndexer) IndexBlock(block *tmtypes.Block, txResults []*abcitypes.ExecTxResult) error {
 	return nil
 }
@@ -11,8 +11,13 @@ import (
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/trace"
 
+	cmttypes "github.com/cometbft/cometbft/types"
+
+	evmmempool "github.com/cosmos/evm/mempool"
 	"github.com/cosmos/evm/rpc/types"
 	evmtrace "github.com/cosmos/evm/trace"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 const (
@@ -181,3 +186,61 @@ func (b *Backend) Status(_ context.Context) (map[string]hexutil.Uint, error) {
 		StatusQueued:  hexutil.Uint(queued),  // #nosec G115 -- overflow not a concern for tx counts, as the mempool will limit far before this number is hit. This is taken directly from Geth.
 	}, nil
 }
+
+// CosmosContent returns the Cosmos transactions of the mempool, grouped by signer
+// and indexed by sequence.
+func (b *Backend) CosmosContent(ctx context.Context) (result map[string]map[string]*types.CosmosPoolTransaction, err error) {
+	ctx, span := tracer.Start(ctx, "CosmosContent")
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+
+	content := make(map[string]map[string]*types.CosmosPoolTransaction)
+
+	// Get the global mempool instance
+	evmMempool := b.Mempool
+	if evmMempool == nil {
+		return content, nil
+	}
+
+	encoder := b.ClientCtx.TxConfig.TxEncoder()
+	for signer, txs := range evmMempool.CosmosContent(ctx) {
+		dump := make(map[string]*types.CosmosPoolTransaction, len(txs))
+		for sequence, tx := range txs {
+			txBytes, err := encoder(tx)
+			if err != nil {
+				return content, fmt.Errorf("failed to encode Cosmos transaction: %w", err)
+			}
+			poolTx := &types.CosmosPoolTransaction{
+				Hash:     common.BytesToHash(cmttypes.Tx(txBytes).Hash()),
+				Messages: make([]string, 0, len(tx.GetMsgs())),
+			}
+			if feeTx, ok := tx.(sdk.FeeTx); ok {
+				poolTx.Gas = hexutil.Uint64(feeTx.GetGas())
+				poolTx.Fee = types.NewCosmosCoins(feeTx.GetFee())
+			}
+			for _, msg := range tx.GetMsgs() {
+				poolTx.Messages = append(poolTx.Messages, sdk.MsgTypeURL(msg))
+			}
+			dump[strconv.FormatUint(sequence, 10)] = poolTx
+		}
+		content[signer] = dump
+	}
+
+	return content, nil
+}
+
+// Dropped returns why the transaction with the given hash, the Ethereum hash of an
+// EVM transaction or the CometBFT hash of a Cosmos transaction, was recently
+// rejected by or removed from the mempool, or nil if it wasn't.
+func (b *Backend) Dropped(ctx context.Context, hash common.Hash) (result *evmmempool.DroppedTx, err error) {
+	_, span := tracer.Start(ctx, "Dropped", trace.WithAttributes(attribute.String("hash", hash.Hex())))
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+
+	if b.Mempool == nil {
+		return nil, nil
+	}
+	dropped, ok := b.Mempool.DroppedTx(hash)
+	if !ok {
+		return nil, nil
+	}
+	return &dropped, nil
+}
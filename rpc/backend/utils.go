@@ -12,6 +12,7 @@ import (
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/consensus/misc/eip4844"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/params"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
@@ -183,66 +184,14 @@ func (b *Backend) ProcessBlock(
 	targetOneFeeHistory.NextBlobBaseFee = big.NewInt(0)
 	targetOneFeeHistory.BlobGasUsedRatio = 0
 
-	if cfg.IsLondon(big.NewInt(blockHeight + 1)) {
-		ctx = types.ContextWithHeight(ctx, blockHeight)
-		params, err := b.QueryClient.FeeMarket.Params(ctx, &feemarkettypes.QueryParamsRequest{})
-		if err != nil {
-			return err
-		}
-		nextBaseFee, err := types.CalcBaseFee(cfg, &header, params.Params)
-		if err != nil {
-			return err
-		}
-		targetOneFeeHistory.NextBaseFee = nextBaseFee
-	} else {
-		targetOneFeeHistory.NextBaseFee = new(big.Int)
-	}
-	if cfg.IsCancun(header.Number, header.Time) {
-		blobGasUsed := uint64(0)
-		if val, ok := (*ethBlock)["blobGasUsed"].(hexutil.Uint64); ok {
-			blobGasUsed = uint64(val)
-		}
-		excessBlobGas := uint64(0)
-		if val, ok := (*ethBlock)["excessBlobGas"].(hexutil.Uint64); ok {
-			excessBlobGas = uint64(val)
-		}
-		header.BlobGasUsed = new(uint64)
-		*header.BlobGasUsed = blobGasUsed
-		header.ExcessBlobGas = new(uint64)
-		*header.ExcessBlobGas = excessBlobGas
-
-		targetOneFeeHistory.BlobBaseFee = eip4844.CalcBlobFee(cfg, &header)
-		nextExcess := eip4844.CalcExcessBlobGas(cfg, &header, header.Time)
-		nextHeader := &ethtypes.Header{
-			Number:        header.Number,
-			Time:          header.Time,
-			ExcessBlobGas: &nextExcess,
-		}
-		targetOneFeeHistory.NextBlobBaseFee = eip4844.CalcBlobFee(cfg, nextHeader)
-
-		maxBlobGas := eip4844.MaxBlobGasPerBlock(cfg, header.Time)
-		targetOneFeeHistory.BlobGasUsedRatio = safeRatio(blobGasUsed, maxBlobGas)
-	}
-	if gasLimitUint64 <= 0 {
-		return fmt.Errorf("gasLimit of block height %d should be bigger than 0 , current gaslimit %d", blockHeight, gasLimitUint64)
-	}
-
-	gasUsedUint64 := gasUsedInt.Uint64()
-	targetOneFeeHistory.GasUsedRatio = safeRatio(gasUsedUint64, uint64(gasLimitUint64))
-	blockGasUsed := float64(gasUsedUint64)
-
-	rewardCount := len(rewardPercentiles)
-	targetOneFeeHistory.Reward = make([]*big.Int, rewardCount)
-	for i := 0; i < rewardCount; i++ {
-		targetOneFeeHistory.Reward[i] = big.NewInt(0)
-	}
-
 	// check cometTxs
 	cometTxs := cometBlock.Block.Txs
 	cometTxResults := cometBlockResult.TxsResults
 	CometTxCount := len(cometTxs)
 
 	var sorter sortGasAndReward
+	// gas used by the transactions of the EVM fee lane
+	evmGasUsed := uint64(0)
 
 	for i := 0; i < CometTxCount; i++ {
 		cometTx := cometTxs[i]
@@ -254,6 +203,7 @@ func (b *Backend) ProcessBlock(
 			continue
 		}
 		txGasUsed := uint64(cometTxResult.GasUsed) // #nosec G115
+		isEthTx := false
 		for _, msg := range tx.GetMsgs() {
 			ethMsg, ok := msg.(*evmtypes.MsgEthereumTx)
 			if !ok {
@@ -268,17 +218,95 @@ func (b *Backend) ProcessBlock(
 				reward = big.NewInt(0)
 			}
 			sorter = append(sorter, txGasAndReward{gasUsed: txGasUsed, reward: reward})
+			isEthTx = true
+		}
+		if isEthTx {
+			evmGasUsed += txGasUsed
 		}
 	}
 
-	// return an all zero row if there are no transactions to gather data from
+	// the gas used ratio and the next base fee are reported for the EVM fee
+	// lane when fee lanes are enabled
+	laneGasLimit := uint64(gasLimitUint64)
+	laneGasUsed := gasUsedInt.Uint64()
+
+	if cfg.IsLondon(big.NewInt(blockHeight + 1)) {
+		ctx = types.ContextWithHeight(ctx, blockHeight)
+		params, err := b.QueryClient.FeeMarket.Params(ctx, &feemarkettypes.QueryParamsRequest{})
+		if err != nil {
+			return err
+		}
+		if params.Params.EnableFeeLanes {
+			laneGasLimit = params.Params.EVMLaneGasLimit(laneGasLimit)
+			laneGasUsed = evmGasUsed
+		}
+		laneHeader := header
+		laneHeader.GasUsed = laneGasUsed
+		nextBaseFee, err := types.CalcBaseFee(cfg, &laneHeader, params.Params)
+		if err != nil {
+			return err
+		}
+		targetOneFeeHistory.NextBaseFee = nextBaseFee
+	} else {
+		targetOneFeeHistory.NextBaseFee = new(big.Int)
+	}
+	if cfg.IsCancun(header.Number, header.Time) {
+		blobGasUsed := uint64(0)
+		if val, ok := (*ethBlock)["blobGasUsed"].(hexutil.Uint64); ok {
+			blobGasUsed = uint64(val)
+		}
+		excessBlobGas := uint64(0)
+		if val, ok := (*ethBlock)["excessBlobGas"].(hexutil.Uint64); ok {
+			excessBlobGas = uint64(val)
+		}
+		setBlobFees(cfg, &header, blobGasUsed, excessBlobGas, targetOneFeeHistory)
+	}
+	if gasLimitUint64 <= 0 {
+		return fmt.Errorf("gasLimit of block height %d should be bigger than 0 , current gaslimit %d", blockHeight, gasLimitUint64)
+	}
+
+	targetOneFeeHistory.GasUsedRatio = safeRatio(laneGasUsed, laneGasLimit)
+	targetOneFeeHistory.Reward = blockRewards(sorter, laneGasUsed, rewardPercentiles)
+	return nil
+}
+
+// setBlobFees sets the blob base fees and the blob gas used ratio of the block to the fee history.
+func setBlobFees(cfg *params.ChainConfig, header *ethtypes.Header, blobGasUsed, excessBlobGas uint64, targetOneFeeHistory *types.OneFeeHistory) {
+	header.BlobGasUsed = new(uint64)
+	*header.BlobGasUsed = blobGasUsed
+	header.ExcessBlobGas = new(uint64)
+	*header.ExcessBlobGas = excessBlobGas
+
+	targetOneFeeHistory.BlobBaseFee = eip4844.CalcBlobFee(cfg, header)
+	nextExcess := eip4844.CalcExcessBlobGas(cfg, header, header.Time)
+	nextHeader := &ethtypes.Header{
+		Number:        header.Number,
+		Time:          header.Time,
+		ExcessBlobGas: &nextExcess,
+	}
+	targetOneFeeHistory.NextBlobBaseFee = eip4844.CalcBlobFee(cfg, nextHeader)
+
+	maxBlobGas := eip4844.MaxBlobGasPerBlock(cfg, header.Time)
+	targetOneFeeHistory.BlobGasUsedRatio = safeRatio(blobGasUsed, maxBlobGas)
+}
+
+// blockRewards returns the rewards at the percentiles of the gas used by the block, it returns
+// an all zero row if there are no transactions to gather data from.
+func blockRewards(sorter sortGasAndReward, gasUsed uint64, rewardPercentiles []float64) []*big.Int {
+	rewardCount := len(rewardPercentiles)
+	rewards := make([]*big.Int, rewardCount)
+	for i := 0; i < rewardCount; i++ {
+		rewards[i] = big.NewInt(0)
+	}
+
 	ethTxCount := len(sorter)
 	if ethTxCount == 0 {
-		return nil
+		return rewards
 	}
 
 	sort.Sort(sorter)
 
+	blockGasUsed := float64(gasUsed)
 	var txIndex int
 	sumGasUsed := sorter[0].gasUsed
 
@@ -288,10 +316,9 @@ func (b *Backend) ProcessBlock(
 			txIndex++
 			sumGasUsed += sorter[txIndex].gasUsed
 		}
-		targetOneFeeHistory.Reward[i] = sorter[txIndex].reward
+		rewards[i] = sorter[txIndex].reward
 	}
-
-	return nil
+	return rewards
 }
 
 func safeRatio(num, denom uint64) float64 {
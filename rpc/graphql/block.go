@@ -0,0 +1,446 @@
+package graphql
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rlp"
+
+	cmtrpctypes "github.com/cometbft/cometbft/rpc/core/types"
+
+	"github.com/cosmos/evm/rpc/namespaces/ethereum/eth/filters"
+	rpctypes "github.com/cosmos/evm/rpc/types"
+)
+
+// Block is an EVM block, identified by its number or by its CometBFT hash. It is
+// fetched from the backend on first use.
+type Block struct {
+	r      *Resolver
+	number rpctypes.BlockNumber
+	hash   *common.Hash
+
+	mu       sync.Mutex
+	resBlock *cmtrpctypes.ResultBlock
+	blockRes *cmtrpctypes.ResultBlockResults
+	block    *ethtypes.Block
+	receipts []*ethtypes.Receipt
+}
+
+func (r *Resolver) blockByNumber(number rpctypes.BlockNumber) *Block {
+	return &Block{r: r, number: number}
+}
+
+func (r *Resolver) blockByHash(hash common.Hash) *Block {
+	return &Block{r: r, hash: &hash}
+}
+
+// resolve returns the EVM block, or nil if it doesn't exist.
+func (b *Block) resolve(ctx context.Context) (*ethtypes.Block, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.block != nil {
+		return b.block, nil
+	}
+
+	var (
+		resBlock *cmtrpctypes.ResultBlock
+		err      error
+	)
+	if b.hash != nil {
+		resBlock, err = b.r.backend.CometBlockByHash(ctx, *b.hash)
+	} else {
+		resBlock, err = b.r.backend.CometBlockByNumber(ctx, b.number)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if resBlock == nil || resBlock.Block == nil {
+		return nil, nil
+	}
+
+	blockRes, err := b.r.backend.CometBlockResultByNumber(ctx, &resBlock.Block.Height)
+	if err != nil {
+		return nil, err
+	}
+	block, err := b.r.backend.EthBlockFromCometBlock(ctx, resBlock, blockRes)
+	if err != nil {
+		return nil, err
+	}
+
+	b.resBlock, b.blockRes, b.block = resBlock, blockRes, block
+	b.number = rpctypes.BlockNumber(resBlock.Block.Height)
+	return block, nil
+}
+
+// mustResolve returns the EVM block, or an error if it doesn't exist.
+func (b *Block) mustResolve(ctx context.Context) (*ethtypes.Block, error) {
+	block, err := b.resolve(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if block == nil {
+		return nil, errors.New("block not found")
+	}
+	return block, nil
+}
+
+// resolveReceipts returns the receipts of the transactions of the block.
+func (b *Block) resolveReceipts(ctx context.Context) ([]*ethtypes.Receipt, error) {
+	if _, err := b.mustResolve(ctx); err != nil {
+		return nil, err
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.receipts != nil {
+		return b.receipts, nil
+	}
+	receipts, err := b.r.backend.EthReceiptsFromCometBlock(ctx, b.resBlock, b.blockRes)
+	if err != nil {
+		return nil, err
+	}
+	b.receipts = receipts
+	return receipts, nil
+}
+
+// resolvedNumber returns the height of the block, once it is resolved.
+func (b *Block) resolvedNumber(ctx context.Context) (rpctypes.BlockNumber, error) {
+	if _, err := b.mustResolve(ctx); err != nil {
+		return 0, err
+	}
+	return b.number, nil
+}
+
+func (b *Block) Number(ctx context.Context) (hexutil.Uint64, error) {
+	block, err := b.mustResolve(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return hexutil.Uint64(block.NumberU64()), nil
+}
+
+// Hash returns the CometBFT hash of the block, which is the block hash reported by
+// the JSON-RPC server.
+func (b *Block) Hash(ctx context.Context) (common.Hash, error) {
+	if _, err := b.mustResolve(ctx); err != nil {
+		return common.Hash{}, err
+	}
+	return common.BytesToHash(b.resBlock.BlockID.Hash), nil
+}
+
+func (b *Block) Parent(ctx context.Context) (*Block, error) {
+	block, err := b.mustResolve(ctx)
+	if err != nil || block.NumberU64() == 0 {
+		return nil, err
+	}
+	return b.r.blockByHash(block.ParentHash()), nil
+}
+
+func (b *Block) Nonce(ctx context.Context) (hexutil.Bytes, error) {
+	block, err := b.mustResolve(ctx)
+	if err != nil {
+		return nil, err
+	}
+	nonce := ethtypes.EncodeNonce(block.Nonce())
+	return nonce[:], nil
+}
+
+func (b *Block) TransactionsRoot(ctx context.Context) (common.Hash, error) {
+	block, err := b.mustResolve(ctx)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return block.TxHash(), nil
+}
+
+func (b *Block) TransactionCount(ctx context.Context) (*hexutil.Uint64, error) {
+	block, err := b.mustResolve(ctx)
+	if err != nil {
+		return nil, err
+	}
+	count := hexutil.Uint64(len(block.Transactions()))
+	return &count, nil
+}
+
+func (b *Block) StateRoot(ctx context.Context) (common.Hash, error) {
+	block, err := b.mustResolve(ctx)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return block.Root(), nil
+}
+
+func (b *Block) ReceiptsRoot(ctx context.Context) (common.Hash, error) {
+	block, err := b.mustResolve(ctx)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return block.ReceiptHash(), nil
+}
+
+func (b *Block) Miner(ctx context.Context, args BlockNumberArgs) (*Account, error) {
+	block, err := b.mustResolve(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &Account{r: b.r, address: block.Coinbase(), blockNum: args.numberOr(b.number)}, nil
+}
+
+func (b *Block) ExtraData(ctx context.Context) (hexutil.Bytes, error) {
+	block, err := b.mustResolve(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return block.Extra(), nil
+}
+
+func (b *Block) GasLimit(ctx context.Context) (hexutil.Uint64, error) {
+	block, err := b.mustResolve(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return hexutil.Uint64(block.GasLimit()), nil
+}
+
+func (b *Block) GasUsed(ctx context.Context) (hexutil.Uint64, error) {
+	block, err := b.mustResolve(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return hexutil.Uint64(block.GasUsed()), nil
+}
+
+func (b *Block) BaseFeePerGas(ctx context.Context) (*hexutil.Big, error) {
+	block, err := b.mustResolve(ctx)
+	if err != nil || block.BaseFee() == nil {
+		return nil, err
+	}
+	return (*hexutil.Big)(block.BaseFee()), nil
+}
+
+// NextBaseFeePerGas returns the base fee of the next block, or nil if the next
+// block is not committed yet.
+func (b *Block) NextBaseFeePerGas(ctx context.Context) (*hexutil.Big, error) {
+	block, err := b.mustResolve(ctx)
+	if err != nil || block.BaseFee() == nil {
+		return nil, err
+	}
+	head, err := b.r.backend.BlockNumber(ctx)
+	if err != nil || uint64(head) <= block.NumberU64() {
+		return nil, err
+	}
+	next := int64(block.NumberU64()) + 1 //#nosec G115 -- block height won't exceed int64
+	blockRes, err := b.r.backend.CometBlockResultByNumber(ctx, &next)
+	if err != nil {
+		return nil, err
+	}
+	baseFee, err := b.r.backend.BaseFee(ctx, blockRes)
+	if err != nil || baseFee == nil {
+		return nil, err
+	}
+	return (*hexutil.Big)(baseFee), nil
+}
+
+func (b *Block) Timestamp(ctx context.Context) (hexutil.Uint64, error) {
+	block, err := b.mustResolve(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return hexutil.Uint64(block.Time()), nil
+}
+
+func (b *Block) LogsBloom(ctx context.Context) (hexutil.Bytes, error) {
+	block, err := b.mustResolve(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return block.Bloom().Bytes(), nil
+}
+
+func (b *Block) MixHash(ctx context.Context) (common.Hash, error) {
+	block, err := b.mustResolve(ctx)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return block.MixDigest(), nil
+}
+
+func (b *Block) Difficulty(ctx context.Context) (hexutil.Big, error) {
+	block, err := b.mustResolve(ctx)
+	if err != nil {
+		return hexutil.Big{}, err
+	}
+	return hexutil.Big(*block.Difficulty()), nil
+}
+
+// OmmerCount returns zero, the chain has no ommers.
+func (b *Block) OmmerCount(_ context.Context) *hexutil.Uint64 {
+	count := hexutil.Uint64(0)
+	return &count
+}
+
+// Ommers returns an empty list, the chain has no ommers.
+func (b *Block) Ommers(_ context.Context) *[]*Block {
+	return &[]*Block{}
+}
+
+// OmmerAt returns nil, the chain has no ommers.
+func (b *Block) OmmerAt(_ context.Context, _ struct{ Index Long }) *Block {
+	return nil
+}
+
+func (b *Block) OmmerHash(ctx context.Context) (common.Hash, error) {
+	block, err := b.mustResolve(ctx)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return block.UncleHash(), nil
+}
+
+func (b *Block) Transactions(ctx context.Context) (*[]*Transaction, error) {
+	block, err := b.mustResolve(ctx)
+	if err != nil {
+		return nil, err
+	}
+	txs := block.Transactions()
+	result := make([]*Transaction, len(txs))
+	for i, tx := range txs {
+		result[i] = b.transaction(tx, i)
+	}
+	return &result, nil
+}
+
+func (b *Block) TransactionAt(ctx context.Context, args struct{ Index Long }) (*Transaction, error) {
+	block, err := b.mustResolve(ctx)
+	if err != nil {
+		return nil, err
+	}
+	txs := block.Transactions()
+	if args.Index < 0 || int(args.Index) >= len(txs) {
+		return nil, nil
+	}
+	return b.transaction(txs[args.Index], int(args.Index)), nil
+}
+
+func (b *Block) transaction(tx *ethtypes.Transaction, index int) *Transaction {
+	return &Transaction{
+		r:        b.r,
+		hash:     tx.Hash(),
+		tx:       tx,
+		block:    b,
+		index:    uint64(index), //#nosec G115 -- index is not negative
+		resolved: true,
+	}
+}
+
+// BlockFilterCriteria is the filter of the logs of a block.
+type BlockFilterCriteria struct {
+	Addresses *[]common.Address
+	Topics    *[][]common.Hash
+}
+
+// Logs returns the logs of the block matching the filter.
+func (b *Block) Logs(ctx context.Context, args struct{ Filter BlockFilterCriteria }) ([]*Log, error) {
+	hash, err := b.Hash(ctx)
+	if err != nil {
+		return nil, err
+	}
+	crit := blockFilterCriteria(hash, args.Filter.Addresses, args.Filter.Topics)
+	return b.r.runFilter(ctx, filters.NewBlockFilter(b.r.logger, b.r.backend, crit))
+}
+
+// Account returns an account at the state of the block.
+func (b *Block) Account(ctx context.Context, args struct{ Address common.Address }) (*Account, error) {
+	number, err := b.resolvedNumber(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &Account{r: b.r, address: args.Address, blockNum: number}, nil
+}
+
+// Call executes a call at the state of the block.
+func (b *Block) Call(ctx context.Context, args struct{ Data CallData }) (*CallResult, error) {
+	number, err := b.resolvedNumber(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return b.r.call(ctx, args.Data, number)
+}
+
+// EstimateGas estimates the gas of a transaction at the state of the block.
+func (b *Block) EstimateGas(ctx context.Context, args struct{ Data CallData }) (hexutil.Uint64, error) {
+	number, err := b.resolvedNumber(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return b.r.estimateGas(ctx, args.Data, number)
+}
+
+func (b *Block) RawHeader(ctx context.Context) (hexutil.Bytes, error) {
+	block, err := b.mustResolve(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return rlp.EncodeToBytes(block.Header())
+}
+
+func (b *Block) Raw(ctx context.Context) (hexutil.Bytes, error) {
+	block, err := b.mustResolve(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return rlp.EncodeToBytes(block)
+}
+
+// WithdrawalsRoot returns nil, the chain has no withdrawals.
+func (b *Block) WithdrawalsRoot(_ context.Context) *common.Hash {
+	return nil
+}
+
+// Withdrawals returns nil, the chain has no withdrawals.
+func (b *Block) Withdrawals(_ context.Context) *[]*Withdrawal {
+	return nil
+}
+
+func (b *Block) BlobGasUsed(ctx context.Context) (*hexutil.Uint64, error) {
+	block, err := b.mustResolve(ctx)
+	if err != nil || block.BlobGasUsed() == nil {
+		return nil, err
+	}
+	used := hexutil.Uint64(*block.BlobGasUsed())
+	return &used, nil
+}
+
+func (b *Block) ExcessBlobGas(ctx context.Context) (*hexutil.Uint64, error) {
+	block, err := b.mustResolve(ctx)
+	if err != nil || block.ExcessBlobGas() == nil {
+		return nil, err
+	}
+	excess := hexutil.Uint64(*block.ExcessBlobGas())
+	return &excess, nil
+}
+
+// Withdrawal is an EIP-4895 withdrawal, it is part of the schema but the chain has
+// no withdrawals.
+type Withdrawal struct {
+	withdrawal *ethtypes.Withdrawal
+}
+
+func (w *Withdrawal) Index(_ context.Context) hexutil.Uint64 {
+	return hexutil.Uint64(w.withdrawal.Index)
+}
+
+func (w *Withdrawal) Validator(_ context.Context) hexutil.Uint64 {
+	return hexutil.Uint64(w.withdrawal.Validator)
+}
+
+func (w *Withdrawal) Address(_ context.Context) common.Address {
+	return w.withdrawal.Address
+}
+
+func (w *Withdrawal) Amount(_ context.Context) hexutil.Uint64 {
+	return hexutil.Uint64(w.withdrawal.Amount)
+}
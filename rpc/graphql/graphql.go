@@ -0,0 +1,445 @@
+// Package graphql implements the EIP-1767 GraphQL interface of the EVM on top of
+// the JSON-RPC backend.
+package graphql
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	ethfilters "github.com/ethereum/go-ethereum/eth/filters"
+
+	cmtrpctypes "github.com/cometbft/cometbft/rpc/core/types"
+
+	"github.com/cosmos/evm/rpc/namespaces/ethereum/eth/filters"
+	rpctypes "github.com/cosmos/evm/rpc/types"
+	servertypes "github.com/cosmos/evm/server/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	"cosmossdk.io/log"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	errInvalidBlockRange = errors.New("invalid from and to block combination: from > to")
+	errBlockRangeLimit   = errors.New("block range exceeds the limit")
+)
+
+// Backend defines the methods of the JSON-RPC backend used by the GraphQL resolvers.
+type Backend interface {
+	filters.Backend
+
+	BlockNumber(ctx context.Context) (hexutil.Uint64, error)
+	CometBlockByNumber(ctx context.Context, blockNum rpctypes.BlockNumber) (*cmtrpctypes.ResultBlock, error)
+	EthBlockFromCometBlock(ctx context.Context, resBlock *cmtrpctypes.ResultBlock, blockRes *cmtrpctypes.ResultBlockResults) (*ethtypes.Block, error)
+	EthReceiptsFromCometBlock(ctx context.Context, resBlock *cmtrpctypes.ResultBlock, blockRes *cmtrpctypes.ResultBlockResults) ([]*ethtypes.Receipt, error)
+	BaseFee(ctx context.Context, blockRes *cmtrpctypes.ResultBlockResults) (*big.Int, error)
+	GetTxByEthHash(ctx context.Context, txHash common.Hash) (*servertypes.TxResult, error)
+	PendingTransactions(ctx context.Context) ([]*sdk.Tx, error)
+
+	GetBalance(ctx context.Context, address common.Address, blockNrOrHash rpctypes.BlockNumberOrHash) (*hexutil.Big, error)
+	GetTransactionCount(ctx context.Context, address common.Address, blockNum rpctypes.BlockNumber) (*hexutil.Uint64, error)
+	GetCode(ctx context.Context, address common.Address, blockNrOrHash rpctypes.BlockNumberOrHash) (hexutil.Bytes, error)
+	GetStorageAt(ctx context.Context, address common.Address, key string, blockNrOrHash rpctypes.BlockNumberOrHash) (hexutil.Bytes, error)
+
+	DoCall(ctx context.Context, args evmtypes.TransactionArgs, blockNr rpctypes.BlockNumber, overrides *json.RawMessage) (*evmtypes.MsgEthereumTxResponse, error)
+	EstimateGas(ctx context.Context, args evmtypes.TransactionArgs, blockNrOrHash *rpctypes.BlockNumberOrHash, overrides *json.RawMessage) (hexutil.Uint64, error)
+	SendRawTransaction(ctx context.Context, data hexutil.Bytes) (common.Hash, error)
+
+	ChainID(ctx context.Context) (*hexutil.Big, error)
+	CurrentHeader(ctx context.Context) (*ethtypes.Header, error)
+	GasPrice(ctx context.Context) (*hexutil.Big, error)
+	SuggestGasTipCap(ctx context.Context, baseFee *big.Int) (*big.Int, error)
+	Syncing(ctx context.Context) (interface{}, error)
+}
+
+// Long is a 64 bit integer, accepted as a JSON number or as a decimal or
+// 0x-prefixed hexadecimal string.
+type Long int64
+
+// ImplementsGraphQLType returns true if Long implements the provided GraphQL type.
+func (l Long) ImplementsGraphQLType(name string) bool { return name == "Long" }
+
+// UnmarshalGraphQL unmarshals the provided GraphQL query data.
+func (l *Long) UnmarshalGraphQL(input interface{}) error {
+	switch input := input.(type) {
+	case string:
+		if strings.HasPrefix(input, "0x") {
+			value, err := hexutil.DecodeUint64(input)
+			*l = Long(value) //#nosec G115 -- block numbers won't exceed int64
+			return err
+		}
+		value, err := strconv.ParseInt(input, 10, 64)
+		*l = Long(value)
+		return err
+	case int32:
+		*l = Long(input)
+	case int64:
+		*l = Long(input)
+	case float64:
+		*l = Long(input)
+	default:
+		return fmt.Errorf("unexpected type %T for Long", input)
+	}
+	return nil
+}
+
+// BlockNumberArgs is the optional block argument of the account fields.
+type BlockNumberArgs struct {
+	Block *Long
+}
+
+// numberOr returns the block number argument, or the given block number if none
+// was provided.
+func (a BlockNumberArgs) numberOr(current rpctypes.BlockNumber) rpctypes.BlockNumber {
+	if a.Block != nil {
+		return rpctypes.BlockNumber(*a.Block)
+	}
+	return current
+}
+
+// Resolver is the root resolver of the GraphQL queries and mutations.
+type Resolver struct {
+	backend Backend
+	logger  log.Logger
+}
+
+// Block returns a block by number or by hash, or the latest block if neither is
+// provided.
+func (r *Resolver) Block(ctx context.Context, args struct {
+	Number *Long
+	Hash   *common.Hash
+}) (*Block, error) {
+	if args.Number != nil && args.Hash != nil {
+		return nil, errors.New("only one of number or hash must be specified")
+	}
+	var block *Block
+	switch {
+	case args.Hash != nil:
+		block = r.blockByHash(*args.Hash)
+	case args.Number != nil:
+		if *args.Number < 0 {
+			return nil, nil
+		}
+		block = r.blockByNumber(rpctypes.BlockNumber(*args.Number))
+	default:
+		block = r.blockByNumber(rpctypes.EthLatestBlockNumber)
+	}
+	ethBlock, err := block.resolve(ctx)
+	if err != nil || ethBlock == nil {
+		return nil, err
+	}
+	return block, nil
+}
+
+// Blocks returns the blocks between two numbers, inclusive. The range is capped by
+// the block range cap of the JSON-RPC server.
+func (r *Resolver) Blocks(ctx context.Context, args struct {
+	From *Long
+	To   *Long
+}) ([]*Block, error) {
+	if args.From == nil {
+		return nil, errors.New("from block number must be specified")
+	}
+	head, err := r.backend.BlockNumber(ctx)
+	if err != nil {
+		return nil, err
+	}
+	from := int64(*args.From)
+	to := int64(head) //#nosec G115 -- block height won't exceed int64
+	if args.To != nil {
+		if int64(*args.To) < from {
+			return nil, errInvalidBlockRange
+		}
+		// the blocks after the head don't exist yet
+		to = min(to, int64(*args.To))
+	}
+	if from > to {
+		return []*Block{}, nil
+	}
+	if limit := int64(r.backend.RPCBlockRangeCap()); limit > 0 && to-from+1 > limit {
+		return nil, errBlockRangeLimit
+	}
+
+	blocks := make([]*Block, 0, to-from+1)
+	for number := from; number <= to; number++ {
+		block := r.blockByNumber(rpctypes.BlockNumber(number))
+		ethBlock, err := block.resolve(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if ethBlock == nil {
+			break
+		}
+		blocks = append(blocks, block)
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+	}
+	return blocks, nil
+}
+
+// Pending returns the pending state.
+func (r *Resolver) Pending(_ context.Context) *Pending {
+	return &Pending{r: r}
+}
+
+// Transaction returns a mined or pending transaction by hash.
+func (r *Resolver) Transaction(ctx context.Context, args struct{ Hash common.Hash }) (*Transaction, error) {
+	tx := &Transaction{r: r, hash: args.Hash}
+	ethTx, _, err := tx.resolve(ctx)
+	if err != nil || ethTx == nil {
+		return nil, err
+	}
+	return tx, nil
+}
+
+// SendRawTransaction broadcasts a signed RLP-encoded transaction.
+func (r *Resolver) SendRawTransaction(ctx context.Context, args struct{ Data hexutil.Bytes }) (common.Hash, error) {
+	return r.backend.SendRawTransaction(ctx, args.Data)
+}
+
+// FilterCriteria is the filter of the logs query.
+type FilterCriteria struct {
+	FromBlock *Long
+	ToBlock   *Long
+	Addresses *[]common.Address
+	Topics    *[][]common.Hash
+}
+
+// Logs returns the logs matching the filter, within the logs and block range caps
+// of the JSON-RPC server.
+func (r *Resolver) Logs(ctx context.Context, args struct{ Filter FilterCriteria }) ([]*Log, error) {
+	begin := rpctypes.EthLatestBlockNumber.Int64()
+	if args.Filter.FromBlock != nil {
+		begin = int64(*args.Filter.FromBlock)
+	}
+	end := rpctypes.EthLatestBlockNumber.Int64()
+	if args.Filter.ToBlock != nil {
+		end = int64(*args.Filter.ToBlock)
+	}
+	if begin > 0 && end > 0 && begin > end {
+		return nil, errInvalidBlockRange
+	}
+	var addresses []common.Address
+	if args.Filter.Addresses != nil {
+		addresses = *args.Filter.Addresses
+	}
+	var topics [][]common.Hash
+	if args.Filter.Topics != nil {
+		topics = *args.Filter.Topics
+	}
+	return r.runFilter(ctx, filters.NewRangeFilter(r.logger, r.backend, begin, end, addresses, topics))
+}
+
+// runFilter returns the logs of a filter, as resolvers of the transactions that
+// emitted them.
+func (r *Resolver) runFilter(ctx context.Context, filter *filters.Filter) ([]*Log, error) {
+	logs, err := filter.Logs(ctx, int(r.backend.RPCLogsCap()), int64(r.backend.RPCBlockRangeCap()))
+	if err != nil {
+		return nil, err
+	}
+	result := make([]*Log, len(logs))
+	for i, log := range logs {
+		result[i] = &Log{
+			r:           r,
+			transaction: &Transaction{r: r, hash: log.TxHash},
+			log:         log,
+		}
+	}
+	return result, nil
+}
+
+// GasPrice returns the suggested gas price.
+func (r *Resolver) GasPrice(ctx context.Context) (hexutil.Big, error) {
+	price, err := r.backend.GasPrice(ctx)
+	if err != nil {
+		return hexutil.Big{}, err
+	}
+	return *price, nil
+}
+
+// MaxPriorityFeePerGas returns the suggested tip of the dynamic fee transactions.
+func (r *Resolver) MaxPriorityFeePerGas(ctx context.Context) (hexutil.Big, error) {
+	head, err := r.backend.CurrentHeader(ctx)
+	if err != nil {
+		return hexutil.Big{}, err
+	}
+	tip, err := r.backend.SuggestGasTipCap(ctx, head.BaseFee)
+	if err != nil {
+		return hexutil.Big{}, err
+	}
+	return hexutil.Big(*tip), nil
+}
+
+// ChainID returns the EIP-155 chain ID.
+func (r *Resolver) ChainID(ctx context.Context) (hexutil.Big, error) {
+	chainID, err := r.backend.ChainID(ctx)
+	if err != nil {
+		return hexutil.Big{}, err
+	}
+	return *chainID, nil
+}
+
+// SyncState is the synchronisation state of the node.
+type SyncState struct {
+	startingBlock hexutil.Uint64
+	currentBlock  hexutil.Uint64
+}
+
+func (s *SyncState) StartingBlock() hexutil.Uint64 { return s.startingBlock }
+func (s *SyncState) CurrentBlock() hexutil.Uint64  { return s.currentBlock }
+
+// HighestBlock returns the current block, the highest block known by the peers
+// is not reported by CometBFT.
+func (s *SyncState) HighestBlock() hexutil.Uint64 { return s.currentBlock }
+
+// Syncing returns the synchronisation state, or nil if the node is not syncing.
+func (r *Resolver) Syncing(ctx context.Context) (*SyncState, error) {
+	res, err := r.backend.Syncing(ctx)
+	if err != nil {
+		return nil, err
+	}
+	progress, ok := res.(map[string]interface{})
+	if !ok {
+		return nil, nil
+	}
+	state := &SyncState{}
+	state.startingBlock, _ = progress["startingBlock"].(hexutil.Uint64)
+	state.currentBlock, _ = progress["currentBlock"].(hexutil.Uint64)
+	return state, nil
+}
+
+// Pending is the pending state of the node.
+type Pending struct {
+	r *Resolver
+}
+
+func (p *Pending) transactions(ctx context.Context) ([]*ethtypes.Transaction, error) {
+	pending, err := p.r.backend.PendingTransactions(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var txs []*ethtypes.Transaction
+	for _, tx := range pending {
+		for _, msg := range (*tx).GetMsgs() {
+			if ethMsg, ok := msg.(*evmtypes.MsgEthereumTx); ok {
+				txs = append(txs, ethMsg.AsTransaction())
+			}
+		}
+	}
+	return txs, nil
+}
+
+// TransactionCount returns the number of pending EVM transactions.
+func (p *Pending) TransactionCount(ctx context.Context) (hexutil.Uint64, error) {
+	txs, err := p.transactions(ctx)
+	return hexutil.Uint64(len(txs)), err
+}
+
+// Transactions returns the pending EVM transactions.
+func (p *Pending) Transactions(ctx context.Context) (*[]*Transaction, error) {
+	txs, err := p.transactions(ctx)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]*Transaction, len(txs))
+	for i, tx := range txs {
+		result[i] = &Transaction{r: p.r, hash: tx.Hash(), tx: tx, resolved: true}
+	}
+	return &result, nil
+}
+
+// Account returns an account at the pending state.
+func (p *Pending) Account(_ context.Context, args struct{ Address common.Address }) *Account {
+	return &Account{r: p.r, address: args.Address, blockNum: rpctypes.EthPendingBlockNumber}
+}
+
+// Call executes a call at the pending state.
+func (p *Pending) Call(ctx context.Context, args struct{ Data CallData }) (*CallResult, error) {
+	return p.r.call(ctx, args.Data, rpctypes.EthPendingBlockNumber)
+}
+
+// EstimateGas estimates the gas of a transaction at the pending state.
+func (p *Pending) EstimateGas(ctx context.Context, args struct{ Data CallData }) (hexutil.Uint64, error) {
+	return p.r.estimateGas(ctx, args.Data, rpctypes.EthPendingBlockNumber)
+}
+
+// CallData is the transaction of a call or of a gas estimation.
+type CallData struct {
+	From                 *common.Address
+	To                   *common.Address
+	Gas                  *Long
+	GasPrice             *hexutil.Big
+	MaxFeePerGas         *hexutil.Big
+	MaxPriorityFeePerGas *hexutil.Big
+	Value                *hexutil.Big
+	Data                 *hexutil.Bytes
+}
+
+func (c CallData) transactionArgs() evmtypes.TransactionArgs {
+	args := evmtypes.TransactionArgs{
+		From:                 c.From,
+		To:                   c.To,
+		GasPrice:             c.GasPrice,
+		MaxFeePerGas:         c.MaxFeePerGas,
+		MaxPriorityFeePerGas: c.MaxPriorityFeePerGas,
+		Value:                c.Value,
+		Data:                 c.Data,
+	}
+	if c.Gas != nil {
+		gas := hexutil.Uint64(*c.Gas) //#nosec G115 -- capped by the RPC gas cap
+		args.Gas = &gas
+	}
+	return args
+}
+
+// CallResult is the result of a call.
+type CallResult struct {
+	data    hexutil.Bytes
+	gasUsed hexutil.Uint64
+	status  hexutil.Uint64
+}
+
+func (c *CallResult) Data() hexutil.Bytes     { return c.data }
+func (c *CallResult) GasUsed() hexutil.Uint64 { return c.gasUsed }
+func (c *CallResult) Status() hexutil.Uint64  { return c.status }
+
+// call executes a call at the given block, within the gas cap of the JSON-RPC server.
+func (r *Resolver) call(ctx context.Context, data CallData, blockNum rpctypes.BlockNumber) (*CallResult, error) {
+	res, err := r.backend.DoCall(ctx, data.transactionArgs(), blockNum, nil)
+	if err != nil {
+		return nil, err
+	}
+	status := hexutil.Uint64(ethtypes.ReceiptStatusSuccessful)
+	if res.Failed() {
+		status = hexutil.Uint64(ethtypes.ReceiptStatusFailed)
+	}
+	return &CallResult{data: res.Ret, gasUsed: hexutil.Uint64(res.GasUsed), status: status}, nil
+}
+
+// estimateGas estimates the gas of a transaction at the given block, within the
+// gas cap of the JSON-RPC server.
+func (r *Resolver) estimateGas(ctx context.Context, data CallData, blockNum rpctypes.BlockNumber) (hexutil.Uint64, error) {
+	return r.backend.EstimateGas(ctx, data.transactionArgs(), &rpctypes.BlockNumberOrHash{BlockNumber: &blockNum}, nil)
+}
+
+// blockFilterCriteria returns the log filter criteria of a block.
+func blockFilterCriteria(hash common.Hash, addresses *[]common.Address, topics *[][]common.Hash) ethfilters.FilterCriteria {
+	crit := ethfilters.FilterCriteria{BlockHash: &hash}
+	if addresses != nil {
+		crit.Addresses = *addresses
+	}
+	if topics != nil {
+		crit.Topics = *topics
+	}
+	return crit
+}
@@ -0,0 +1,132 @@
+package graphql
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log"
+)
+
+// testBackend implements the methods used by the tests, the other methods of the
+// Backend panic.
+type testBackend struct {
+	Backend
+
+	head       uint64
+	blockRange int32
+}
+
+func (b *testBackend) ChainID(context.Context) (*hexutil.Big, error) {
+	return (*hexutil.Big)(big.NewInt(9001)), nil
+}
+
+func (b *testBackend) GasPrice(context.Context) (*hexutil.Big, error) {
+	return (*hexutil.Big)(big.NewInt(1_000_000_000)), nil
+}
+
+func (b *testBackend) BlockNumber(context.Context) (hexutil.Uint64, error) {
+	return hexutil.Uint64(b.head), nil
+}
+
+func (b *testBackend) RPCBlockRangeCap() int32 {
+	return b.blockRange
+}
+
+func execQuery(t *testing.T, backend Backend, query string) (int, map[string]json.RawMessage) {
+	t.Helper()
+	h, err := NewHandler(backend, log.NewNopLogger())
+	require.NoError(t, err)
+
+	body, err := json.Marshal(map[string]string{"query": query})
+	require.NoError(t, err)
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/graphql", strings.NewReader(string(body))))
+
+	var res map[string]json.RawMessage
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &res))
+	return rec.Code, res
+}
+
+func errorMessage(t *testing.T, res map[string]json.RawMessage) string {
+	t.Helper()
+	var errs []struct{ Message string }
+	require.NoError(t, json.Unmarshal(res["errors"], &errs))
+	require.Len(t, errs, 1)
+	return errs[0].Message
+}
+
+func TestNewHandler(t *testing.T) {
+	// the schema is validated against the resolvers
+	_, err := NewHandler(&testBackend{}, log.NewNopLogger())
+	require.NoError(t, err)
+}
+
+func TestQuery(t *testing.T) {
+	code, res := execQuery(t, &testBackend{}, "{ chainID gasPrice }")
+	require.Equal(t, http.StatusOK, code)
+	require.JSONEq(t, `{"chainID":"0x2329","gasPrice":"0x3b9aca00"}`, string(res["data"]))
+}
+
+func TestTrailingData(t *testing.T) {
+	h, err := NewHandler(&testBackend{}, log.NewNopLogger())
+	require.NoError(t, err)
+
+	// the body is rejected as by the access policy, not executed up to the query
+	for _, body := range []string{`{"query":"{ chainID }"} x`, `{"query":"{ chainID }"}{"query":"{ gasPrice }"}`} {
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/graphql", strings.NewReader(body)))
+		require.Equal(t, http.StatusBadRequest, rec.Code)
+		require.NotContains(t, rec.Body.String(), "0x2329")
+	}
+}
+
+func TestBlocksRangeCap(t *testing.T) {
+	backend := &testBackend{head: 100, blockRange: 10}
+
+	code, res := execQuery(t, backend, "{ blocks(from: 50, to: 80) { number } }")
+	require.Equal(t, http.StatusBadRequest, code)
+	require.Equal(t, errBlockRangeLimit.Error(), errorMessage(t, res))
+
+	code, res = execQuery(t, backend, "{ blocks(from: 80, to: 50) { number } }")
+	require.Equal(t, http.StatusBadRequest, code)
+	require.Equal(t, errInvalidBlockRange.Error(), errorMessage(t, res))
+
+	// the blocks after the head don't exist
+	code, res = execQuery(t, backend, "{ blocks(from: 200) { number } }")
+	require.Equal(t, http.StatusOK, code)
+	require.JSONEq(t, `{"blocks":[]}`, string(res["data"]))
+}
+
+func TestLongUnmarshalGraphQL(t *testing.T) {
+	testCases := []struct {
+		input  interface{}
+		exp    Long
+		expErr bool
+	}{
+		{"0x10", 16, false},
+		{"16", 16, false},
+		{int32(16), 16, false},
+		{int64(16), 16, false},
+		{float64(16), 16, false},
+		{"0xzz", 0, true},
+		{true, 0, true},
+	}
+	for _, tc := range testCases {
+		var l Long
+		err := l.UnmarshalGraphQL(tc.input)
+		if tc.expErr {
+			require.Error(t, err)
+			continue
+		}
+		require.NoError(t, err)
+		require.Equal(t, tc.exp, l)
+	}
+}
@@ -0,0 +1,61 @@
+package graphql
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+
+	"github.com/graph-gophers/graphql-go"
+
+	"cosmossdk.io/log"
+)
+
+// maxRequestSize is the maximum size of a GraphQL request body.
+const maxRequestSize = 5 * 1024 * 1024
+
+// handler executes the GraphQL queries posted as JSON.
+type handler struct {
+	schema *graphql.Schema
+}
+
+// NewHandler returns the HTTP handler of the GraphQL queries on the backend.
+func NewHandler(backend Backend, logger log.Logger) (http.Handler, error) {
+	resolver := &Resolver{backend: backend, logger: logger}
+	schema, err := graphql.ParseSchema(schema, resolver)
+	if err != nil {
+		return nil, err
+	}
+	return &handler{schema: schema}, nil
+}
+
+func (h *handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var params struct {
+		Query         string                 `json:"query"`
+		OperationName string                 `json:"operationName"`
+		Variables     map[string]interface{} `json:"variables"`
+	}
+	// the body is parsed as strictly as by the access policy, trailing data included,
+	// so that the policy checks the query which is executed
+	body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxRequestSize))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if err := json.Unmarshal(body, &params); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	response := h.schema.Exec(r.Context(), params.Query, params.OperationName, params.Variables)
+	responseJSON, err := json.Marshal(response)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if len(response.Errors) > 0 {
+		w.WriteHeader(http.StatusBadRequest)
+	}
+	_, _ = w.Write(responseJSON)
+}
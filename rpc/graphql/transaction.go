@@ -0,0 +1,499 @@
+package graphql
+
+import (
+	"context"
+	"math/big"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	rpctypes "github.com/cosmos/evm/rpc/types"
+)
+
+// Transaction is a mined or pending EVM transaction, fetched from the backend on
+// first use.
+type Transaction struct {
+	r    *Resolver
+	hash common.Hash
+
+	mu       sync.Mutex
+	resolved bool
+	tx       *ethtypes.Transaction
+	// block is nil for the pending transactions
+	block *Block
+	index uint64
+}
+
+// resolve returns the transaction and the block that includes it, it returns a
+// nil block for a pending transaction and a nil transaction if it is unknown.
+func (t *Transaction) resolve(ctx context.Context) (*ethtypes.Transaction, *Block, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.resolved {
+		return t.tx, t.block, nil
+	}
+
+	if res, err := t.r.backend.GetTxByEthHash(ctx, t.hash); err == nil {
+		block := t.r.blockByNumber(rpctypes.BlockNumber(res.Height))
+		ethBlock, err := block.resolve(ctx)
+		if err != nil {
+			return nil, nil, err
+		}
+		if ethBlock != nil {
+			for i, tx := range ethBlock.Transactions() {
+				if tx.Hash() == t.hash {
+					t.tx, t.block, t.index = tx, block, uint64(i) //#nosec G115 -- index is not negative
+					t.resolved = true
+					return t.tx, t.block, nil
+				}
+			}
+		}
+	}
+
+	pending, err := (&Pending{r: t.r}).transactions(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, tx := range pending {
+		if tx.Hash() == t.hash {
+			t.tx = tx
+			break
+		}
+	}
+	t.resolved = true
+	return t.tx, nil, nil
+}
+
+// resolveReceipt returns the receipt of a mined transaction, or nil for a pending
+// transaction.
+func (t *Transaction) resolveReceipt(ctx context.Context) (*ethtypes.Receipt, error) {
+	_, block, err := t.resolve(ctx)
+	if err != nil || block == nil {
+		return nil, err
+	}
+	receipts, err := block.resolveReceipts(ctx)
+	if err != nil || t.index >= uint64(len(receipts)) {
+		return nil, err
+	}
+	return receipts[t.index], nil
+}
+
+// baseFee returns the base fee of the block of a mined transaction.
+func (t *Transaction) baseFee(ctx context.Context) (*big.Int, error) {
+	_, block, err := t.resolve(ctx)
+	if err != nil || block == nil {
+		return nil, err
+	}
+	ethBlock, err := block.mustResolve(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return ethBlock.BaseFee(), nil
+}
+
+func (t *Transaction) Hash(_ context.Context) common.Hash {
+	return t.hash
+}
+
+func (t *Transaction) Nonce(ctx context.Context) (hexutil.Uint64, error) {
+	tx, _, err := t.resolve(ctx)
+	if err != nil || tx == nil {
+		return 0, err
+	}
+	return hexutil.Uint64(tx.Nonce()), nil
+}
+
+func (t *Transaction) Index(ctx context.Context) (*hexutil.Uint64, error) {
+	_, block, err := t.resolve(ctx)
+	if err != nil || block == nil {
+		return nil, err
+	}
+	index := hexutil.Uint64(t.index)
+	return &index, nil
+}
+
+func (t *Transaction) From(ctx context.Context, args BlockNumberArgs) (*Account, error) {
+	tx, block, err := t.resolve(ctx)
+	if err != nil || tx == nil {
+		return nil, err
+	}
+	from, err := ethtypes.Sender(txSigner(tx), tx)
+	if err != nil {
+		return nil, err
+	}
+	return t.account(from, block, args), nil
+}
+
+func (t *Transaction) To(ctx context.Context, args BlockNumberArgs) (*Account, error) {
+	tx, block, err := t.resolve(ctx)
+	if err != nil || tx == nil || tx.To() == nil {
+		return nil, err
+	}
+	return t.account(*tx.To(), block, args), nil
+}
+
+// account returns an account at the block argument, or else at the block of
+// the transaction.
+func (t *Transaction) account(address common.Address, block *Block, args BlockNumberArgs) *Account {
+	blockNum := rpctypes.EthPendingBlockNumber
+	if block != nil {
+		blockNum = block.number
+	}
+	return &Account{r: t.r, address: address, blockNum: args.numberOr(blockNum)}
+}
+
+func (t *Transaction) Value(ctx context.Context) (hexutil.Big, error) {
+	tx, _, err := t.resolve(ctx)
+	if err != nil || tx == nil {
+		return hexutil.Big{}, err
+	}
+	return hexutil.Big(*tx.Value()), nil
+}
+
+// GasPrice returns the gas price of a legacy transaction, or the effective gas
+// price of a mined dynamic fee transaction.
+func (t *Transaction) GasPrice(ctx context.Context) (hexutil.Big, error) {
+	tx, _, err := t.resolve(ctx)
+	if err != nil || tx == nil {
+		return hexutil.Big{}, err
+	}
+	baseFee, err := t.baseFee(ctx)
+	if err != nil {
+		return hexutil.Big{}, err
+	}
+	if baseFee == nil || tx.Type() == ethtypes.LegacyTxType || tx.Type() == ethtypes.AccessListTxType {
+		return hexutil.Big(*tx.GasPrice()), nil
+	}
+	return hexutil.Big(*effectiveGasPrice(tx, baseFee)), nil
+}
+
+func (t *Transaction) EffectiveGasPrice(ctx context.Context) (*hexutil.Big, error) {
+	tx, block, err := t.resolve(ctx)
+	if err != nil || tx == nil || block == nil {
+		return nil, err
+	}
+	baseFee, err := t.baseFee(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if baseFee == nil {
+		return (*hexutil.Big)(tx.GasPrice()), nil
+	}
+	return (*hexutil.Big)(effectiveGasPrice(tx, baseFee)), nil
+}
+
+func (t *Transaction) MaxFeePerGas(ctx context.Context) (*hexutil.Big, error) {
+	tx, _, err := t.resolve(ctx)
+	if err != nil || tx == nil || !isDynamicFeeTx(tx) {
+		return nil, err
+	}
+	return (*hexutil.Big)(tx.GasFeeCap()), nil
+}
+
+func (t *Transaction) MaxPriorityFeePerGas(ctx context.Context) (*hexutil.Big, error) {
+	tx, _, err := t.resolve(ctx)
+	if err != nil || tx == nil || !isDynamicFeeTx(tx) {
+		return nil, err
+	}
+	return (*hexutil.Big)(tx.GasTipCap()), nil
+}
+
+// MaxFeePerBlobGas returns nil, the chain doesn't accept blob transactions.
+func (t *Transaction) MaxFeePerBlobGas(_ context.Context) *hexutil.Big {
+	return nil
+}
+
+// BlobVersionedHashes returns nil, the chain doesn't accept blob transactions.
+func (t *Transaction) BlobVersionedHashes(_ context.Context) *[]common.Hash {
+	return nil
+}
+
+func (t *Transaction) EffectiveTip(ctx context.Context) (*hexutil.Big, error) {
+	tx, block, err := t.resolve(ctx)
+	if err != nil || tx == nil || block == nil {
+		return nil, err
+	}
+	baseFee, err := t.baseFee(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if baseFee == nil {
+		return (*hexutil.Big)(tx.GasPrice()), nil
+	}
+	tip, err := tx.EffectiveGasTip(baseFee)
+	if err != nil {
+		return nil, err
+	}
+	return (*hexutil.Big)(tip), nil
+}
+
+func (t *Transaction) Gas(ctx context.Context) (hexutil.Uint64, error) {
+	tx, _, err := t.resolve(ctx)
+	if err != nil || tx == nil {
+		return 0, err
+	}
+	return hexutil.Uint64(tx.Gas()), nil
+}
+
+func (t *Transaction) InputData(ctx context.Context) (hexutil.Bytes, error) {
+	tx, _, err := t.resolve(ctx)
+	if err != nil || tx == nil {
+		return hexutil.Bytes{}, err
+	}
+	return tx.Data(), nil
+}
+
+func (t *Transaction) Block(ctx context.Context) (*Block, error) {
+	_, block, err := t.resolve(ctx)
+	return block, err
+}
+
+func (t *Transaction) Status(ctx context.Context) (*hexutil.Uint64, error) {
+	receipt, err := t.resolveReceipt(ctx)
+	if err != nil || receipt == nil {
+		return nil, err
+	}
+	status := hexutil.Uint64(receipt.Status)
+	return &status, nil
+}
+
+func (t *Transaction) GasUsed(ctx context.Context) (*hexutil.Uint64, error) {
+	receipt, err := t.resolveReceipt(ctx)
+	if err != nil || receipt == nil {
+		return nil, err
+	}
+	used := hexutil.Uint64(receipt.GasUsed)
+	return &used, nil
+}
+
+func (t *Transaction) CumulativeGasUsed(ctx context.Context) (*hexutil.Uint64, error) {
+	receipt, err := t.resolveReceipt(ctx)
+	if err != nil || receipt == nil {
+		return nil, err
+	}
+	used := hexutil.Uint64(receipt.CumulativeGasUsed)
+	return &used, nil
+}
+
+// BlobGasUsed returns nil, the chain doesn't accept blob transactions.
+func (t *Transaction) BlobGasUsed(_ context.Context) *hexutil.Uint64 {
+	return nil
+}
+
+// BlobGasPrice returns nil, the chain doesn't accept blob transactions.
+func (t *Transaction) BlobGasPrice(_ context.Context) *hexutil.Big {
+	return nil
+}
+
+func (t *Transaction) CreatedContract(ctx context.Context, args BlockNumberArgs) (*Account, error) {
+	receipt, err := t.resolveReceipt(ctx)
+	if err != nil || receipt == nil || receipt.ContractAddress == (common.Address{}) {
+		return nil, err
+	}
+	_, block, err := t.resolve(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return t.account(receipt.ContractAddress, block, args), nil
+}
+
+func (t *Transaction) Logs(ctx context.Context) (*[]*Log, error) {
+	receipt, err := t.resolveReceipt(ctx)
+	if err != nil || receipt == nil {
+		return nil, err
+	}
+	logs := make([]*Log, len(receipt.Logs))
+	for i, log := range receipt.Logs {
+		logs[i] = &Log{r: t.r, transaction: t, log: log}
+	}
+	return &logs, nil
+}
+
+func (t *Transaction) R(ctx context.Context) (hexutil.Big, error) {
+	tx, _, err := t.resolve(ctx)
+	if err != nil || tx == nil {
+		return hexutil.Big{}, err
+	}
+	_, r, _ := tx.RawSignatureValues()
+	return hexutil.Big(*r), nil
+}
+
+func (t *Transaction) S(ctx context.Context) (hexutil.Big, error) {
+	tx, _, err := t.resolve(ctx)
+	if err != nil || tx == nil {
+		return hexutil.Big{}, err
+	}
+	_, _, s := tx.RawSignatureValues()
+	return hexutil.Big(*s), nil
+}
+
+func (t *Transaction) V(ctx context.Context) (hexutil.Big, error) {
+	tx, _, err := t.resolve(ctx)
+	if err != nil || tx == nil {
+		return hexutil.Big{}, err
+	}
+	v, _, _ := tx.RawSignatureValues()
+	return hexutil.Big(*v), nil
+}
+
+func (t *Transaction) YParity(ctx context.Context) (*hexutil.Big, error) {
+	tx, _, err := t.resolve(ctx)
+	if err != nil || tx == nil || tx.Type() == ethtypes.LegacyTxType {
+		return nil, err
+	}
+	v, _, _ := tx.RawSignatureValues()
+	return (*hexutil.Big)(v), nil
+}
+
+func (t *Transaction) Type(ctx context.Context) (*hexutil.Uint64, error) {
+	tx, _, err := t.resolve(ctx)
+	if err != nil || tx == nil {
+		return nil, err
+	}
+	txType := hexutil.Uint64(tx.Type())
+	return &txType, nil
+}
+
+func (t *Transaction) AccessList(ctx context.Context) (*[]*AccessTuple, error) {
+	tx, _, err := t.resolve(ctx)
+	if err != nil || tx == nil {
+		return nil, err
+	}
+	accessList := tx.AccessList()
+	result := make([]*AccessTuple, len(accessList))
+	for i, tuple := range accessList {
+		result[i] = &AccessTuple{address: tuple.Address, storageKeys: tuple.StorageKeys}
+	}
+	return &result, nil
+}
+
+func (t *Transaction) Raw(ctx context.Context) (hexutil.Bytes, error) {
+	tx, _, err := t.resolve(ctx)
+	if err != nil || tx == nil {
+		return hexutil.Bytes{}, err
+	}
+	return tx.MarshalBinary()
+}
+
+func (t *Transaction) RawReceipt(ctx context.Context) (hexutil.Bytes, error) {
+	receipt, err := t.resolveReceipt(ctx)
+	if err != nil || receipt == nil {
+		return hexutil.Bytes{}, err
+	}
+	return receipt.MarshalBinary()
+}
+
+// txSigner returns the signer of a transaction, matching the signer used by the
+// JSON-RPC server.
+func txSigner(tx *ethtypes.Transaction) ethtypes.Signer {
+	if tx.Protected() {
+		return ethtypes.LatestSignerForChainID(tx.ChainId())
+	}
+	return ethtypes.FrontierSigner{}
+}
+
+func isDynamicFeeTx(tx *ethtypes.Transaction) bool {
+	switch tx.Type() {
+	case ethtypes.DynamicFeeTxType, ethtypes.BlobTxType, ethtypes.SetCodeTxType:
+		return true
+	default:
+		return false
+	}
+}
+
+// effectiveGasPrice returns min(gasTipCap + baseFee, gasFeeCap).
+func effectiveGasPrice(tx *ethtypes.Transaction, baseFee *big.Int) *big.Int {
+	price := new(big.Int).Add(tx.GasTipCap(), baseFee)
+	if price.Cmp(tx.GasFeeCap()) > 0 {
+		return new(big.Int).Set(tx.GasFeeCap())
+	}
+	return price
+}
+
+// Log is an EVM log.
+type Log struct {
+	r           *Resolver
+	transaction *Transaction
+	log         *ethtypes.Log
+}
+
+func (l *Log) Transaction(_ context.Context) *Transaction {
+	return l.transaction
+}
+
+// Account returns the contract that emitted the log, at the block argument or
+// else at the latest block.
+func (l *Log) Account(_ context.Context, args BlockNumberArgs) *Account {
+	return &Account{r: l.r, address: l.log.Address, blockNum: args.numberOr(rpctypes.EthLatestBlockNumber)}
+}
+
+func (l *Log) Index(_ context.Context) hexutil.Uint64 {
+	return hexutil.Uint64(l.log.Index)
+}
+
+func (l *Log) Topics(_ context.Context) []common.Hash {
+	return l.log.Topics
+}
+
+func (l *Log) Data(_ context.Context) hexutil.Bytes {
+	return l.log.Data
+}
+
+// AccessTuple is an EIP-2930 access list entry.
+type AccessTuple struct {
+	address     common.Address
+	storageKeys []common.Hash
+}
+
+func (at *AccessTuple) Address(_ context.Context) common.Address {
+	return at.address
+}
+
+func (at *AccessTuple) StorageKeys(_ context.Context) []common.Hash {
+	return at.storageKeys
+}
+
+// Account is an EVM account at a block.
+type Account struct {
+	r        *Resolver
+	address  common.Address
+	blockNum rpctypes.BlockNumber
+}
+
+func (a *Account) blockNrOrHash() rpctypes.BlockNumberOrHash {
+	return rpctypes.BlockNumberOrHash{BlockNumber: &a.blockNum}
+}
+
+func (a *Account) Address(_ context.Context) common.Address {
+	return a.address
+}
+
+func (a *Account) Balance(ctx context.Context) (hexutil.Big, error) {
+	balance, err := a.r.backend.GetBalance(ctx, a.address, a.blockNrOrHash())
+	if err != nil {
+		return hexutil.Big{}, err
+	}
+	return *balance, nil
+}
+
+func (a *Account) TransactionCount(ctx context.Context) (hexutil.Uint64, error) {
+	nonce, err := a.r.backend.GetTransactionCount(ctx, a.address, a.blockNum)
+	if err != nil {
+		return 0, err
+	}
+	return *nonce, nil
+}
+
+func (a *Account) Code(ctx context.Context) (hexutil.Bytes, error) {
+	return a.r.backend.GetCode(ctx, a.address, a.blockNrOrHash())
+}
+
+func (a *Account) Storage(ctx context.Context, args struct{ Slot common.Hash }) (common.Hash, error) {
+	value, err := a.r.backend.GetStorageAt(ctx, a.address, args.Slot.Hex(), a.blockNrOrHash())
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return common.BytesToHash(value), nil
+}
@@ -0,0 +1,150 @@
+package cosmos
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	rpctypes "github.com/cosmos/evm/rpc/types"
+
+	"cosmossdk.io/log"
+
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+)
+
+const (
+	// DefaultProposalsLimit is the page size of cosmos_getProposals if not provided.
+	DefaultProposalsLimit = 20
+	// MaxProposalsLimit is the maximum page size of cosmos_getProposals.
+	MaxProposalsLimit = 100
+)
+
+// Backend defines the methods required by the cosmos namespace.
+type Backend interface {
+	CosmosBalances(ctx context.Context, address common.Address) ([]rpctypes.CosmosCoin, error)
+	CosmosDelegations(ctx context.Context, address common.Address) ([]rpctypes.CosmosDelegation, error)
+	CosmosDelegationRewards(ctx context.Context, address common.Address) (*rpctypes.CosmosDelegationRewards, error)
+	CosmosUnbondingDelegations(ctx context.Context, address common.Address) ([]rpctypes.CosmosUnbondingDelegation, error)
+	CosmosProposals(ctx context.Context, status govv1.ProposalStatus, offset, limit uint64) ([]rpctypes.CosmosProposal, error)
+	CosmosProposal(ctx context.Context, id uint64) (*rpctypes.CosmosProposal, error)
+	CosmosInflation(ctx context.Context) (*rpctypes.CosmosInflation, error)
+	CosmosSupply(ctx context.Context) (*rpctypes.CosmosSupply, error)
+	Bech32ToHex(ctx context.Context, bech32 string) (common.Address, error)
+	HexToBech32(ctx context.Context, address common.Address) (string, error)
+}
+
+// PublicAPI offers the native chain data (bank, staking, distribution, governance and
+// epixmint) to the EVM clients. The accounts are identified by their 0x address.
+type PublicAPI struct {
+	ctx     context.Context
+	logger  log.Logger
+	backend Backend
+}
+
+// NewPublicAPI creates an instance of the cosmos API.
+func NewPublicAPI(logger log.Logger, backend Backend) *PublicAPI {
+	return &PublicAPI{
+		ctx:     context.Background(),
+		logger:  logger.With("api", "cosmos"),
+		backend: backend,
+	}
+}
+
+// GetBalances returns the bank balances of the address in all the denominations.
+func (api *PublicAPI) GetBalances(address common.Address) ([]rpctypes.CosmosCoin, error) {
+	api.logger.Debug("cosmos_getBalances", "address", address.Hex())
+	return api.backend.CosmosBalances(api.ctx, address)
+}
+
+// GetDelegations returns the staking delegations of the address.
+func (api *PublicAPI) GetDelegations(address common.Address) ([]rpctypes.CosmosDelegation, error) {
+	api.logger.Debug("cosmos_getDelegations", "address", address.Hex())
+	return api.backend.CosmosDelegations(api.ctx, address)
+}
+
+// GetDelegationRewards returns the pending rewards of the address delegations.
+func (api *PublicAPI) GetDelegationRewards(address common.Address) (*rpctypes.CosmosDelegationRewards, error) {
+	api.logger.Debug("cosmos_getDelegationRewards", "address", address.Hex())
+	return api.backend.CosmosDelegationRewards(api.ctx, address)
+}
+
+// GetUnbondingDelegations returns the unbonding entries of the address.
+func (api *PublicAPI) GetUnbondingDelegations(address common.Address) ([]rpctypes.CosmosUnbondingDelegation, error) {
+	api.logger.Debug("cosmos_getUnbondingDelegations", "address", address.Hex())
+	return api.backend.CosmosUnbondingDelegations(api.ctx, address)
+}
+
+// GetProposals returns a page of the governance proposals, newest first. The status filter
+// is optional and accepts either the full enum name (e.g. PROPOSAL_STATUS_VOTING_PERIOD)
+// or its short form (e.g. voting_period).
+func (api *PublicAPI) GetProposals(status *string, offset, limit *hexutil.Uint64) ([]rpctypes.CosmosProposal, error) {
+	api.logger.Debug("cosmos_getProposals")
+
+	proposalStatus := govv1.ProposalStatus_PROPOSAL_STATUS_UNSPECIFIED
+	if status != nil && *status != "" {
+		var err error
+		if proposalStatus, err = ParseProposalStatus(*status); err != nil {
+			return nil, err
+		}
+	}
+
+	pageSize := uint64(DefaultProposalsLimit)
+	if limit != nil {
+		if *limit == 0 || *limit > MaxProposalsLimit {
+			return nil, fmt.Errorf("limit must be between 1 and %d, got %d", MaxProposalsLimit, *limit)
+		}
+		pageSize = uint64(*limit)
+	}
+	var pageOffset uint64
+	if offset != nil {
+		pageOffset = uint64(*offset)
+	}
+
+	return api.backend.CosmosProposals(api.ctx, proposalStatus, pageOffset, pageSize)
+}
+
+// GetProposal returns the governance proposal with the id.
+func (api *PublicAPI) GetProposal(id hexutil.Uint64) (*rpctypes.CosmosProposal, error) {
+	api.logger.Debug("cosmos_getProposal", "id", id)
+	return api.backend.CosmosProposal(api.ctx, uint64(id))
+}
+
+// GetInflation returns the current epixmint inflation rate and annual provisions.
+func (api *PublicAPI) GetInflation() (*rpctypes.CosmosInflation, error) {
+	api.logger.Debug("cosmos_getInflation")
+	return api.backend.CosmosInflation(api.ctx)
+}
+
+// GetSupply returns the current and the maximum epixmint supply.
+func (api *PublicAPI) GetSupply() (*rpctypes.CosmosSupply, error) {
+	api.logger.Debug("cosmos_getSupply")
+	return api.backend.CosmosSupply(api.ctx)
+}
+
+// Bech32ToHex converts a bech32 address to its 0x representation.
+func (api *PublicAPI) Bech32ToHex(bech32 string) (common.Address, error) {
+	api.logger.Debug("cosmos_bech32ToHex", "address", bech32)
+	return api.backend.Bech32ToHex(api.ctx, bech32)
+}
+
+// HexToBech32 converts a 0x address to its bech32 representation.
+func (api *PublicAPI) HexToBech32(address common.Address) (string, error) {
+	api.logger.Debug("cosmos_hexToBech32", "address", address.Hex())
+	return api.backend.HexToBech32(api.ctx, address)
+}
+
+// ParseProposalStatus parses the full or the short name of a governance proposal status.
+func ParseProposalStatus(status string) (govv1.ProposalStatus, error) {
+	name := strings.ToUpper(status)
+	if !strings.HasPrefix(name, "PROPOSAL_STATUS_") {
+		name = "PROPOSAL_STATUS_" + name
+	}
+	value, ok := govv1.ProposalStatus_value[name]
+	if !ok {
+		return govv1.ProposalStatus_PROPOSAL_STATUS_UNSPECIFIED, fmt.Errorf("invalid proposal status %q", status)
+	}
+	return govv1.ProposalStatus(value), nil
+}
@@ -0,0 +1,34 @@
+package cosmos
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+)
+
+func TestParseProposalStatus(t *testing.T) {
+	testCases := []struct {
+		status   string
+		expected govv1.ProposalStatus
+		expErr   bool
+	}{
+		{"PROPOSAL_STATUS_VOTING_PERIOD", govv1.ProposalStatus_PROPOSAL_STATUS_VOTING_PERIOD, false},
+		{"voting_period", govv1.ProposalStatus_PROPOSAL_STATUS_VOTING_PERIOD, false},
+		{"Passed", govv1.ProposalStatus_PROPOSAL_STATUS_PASSED, false},
+		{"deposit_period", govv1.ProposalStatus_PROPOSAL_STATUS_DEPOSIT_PERIOD, false},
+		{"unknown", govv1.ProposalStatus_PROPOSAL_STATUS_UNSPECIFIED, true},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.status, func(t *testing.T) {
+			status, err := ParseProposalStatus(tc.status)
+			if tc.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, status)
+		})
+	}
+}
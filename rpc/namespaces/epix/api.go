@@ -0,0 +1,117 @@
+package epix
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rpc"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	evmmempool "github.com/cosmos/evm/mempool"
+	"github.com/cosmos/evm/rpc/backend"
+	"github.com/cosmos/evm/rpc/stream"
+	rpctypes "github.com/cosmos/evm/rpc/types"
+	servertypes "github.com/cosmos/evm/server/types"
+	evmtrace "github.com/cosmos/evm/trace"
+
+	"cosmossdk.io/log"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/server"
+)
+
+const (
+	// Namespace is the JSON-RPC namespace of the EpixChain specific APIs.
+	Namespace = "epix"
+
+	apiVersion = "1.0"
+
+	// DefaultTxsByAddressLimit is the page size of epix_getTransactionsByAddress if not provided.
+	DefaultTxsByAddressLimit = 100
+	// MaxTxsByAddressLimit is the maximum page size of epix_getTransactionsByAddress.
+	MaxTxsByAddressLimit = 1000
+)
+
+var tracer = otel.Tracer("evm/rpc/namespaces/epix")
+
+// Backend defines the methods required by the epix namespace.
+type Backend interface {
+	GetTransactionsByAddress(
+		ctx context.Context,
+		address common.Address,
+		fromBlock, toBlock rpctypes.BlockNumber,
+		cursor hexutil.Bytes,
+		limit int,
+	) (*rpctypes.AddressTransactionsResult, error)
+}
+
+// PublicAPI offers the EpixChain specific APIs, served from the custom indexer.
+type PublicAPI struct {
+	logger  log.Logger
+	backend Backend
+}
+
+// NewPublicAPI creates an instance of the epix API.
+func NewPublicAPI(logger log.Logger, backend Backend) *PublicAPI {
+	return &PublicAPI{
+		logger:  logger.With("module", "epix"),
+		backend: backend,
+	}
+}
+
+// CreateAPIs creates the epix namespace APIs, it is meant to be registered with
+// rpc.RegisterAPINamespace.
+func CreateAPIs(
+	ctx *server.Context,
+	clientCtx client.Context,
+	_ *stream.RPCStream,
+	allowUnprotectedTxs bool,
+	indexer servertypes.EVMTxIndexer,
+	mempool *evmmempool.ExperimentalEVMMempool,
+	cache *backend.ResponseCache,
+	pending *backend.PendingStateCache,
+) []rpc.API {
+	evmBackend := backend.NewBackend(ctx, ctx.Logger, clientCtx, allowUnprotectedTxs, indexer, mempool, cache, pending)
+	return []rpc.API{
+		{
+			Namespace: Namespace,
+			Version:   apiVersion,
+			Service:   NewPublicAPI(ctx.Logger, evmBackend),
+			Public:    true,
+		},
+	}
+}
+
+// GetTransactionsByAddress returns the EVM transactions sent from or to the address within
+// the block range, in ascending order. The roles of every entry describe how the address is
+// involved: "from", "to", "create" or "internal" (only if the node traces internal txs).
+// The cursor returned as nextCursor must be passed to fetch the next page.
+func (api *PublicAPI) GetTransactionsByAddress(
+	address common.Address,
+	fromBlock, toBlock rpctypes.BlockNumber,
+	cursor *hexutil.Bytes,
+	limit *hexutil.Uint64,
+) (_ *rpctypes.AddressTransactionsResult, err error) {
+	api.logger.Debug("epix_getTransactionsByAddress", "address", address.Hex(), "from", fromBlock, "to", toBlock)
+	ctx, span := tracer.Start(context.Background(), "GetTransactionsByAddress", trace.WithAttributes(attribute.String("address", address.Hex())))
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+
+	pageSize := DefaultTxsByAddressLimit
+	if limit != nil {
+		if *limit == 0 || *limit > MaxTxsByAddressLimit {
+			return nil, fmt.Errorf("limit must be between 1 and %d, got %d", MaxTxsByAddressLimit, *limit)
+		}
+		pageSize = int(*limit)
+	}
+
+	var pageCursor hexutil.Bytes
+	if cursor != nil {
+		pageCursor = *cursor
+	}
+
+	return api.backend.GetTransactionsByAddress(ctx, address, fromBlock, toBlock, pageCursor, pageSize)
+}
@@ -0,0 +1,289 @@
+package admin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"runtime"
+	"slices"
+	"strconv"
+	"strings"
+
+	"go.opentelemetry.io/otel"
+
+	"github.com/cometbft/cometbft/p2p"
+	rpcclient "github.com/cometbft/cometbft/rpc/client"
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+
+	"cosmossdk.io/log"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/cosmos/cosmos-sdk/version"
+)
+
+var tracer = otel.Tracer("evm/rpc/namespaces/ethereum/admin")
+
+const (
+	// enodeScheme is the scheme of the geth node URLs, the CometBFT node ID
+	// replaces the public key of the node.
+	enodeScheme = "enode://"
+	// gethNodeIDLength is the hex length of a geth node ID, a secp256k1 public key.
+	gethNodeIDLength = 128
+)
+
+// peerDialer is implemented by the CometBFT clients able to dial peers.
+type peerDialer interface {
+	DialPeers(ctx context.Context, peers []string, persistent, unconditional, private bool) (*coretypes.ResultDialPeers, error)
+}
+
+// NodeInfo is the information about the node, in the geth admin_nodeInfo format.
+type NodeInfo struct {
+	ID    string `json:"id"`
+	Name  string `json:"name"`
+	Enode string `json:"enode"`
+	ENR   string `json:"enr"`
+	IP    string `json:"ip"`
+	Ports struct {
+		Discovery int `json:"discovery"`
+		Listener  int `json:"listener"`
+	} `json:"ports"`
+	ListenAddr string                 `json:"listenAddr"`
+	Protocols  map[string]interface{} `json:"protocols"`
+}
+
+// PeerInfo is the information about a connected peer, in the geth admin_peers
+// format.
+type PeerInfo struct {
+	ENR     string   `json:"enr,omitempty"`
+	Enode   string   `json:"enode"`
+	ID      string   `json:"id"`
+	Name    string   `json:"name"`
+	Caps    []string `json:"caps"`
+	Network struct {
+		LocalAddress  string `json:"localAddress"`
+		RemoteAddress string `json:"remoteAddress"`
+		Inbound       bool   `json:"inbound"`
+		Trusted       bool   `json:"trusted"`
+		Static        bool   `json:"static"`
+	} `json:"network"`
+	Protocols map[string]interface{} `json:"protocols"`
+}
+
+// CometProtocol is the CometBFT entry of the protocols of a node or peer.
+type CometProtocol struct {
+	Network  string `json:"network"`
+	Version  string `json:"version"`
+	Moniker  string `json:"moniker"`
+	P2P      uint64 `json:"p2p"`
+	Block    uint64 `json:"block"`
+	App      uint64 `json:"app"`
+	Channels string `json:"channels"`
+}
+
+// API is the private admin prefixed set of APIs of the node operators. It maps
+// the geth admin methods to the CometBFT node, the methods CometBFT can't serve
+// return an error.
+type API struct {
+	ctx      *server.Context
+	logger   log.Logger
+	tmClient rpcclient.Client
+}
+
+// NewAPI creates an instance of the Admin API.
+func NewAPI(ctx *server.Context, clientCtx client.Context) *API {
+	return &API{
+		ctx:      ctx,
+		logger:   ctx.Logger.With("api", "admin"),
+		tmClient: clientCtx.Client.(rpcclient.Client),
+	}
+}
+
+// NodeInfo returns the information about the node, the id is the CometBFT node
+// ID and the enode URL is built from it and the p2p address of the node.
+func (api *API) NodeInfo() (*NodeInfo, error) {
+	api.logger.Debug("admin_nodeInfo")
+	ctx, span := tracer.Start(context.Background(), "admin_nodeInfo")
+	defer span.End()
+
+	status, err := api.tmClient.Status(ctx)
+	if err != nil {
+		return nil, err
+	}
+	nodeInfo := status.NodeInfo
+
+	listenAddr := nodeInfo.ListenAddr
+	if api.ctx.Config != nil && api.ctx.Config.P2P.ExternalAddress != "" {
+		listenAddr = api.ctx.Config.P2P.ExternalAddress
+	}
+	host, port := splitHostPort(listenAddr)
+
+	info := &NodeInfo{
+		ID:         string(nodeInfo.ID()),
+		Name:       nodeName(),
+		Enode:      enodeURL(nodeInfo.ID(), listenAddr),
+		IP:         host,
+		ListenAddr: net.JoinHostPort(host, strconv.Itoa(port)),
+		Protocols:  map[string]interface{}{"cometbft": cometProtocol(nodeInfo)},
+	}
+	info.Ports.Discovery = port
+	info.Ports.Listener = port
+	return info, nil
+}
+
+// Peers returns the information about the connected peers. The peers dialed
+// persistently are reported as static, and the unconditional peers as trusted.
+func (api *API) Peers() ([]*PeerInfo, error) {
+	api.logger.Debug("admin_peers")
+	ctx, span := tracer.Start(context.Background(), "admin_peers")
+	defer span.End()
+
+	netInfo, err := api.tmClient.NetInfo(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var localAddress string
+	var persistent, unconditional []string
+	if cfg := api.ctx.Config; cfg != nil {
+		host, port := splitHostPort(cfg.P2P.ListenAddress)
+		localAddress = net.JoinHostPort(host, strconv.Itoa(port))
+		persistent = peerIDs(cfg.P2P.PersistentPeers)
+		unconditional = peerIDs(cfg.P2P.UnconditionalPeerIDs)
+	}
+
+	peers := make([]*PeerInfo, 0, len(netInfo.Peers))
+	for _, peer := range netInfo.Peers {
+		nodeInfo := peer.NodeInfo
+		id := string(nodeInfo.ID())
+		_, port := splitHostPort(nodeInfo.ListenAddr)
+		remoteAddress := net.JoinHostPort(peer.RemoteIP, strconv.Itoa(port))
+
+		info := &PeerInfo{
+			Enode:     enodeURL(nodeInfo.ID(), remoteAddress),
+			ID:        id,
+			Name:      fmt.Sprintf("%s/CometBFT/v%s", nodeInfo.Moniker, nodeInfo.Version),
+			Caps:      []string{fmt.Sprintf("cometbft/%d", nodeInfo.ProtocolVersion.P2P)},
+			Protocols: map[string]interface{}{"cometbft": cometProtocol(nodeInfo)},
+		}
+		info.Network.LocalAddress = localAddress
+		info.Network.RemoteAddress = remoteAddress
+		info.Network.Inbound = !peer.IsOutbound
+		info.Network.Trusted = slices.Contains(unconditional, strings.ToLower(id))
+		info.Network.Static = slices.Contains(persistent, strings.ToLower(id))
+		peers = append(peers, info)
+	}
+	return peers, nil
+}
+
+// AddPeer dials a persistent peer, the url is either an enode URL with the
+// CometBFT node ID or a CometBFT peer address "id@host:port".
+func (api *API) AddPeer(url string) (bool, error) {
+	api.logger.Debug("admin_addPeer", "url", url)
+	ctx, span := tracer.Start(context.Background(), "admin_addPeer")
+	defer span.End()
+
+	addr, err := ParsePeerURL(url)
+	if err != nil {
+		return false, err
+	}
+	dialer, ok := api.tmClient.(peerDialer)
+	if !ok {
+		return false, fmt.Errorf("client %T can't dial peers", api.tmClient)
+	}
+	if _, err := dialer.DialPeers(ctx, []string{addr}, true, false, false); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// RemovePeer disconnects from a peer.
+// Unsupported on CometBFT, which doesn't expose the removal of a peer.
+func (api *API) RemovePeer(_ string) (bool, error) {
+	api.logger.Debug("admin_removePeer")
+	return false, errors.New("admin_removePeer is not supported on CometBFT, remove the peer from persistent_peers in config.toml")
+}
+
+// Datadir returns the home directory of the node.
+func (api *API) Datadir() string {
+	api.logger.Debug("admin_datadir")
+	return api.ctx.Config.RootDir
+}
+
+// StartHTTP starts the HTTP JSON-RPC server.
+// Unsupported, the server is started with the node from the json-rpc configuration.
+func (api *API) StartHTTP(_ *string, _ *int, _ *string, _ *string, _ *string) (bool, error) {
+	api.logger.Debug("admin_startHTTP")
+	return false, errors.New("admin_startHTTP is not supported, enable the json-rpc server in app.toml")
+}
+
+// StopHTTP stops the HTTP JSON-RPC server.
+// Unsupported, the server runs as long as the node.
+func (api *API) StopHTTP() (bool, error) {
+	api.logger.Debug("admin_stopHTTP")
+	return false, errors.New("admin_stopHTTP is not supported, disable the json-rpc server in app.toml")
+}
+
+// ParsePeerURL returns the CometBFT peer address "id@host:port" of an enode URL
+// or of a CometBFT peer address. The enode URLs must use the CometBFT node ID,
+// not a geth public key.
+func ParsePeerURL(url string) (string, error) {
+	addr, _, _ := strings.Cut(strings.TrimPrefix(url, enodeScheme), "?")
+	if id, _, ok := strings.Cut(addr, "@"); ok && len(id) == gethNodeIDLength {
+		return "", fmt.Errorf("invalid peer %s: geth node IDs are not supported, use the CometBFT node ID", url)
+	}
+	netAddr, err := p2p.NewNetAddressString(addr)
+	if err != nil {
+		return "", fmt.Errorf("invalid peer %s: %w", url, err)
+	}
+	return netAddr.String(), nil
+}
+
+// enodeURL returns the enode-like identifier of a CometBFT node.
+func enodeURL(id p2p.ID, listenAddr string) string {
+	return enodeScheme + p2p.IDAddressString(id, listenAddr)
+}
+
+// nodeName returns the client name of the node, in the geth format.
+func nodeName() string {
+	return fmt.Sprintf("%s/v%s/%s-%s/%s", version.AppName, version.Version, runtime.GOOS, runtime.GOARCH, runtime.Version())
+}
+
+// splitHostPort returns the host and port of a CometBFT listen address such as
+// "tcp://0.0.0.0:26656".
+func splitHostPort(listenAddr string) (string, int) {
+	if _, addr, ok := strings.Cut(listenAddr, "://"); ok {
+		listenAddr = addr
+	}
+	host, portStr, err := net.SplitHostPort(listenAddr)
+	if err != nil {
+		return listenAddr, 0
+	}
+	port, _ := strconv.Atoi(portStr)
+	return host, port
+}
+
+// peerIDs returns the node IDs of a comma separated list of peers or IDs.
+func peerIDs(peers string) []string {
+	var ids []string
+	for _, peer := range strings.Split(peers, ",") {
+		id, _, _ := strings.Cut(strings.TrimSpace(peer), "@")
+		if id != "" {
+			ids = append(ids, strings.ToLower(id))
+		}
+	}
+	return ids
+}
+
+func cometProtocol(nodeInfo p2p.DefaultNodeInfo) CometProtocol {
+	return CometProtocol{
+		Network:  nodeInfo.Network,
+		Version:  nodeInfo.Version,
+		Moniker:  nodeInfo.Moniker,
+		P2P:      nodeInfo.ProtocolVersion.P2P,
+		Block:    nodeInfo.ProtocolVersion.Block,
+		App:      nodeInfo.ProtocolVersion.App,
+		Channels: nodeInfo.Channels.String(),
+	}
+}
@@ -0,0 +1,48 @@
+package admin
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParsePeerURL(t *testing.T) {
+	id := "f4a0dfca4dd5b3b3d3a5d9e8a3a0cd7bd1df9e27"
+	testCases := []struct {
+		name   string
+		url    string
+		exp    string
+		expErr bool
+	}{
+		{"cometbft address", id + "@127.0.0.1:26656", id + "@127.0.0.1:26656", false},
+		{"enode url", "enode://" + id + "@10.0.0.1:26656", id + "@10.0.0.1:26656", false},
+		{"enode url with discovery port", "enode://" + id + "@10.0.0.1:26656?discport=0", id + "@10.0.0.1:26656", false},
+		{"geth node id", "enode://" + id + id + id + "0123456789abcdef0123456789abcdef0123456789abcdef@10.0.0.1:30303", "", true},
+		{"missing id", "10.0.0.1:26656", "", true},
+		{"missing port", id + "@10.0.0.1", "", true},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			addr, err := ParsePeerURL(tc.url)
+			if tc.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.exp, addr)
+		})
+	}
+}
+
+func TestSplitHostPort(t *testing.T) {
+	host, port := splitHostPort("tcp://0.0.0.0:26656")
+	require.Equal(t, "0.0.0.0", host)
+	require.Equal(t, 26656, port)
+
+	host, port = splitHostPort("1.2.3.4:26656")
+	require.Equal(t, "1.2.3.4", host)
+	require.Equal(t, 26656, port)
+
+	require.Equal(t, "enode://abcd@1.2.3.4:26656", enodeURL("abcd", "tcp://1.2.3.4:26656"))
+	require.Equal(t, []string{"abcd", "ef01"}, peerIDs("ABCD@1.2.3.4:26656, ef01"))
+}
@@ -55,6 +55,8 @@ type EthereumAPI interface {
 	// on-chain, and interact with smart contracts.
 	SendRawTransaction(data hexutil.Bytes) (common.Hash, error)
 	SendTransaction(args evmtypes.TransactionArgs) (common.Hash, error)
+	SendBundle(args rpctypes.SendBundleArgs) (*rpctypes.SendBundleResult, error)
+	CallBundle(args rpctypes.CallBundleArgs) (*rpctypes.CallBundleResult, error)
 	// eth_sendPrivateTransaction
 	// eth_cancel	PrivateTransaction
 
@@ -265,6 +267,24 @@ func (e *PublicAPI) SendRawTransaction(data hexutil.Bytes) (_ common.Hash, err e
 	return e.backend.SendRawTransaction(ctx, data)
 }
 
+// SendBundle submits a bundle of raw transactions included contiguously and in
+// order in the target block, or not at all. Bundles are disabled by default.
+func (e *PublicAPI) SendBundle(args rpctypes.SendBundleArgs) (_ *rpctypes.SendBundleResult, err error) {
+	ctx, span := tracer.Start(context.Background(), "eth_sendBundle")
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+	e.logger.Debug("eth_sendBundle", "tx_count", len(args.Txs), "block_number", uint64(args.BlockNumber))
+	return e.backend.SendBundle(ctx, args)
+}
+
+// CallBundle executes a bundle of raw transactions on top of the latest state
+// and returns their results.
+func (e *PublicAPI) CallBundle(args rpctypes.CallBundleArgs) (_ *rpctypes.CallBundleResult, err error) {
+	ctx, span := tracer.Start(context.Background(), "eth_callBundle")
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+	e.logger.Debug("eth_callBundle", "tx_count", len(args.Txs))
+	return e.backend.CallBundle(ctx, args)
+}
+
 // SendTransaction sends an Ethereum transaction.
 func (e *PublicAPI) SendTransaction(args evmtypes.TransactionArgs) (_ common.Hash, err error) {
 	ctx, span := tracer.Start(context.Background(), "eth_sendTransaction")
@@ -52,6 +52,7 @@ type Backend interface {
 	GetLogs(ctx context.Context, blockHash common.Hash) ([][]*ethtypes.Log, error)
 	GetLogsByHeight(ctx context.Context, height *int64) ([][]*ethtypes.Log, error)
 	BlockBloomFromCometBlock(ctx context.Context, blockRes *coretypes.ResultBlockResults) (ethtypes.Bloom, error)
+	LogBlocksFromIndexer(ctx context.Context, from, to int64, addresses []common.Address, topics [][]common.Hash) ([]int64, bool, error)
 
 	BloomStatus() (uint64, uint64)
 
@@ -168,13 +168,31 @@ func (f *Filter) Logs(ctx context.Context, logLimit int, blockLimit int64) (logs
 		return nil, errInvalidBlockRange
 	}
 
-	if blockLimit > 0 && to-from > uint64(blockLimit) {
-		return nil, fmt.Errorf("maximum [from, to] blocks distance: %d", blockLimit)
+	// Use the indexer log index to only visit the blocks with matching logs when
+	// available, the block range cap then bounds the number of visited blocks
+	// instead of the distance of the range.
+	heights, indexed, err := f.backend.LogBlocksFromIndexer(ctx, int64(from), int64(to), f.criteria.Addresses, f.criteria.Topics) //#nosec G115
+	if err != nil {
+		f.logger.Debug("failed to query the log index, falling back to block scan", "error", err.Error())
+		indexed = false
+	}
+
+	if indexed {
+		if blockLimit > 0 && int64(len(heights)) > blockLimit {
+			return nil, fmt.Errorf("maximum blocks with matching logs: %d", blockLimit)
+		}
+	} else {
+		if blockLimit > 0 && to-from > uint64(blockLimit) {
+			return nil, fmt.Errorf("maximum [from, to] blocks distance: %d", blockLimit)
+		}
+		heights = make([]int64, 0, to-from+1)
+		for height := from; height <= to; height++ {
+			heights = append(heights, int64(height)) //#nosec G115
+		}
 	}
 
-	for height := from; height <= to; height++ {
-		h := int64(height) //#nosec G115
-		blockRes, err := f.backend.CometBlockResultByNumber(ctx, &h)
+	for _, height := range heights {
+		blockRes, err := f.backend.CometBlockResultByNumber(ctx, &height)
 		if err != nil {
 			f.logger.Debug("failed to fetch block result from CometBFT", "height", height, "error", err.Error())
 			return nil, fmt.Errorf("failed to fetch block result from CometBFT: %w", err)
@@ -58,6 +58,7 @@ func TestLogs(t *testing.T) {
 			prepare: func() *filtermocks.Backend {
 				backend := &filtermocks.Backend{}
 				backend.EXPECT().HeaderByNumber(mock.Anything, mock.Anything).Return(fakeHeader, nil)
+				backend.EXPECT().LogBlocksFromIndexer(mock.Anything, blockHeight, blockHeight, mock.Anything, mock.Anything).Return(nil, false, nil)
 				backend.EXPECT().CometBlockResultByNumber(mock.Anything, &blockHeight).Return((*cmtrpctypes.ResultBlockResults)(nil), errors.New("block result error"))
 				return backend
 			},
@@ -74,6 +75,7 @@ func TestLogs(t *testing.T) {
 			prepare: func() *filtermocks.Backend {
 				backend := &filtermocks.Backend{}
 				backend.EXPECT().HeaderByNumber(mock.Anything, mock.Anything).Return(fakeHeader, nil)
+				backend.EXPECT().LogBlocksFromIndexer(mock.Anything, blockHeight, blockHeight, mock.Anything, mock.Anything).Return(nil, false, nil)
 				backend.EXPECT().CometBlockResultByNumber(mock.Anything, &blockHeight).Return(fakeBlockRes, nil)
 				backend.EXPECT().BlockBloomFromCometBlock(mock.Anything, fakeBlockRes).Return(ethtypes.Bloom{}, errors.New("bloom error"))
 				return backend
@@ -146,6 +148,40 @@ func TestFilter(t *testing.T) {
 			},
 			expErr: "invalid block range params",
 		},
+		{
+			name:   "block range cap applies without log index",
+			filter: filters.FilterCriteria{FromBlock: big.NewInt(1), ToBlock: big.NewInt(100)},
+			expectations: func(b *filtermocks.Backend) {
+				b.EXPECT().HeaderByNumber(mock.Anything, rpctypes.EthLatestBlockNumber).Return(&ethtypes.Header{Number: big.NewInt(100)}, nil)
+				b.EXPECT().LogBlocksFromIndexer(mock.Anything, int64(1), int64(100), mock.Anything, mock.Anything).Return(nil, false, nil)
+			},
+			expErr: "maximum [from, to] blocks distance: 50",
+		},
+		{
+			name:   "block range cap applies to the log index candidate blocks",
+			filter: filters.FilterCriteria{FromBlock: big.NewInt(1), ToBlock: big.NewInt(100)},
+			expectations: func(b *filtermocks.Backend) {
+				heights := make([]int64, 51)
+				for i := range heights {
+					heights[i] = int64(i + 1)
+				}
+				b.EXPECT().HeaderByNumber(mock.Anything, rpctypes.EthLatestBlockNumber).Return(&ethtypes.Header{Number: big.NewInt(100)}, nil)
+				b.EXPECT().LogBlocksFromIndexer(mock.Anything, int64(1), int64(100), mock.Anything, mock.Anything).Return(heights, true, nil)
+			},
+			expErr: "maximum blocks with matching logs: 50",
+		},
+		{
+			name:   "log index only visits candidate blocks",
+			filter: filters.FilterCriteria{FromBlock: big.NewInt(1), ToBlock: big.NewInt(100)},
+			expectations: func(b *filtermocks.Backend) {
+				height := int64(42)
+				blockRes := &cmtrpctypes.ResultBlockResults{Height: height}
+				b.EXPECT().HeaderByNumber(mock.Anything, rpctypes.EthLatestBlockNumber).Return(&ethtypes.Header{Number: big.NewInt(100)}, nil)
+				b.EXPECT().LogBlocksFromIndexer(mock.Anything, int64(1), int64(100), mock.Anything, mock.Anything).Return([]int64{height}, true, nil)
+				b.EXPECT().CometBlockResultByNumber(mock.Anything, &height).Return(blockRes, nil)
+				b.EXPECT().BlockBloomFromCometBlock(mock.Anything, blockRes).Return(ethtypes.Bloom{}, nil)
+			},
+		},
 	}
 
 	for _, tc := range testCases {
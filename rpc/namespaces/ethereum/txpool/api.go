@@ -9,6 +9,7 @@ import (
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/trace"
 
+	evmmempool "github.com/cosmos/evm/mempool"
 	"github.com/cosmos/evm/rpc/backend"
 	"github.com/cosmos/evm/rpc/types"
 	evmtrace "github.com/cosmos/evm/trace"
@@ -64,3 +65,19 @@ func (api *PublicAPI) Status() (_ map[string]hexutil.Uint, err error) {
 	defer func() { evmtrace.EndSpanErr(span, err) }()
 	return api.backend.Status(ctx)
 }
+
+// CosmosContent returns the Cosmos transactions contained within the transaction pool
+func (api *PublicAPI) CosmosContent() (_ map[string]map[string]*types.CosmosPoolTransaction, err error) {
+	api.logger.Debug("txpool_cosmosContent")
+	ctx, span := tracer.Start(context.Background(), "CosmosContent")
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+	return api.backend.CosmosContent(ctx)
+}
+
+// Dropped returns why a recently dropped transaction was rejected by or removed from the transaction pool
+func (api *PublicAPI) Dropped(hash common.Hash) (_ *evmmempool.DroppedTx, err error) {
+	api.logger.Debug("txpool_dropped", "hash", hash.Hex())
+	ctx, span := tracer.Start(context.Background(), "Dropped", trace.WithAttributes(attribute.String("hash", hash.Hex())))
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+	return api.backend.Dropped(ctx, hash)
+}
@@ -0,0 +1,187 @@
+package policy
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math"
+	"net/http"
+	"time"
+)
+
+const (
+	// ErrCodeParseError is the JSON-RPC error code of the request bodies which can't be parsed.
+	ErrCodeParseError = -32700
+	// ErrCodeInvalidAPIKey is the JSON-RPC error code of the requests with an unknown API key.
+	ErrCodeInvalidAPIKey = -32002
+	// ErrCodeMethodNotSupported is the JSON-RPC error code of the methods denied by the policy.
+	ErrCodeMethodNotSupported = -32004
+	// ErrCodeLimitExceeded is the JSON-RPC error code of the rate limited requests.
+	ErrCodeLimitExceeded = -32005
+)
+
+// ErrInvalidAPIKey is returned for the requests with an unknown API key.
+var ErrInvalidAPIKey = &Error{
+	Code:       ErrCodeInvalidAPIKey,
+	Message:    "invalid API key",
+	HTTPStatus: http.StatusUnauthorized,
+}
+
+// ErrParse is returned for the request bodies which aren't a single or a batch
+// request. They are rejected rather than passed on unchecked, as the server would
+// serve the first request of a body followed by trailing data.
+var ErrParse = &Error{
+	Code:       ErrCodeParseError,
+	Message:    "parse error",
+	HTTPStatus: http.StatusOK,
+}
+
+// ErrRequestTooLarge is returned for the request bodies larger than the limit of
+// the server.
+var ErrRequestTooLarge = &Error{
+	Code:       ErrCodeParseError,
+	Message:    "request body too large",
+	HTTPStatus: http.StatusRequestEntityTooLarge,
+}
+
+// Error is a JSON-RPC error returned by the policy, along with the status of the
+// HTTP response.
+type Error struct {
+	Code       int
+	Message    string
+	HTTPStatus int
+	// RetryAfter is the time until a rate limited client can retry the request.
+	RetryAfter time.Duration
+}
+
+func (e *Error) Error() string {
+	return e.Message
+}
+
+// RetryAfterSeconds returns the value of the Retry-After header of the error, or
+// zero if the request should not be retried.
+func (e *Error) RetryAfterSeconds() int {
+	if e.RetryAfter <= 0 {
+		return 0
+	}
+	return int(math.Ceil(e.RetryAfter.Seconds()))
+}
+
+func newMethodNotSupportedError(method string) *Error {
+	return &Error{
+		Code:       ErrCodeMethodNotSupported,
+		Message:    fmt.Sprintf("method %s is not available", method),
+		HTTPStatus: http.StatusOK,
+	}
+}
+
+func newLocalMethodError(method string) *Error {
+	return &Error{
+		Code:       ErrCodeMethodNotSupported,
+		Message:    fmt.Sprintf("method %s is only available to local clients", method),
+		HTTPStatus: http.StatusOK,
+	}
+}
+
+func newLimitExceededError(retryAfter time.Duration) *Error {
+	return &Error{
+		Code:       ErrCodeLimitExceeded,
+		Message:    "request rate limit exceeded",
+		HTTPStatus: http.StatusTooManyRequests,
+		RetryAfter: retryAfter,
+	}
+}
+
+// newCostExceedsBurstError returns the error of the requests that cost more than the
+// rate limit burst, they are not retryable.
+func newCostExceedsBurstError(cost, burst int) *Error {
+	return &Error{
+		Code:       ErrCodeLimitExceeded,
+		Message:    fmt.Sprintf("request cost %d exceeds the rate limit burst %d", cost, burst),
+		HTTPStatus: http.StatusTooManyRequests,
+	}
+}
+
+type jsonRequest struct {
+	ID     json.RawMessage `json:"id"`
+	Method string          `json:"method"`
+}
+
+type jsonError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+type jsonErrorResponse struct {
+	Version string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id"`
+	Error   jsonError       `json:"error"`
+}
+
+// isBatch returns true when the first non-whitespace character is '['
+func isBatch(body []byte) bool {
+	body = bytes.TrimLeft(body, " \t\r\n")
+	return len(body) > 0 && body[0] == '['
+}
+
+func parseRequests(body []byte) ([]jsonRequest, bool, error) {
+	if isBatch(body) {
+		var reqs []jsonRequest
+		if err := json.Unmarshal(body, &reqs); err != nil {
+			return nil, true, err
+		}
+		return reqs, true, nil
+	}
+	var req jsonRequest
+	if err := json.Unmarshal(body, &req); err != nil {
+		return nil, false, err
+	}
+	return []jsonRequest{req}, false, nil
+}
+
+// RequestMethods returns the methods called by a single or a batch JSON-RPC request.
+// It returns an error if the request is followed by trailing data.
+func RequestMethods(body []byte) ([]string, error) {
+	reqs, _, err := parseRequests(body)
+	if err != nil {
+		return nil, err
+	}
+	methods := make([]string, len(reqs))
+	for i, req := range reqs {
+		methods[i] = req.Method
+	}
+	return methods, nil
+}
+
+// ErrorResponse returns the JSON-RPC response of the error for each request of the
+// body, a batch request gets a batch response.
+func ErrorResponse(body []byte, rpcErr *Error) []byte {
+	reqs, batch, err := parseRequests(body)
+	if err != nil || len(reqs) == 0 {
+		reqs, batch = []jsonRequest{{}}, false
+	}
+	responses := make([]jsonErrorResponse, len(reqs))
+	for i, req := range reqs {
+		id := req.ID
+		if len(id) == 0 {
+			id = json.RawMessage("null")
+		}
+		responses[i] = jsonErrorResponse{
+			Version: "2.0",
+			ID:      id,
+			Error:   jsonError{Code: rpcErr.Code, Message: rpcErr.Message},
+		}
+	}
+
+	var res []byte
+	if batch {
+		res, err = json.Marshal(responses)
+	} else {
+		res, err = json.Marshal(responses[0])
+	}
+	if err != nil {
+		// unreachable, the response only holds valid JSON values
+		return nil
+	}
+	return res
+}
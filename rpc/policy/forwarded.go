@@ -0,0 +1,85 @@
+package policy
+
+import (
+	"net"
+	"net/http"
+	"net/netip"
+	"strings"
+)
+
+const (
+	forwardedHeaderName     = "Forwarded"
+	xForwardedForHeaderName = "X-Forwarded-For"
+)
+
+// forwardedIP returns the client IP of a request. The forwarded headers are only
+// read if the remote host is a trusted proxy, the hops are followed from the
+// closest one while they are trusted proxies too, so that a client can't spoof its
+// IP by prepending hops. It also returns true if the request has forwarded headers
+// which were ignored, as the remote host is then an untrusted proxy.
+func (p *Policy) forwardedIP(remoteIP string, header http.Header) (string, bool) {
+	addr, err := netip.ParseAddr(remoteIP)
+	if err != nil || !p.isTrustedProxy(addr) {
+		return remoteIP, len(forwardedHops(header)) > 0
+	}
+
+	hops := forwardedHops(header)
+	for i := len(hops) - 1; i >= 0; i-- {
+		hop, err := netip.ParseAddr(hops[i])
+		if err != nil {
+			// an unknown or obfuscated hop can't be trusted, nor the hops behind it
+			return hops[i], false
+		}
+		addr = hop.Unmap()
+		if !p.isTrustedProxy(addr) {
+			break
+		}
+	}
+	return addr.String(), false
+}
+
+// isTrustedProxy returns true if the address is one of the trusted proxies.
+func (p *Policy) isTrustedProxy(addr netip.Addr) bool {
+	addr = addr.Unmap()
+	for _, prefix := range p.trustedProxies {
+		if prefix.Contains(addr) {
+			return true
+		}
+	}
+	return false
+}
+
+// forwardedHops returns the IPs of the hops of a request, from the client to the
+// closest proxy. The RFC 7239 Forwarded header takes precedence over X-Forwarded-For.
+func forwardedHops(header http.Header) []string {
+	var hops []string
+	if values := header.Values(forwardedHeaderName); len(values) > 0 {
+		for _, element := range strings.Split(strings.Join(values, ","), ",") {
+			var hop string
+			for _, pair := range strings.Split(element, ";") {
+				key, value, _ := strings.Cut(strings.TrimSpace(pair), "=")
+				if strings.EqualFold(key, "for") {
+					hop = forwardedNode(value)
+				}
+			}
+			hops = append(hops, hop)
+		}
+		return hops
+	}
+	for _, value := range header.Values(xForwardedForHeaderName) {
+		for _, hop := range strings.Split(value, ",") {
+			hops = append(hops, forwardedNode(hop))
+		}
+	}
+	return hops
+}
+
+// forwardedNode returns the IP of a forwarded node, e.g. `192.0.2.43:47011` or
+// `"[2001:db8:cafe::17]:4711"`.
+func forwardedNode(node string) string {
+	node = strings.Trim(strings.TrimSpace(node), `"`)
+	if host, _, err := net.SplitHostPort(node); err == nil {
+		return host
+	}
+	return strings.TrimSuffix(strings.TrimPrefix(node, "["), "]")
+}
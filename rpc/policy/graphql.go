@@ -0,0 +1,146 @@
+package policy
+
+import (
+	"encoding/json"
+	"net/http"
+)
+
+// graphQLFieldMethods maps the fields of the GraphQL schema to the JSON-RPC
+// methods serving the same data, so that the GraphQL requests are subject to the
+// method rules and costs of the JSON-RPC ones.
+var graphQLFieldMethods = map[string]string{
+	"block":                "eth_getBlockByNumber",
+	"blocks":               "eth_getBlockByNumber",
+	"transaction":          "eth_getTransactionByHash",
+	"logs":                 "eth_getLogs",
+	"gasPrice":             "eth_gasPrice",
+	"maxPriorityFeePerGas": "eth_maxPriorityFeePerGas",
+	"syncing":              "eth_syncing",
+	"chainID":              "eth_chainId",
+	"sendRawTransaction":   "eth_sendRawTransaction",
+	"call":                 "eth_call",
+	"estimateGas":          "eth_estimateGas",
+	"balance":              "eth_getBalance",
+	"transactionCount":     "eth_getTransactionCount",
+	"code":                 "eth_getCode",
+	"storage":              "eth_getStorageAt",
+}
+
+type graphQLRequest struct {
+	Query string `json:"query"`
+}
+
+type graphQLError struct {
+	Message string `json:"message"`
+}
+
+type graphQLErrorResponse struct {
+	Errors []graphQLError `json:"errors"`
+}
+
+// GraphQLHandler wraps an HTTP GraphQL handler with the policy, a request is
+// checked as the JSON-RPC methods of the fields it selects. The rejected requests
+// get a GraphQL error response, the rate limited ones with a 429 status.
+func (p *Policy) GraphQLHandler(next http.Handler) http.Handler {
+	return p.handler(next, GraphQLMethods, graphQLResponse)
+}
+
+// GraphQLMethods returns the JSON-RPC methods of the fields selected by a GraphQL
+// request, once per selection. The query isn't validated: every name matching a
+// field is counted, aliases included, so a request is never charged less than
+// its execution.
+func GraphQLMethods(body []byte) ([]string, error) {
+	var req graphQLRequest
+	if err := json.Unmarshal(body, &req); err != nil {
+		return nil, err
+	}
+
+	var methods []string
+	for _, name := range graphQLNames(req.Query) {
+		if method, ok := graphQLFieldMethods[name]; ok {
+			methods = append(methods, method)
+		}
+	}
+	return methods, nil
+}
+
+// graphQLNames returns the names of a GraphQL document, skipping the comments,
+// the strings, the variables and the directives.
+func graphQLNames(query string) []string {
+	var names []string
+	for i := 0; i < len(query); {
+		c := query[i]
+		switch {
+		case c == '#':
+			for i < len(query) && query[i] != '\n' && query[i] != '\r' {
+				i++
+			}
+		case c == '"':
+			i = skipGraphQLString(query, i)
+		case c == '$' || c == '@':
+			// skip the variable or directive name
+			i++
+			for i < len(query) && isGraphQLNameChar(query[i]) {
+				i++
+			}
+		case isGraphQLNameStart(c):
+			start := i
+			for i < len(query) && isGraphQLNameChar(query[i]) {
+				i++
+			}
+			names = append(names, query[start:i])
+		case c >= '0' && c <= '9':
+			// skip the numbers, including their exponent and suffix letters
+			for i < len(query) && (isGraphQLNameChar(query[i]) || query[i] == '.') {
+				i++
+			}
+		default:
+			i++
+		}
+	}
+	return names
+}
+
+// skipGraphQLString returns the index following the string or block string
+// starting at i.
+func skipGraphQLString(query string, i int) int {
+	if len(query) >= i+3 && query[i:i+3] == `"""` {
+		for i += 3; i < len(query); i++ {
+			if query[i] == '\\' && len(query) >= i+4 && query[i+1:i+4] == `"""` {
+				i += 3
+				continue
+			}
+			if len(query) >= i+3 && query[i:i+3] == `"""` {
+				return i + 3
+			}
+		}
+		return i
+	}
+	for i++; i < len(query); i++ {
+		switch query[i] {
+		case '\\':
+			i++
+		case '"', '\n', '\r':
+			return i + 1
+		}
+	}
+	return i
+}
+
+func isGraphQLNameStart(c byte) bool {
+	return c == '_' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
+
+func isGraphQLNameChar(c byte) bool {
+	return isGraphQLNameStart(c) || (c >= '0' && c <= '9')
+}
+
+// graphQLResponse returns the GraphQL response of a policy error.
+func graphQLResponse(_ []byte, rpcErr *Error) []byte {
+	res, err := json.Marshal(graphQLErrorResponse{Errors: []graphQLError{{Message: rpcErr.Message}}})
+	if err != nil {
+		// unreachable, the response only holds strings
+		return nil
+	}
+	return res
+}
@@ -0,0 +1,110 @@
+package policy
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/evm/server/config"
+)
+
+func TestGraphQLMethods(t *testing.T) {
+	testCases := []struct {
+		name  string
+		query string
+		exp   []string
+	}{
+		{
+			"query fields",
+			`{ block(number: 1) { hash transactionCount } chainID }`,
+			[]string{"eth_getBlockByNumber", "eth_getTransactionCount", "eth_chainId"},
+		},
+		{
+			"aliased fields are counted once per selection",
+			`query { a: block(number: 1) { number } b: block(number: 2) { number } }`,
+			[]string{"eth_getBlockByNumber", "eth_getBlockByNumber"},
+		},
+		{
+			"mutation",
+			`mutation ($data: Bytes!) { sendRawTransaction(data: $data) }`,
+			[]string{"eth_sendRawTransaction"},
+		},
+		{
+			"strings, comments, variables and directives are skipped",
+			"query ($call: Boolean!) { # logs\n block(hash: \"call\") @include(if: $call) { number } }",
+			[]string{"eth_getBlockByNumber"},
+		},
+		{
+			"block strings are skipped",
+			`{ pending { estimateGas(data: {data: """call \""" logs"""}) } }`,
+			[]string{"eth_estimateGas"},
+		},
+		{
+			"introspection",
+			`{ __schema { types { name } } }`,
+			nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			body, err := json.Marshal(graphQLRequest{Query: tc.query})
+			require.NoError(t, err)
+			methods, err := GraphQLMethods(body)
+			require.NoError(t, err)
+			require.Equal(t, tc.exp, methods)
+		})
+	}
+
+	_, err := GraphQLMethods([]byte("{"))
+	require.Error(t, err)
+}
+
+func TestGraphQLHandler(t *testing.T) {
+	cfg := config.DefaultJSONRPCConfig()
+	cfg.DeniedMethods = []string{"eth_sendRawTransaction"}
+	cfg.IPRateLimit = 1
+	cfg.IPRateBurst = 1
+	p, err := New(*cfg)
+	require.NoError(t, err)
+
+	var served int
+	handler := p.GraphQLHandler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		served++
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	newRequest := func(body string) *http.Request {
+		req := httptest.NewRequest(http.MethodPost, "/graphql", strings.NewReader(body))
+		req.RemoteAddr = "10.0.0.1:1234"
+		return req
+	}
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, newRequest(`{"query":"mutation { sendRawTransaction(data: \"0x00\") }"}`))
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.JSONEq(t, `{"errors":[{"message":"method eth_sendRawTransaction is not available"}]}`, rec.Body.String())
+	require.Zero(t, served)
+
+	// the denied field can't be hidden behind trailing data
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, newRequest(`{"query":"mutation { sendRawTransaction(data: \"0x00\") }"} x`))
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.JSONEq(t, `{"errors":[{"message":"parse error"}]}`, rec.Body.String())
+	require.Zero(t, served)
+
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, newRequest(`{"query":"{ chainID }"}`))
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Equal(t, 1, served)
+
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, newRequest(`{"query":"{ chainID }"}`))
+	require.Equal(t, http.StatusTooManyRequests, rec.Code)
+	require.Equal(t, "1", rec.Header().Get("Retry-After"))
+	require.Equal(t, 1, served)
+}
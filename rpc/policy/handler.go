@@ -0,0 +1,71 @@
+package policy
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"strconv"
+)
+
+// maxRequestSize is the maximum size of the request bodies read by the policy,
+// it matches the limit of the go-ethereum HTTP server.
+const maxRequestSize = 5 * 1024 * 1024
+
+// Handler wraps an HTTP JSON-RPC handler with the policy. The rejected requests
+// get a JSON-RPC error response, the rate limited ones with a 429 status. The
+// bodies which aren't valid JSON-RPC requests are rejected with a parse error.
+func (p *Policy) Handler(next http.Handler) http.Handler {
+	return p.handler(next, RequestMethods, ErrorResponse)
+}
+
+// handler wraps an HTTP handler with the policy, requestMethods returns the
+// methods called by a request body and errorResponse the body of the response to
+// a rejected request.
+func (p *Policy) handler(
+	next http.Handler,
+	requestMethods func(body []byte) ([]string, error),
+	errorResponse func(body []byte, rpcErr *Error) []byte,
+) http.Handler {
+	if p == nil {
+		return next
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || p.isForwarded(r) {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		// the bodies which can't be checked are rejected, never passed on unchecked
+		body, err := io.ReadAll(io.LimitReader(r.Body, maxRequestSize+1))
+		_ = r.Body.Close()
+		if err != nil {
+			writeError(w, errorResponse(nil, ErrParse), ErrParse)
+			return
+		}
+		if len(body) > maxRequestSize {
+			writeError(w, errorResponse(nil, ErrRequestTooLarge), ErrRequestTooLarge)
+			return
+		}
+		r.Body = io.NopCloser(bytes.NewReader(body))
+
+		methods, err := requestMethods(body)
+		if err != nil {
+			writeError(w, errorResponse(body, ErrParse), ErrParse)
+			return
+		}
+		if rpcErr := p.Check(p.ClientFromRequest(r), methods); rpcErr != nil {
+			writeError(w, errorResponse(body, rpcErr), rpcErr)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+func writeError(w http.ResponseWriter, res []byte, rpcErr *Error) {
+	w.Header().Set("Content-Type", "application/json")
+	if retryAfter := rpcErr.RetryAfterSeconds(); retryAfter > 0 {
+		w.Header().Set("Retry-After", strconv.Itoa(retryAfter))
+	}
+	w.WriteHeader(rpcErr.HTTPStatus)
+	_, _ = w.Write(res)
+}
@@ -0,0 +1,120 @@
+package policy
+
+import (
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+const (
+	// limiterIdleTimeout is the time after which the token bucket of an idle client
+	// is removed, it is full again by then for any sensible rate limit.
+	limiterIdleTimeout = 10 * time.Minute
+	// limiterSweepInterval is the interval between the removals of the idle buckets.
+	limiterSweepInterval = time.Minute
+	// limiterMaxClients is the maximum number of client buckets, the new clients
+	// share an overflow bucket once it is reached, so that rotating source IPs
+	// can't grow the set without bound.
+	limiterMaxClients = 100_000
+)
+
+// limiterSet holds a token bucket per client, up to maxClients buckets. A nil
+// limiterSet doesn't limit the requests.
+type limiterSet struct {
+	mu         sync.Mutex
+	limit      rate.Limit
+	burst      int
+	maxClients int
+	limiters   map[string]*clientLimiter
+	// overflow is the bucket shared by the clients seen while the set is full
+	overflow  *rate.Limiter
+	lastSweep time.Time
+}
+
+type clientLimiter struct {
+	limiter  *rate.Limiter
+	lastSeen time.Time
+}
+
+// newLimiterSet returns the limiter set of the given requests per second, or nil if
+// the limit is zero. The burst defaults to one second of requests, or to maxCost
+// if larger, so that every method can be called on its own.
+func newLimiterSet(limit float64, burst, maxCost int) *limiterSet {
+	if limit <= 0 {
+		return nil
+	}
+	if burst <= 0 {
+		burst = max(int(limit), maxCost, 1)
+	}
+	return &limiterSet{
+		limit:      rate.Limit(limit),
+		burst:      burst,
+		maxClients: limiterMaxClients,
+		limiters:   make(map[string]*clientLimiter),
+		overflow:   rate.NewLimiter(rate.Limit(limit), burst),
+	}
+}
+
+// exceedsBurst returns true if the cost of a request is above the burst, such a
+// request can never be served.
+func (s *limiterSet) exceedsBurst(cost int) bool {
+	return s != nil && cost > s.burst
+}
+
+// allow consumes the cost of a request from the bucket of the client.
+func (s *limiterSet) allow(id string, cost int, now time.Time) bool {
+	if s == nil {
+		return true
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.sweep(now)
+	cl, ok := s.limiters[id]
+	if !ok {
+		if len(s.limiters) >= s.maxClients {
+			return s.overflow.AllowN(now, cost)
+		}
+		cl = &clientLimiter{limiter: rate.NewLimiter(s.limit, s.burst)}
+		s.limiters[id] = cl
+		clientsGauge.Inc(1)
+	}
+	cl.lastSeen = now
+	return cl.limiter.AllowN(now, cost)
+}
+
+// sweep removes the buckets of the clients idle for limiterIdleTimeout.
+func (s *limiterSet) sweep(now time.Time) {
+	if now.Sub(s.lastSweep) < limiterSweepInterval {
+		return
+	}
+	s.lastSweep = now
+	for id, cl := range s.limiters {
+		if now.Sub(cl.lastSeen) >= limiterIdleTimeout {
+			delete(s.limiters, id)
+			clientsGauge.Dec(1)
+		}
+	}
+}
+
+// reservationDelay returns the time until the client can send a request of the
+// given cost, it is reported in the Retry-After header.
+func (s *limiterSet) reservationDelay(id string, cost int, now time.Time) time.Duration {
+	if s == nil {
+		return 0
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	limiter := s.overflow
+	if cl, ok := s.limiters[id]; ok {
+		limiter = cl.limiter
+	}
+	tokens := limiter.TokensAt(now)
+	missing := float64(cost) - tokens
+	if missing <= 0 {
+		return 0
+	}
+	return time.Duration(missing / float64(s.limit) * float64(time.Second))
+}
@@ -0,0 +1,293 @@
+// Package policy implements the access policy of the JSON-RPC servers: the allowed
+// and denied methods, the admin methods only served to the local clients, and the
+// rate limits of the client IPs and of the API keys.
+package policy
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/hex"
+	"errors"
+	"net"
+	"net/http"
+	"net/netip"
+	"slices"
+	"strings"
+	"time"
+
+	gethmetrics "github.com/ethereum/go-ethereum/metrics"
+
+	"github.com/cosmos/evm/server/config"
+)
+
+const (
+	// APIKeyHeader is the HTTP header of the API key of a request.
+	APIKeyHeader = "X-API-Key"
+	// APIKeyQueryParam is the URL query parameter of the API key of a request.
+	APIKeyQueryParam = "apikey"
+	// AdminTokenHeader is the HTTP header of the admin token of a request.
+	AdminTokenHeader = "X-Admin-Token"
+
+	// forwardedHeader marks the requests forwarded by the websocket server to the
+	// HTTP server, which have already been checked.
+	forwardedHeader = "X-Evm-Policy-Token"
+)
+
+// localNamespaces are the namespaces only served to the clients of the local host
+// holding the admin token.
+var localNamespaces = []string{"admin"}
+
+var (
+	requestsCounter     = gethmetrics.NewRegisteredCounter("rpc/policy/requests", nil)
+	costCounter         = gethmetrics.NewRegisteredCounter("rpc/policy/cost", nil)
+	deniedCounter       = gethmetrics.NewRegisteredCounter("rpc/policy/denied", nil)
+	limitedCounter      = gethmetrics.NewRegisteredCounter("rpc/policy/limited", nil)
+	unauthorizedCounter = gethmetrics.NewRegisteredCounter("rpc/policy/unauthorized", nil)
+	clientsGauge        = gethmetrics.NewRegisteredGauge("rpc/policy/clients", nil)
+)
+
+// Client identifies the origin of a request.
+type Client struct {
+	IP         string
+	APIKey     string
+	AdminToken string
+	// Proxied is true if the request was forwarded by a proxy which isn't trusted,
+	// so that the IP is the one of the proxy rather than of the client.
+	Proxied bool
+}
+
+// IsLocal returns true if the client connects from the local host, and not
+// through an untrusted proxy running on it.
+func (c Client) IsLocal() bool {
+	ip := net.ParseIP(c.IP)
+	return ip != nil && ip.IsLoopback() && !c.Proxied
+}
+
+// ClientFromRequest returns the client of an HTTP or websocket upgrade request, the
+// IP of the clients of the trusted proxies is read from the forwarded headers.
+func (p *Policy) ClientFromRequest(r *http.Request) Client {
+	ip, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		ip = r.RemoteAddr
+	}
+	proxied := false
+	if p != nil {
+		ip, proxied = p.forwardedIP(ip, r.Header)
+	}
+	apiKey := r.Header.Get(APIKeyHeader)
+	if apiKey == "" {
+		apiKey = r.URL.Query().Get(APIKeyQueryParam)
+	}
+	return Client{IP: ip, APIKey: apiKey, AdminToken: r.Header.Get(AdminTokenHeader), Proxied: proxied}
+}
+
+// Policy checks the methods and the rate limits of the JSON-RPC requests.
+// A nil Policy allows all the requests.
+type Policy struct {
+	allowed []pattern
+	denied  []pattern
+	local   []pattern // methods only served to the local clients
+	// trustedProxies are the reverse proxies forwarding the client IPs
+	trustedProxies []netip.Prefix
+	costs          map[string]int
+	// prefixCosts are the costs of the patterns with a trailing "*"
+	prefixCosts map[string]int
+
+	apiKeys     map[string]struct{}
+	ipLimits    *limiterSet
+	apiKeyLimit *limiterSet
+
+	// token authenticates the requests forwarded by the websocket server
+	token string
+	// adminToken is the shared secret required by the local methods on top of
+	// the local connection.
+	adminToken string
+}
+
+// New creates the access policy of the JSON-RPC configuration. It returns nil if
+// the configuration doesn't restrict the methods, doesn't enable a local
+// namespace, nor rate limit the requests. A local namespace can't be enabled
+// without an admin token.
+func New(cfg config.JSONRPCConfig) (*Policy, error) {
+	entries, err := config.ParseMethodCosts(cfg.MethodCosts)
+	if err != nil {
+		return nil, err
+	}
+	var local []string
+	for _, ns := range cfg.API {
+		if slices.Contains(localNamespaces, ns) {
+			local = append(local, ns+"_*")
+		}
+	}
+	if len(local) > 0 && cfg.AdminToken == "" {
+		return nil, errors.New("the admin namespace requires an admin token")
+	}
+	if len(cfg.AllowedMethods) == 0 && len(cfg.DeniedMethods) == 0 && len(cfg.APIKeys) == 0 &&
+		cfg.IPRateLimit == 0 && cfg.APIKeyRateLimit == 0 && len(local) == 0 {
+		return nil, nil
+	}
+
+	token := make([]byte, 16)
+	if _, err := rand.Read(token); err != nil {
+		return nil, err
+	}
+
+	trustedProxies, err := config.ParseTrustedProxies(cfg.TrustedProxies)
+	if err != nil {
+		return nil, err
+	}
+
+	maxCost := config.DefaultMethodCost
+	for _, cost := range entries {
+		maxCost = max(maxCost, cost)
+	}
+
+	p := &Policy{
+		allowed:        newPatterns(cfg.AllowedMethods),
+		denied:         newPatterns(cfg.DeniedMethods),
+		local:          newPatterns(local),
+		trustedProxies: trustedProxies,
+		costs:          make(map[string]int),
+		prefixCosts:    make(map[string]int),
+		apiKeys:        make(map[string]struct{}, len(cfg.APIKeys)),
+		ipLimits:       newLimiterSet(cfg.IPRateLimit, cfg.IPRateBurst, maxCost),
+		apiKeyLimit:    newLimiterSet(cfg.APIKeyRateLimit, cfg.APIKeyRateBurst, maxCost),
+		token:          hex.EncodeToString(token),
+		adminToken:     cfg.AdminToken,
+	}
+	for method, cost := range entries {
+		if prefix, ok := strings.CutSuffix(method, "*"); ok {
+			p.prefixCosts[prefix] = cost
+		} else {
+			p.costs[method] = cost
+		}
+	}
+	for _, key := range cfg.APIKeys {
+		p.apiKeys[key] = struct{}{}
+	}
+	return p, nil
+}
+
+// Check returns an error if the client is not allowed to call the methods of a
+// request, a batch request is checked as a whole and rejected if its total cost
+// exceeds the rate limit burst.
+func (p *Policy) Check(client Client, methods []string) *Error {
+	if p == nil {
+		return nil
+	}
+	requestsCounter.Inc(1)
+
+	limits, id := p.ipLimits, client.IP
+	if client.APIKey != "" {
+		if _, ok := p.apiKeys[client.APIKey]; !ok {
+			unauthorizedCounter.Inc(1)
+			return ErrInvalidAPIKey
+		}
+		limits, id = p.apiKeyLimit, client.APIKey
+	}
+
+	cost := 0
+	for _, method := range methods {
+		if !p.Allowed(method) {
+			deniedCounter.Inc(1)
+			return newMethodNotSupportedError(method)
+		}
+		if matchAny(p.local, method) && !p.isAdmin(client) {
+			deniedCounter.Inc(1)
+			return newLocalMethodError(method)
+		}
+		cost += p.Cost(method)
+	}
+
+	if limits.exceedsBurst(cost) {
+		limitedCounter.Inc(1)
+		return newCostExceedsBurstError(cost, limits.burst)
+	}
+	now := time.Now()
+	if !limits.allow(id, cost, now) {
+		limitedCounter.Inc(1)
+		return newLimitExceededError(limits.reservationDelay(id, cost, now))
+	}
+	costCounter.Inc(int64(cost))
+	return nil
+}
+
+// Allowed returns true if the method is allowed by the policy.
+func (p *Policy) Allowed(method string) bool {
+	if matchAny(p.denied, method) {
+		return false
+	}
+	return len(p.allowed) == 0 || matchAny(p.allowed, method)
+}
+
+// Cost returns the rate limit cost of the method, the exact method costs take
+// precedence over the longest matching prefix.
+func (p *Policy) Cost(method string) int {
+	if cost, ok := p.costs[method]; ok {
+		return cost
+	}
+	cost, matched := config.DefaultMethodCost, -1
+	for prefix, prefixCost := range p.prefixCosts {
+		if len(prefix) > matched && strings.HasPrefix(method, prefix) {
+			cost, matched = prefixCost, len(prefix)
+		}
+	}
+	return cost
+}
+
+// MarkForwarded marks a request forwarded by the websocket server, which is not
+// checked again by the HTTP server.
+func (p *Policy) MarkForwarded(r *http.Request) {
+	if p == nil {
+		return
+	}
+	r.Header.Set(forwardedHeader, p.token)
+}
+
+// isAdmin returns true if the client connects from the local host with the admin
+// token.
+func (p *Policy) isAdmin(client Client) bool {
+	if !client.IsLocal() || p.adminToken == "" {
+		return false
+	}
+	return subtle.ConstantTimeCompare([]byte(client.AdminToken), []byte(p.adminToken)) == 1
+}
+
+func (p *Policy) isForwarded(r *http.Request) bool {
+	return r.Header.Get(forwardedHeader) == p.token
+}
+
+// pattern matches a method name, or a method prefix if it has a trailing "*".
+type pattern struct {
+	value  string
+	prefix bool
+}
+
+func newPatterns(methods []string) []pattern {
+	patterns := make([]pattern, 0, len(methods))
+	for _, method := range methods {
+		method = strings.TrimSpace(method)
+		if method == "" {
+			continue
+		}
+		value, prefix := strings.CutSuffix(method, "*")
+		patterns = append(patterns, pattern{value: value, prefix: prefix})
+	}
+	return patterns
+}
+
+func (p pattern) match(method string) bool {
+	if p.prefix {
+		return strings.HasPrefix(method, p.value)
+	}
+	return method == p.value
+}
+
+func matchAny(patterns []pattern, method string) bool {
+	for _, p := range patterns {
+		if p.match(method) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,385 @@
+package policy
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/evm/server/config"
+)
+
+func TestNew(t *testing.T) {
+	cfg := config.DefaultJSONRPCConfig()
+	p, err := New(*cfg)
+	require.NoError(t, err)
+	require.Nil(t, p, "default config should not restrict the requests")
+
+	cfg.MethodCosts = []string{"eth_call"}
+	_, err = New(*cfg)
+	require.Error(t, err)
+}
+
+func TestPolicyMethods(t *testing.T) {
+	cfg := config.DefaultJSONRPCConfig()
+	cfg.AllowedMethods = []string{"eth_*", "net_version"}
+	cfg.DeniedMethods = []string{"eth_sendRawTransaction"}
+	p, err := New(*cfg)
+	require.NoError(t, err)
+
+	require.True(t, p.Allowed("eth_call"))
+	require.True(t, p.Allowed("net_version"))
+	require.False(t, p.Allowed("net_listening"))
+	require.False(t, p.Allowed("debug_traceTransaction"))
+	require.False(t, p.Allowed("eth_sendRawTransaction"))
+
+	client := Client{IP: "10.0.0.1"}
+	require.Nil(t, p.Check(client, []string{"eth_chainId", "net_version"}))
+	rpcErr := p.Check(client, []string{"eth_chainId", "debug_traceTransaction"})
+	require.NotNil(t, rpcErr)
+	require.Equal(t, ErrCodeMethodNotSupported, rpcErr.Code)
+}
+
+func TestPolicyLocalNamespaces(t *testing.T) {
+	cfg := config.DefaultJSONRPCConfig()
+	cfg.API = append(cfg.API, "admin")
+	_, err := New(*cfg)
+	require.Error(t, err, "the admin namespace can't be enabled without an admin token")
+
+	// the admin token is required on top of the local connection
+	cfg.AdminToken = "secret"
+	p, err := New(*cfg)
+	require.NoError(t, err)
+	require.NotNil(t, p, "enabled admin namespace should restrict the requests")
+
+	require.Nil(t, p.Check(Client{IP: "127.0.0.1", AdminToken: "secret"}, []string{"admin_nodeInfo"}))
+	require.Nil(t, p.Check(Client{IP: "::1", AdminToken: "secret"}, []string{"admin_peers"}))
+	require.Nil(t, p.Check(Client{IP: "10.0.0.1"}, []string{"eth_chainId"}))
+	rpcErr := p.Check(Client{IP: "10.0.0.1", AdminToken: "secret"}, []string{"eth_chainId", "admin_addPeer"})
+	require.NotNil(t, rpcErr)
+	require.Equal(t, ErrCodeMethodNotSupported, rpcErr.Code)
+	require.Contains(t, rpcErr.Message, "local clients")
+
+	for _, client := range []Client{
+		{IP: "127.0.0.1"},
+		{IP: "127.0.0.1", AdminToken: "wrong"},
+		{IP: "127.0.0.1", AdminToken: "secret", Proxied: true},
+	} {
+		require.NotNil(t, p.Check(client, []string{"admin_addPeer"}))
+	}
+}
+
+func TestPolicyProxiedLoopback(t *testing.T) {
+	cfg := config.DefaultJSONRPCConfig()
+	cfg.API = append(cfg.API, "admin")
+	cfg.AdminToken = "secret"
+	p, err := New(*cfg)
+	require.NoError(t, err)
+
+	newRequest := func(header http.Header) *http.Request {
+		req := httptest.NewRequest(http.MethodPost, "/", nil)
+		req.RemoteAddr = "127.0.0.1:1234"
+		req.Header = header
+		req.Header.Set(AdminTokenHeader, "secret")
+		return req
+	}
+
+	// a reverse proxy on the local host, which isn't a trusted proxy, forwards
+	// the requests of the remote clients from the loopback address
+	for _, header := range []http.Header{
+		{"X-Forwarded-For": {"192.0.2.1"}},
+		{"Forwarded": {"for=192.0.2.1"}},
+	} {
+		client := p.ClientFromRequest(newRequest(header))
+		require.Equal(t, "127.0.0.1", client.IP)
+		require.True(t, client.Proxied)
+		require.False(t, client.IsLocal())
+		require.NotNil(t, p.Check(client, []string{"admin_addPeer"}))
+	}
+
+	client := p.ClientFromRequest(newRequest(http.Header{}))
+	require.False(t, client.Proxied)
+	require.Nil(t, p.Check(client, []string{"admin_addPeer"}))
+}
+
+func TestPolicyCost(t *testing.T) {
+	cfg := config.DefaultJSONRPCConfig()
+	cfg.MethodCosts = []string{"debug_*=50", "debug_trace*=80", "debug_traceCall=20"}
+	cfg.IPRateLimit = 10
+	p, err := New(*cfg)
+	require.NoError(t, err)
+
+	require.Equal(t, 20, p.Cost("debug_traceCall"))
+	require.Equal(t, 80, p.Cost("debug_traceBlockByNumber"))
+	require.Equal(t, 50, p.Cost("debug_getRawBlock"))
+	require.Equal(t, config.DefaultMethodCost, p.Cost("eth_chainId"))
+
+	// the default burst allows the most expensive method
+	require.Nil(t, p.Check(Client{IP: "10.0.0.1"}, []string{"debug_traceBlockByNumber"}))
+}
+
+func TestPolicyRateLimits(t *testing.T) {
+	cfg := config.DefaultJSONRPCConfig()
+	cfg.MethodCosts = []string{"eth_getLogs=3"}
+	cfg.IPRateLimit = 1
+	cfg.IPRateBurst = 3
+	cfg.APIKeys = []string{"key"}
+	cfg.APIKeyRateLimit = 100
+	p, err := New(*cfg)
+	require.NoError(t, err)
+
+	client := Client{IP: "10.0.0.1"}
+	require.Nil(t, p.Check(client, []string{"eth_getLogs"}))
+	rpcErr := p.Check(client, []string{"eth_chainId"})
+	require.NotNil(t, rpcErr)
+	require.Equal(t, ErrCodeLimitExceeded, rpcErr.Code)
+	require.Equal(t, http.StatusTooManyRequests, rpcErr.HTTPStatus)
+	require.Positive(t, rpcErr.RetryAfterSeconds())
+
+	// a batch costing more than the burst is rejected, and isn't retryable
+	rpcErr = p.Check(Client{IP: "10.0.0.3"}, []string{"eth_getLogs", "eth_chainId"})
+	require.NotNil(t, rpcErr)
+	require.Equal(t, ErrCodeLimitExceeded, rpcErr.Code)
+	require.Zero(t, rpcErr.RetryAfterSeconds())
+	require.Nil(t, p.Check(Client{IP: "10.0.0.3"}, []string{"eth_getLogs"}))
+
+	// other IPs have their own bucket
+	require.Nil(t, p.Check(Client{IP: "10.0.0.2"}, []string{"eth_chainId"}))
+
+	// API keys are limited separately from the IPs
+	keyClient := Client{IP: "10.0.0.1", APIKey: "key"}
+	for range 10 {
+		require.Nil(t, p.Check(keyClient, []string{"eth_getLogs"}))
+	}
+	require.Equal(t, ErrInvalidAPIKey, p.Check(Client{IP: "10.0.0.1", APIKey: "other"}, []string{"eth_chainId"}))
+}
+
+func TestLimiterSetSweep(t *testing.T) {
+	s := newLimiterSet(1, 1, 1)
+	now := time.Now()
+	require.True(t, s.allow("a", 1, now))
+	require.False(t, s.allow("a", 1, now))
+
+	// a cost above the burst is never allowed
+	require.True(t, s.exceedsBurst(10))
+	require.False(t, s.allow("b", 10, now))
+
+	later := now.Add(limiterIdleTimeout)
+	require.True(t, s.allow("c", 1, later))
+	require.Len(t, s.limiters, 1)
+
+	require.True(t, (*limiterSet)(nil).allow("a", 100, now))
+}
+
+func TestLimiterSetMaxClients(t *testing.T) {
+	s := newLimiterSet(1, 1, 1)
+	s.maxClients = 2
+	now := time.Now()
+	require.True(t, s.allow("a", 1, now))
+	require.True(t, s.allow("b", 1, now))
+
+	// the clients seen once the set is full share the overflow bucket
+	require.True(t, s.allow("c", 1, now))
+	require.False(t, s.allow("d", 1, now))
+	require.Len(t, s.limiters, 2)
+	require.Equal(t, time.Second, s.reservationDelay("d", 1, now))
+
+	// the buckets of the idle clients are freed for the new ones
+	later := now.Add(limiterIdleTimeout)
+	require.True(t, s.allow("d", 1, later))
+	require.True(t, s.allow("e", 1, later))
+	require.Len(t, s.limiters, 2)
+}
+
+func TestErrorResponse(t *testing.T) {
+	rpcErr := newLimitExceededError(time.Second)
+
+	var single jsonErrorResponse
+	require.NoError(t, json.Unmarshal(ErrorResponse([]byte(`{"jsonrpc":"2.0","id":7,"method":"eth_chainId"}`), rpcErr), &single))
+	require.Equal(t, json.RawMessage("7"), single.ID)
+	require.Equal(t, ErrCodeLimitExceeded, single.Error.Code)
+
+	var batch []jsonErrorResponse
+	body := `[{"jsonrpc":"2.0","id":"a","method":"eth_chainId"},{"jsonrpc":"2.0","id":2,"method":"eth_blockNumber"}]`
+	require.NoError(t, json.Unmarshal(ErrorResponse([]byte(body), rpcErr), &batch))
+	require.Len(t, batch, 2)
+	require.Equal(t, json.RawMessage(`"a"`), batch[0].ID)
+	require.Equal(t, json.RawMessage("2"), batch[1].ID)
+
+	require.NoError(t, json.Unmarshal(ErrorResponse([]byte("{"), rpcErr), &single))
+	require.Equal(t, json.RawMessage("null"), single.ID)
+}
+
+func TestHandler(t *testing.T) {
+	cfg := config.DefaultJSONRPCConfig()
+	cfg.DeniedMethods = []string{"debug_*"}
+	cfg.IPRateLimit = 1
+	cfg.IPRateBurst = 1
+	p, err := New(*cfg)
+	require.NoError(t, err)
+
+	var served int
+	handler := p.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		served++
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	newRequest := func(body string) *http.Request {
+		req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(body))
+		req.RemoteAddr = "10.0.0.1:1234"
+		return req
+	}
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, newRequest(`{"jsonrpc":"2.0","id":1,"method":"debug_traceCall"}`))
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Contains(t, rec.Body.String(), "not available")
+	require.Zero(t, served)
+
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, newRequest(`{"jsonrpc":"2.0","id":1,"method":"eth_chainId"}`))
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Equal(t, 1, served)
+
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, newRequest(`{"jsonrpc":"2.0","id":2,"method":"eth_chainId"}`))
+	require.Equal(t, http.StatusTooManyRequests, rec.Code)
+	require.Equal(t, "1", rec.Header().Get("Retry-After"))
+	require.Equal(t, 1, served)
+
+	// the requests forwarded by the websocket server are not limited again
+	req := newRequest(`{"jsonrpc":"2.0","id":3,"method":"eth_chainId"}`)
+	p.MarkForwarded(req)
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Equal(t, 2, served)
+}
+
+func TestHandlerRejectsUnparsableBodies(t *testing.T) {
+	cfg := config.DefaultJSONRPCConfig()
+	cfg.API = append(cfg.API, "admin")
+	cfg.AdminToken = "secret"
+	cfg.DeniedMethods = []string{"admin_addPeer"}
+	p, err := New(*cfg)
+	require.NoError(t, err)
+
+	var served int
+	handler := p.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		served++
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	testCases := []struct {
+		name      string
+		body      string
+		expStatus int
+		expRes    string
+	}{
+		{
+			"denied method",
+			`{"jsonrpc":"2.0","id":1,"method":"admin_addPeer","params":["x"]}`,
+			http.StatusOK,
+			`{"jsonrpc":"2.0","id":1,"error":{"code":-32004,"message":"method admin_addPeer is not available"}}`,
+		},
+		{
+			"trailing data",
+			`{"jsonrpc":"2.0","id":1,"method":"admin_addPeer","params":["x"]} garbage`,
+			http.StatusOK,
+			`{"jsonrpc":"2.0","id":null,"error":{"code":-32700,"message":"parse error"}}`,
+		},
+		{
+			"trailing request",
+			`{"jsonrpc":"2.0","id":1,"method":"eth_chainId"}{"jsonrpc":"2.0","id":2,"method":"admin_addPeer"}`,
+			http.StatusOK,
+			`{"jsonrpc":"2.0","id":null,"error":{"code":-32700,"message":"parse error"}}`,
+		},
+		{
+			"batch followed by trailing data",
+			`[{"jsonrpc":"2.0","id":1,"method":"admin_addPeer","params":["x"]}] garbage`,
+			http.StatusOK,
+			`{"jsonrpc":"2.0","id":null,"error":{"code":-32700,"message":"parse error"}}`,
+		},
+		{
+			"oversized body",
+			`{"jsonrpc":"2.0","id":1,"method":"eth_chainId","params":["` + strings.Repeat("x", maxRequestSize) + `"]}`,
+			http.StatusRequestEntityTooLarge,
+			`{"jsonrpc":"2.0","id":null,"error":{"code":-32700,"message":"request body too large"}}`,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(tc.body))
+			req.RemoteAddr = "8.8.8.8:1234"
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, req)
+			require.Equal(t, tc.expStatus, rec.Code)
+			require.JSONEq(t, tc.expRes, rec.Body.String())
+			require.Zero(t, served)
+		})
+	}
+}
+
+func TestClientFromRequest(t *testing.T) {
+	var p *Policy
+	req := httptest.NewRequest(http.MethodPost, "/?apikey=query", nil)
+	req.RemoteAddr = "10.0.0.1:1234"
+	require.Equal(t, Client{IP: "10.0.0.1", APIKey: "query"}, p.ClientFromRequest(req))
+
+	req.Header.Set(APIKeyHeader, "header")
+	require.Equal(t, Client{IP: "10.0.0.1", APIKey: "header"}, p.ClientFromRequest(req))
+
+	req.Header.Set(AdminTokenHeader, "secret")
+	require.Equal(t, Client{IP: "10.0.0.1", APIKey: "header", AdminToken: "secret"}, p.ClientFromRequest(req))
+}
+
+func TestClientFromRequestTrustedProxies(t *testing.T) {
+	cfg := config.DefaultJSONRPCConfig()
+	cfg.API = append(cfg.API, "admin")
+	cfg.AdminToken = "secret"
+	cfg.TrustedProxies = []string{"127.0.0.1", "10.0.0.0/8"}
+	p, err := New(*cfg)
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name       string
+		remoteAddr string
+		header     http.Header
+		expIP      string
+	}{
+		{"untrusted remote ignores the headers", "192.0.2.1:1234", http.Header{"X-Forwarded-For": {"127.0.0.1"}}, "192.0.2.1"},
+		{"trusted proxy without headers", "127.0.0.1:1234", nil, "127.0.0.1"},
+		{"x-forwarded-for", "127.0.0.1:1234", http.Header{"X-Forwarded-For": {"192.0.2.1"}}, "192.0.2.1"},
+		{"spoofed hops are skipped", "127.0.0.1:1234", http.Header{"X-Forwarded-For": {"127.0.0.1, 192.0.2.1"}}, "192.0.2.1"},
+		{"chained trusted proxies", "127.0.0.1:1234", http.Header{"X-Forwarded-For": {"192.0.2.1, 10.0.0.2", "10.0.0.3"}}, "192.0.2.1"},
+		{"forwarded header", "10.0.0.1:1234", http.Header{"Forwarded": {`for=192.0.2.60;proto=http, for="[2001:db8:cafe::17]:4711"`}}, "2001:db8:cafe::17"},
+		{"forwarded takes precedence", "10.0.0.1:1234", http.Header{"Forwarded": {"for=192.0.2.60"}, "X-Forwarded-For": {"192.0.2.1"}}, "192.0.2.60"},
+		{"unknown hop", "10.0.0.1:1234", http.Header{"Forwarded": {"for=unknown"}}, "unknown"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/", nil)
+			req.RemoteAddr = tc.remoteAddr
+			req.Header = tc.header
+			if req.Header == nil {
+				req.Header = http.Header{}
+			}
+			require.Equal(t, tc.expIP, p.ClientFromRequest(req).IP)
+		})
+	}
+
+	// the remote clients behind a local proxy are not local
+	req := httptest.NewRequest(http.MethodPost, "/", nil)
+	req.RemoteAddr = "127.0.0.1:1234"
+	req.Header.Set("X-Forwarded-For", "192.0.2.1")
+	req.Header.Set(AdminTokenHeader, "secret")
+	require.NotNil(t, p.Check(p.ClientFromRequest(req), []string{"admin_nodeInfo"}))
+
+	cfg.TrustedProxies = []string{"not-an-ip"}
+	_, err = New(*cfg)
+	require.Error(t, err)
+}
@@ -3,6 +3,7 @@ package stream
 import (
 	"context"
 	"fmt"
+	"math/big"
 	"sync"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -23,8 +24,9 @@ import (
 )
 
 const (
-	streamSubscriberName = "evm-json-rpc"
-	subscribBufferSize   = 1024
+	streamSubscriberName       = "evm-json-rpc"
+	cosmosStreamSubscriberName = "evm-json-rpc-cosmos"
+	subscribBufferSize         = 1024
 
 	headerStreamSegmentSize = 128
 	headerStreamCapacity    = 128 * 32
@@ -32,6 +34,8 @@ const (
 	txStreamCapacity        = 1024 * 32
 	logStreamSegmentSize    = 2048
 	logStreamCapacity       = 2048 * 32
+	cosmosStreamSegmentSize = 2048
+	cosmosStreamCapacity    = 2048 * 32
 )
 
 var (
@@ -41,6 +45,7 @@ var (
 		sdk.EventTypeMessage,
 		sdk.AttributeKeyModule, evmtypes.ModuleName)).String()
 	blockEvents          = cmttypes.QueryForEvent(cmttypes.EventNewBlock).String()
+	txEvents             = cmttypes.QueryForEvent(cmttypes.EventTx).String()
 	evmTxHashKey         = fmt.Sprintf("%s.%s", evmtypes.TypeMsgEthereumTx, evmtypes.AttributeKeyEthereumTxHash)
 	NewBlockHeaderEvents = cmtquery.MustCompile(fmt.Sprintf("%s='%s'", cmttypes.EventTypeKey, cmttypes.EventNewBlockHeader))
 )
@@ -50,7 +55,14 @@ type RPCHeader struct {
 	Hash      common.Hash
 }
 
-// RPCStream provides data streams for newHeads, logs, and pendingTransactions.
+// MempoolTx is a transaction inserted into the EVM mempool.
+type MempoolTx struct {
+	Tx *ethtypes.Transaction
+	// Queued is true for the nonce-gapped transactions.
+	Queued bool
+}
+
+// RPCStream provides data streams for newHeads, logs, pendingTransactions and cosmosEvents.
 type RPCStream struct {
 	evtClient rpcclient.EventsClient
 	logger    log.Logger
@@ -63,6 +75,16 @@ type RPCStream struct {
 	// pendingTxStream is backed by check-tx ante handler
 	pendingTxStream *Stream[common.Hash]
 
+	// mempoolTxStream is backed by the EVM mempool insert path, it's nil if the
+	// EVM mempool is not enabled.
+	mempoolTxStream *Stream[MempoolTx]
+	currentHeader   func() *ethtypes.Header
+
+	// cosmosEventStream is backed by a separate cometbft event subscription to
+	// all the transactions, it's only started by the cosmosEvents subscribers.
+	cosmosEventStream *Stream[types.CosmosEvent]
+	cosmosMu          sync.Mutex
+
 	wg sync.WaitGroup
 }
 
@@ -102,15 +124,53 @@ func (s *RPCStream) initSubscriptions() {
 	go s.start(&s.wg, chBlocks, chLogs)
 }
 
-func (s *RPCStream) Close() error {
-	if s.headerStream == nil {
-		// not initialized
+// initCosmosSubscriptions subscribes to the cometbft events backing the cosmos
+// event stream on the first call, a failed subscription is retried on the next call.
+func (s *RPCStream) initCosmosSubscriptions() error {
+	s.cosmosMu.Lock()
+	defer s.cosmosMu.Unlock()
+
+	if s.cosmosEventStream != nil {
+		// already initialized
 		return nil
 	}
 
-	if err := s.evtClient.UnsubscribeAll(context.Background(), streamSubscriberName); err != nil {
+	ctx := context.Background()
+
+	chBlocks, err := s.evtClient.Subscribe(ctx, cosmosStreamSubscriberName, blockEvents, subscribBufferSize)
+	if err != nil {
 		return err
 	}
+
+	chTxs, err := s.evtClient.Subscribe(ctx, cosmosStreamSubscriberName, txEvents, subscribBufferSize)
+	if err != nil {
+		if err := s.evtClient.UnsubscribeAll(context.Background(), cosmosStreamSubscriberName); err != nil {
+			s.logger.Error("failed to unsubscribe", "err", err)
+		}
+		return err
+	}
+
+	s.cosmosEventStream = NewStream[types.CosmosEvent](cosmosStreamSegmentSize, cosmosStreamCapacity)
+	s.wg.Add(1)
+	go s.startCosmos(&s.wg, chBlocks, chTxs)
+	return nil
+}
+
+func (s *RPCStream) Close() error {
+	s.cosmosMu.Lock()
+	cosmosStarted := s.cosmosEventStream != nil
+	s.cosmosMu.Unlock()
+	if cosmosStarted {
+		if err := s.evtClient.UnsubscribeAll(context.Background(), cosmosStreamSubscriberName); err != nil {
+			return err
+		}
+	}
+
+	if s.headerStream != nil {
+		if err := s.evtClient.UnsubscribeAll(context.Background(), streamSubscriberName); err != nil {
+			return err
+		}
+	}
 	s.wg.Wait()
 	return nil
 }
@@ -129,6 +189,59 @@ func (s *RPCStream) LogStream() *Stream[*ethtypes.Log] {
 	return s.logStream
 }
 
+// EnableMempoolTxStream enables the stream of the transactions inserted into the
+// EVM mempool, currentHeader returns the mempool head used to fill the pending
+// transaction fields.
+func (s *RPCStream) EnableMempoolTxStream(currentHeader func() *ethtypes.Header) {
+	s.currentHeader = currentHeader
+	s.mempoolTxStream = NewStream[MempoolTx](txStreamSegmentSize, txStreamCapacity)
+}
+
+// MempoolTxStream returns the stream of the transactions inserted into the EVM
+// mempool, nil if the stream is not enabled.
+func (s *RPCStream) MempoolTxStream() *Stream[MempoolTx] {
+	return s.mempoolTxStream
+}
+
+// ListenMempoolTx is a callback passed to the EVM mempool to listen for the inserted transactions.
+// It's called under the mempool lock, so it only publishes the raw transaction,
+// the subscribers build the RPC transactions with MempoolRPCTransactions.
+func (s *RPCStream) ListenMempoolTx(tx *ethtypes.Transaction, queued bool) {
+	s.mempoolTxStream.Add(MempoolTx{Tx: tx, Queued: queued})
+}
+
+// MempoolRPCTransactions builds the RPC transactions of the mempool
+// transactions, the pending fields are filled from the current mempool head.
+func (s *RPCStream) MempoolRPCTransactions(items []MempoolTx) []*types.RPCTransaction {
+	var (
+		baseFee     *big.Int
+		blockNumber uint64
+		blockTime   uint64
+	)
+	if header := s.currentHeader(); header != nil {
+		baseFee = header.BaseFee
+		blockNumber = header.Number.Uint64()
+		blockTime = header.Time
+	}
+
+	chainConfig := evmtypes.GetEthChainConfig()
+	rpcTxs := make([]*types.RPCTransaction, len(items))
+	for i, item := range items {
+		rpcTxs[i] = types.NewRPCTransaction(item.Tx, common.Hash{}, blockNumber, blockTime, 0, baseFee, chainConfig)
+	}
+	return rpcTxs
+}
+
+// CosmosEventStream returns the stream of the events emitted by the cosmos
+// transactions and by the begin and end blockers, it returns an error if the
+// cometbft event subscriptions fail.
+func (s *RPCStream) CosmosEventStream() (*Stream[types.CosmosEvent], error) {
+	if err := s.initCosmosSubscriptions(); err != nil {
+		return nil, err
+	}
+	return s.cosmosEventStream, nil
+}
+
 // ListenPendingTx is a callback passed to application to listen for pending transactions in CheckTx.
 func (s *RPCStream) ListenPendingTx(hash common.Hash) {
 	s.PendingTxStream().Add(hash)
@@ -202,3 +315,62 @@ func (s *RPCStream) start(
 		}
 	}
 }
+
+func (s *RPCStream) startCosmos(
+	wg *sync.WaitGroup,
+	chBlocks <-chan coretypes.ResultEvent,
+	chTxs <-chan coretypes.ResultEvent,
+) {
+	defer func() {
+		wg.Done()
+		if err := s.evtClient.UnsubscribeAll(context.Background(), cosmosStreamSubscriberName); err != nil {
+			s.logger.Error("failed to unsubscribe", "err", err)
+		}
+	}()
+
+	for {
+		select {
+		case ev, ok := <-chBlocks:
+			if !ok {
+				chBlocks = nil
+				break
+			}
+
+			data, ok := ev.Data.(cmttypes.EventDataNewBlock)
+			if !ok {
+				s.logger.Error("event data type mismatch", "type", fmt.Sprintf("%T", ev.Data))
+				continue
+			}
+			height, err := utils.SafeUint64(data.Block.Height)
+			if err != nil {
+				continue
+			}
+
+			// begin and end blocker events, e.g. the mint and the gov proposal events
+			s.cosmosEventStream.Add(types.NewCosmosEvents(data.ResultFinalizeBlock.Events, height, "")...)
+
+		case ev, ok := <-chTxs:
+			if !ok {
+				chTxs = nil
+				break
+			}
+
+			dataTx, ok := ev.Data.(cmttypes.EventDataTx)
+			if !ok {
+				s.logger.Error("event data type mismatch", "type", fmt.Sprintf("%T", ev.Data))
+				continue
+			}
+			height, err := utils.SafeUint64(dataTx.Height)
+			if err != nil {
+				continue
+			}
+
+			txHash := fmt.Sprintf("%X", cmttypes.Tx(dataTx.Tx).Hash())
+			s.cosmosEventStream.Add(types.NewCosmosEvents(dataTx.Result.Events, height, txHash)...)
+		}
+
+		if chBlocks == nil && chTxs == nil {
+			break
+		}
+	}
+}
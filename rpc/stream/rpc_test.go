@@ -0,0 +1,121 @@
+package stream
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"sync"
+	"testing"
+
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+
+	"github.com/cosmos/evm/rpc/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	"cosmossdk.io/log"
+)
+
+// fakeEventsClient records the subscriptions, the first failures subscriptions
+// return an error.
+type fakeEventsClient struct {
+	mu            sync.Mutex
+	failures      int
+	subscriptions map[string][]chan coretypes.ResultEvent
+}
+
+func (c *fakeEventsClient) Subscribe(_ context.Context, subscriber, _ string, _ ...int) (<-chan coretypes.ResultEvent, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.failures > 0 {
+		c.failures--
+		return nil, errors.New("subscription failed")
+	}
+	ch := make(chan coretypes.ResultEvent)
+	c.subscriptions[subscriber] = append(c.subscriptions[subscriber], ch)
+	return ch, nil
+}
+
+func (c *fakeEventsClient) Unsubscribe(context.Context, string, string) error {
+	return nil
+}
+
+func (c *fakeEventsClient) UnsubscribeAll(_ context.Context, subscriber string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, ch := range c.subscriptions[subscriber] {
+		close(ch)
+	}
+	delete(c.subscriptions, subscriber)
+	return nil
+}
+
+func (c *fakeEventsClient) subscriptionCount(subscriber string) int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return len(c.subscriptions[subscriber])
+}
+
+func TestCosmosEventStream(t *testing.T) {
+	client := &fakeEventsClient{failures: 1, subscriptions: make(map[string][]chan coretypes.ResultEvent)}
+	s := NewRPCStreams(client, log.NewNopLogger(), nil)
+
+	// the failed subscription is returned and retried on the next call
+	_, err := s.CosmosEventStream()
+	require.Error(t, err)
+	require.Zero(t, client.subscriptionCount(cosmosStreamSubscriberName))
+
+	// the concurrent subscribers share a single stream
+	var wg sync.WaitGroup
+	streams := make([]*Stream[types.CosmosEvent], 10)
+	for i := range streams {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			stream, err := s.CosmosEventStream()
+			require.NoError(t, err)
+			streams[i] = stream
+		}(i)
+	}
+	wg.Wait()
+	for _, stream := range streams {
+		require.Same(t, streams[0], stream)
+	}
+	require.Equal(t, 2, client.subscriptionCount(cosmosStreamSubscriberName))
+
+	require.NoError(t, s.Close())
+	require.Zero(t, client.subscriptionCount(cosmosStreamSubscriberName))
+}
+
+func TestMempoolTxStream(t *testing.T) {
+	configurator := evmtypes.NewEVMConfigurator()
+	configurator.ResetTestConfig()
+	t.Cleanup(configurator.ResetTestConfig)
+	require.NoError(t, evmtypes.SetChainConfig(evmtypes.DefaultChainConfig(9001)))
+
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	signer := ethtypes.LatestSignerForChainID(big.NewInt(9001))
+	tx, err := ethtypes.SignNewTx(key, signer, &ethtypes.LegacyTx{Nonce: 1, Gas: 21000, GasPrice: big.NewInt(10)})
+	require.NoError(t, err)
+
+	s := NewRPCStreams(nil, log.NewNopLogger(), nil)
+	require.Nil(t, s.MempoolTxStream())
+	s.EnableMempoolTxStream(func() *ethtypes.Header {
+		return &ethtypes.Header{Number: big.NewInt(5), Time: 1, BaseFee: big.NewInt(1)}
+	})
+
+	// the raw transaction is published, the sender is recovered by the subscriber
+	s.ListenMempoolTx(tx, true)
+	items, _ := s.MempoolTxStream().ReadNonBlocking(0)
+	require.Equal(t, []MempoolTx{{Tx: tx, Queued: true}}, items)
+
+	rpcTxs := s.MempoolRPCTransactions(items)
+	require.Len(t, rpcTxs, 1)
+	require.Equal(t, tx.Hash(), rpcTxs[0].Hash)
+	require.Equal(t, crypto.PubkeyToAddress(key.PublicKey), rpcTxs[0].From)
+	require.Nil(t, rpcTxs[0].BlockHash)
+}
@@ -0,0 +1,58 @@
+package types
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// SendBundleArgs are the arguments of eth_sendBundle, in the Flashbots format.
+type SendBundleArgs struct {
+	// Txs are the signed raw transactions of the bundle, in the inclusion order.
+	Txs []hexutil.Bytes `json:"txs"`
+	// BlockNumber is the number of the block the bundle targets.
+	BlockNumber hexutil.Uint64 `json:"blockNumber"`
+	// MinTimestamp and MaxTimestamp bound the time of the block, in seconds.
+	MinTimestamp *uint64 `json:"minTimestamp,omitempty"`
+	MaxTimestamp *uint64 `json:"maxTimestamp,omitempty"`
+	// RevertingTxHashes are the transactions allowed to revert.
+	RevertingTxHashes []common.Hash `json:"revertingTxHashes,omitempty"`
+}
+
+// SendBundleResult is the result of eth_sendBundle.
+type SendBundleResult struct {
+	BundleHash common.Hash `json:"bundleHash"`
+}
+
+// CallBundleArgs are the arguments of eth_callBundle, in the Flashbots format.
+// The bundle is always executed on top of the latest state.
+type CallBundleArgs struct {
+	Txs              []hexutil.Bytes `json:"txs"`
+	BlockNumber      hexutil.Uint64  `json:"blockNumber"`
+	StateBlockNumber *BlockNumber    `json:"stateBlockNumber,omitempty"`
+}
+
+// CallBundleResult is the result of eth_callBundle.
+type CallBundleResult struct {
+	BundleHash       common.Hash          `json:"bundleHash"`
+	BundleGasPrice   *hexutil.Big         `json:"bundleGasPrice"`
+	GasFees          *hexutil.Big         `json:"gasFees"`
+	StateBlockNumber hexutil.Uint64       `json:"stateBlockNumber"`
+	TotalGasUsed     hexutil.Uint64       `json:"totalGasUsed"`
+	Results          []CallBundleTxResult `json:"results"`
+}
+
+// CallBundleTxResult is the execution result of a transaction of eth_callBundle.
+type CallBundleTxResult struct {
+	TxHash      common.Hash     `json:"txHash"`
+	FromAddress common.Address  `json:"fromAddress"`
+	ToAddress   *common.Address `json:"toAddress"`
+	GasUsed     hexutil.Uint64  `json:"gasUsed"`
+	GasPrice    *hexutil.Big    `json:"gasPrice"`
+	GasFees     *hexutil.Big    `json:"gasFees"`
+	// Value is the return data of the call.
+	Value hexutil.Bytes `json:"value,omitempty"`
+	// Error is set if the transaction failed or reverted, Revert is the revert
+	// reason.
+	Error  string `json:"error,omitempty"`
+	Revert string `json:"revert,omitempty"`
+}
@@ -0,0 +1,211 @@
+package types
+
+import (
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// The cosmos namespace results use decimal strings for the amounts, so that
+// the integer and the decimal amounts of the cosmos modules are lossless.
+
+// CosmosCoin represents an amount of a cosmos denomination.
+type CosmosCoin struct {
+	Denom  string `json:"denom"`
+	Amount string `json:"amount"`
+}
+
+// CosmosDelegation represents a delegation of an account to a validator.
+type CosmosDelegation struct {
+	Validator    string         `json:"validator"`
+	ValidatorHex common.Address `json:"validatorHex"`
+	Shares       string         `json:"shares"`
+	Balance      CosmosCoin     `json:"balance"`
+}
+
+// CosmosDelegationRewards represents the pending rewards of the delegations of an account.
+type CosmosDelegationRewards struct {
+	Rewards []CosmosValidatorRewards `json:"rewards"`
+	Total   []CosmosCoin             `json:"total"`
+}
+
+// CosmosValidatorRewards represents the pending rewards of a delegation to a validator.
+type CosmosValidatorRewards struct {
+	Validator    string         `json:"validator"`
+	ValidatorHex common.Address `json:"validatorHex"`
+	Rewards      []CosmosCoin   `json:"rewards"`
+}
+
+// CosmosUnbondingDelegation represents the unbonding entries of an account from a validator.
+type CosmosUnbondingDelegation struct {
+	Validator    string                 `json:"validator"`
+	ValidatorHex common.Address         `json:"validatorHex"`
+	Entries      []CosmosUnbondingEntry `json:"entries"`
+}
+
+// CosmosUnbondingEntry represents a single unbonding entry.
+type CosmosUnbondingEntry struct {
+	CreationHeight hexutil.Uint64 `json:"creationHeight"`
+	CompletionTime time.Time      `json:"completionTime"`
+	InitialBalance string         `json:"initialBalance"`
+	Balance        string         `json:"balance"`
+}
+
+// CosmosProposal represents a governance proposal.
+type CosmosProposal struct {
+	ID              hexutil.Uint64     `json:"id"`
+	Title           string             `json:"title"`
+	Summary         string             `json:"summary"`
+	Metadata        string             `json:"metadata"`
+	Status          string             `json:"status"`
+	Proposer        *common.Address    `json:"proposer"`
+	Messages        []string           `json:"messages"`
+	TotalDeposit    []CosmosCoin       `json:"totalDeposit"`
+	FinalTally      *CosmosTallyResult `json:"finalTally"`
+	SubmitTime      *time.Time         `json:"submitTime"`
+	DepositEndTime  *time.Time         `json:"depositEndTime"`
+	VotingStartTime *time.Time         `json:"votingStartTime"`
+	VotingEndTime   *time.Time         `json:"votingEndTime"`
+	Expedited       bool               `json:"expedited"`
+}
+
+// CosmosTallyResult represents the votes of a governance proposal.
+type CosmosTallyResult struct {
+	Yes        string `json:"yes"`
+	Abstain    string `json:"abstain"`
+	No         string `json:"no"`
+	NoWithVeto string `json:"noWithVeto"`
+}
+
+// CosmosInflation represents the epixmint inflation.
+type CosmosInflation struct {
+	Inflation        string `json:"inflation"`
+	AnnualProvisions string `json:"annualProvisions"`
+}
+
+// CosmosSupply represents the epixmint supply of the mint denomination.
+type CosmosSupply struct {
+	CurrentSupply string `json:"currentSupply"`
+	MaxSupply     string `json:"maxSupply"`
+}
+
+// CosmosPoolTransaction represents a Cosmos transaction of the mempool, the hash is
+// its CometBFT hash.
+type CosmosPoolTransaction struct {
+	Hash     common.Hash    `json:"hash"`
+	Gas      hexutil.Uint64 `json:"gas"`
+	Fee      []CosmosCoin   `json:"fee"`
+	Messages []string       `json:"messages"`
+}
+
+// NewCosmosCoins converts the coins to their JSON-RPC representation.
+func NewCosmosCoins(coins sdk.Coins) []CosmosCoin {
+	result := make([]CosmosCoin, len(coins))
+	for i, coin := range coins {
+		result[i] = CosmosCoin{Denom: coin.Denom, Amount: coin.Amount.String()}
+	}
+	return result
+}
+
+// NewCosmosDecCoins converts the decimal coins to their JSON-RPC representation.
+func NewCosmosDecCoins(coins sdk.DecCoins) []CosmosCoin {
+	result := make([]CosmosCoin, len(coins))
+	for i, coin := range coins {
+		result[i] = CosmosCoin{Denom: coin.Denom, Amount: coin.Amount.String()}
+	}
+	return result
+}
+
+// NewCosmosDelegation converts a staking delegation to its JSON-RPC representation.
+func NewCosmosDelegation(res stakingtypes.DelegationResponse) CosmosDelegation {
+	return CosmosDelegation{
+		Validator:    res.Delegation.ValidatorAddress,
+		ValidatorHex: ValidatorHexAddress(res.Delegation.ValidatorAddress),
+		Shares:       res.Delegation.Shares.String(),
+		Balance:      CosmosCoin{Denom: res.Balance.Denom, Amount: res.Balance.Amount.String()},
+	}
+}
+
+// NewCosmosDelegationRewards converts the distribution rewards to their JSON-RPC representation.
+func NewCosmosDelegationRewards(res *distrtypes.QueryDelegationTotalRewardsResponse) *CosmosDelegationRewards {
+	result := &CosmosDelegationRewards{
+		Rewards: make([]CosmosValidatorRewards, len(res.Rewards)),
+		Total:   NewCosmosDecCoins(res.Total),
+	}
+	for i, reward := range res.Rewards {
+		result.Rewards[i] = CosmosValidatorRewards{
+			Validator:    reward.ValidatorAddress,
+			ValidatorHex: ValidatorHexAddress(reward.ValidatorAddress),
+			Rewards:      NewCosmosDecCoins(reward.Reward),
+		}
+	}
+	return result
+}
+
+// NewCosmosUnbondingDelegation converts a staking unbonding delegation to its JSON-RPC representation.
+func NewCosmosUnbondingDelegation(ubd stakingtypes.UnbondingDelegation) CosmosUnbondingDelegation {
+	result := CosmosUnbondingDelegation{
+		Validator:    ubd.ValidatorAddress,
+		ValidatorHex: ValidatorHexAddress(ubd.ValidatorAddress),
+		Entries:      make([]CosmosUnbondingEntry, len(ubd.Entries)),
+	}
+	for i, entry := range ubd.Entries {
+		result.Entries[i] = CosmosUnbondingEntry{
+			CreationHeight: hexutil.Uint64(entry.CreationHeight), //#nosec G115 -- block height is never negative
+			CompletionTime: entry.CompletionTime,
+			InitialBalance: entry.InitialBalance.String(),
+			Balance:        entry.Balance.String(),
+		}
+	}
+	return result
+}
+
+// NewCosmosProposal converts a governance proposal to its JSON-RPC representation.
+func NewCosmosProposal(proposal *govv1.Proposal) CosmosProposal {
+	result := CosmosProposal{
+		ID:              hexutil.Uint64(proposal.Id),
+		Title:           proposal.Title,
+		Summary:         proposal.Summary,
+		Metadata:        proposal.Metadata,
+		Status:          proposal.Status.String(),
+		Messages:        make([]string, len(proposal.Messages)),
+		TotalDeposit:    NewCosmosCoins(proposal.TotalDeposit),
+		SubmitTime:      proposal.SubmitTime,
+		DepositEndTime:  proposal.DepositEndTime,
+		VotingStartTime: proposal.VotingStartTime,
+		VotingEndTime:   proposal.VotingEndTime,
+		Expedited:       proposal.Expedited,
+	}
+	for i, msg := range proposal.Messages {
+		result.Messages[i] = msg.TypeUrl
+	}
+	if proposer, err := sdk.AccAddressFromBech32(proposal.Proposer); err == nil {
+		hex := common.BytesToAddress(proposer)
+		result.Proposer = &hex
+	}
+	if tally := proposal.FinalTallyResult; tally != nil {
+		result.FinalTally = &CosmosTallyResult{
+			Yes:        tally.YesCount,
+			Abstain:    tally.AbstainCount,
+			No:         tally.NoCount,
+			NoWithVeto: tally.NoWithVetoCount,
+		}
+	}
+	return result
+}
+
+// ValidatorHexAddress returns the hex representation of a validator operator address,
+// or the zero address if it can't be decoded.
+func ValidatorHexAddress(operator string) common.Address {
+	valAddr, err := sdk.ValAddressFromBech32(operator)
+	if err != nil {
+		return common.Address{}
+	}
+	return common.BytesToAddress(valAddr)
+}
@@ -0,0 +1,103 @@
+package types
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+)
+
+// CosmosEvent is the payload of the cosmosEvents subscription. The bech32
+// addresses of the attribute values are converted to hex, so that the EVM
+// clients can match them against their accounts.
+type CosmosEvent struct {
+	Type       string            `json:"type"`
+	Attributes map[string]string `json:"attributes"`
+	// BlockNumber is the height of the block that emitted the event.
+	BlockNumber hexutil.Uint64 `json:"blockNumber"`
+	// TxHash is the hash of the cosmos transaction that emitted the event, it's
+	// empty for the events emitted by the begin and end blockers.
+	TxHash string `json:"txHash,omitempty"`
+}
+
+// NewCosmosEvents converts the ABCI events of a block or of a transaction to
+// the cosmosEvents payloads.
+func NewCosmosEvents(events []abci.Event, height uint64, txHash string) []CosmosEvent {
+	res := make([]CosmosEvent, 0, len(events))
+	for _, event := range events {
+		attrs := make(map[string]string, len(event.Attributes))
+		for _, attr := range event.Attributes {
+			attrs[attr.Key] = CosmosEventValue(attr.Value)
+		}
+		res = append(res, CosmosEvent{
+			Type:        event.Type,
+			Attributes:  attrs,
+			BlockNumber: hexutil.Uint64(height),
+			TxHash:      txHash,
+		})
+	}
+	return res
+}
+
+// CosmosEventValue converts a bech32 address (account, validator or module
+// account) to its hex representation, the other values are returned as is.
+func CosmosEventValue(value string) string {
+	_, bz, err := bech32.DecodeAndConvert(value)
+	if err != nil {
+		return value
+	}
+	switch len(bz) {
+	case common.AddressLength:
+		return common.BytesToAddress(bz).Hex()
+	case 32:
+		return hexutil.Encode(bz)
+	default:
+		return value
+	}
+}
+
+// CosmosEventFilter selects the events of the cosmosEvents subscription.
+type CosmosEventFilter struct {
+	// Types are the accepted event types, all the types are accepted if empty.
+	Types []string
+	// Attributes are the attribute values the event must contain. The bech32
+	// addresses are converted to hex, and the hex values are compared case
+	// insensitively.
+	Attributes map[string]string
+}
+
+// NewCosmosEventFilter returns the filter of the given event types and attributes.
+func NewCosmosEventFilter(eventTypes []string, attributes map[string]string) CosmosEventFilter {
+	attrs := make(map[string]string, len(attributes))
+	for key, value := range attributes {
+		attrs[key] = CosmosEventValue(value)
+	}
+	return CosmosEventFilter{Types: eventTypes, Attributes: attrs}
+}
+
+// Matches returns true if the event passes the filter.
+func (f CosmosEventFilter) Matches(event CosmosEvent) bool {
+	if len(f.Types) > 0 && !slices.Contains(f.Types, event.Type) {
+		return false
+	}
+
+	for key, expected := range f.Attributes {
+		value, ok := event.Attributes[key]
+		if !ok {
+			return false
+		}
+		if strings.HasPrefix(value, "0x") {
+			if !strings.EqualFold(value, expected) {
+				return false
+			}
+		} else if value != expected {
+			return false
+		}
+	}
+	return true
+}
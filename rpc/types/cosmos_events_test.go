@@ -0,0 +1,75 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestCosmosEventValue(t *testing.T) {
+	address := common.HexToAddress("0x57f96e6B86CdeFdB3d412547816a82E3E0EbF9D2")
+	valAddress := sdk.ValAddress(address.Bytes()).String()
+	moduleAddress := common.BytesToHash([]byte("module")).Bytes()
+
+	testCases := []struct {
+		name  string
+		value string
+		exp   string
+	}{
+		{"account address", sdk.AccAddress(address.Bytes()).String(), address.Hex()},
+		{"validator address", valAddress, address.Hex()},
+		{"32 bytes address", sdk.AccAddress(moduleAddress).String(), common.BytesToHash(moduleAddress).Hex()},
+		{"amount", "1000aepix", "1000aepix"},
+		{"proposal id", "1", "1"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.exp, CosmosEventValue(tc.value))
+		})
+	}
+}
+
+func TestCosmosEventFilter(t *testing.T) {
+	delegator := common.HexToAddress("0x57f96e6B86CdeFdB3d412547816a82E3E0EbF9D2")
+	events := NewCosmosEvents([]abci.Event{
+		{Type: "delegate", Attributes: []abci.EventAttribute{
+			{Key: "delegator", Value: sdk.AccAddress(delegator.Bytes()).String()},
+			{Key: "amount", Value: "1000aepix"},
+		}},
+		{Type: "mint", Attributes: []abci.EventAttribute{
+			{Key: "amount", Value: "50aepix"},
+		}},
+	}, 10, "ABCD")
+	require.Len(t, events, 2)
+	require.Equal(t, delegator.Hex(), events[0].Attributes["delegator"])
+	require.Equal(t, uint64(10), uint64(events[0].BlockNumber))
+	require.Equal(t, "ABCD", events[0].TxHash)
+
+	testCases := []struct {
+		name   string
+		filter CosmosEventFilter
+		exp    []bool
+	}{
+		{"no filter", NewCosmosEventFilter(nil, nil), []bool{true, true}},
+		{"types", NewCosmosEventFilter([]string{"mint"}, nil), []bool{false, true}},
+		{"bech32 attribute", NewCosmosEventFilter(nil, map[string]string{
+			"delegator": sdk.AccAddress(delegator.Bytes()).String(),
+		}), []bool{true, false}},
+		{"lower case hex attribute", NewCosmosEventFilter([]string{"delegate"}, map[string]string{
+			"delegator": "0x57f96e6b86cdefdb3d412547816a82e3e0ebf9d2",
+		}), []bool{true, false}},
+		{"attribute mismatch", NewCosmosEventFilter(nil, map[string]string{"amount": "1aepix"}), []bool{false, false}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			for i, event := range events {
+				require.Equal(t, tc.exp[i], tc.filter.Matches(event))
+			}
+		})
+	}
+}
@@ -0,0 +1,59 @@
+package types
+
+import (
+	"bytes"
+	"math/big"
+	"slices"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// PendingTxFilter selects the transactions of the newPendingTransactions
+// subscription. The empty criteria match all the transactions.
+type PendingTxFilter struct {
+	// FullTx pushes the RPC transaction objects instead of the hashes.
+	FullTx bool
+	// IncludeQueued also pushes the nonce-gapped transactions.
+	IncludeQueued bool
+	// From are the accepted senders.
+	From []common.Address
+	// To are the accepted recipients, the contract creations never match.
+	To []common.Address
+	// MinTip is the minimum priority fee, the gas price of the legacy
+	// transactions.
+	MinTip *big.Int
+	// Selector is the prefix of the transaction input, e.g. a method selector.
+	Selector []byte
+}
+
+// HasCriteria returns true if the filter needs the transactions of the EVM
+// mempool rather than the pending transaction hashes.
+func (f PendingTxFilter) HasCriteria() bool {
+	return f.FullTx || f.IncludeQueued || len(f.From) > 0 || len(f.To) > 0 || f.MinTip != nil || len(f.Selector) > 0
+}
+
+// Matches returns true if the transaction passes the filter.
+func (f PendingTxFilter) Matches(tx *RPCTransaction, queued bool) bool {
+	if queued && !f.IncludeQueued {
+		return false
+	}
+	if len(f.From) > 0 && !slices.Contains(f.From, tx.From) {
+		return false
+	}
+	if len(f.To) > 0 && (tx.To == nil || !slices.Contains(f.To, *tx.To)) {
+		return false
+	}
+	if f.MinTip != nil {
+		tip := tx.GasTipCap
+		if tip == nil {
+			tip = tx.GasPrice
+		}
+		if tip == nil || tip.ToInt().Cmp(f.MinTip) < 0 {
+			return false
+		}
+	}
+	if len(f.Selector) > 0 && !bytes.HasPrefix(tx.Input, f.Selector) {
+		return false
+	}
+	return true
+}
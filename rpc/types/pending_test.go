@@ -0,0 +1,92 @@
+package types
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	ethparams "github.com/ethereum/go-ethereum/params"
+	"github.com/ethereum/go-ethereum/trie"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPendingTxFilter(t *testing.T) {
+	from := common.HexToAddress("0x57f96e6B86CdeFdB3d412547816a82E3E0EbF9D2")
+	to := common.BigToAddress(big.NewInt(1))
+	tx := &RPCTransaction{
+		From:      from,
+		To:        &to,
+		GasPrice:  (*hexutil.Big)(big.NewInt(10)),
+		GasTipCap: (*hexutil.Big)(big.NewInt(2)),
+		Input:     hexutil.Bytes{0xa9, 0x05, 0x9c, 0xbb, 0x01},
+	}
+	creation := &RPCTransaction{
+		From:     from,
+		GasPrice: (*hexutil.Big)(big.NewInt(10)),
+	}
+
+	testCases := []struct {
+		name        string
+		filter      PendingTxFilter
+		hasCriteria bool
+		tx          *RPCTransaction
+		queued      bool
+		exp         bool
+	}{
+		{"no criteria", PendingTxFilter{}, false, tx, false, true},
+		{"queued not included", PendingTxFilter{FullTx: true}, true, tx, true, false},
+		{"queued included", PendingTxFilter{IncludeQueued: true}, true, tx, true, true},
+		{"from", PendingTxFilter{From: []common.Address{from}}, true, tx, false, true},
+		{"from mismatch", PendingTxFilter{From: []common.Address{to}}, true, tx, false, false},
+		{"to", PendingTxFilter{To: []common.Address{to}}, true, tx, false, true},
+		{"to contract creation", PendingTxFilter{To: []common.Address{to}}, true, creation, false, false},
+		{"min tip", PendingTxFilter{MinTip: big.NewInt(2)}, true, tx, false, true},
+		{"min tip too high", PendingTxFilter{MinTip: big.NewInt(3)}, true, tx, false, false},
+		{"min tip of legacy tx", PendingTxFilter{MinTip: big.NewInt(10)}, true, creation, false, true},
+		{"selector", PendingTxFilter{Selector: []byte{0xa9, 0x05, 0x9c, 0xbb}}, true, tx, false, true},
+		{"selector mismatch", PendingTxFilter{Selector: []byte{0x09, 0x5e, 0xa7, 0xb3}}, true, tx, false, false},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.hasCriteria, tc.filter.HasCriteria())
+			require.Equal(t, tc.exp, tc.filter.Matches(tc.tx, tc.queued))
+		})
+	}
+}
+
+func TestRPCMarshalPendingBlock(t *testing.T) {
+	chainID := big.NewInt(9001)
+	config := &ethparams.ChainConfig{ChainID: chainID, LondonBlock: big.NewInt(0)}
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	tx := ethtypes.MustSignNewTx(key, ethtypes.LatestSignerForChainID(chainID), &ethtypes.DynamicFeeTx{
+		ChainID:   chainID,
+		GasTipCap: big.NewInt(1),
+		GasFeeCap: big.NewInt(10),
+		Gas:       21000,
+		To:        &common.Address{},
+	})
+	header := &ethtypes.Header{
+		Number:     big.NewInt(11),
+		ParentHash: common.BigToHash(big.NewInt(10)),
+		Difficulty: big.NewInt(0),
+		BaseFee:    big.NewInt(5),
+	}
+	block := ethtypes.NewBlock(header, &ethtypes.Body{Transactions: []*ethtypes.Transaction{tx}}, nil, trie.NewStackTrie(nil))
+
+	fields := RPCMarshalPendingBlock(block, false, config)
+	require.Nil(t, fields["hash"])
+	require.Nil(t, fields["nonce"])
+	require.Nil(t, fields["miner"])
+	require.Equal(t, (*hexutil.Big)(big.NewInt(11)), fields["number"])
+	require.Equal(t, []interface{}{tx.Hash()}, fields["transactions"])
+
+	fields = RPCMarshalPendingBlock(block, true, config)
+	rpcTx, ok := fields["transactions"].([]interface{})[0].(*RPCTransaction)
+	require.True(t, ok)
+	require.Equal(t, crypto.PubkeyToAddress(key.PublicKey), rpcTx.From)
+	require.Nil(t, rpcTx.BlockHash)
+}
@@ -6,21 +6,32 @@ import (
 	abci "github.com/cometbft/cometbft/abci/types"
 	"github.com/cometbft/cometbft/proto/tendermint/crypto"
 
+	epixminttypes "github.com/cosmos/evm/x/epixmint/types"
 	feemarkettypes "github.com/cosmos/evm/x/feemarket/types"
 	evmtypes "github.com/cosmos/evm/x/vm/types"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/types/tx"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
 // QueryClient defines a gRPC Client used for:
 //   - Transaction simulation
 //   - EVM module queries
 //   - Fee market module queries
+//   - Native chain queries of the cosmos namespace
 type QueryClient struct {
 	tx.ServiceClient
 	evmtypes.QueryClient
-	FeeMarket feemarkettypes.QueryClient
+	FeeMarket    feemarkettypes.QueryClient
+	Bank         banktypes.QueryClient
+	Staking      stakingtypes.QueryClient
+	Distribution distrtypes.QueryClient
+	Gov          govv1.QueryClient
+	EpixMint     epixminttypes.QueryClient
 }
 
 // NewQueryClient creates a new gRPC query client
@@ -29,6 +40,11 @@ func NewQueryClient(clientCtx client.Context) *QueryClient {
 		ServiceClient: tx.NewServiceClient(clientCtx),
 		QueryClient:   evmtypes.NewQueryClient(clientCtx),
 		FeeMarket:     feemarkettypes.NewQueryClient(clientCtx),
+		Bank:          banktypes.NewQueryClient(clientCtx),
+		Staking:       stakingtypes.NewQueryClient(clientCtx),
+		Distribution:  distrtypes.NewQueryClient(clientCtx),
+		Gov:           govv1.NewQueryClient(clientCtx),
+		EpixMint:      epixminttypes.NewQueryClient(clientCtx),
 	}
 }
 
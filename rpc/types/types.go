@@ -189,3 +189,17 @@ type TraceConfig struct {
 	evmtypes.TraceConfig
 	TracerConfig json.RawMessage `json:"tracerConfig"`
 }
+
+// AddressTransaction represents an entry of the transaction history of an address.
+type AddressTransaction struct {
+	Hash             common.Hash    `json:"hash"`
+	BlockNumber      hexutil.Uint64 `json:"blockNumber"`
+	TransactionIndex hexutil.Uint64 `json:"transactionIndex"`
+	Roles            []string       `json:"roles"`
+}
+
+// AddressTransactionsResult represents a page of the transaction history of an address.
+type AddressTransactionsResult struct {
+	Transactions []*AddressTransaction `json:"transactions"`
+	NextCursor   hexutil.Bytes         `json:"nextCursor,omitempty"`
+}
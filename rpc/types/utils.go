@@ -375,7 +375,9 @@ func CalcBaseFee(config *ethparams.ChainConfig, parent *ethtypes.Header, p feema
 	if p.ElasticityMultiplier == 0 {
 		return nil, errors.New("ElasticityMultiplier cannot be 0 as it's checked in the params validation")
 	}
-	parentGasTarget := parent.GasLimit / uint64(p.ElasticityMultiplier)
+	// with fee lanes, the parent gas used is the gas of its EVM transactions,
+	// targeted against the EVM share of the gas limit
+	parentGasTarget := p.EVMLaneGasLimit(parent.GasLimit) / uint64(p.ElasticityMultiplier)
 
 	factor := evmtypes.GetEVMCoinDecimals().ConversionFactor()
 	minGasPrice := p.MinGasPrice.Mul(sdkmath.LegacyNewDecFromInt(factor))
@@ -470,6 +472,30 @@ func RPCMarshalBlock(block *ethtypes.Block, cmtBlock *cmtrpccore.ResultBlock, ms
 	return fields, nil
 }
 
+// RPCMarshalPendingBlock converts the given pending block to the RPC output. Like geth, the hash, nonce
+// and miner of the pending block are null, and the transactions don't have the block fields.
+func RPCMarshalPendingBlock(block *ethtypes.Block, fullTx bool, config *ethparams.ChainConfig) map[string]interface{} {
+	fields := RPCMarshalHeader(block.Header(), nil)
+	fields["hash"] = nil
+	fields["nonce"] = nil
+	fields["miner"] = nil
+	fields["size"] = hexutil.Uint64(block.Size())
+
+	txs := block.Transactions()
+	transactions := make([]interface{}, len(txs))
+	for i, tx := range txs {
+		if fullTx {
+			transactions[i] = NewRPCTransaction(tx, common.Hash{}, block.NumberU64(), block.Time(), uint64(i), block.BaseFee(), config) //nolint:gosec // G115
+		} else {
+			transactions[i] = tx.Hash()
+		}
+	}
+	fields["transactions"] = transactions
+	fields["uncles"] = []common.Hash{}
+	fields["withdrawals"] = block.Withdrawals()
+	return fields
+}
+
 // newRPCTransactionFromBlockIndex returns a transaction that will serialize to the RPC representation.
 func newRPCTransactionFromBlockIndex(b *ethtypes.Block, blockHash common.Hash, index uint64, config *ethparams.ChainConfig) *RPCTransaction {
 	txs := b.Transactions()
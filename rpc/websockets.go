@@ -16,6 +16,7 @@ import (
 	"sync"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/eth/filters"
 	"github.com/ethereum/go-ethereum/rpc"
@@ -24,7 +25,9 @@ import (
 	"github.com/pkg/errors"
 
 	rpcfilters "github.com/cosmos/evm/rpc/namespaces/ethereum/eth/filters"
+	"github.com/cosmos/evm/rpc/policy"
 	"github.com/cosmos/evm/rpc/stream"
+	rpctypes "github.com/cosmos/evm/rpc/types"
 	"github.com/cosmos/evm/server/config"
 
 	"cosmossdk.io/log"
@@ -74,11 +77,18 @@ type websocketsServer struct {
 	certFile       string
 	keyFile        string
 	allowedOrigins []string // allowed origins for WebSocket connections
+	policy         *policy.Policy
 	api            *pubSubAPI
 	logger         log.Logger
 }
 
-func NewWebsocketsServer(clientCtx client.Context, logger log.Logger, stream *stream.RPCStream, cfg *config.Config) WebsocketsServer {
+func NewWebsocketsServer(
+	clientCtx client.Context,
+	logger log.Logger,
+	stream *stream.RPCStream,
+	cfg *config.Config,
+	policy *policy.Policy,
+) WebsocketsServer {
 	logger = logger.With("api", "websocket-server")
 	return &websocketsServer{
 		rpcAddr:        cfg.JSONRPC.Address,
@@ -86,6 +96,7 @@ func NewWebsocketsServer(clientCtx client.Context, logger log.Logger, stream *st
 		certFile:       cfg.TLS.CertificatePath,
 		keyFile:        cfg.TLS.KeyPath,
 		allowedOrigins: cfg.JSONRPC.WSOrigins,
+		policy:         policy,
 		api:            newPubSubAPI(clientCtx, logger, stream),
 		logger:         logger,
 	}
@@ -194,8 +205,9 @@ func (s *websocketsServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	conn.SetReadLimit(maxMessageSize)
 
 	ws := &wsConn{
-		mux:  new(sync.Mutex),
-		conn: conn,
+		mux:    new(sync.Mutex),
+		conn:   conn,
+		client: s.policy.ClientFromRequest(r),
 	}
 
 	s.readLoop(ws)
@@ -217,6 +229,8 @@ func (s *websocketsServer) sendErrResponse(wsConn *wsConn, msg string) {
 type wsConn struct {
 	conn *websocket.Conn
 	mux  *sync.Mutex
+	// client is the origin of the connection, checked by the access policy
+	client policy.Client
 }
 
 func (w *wsConn) WriteJSON(v any) error {
@@ -259,6 +273,10 @@ readLoop:
 			return
 		}
 
+		if !s.checkPolicy(wsConn, mb) {
+			continue
+		}
+
 		if isBatch(mb) {
 			if err := s.tcpGetAndSendResponse(wsConn, mb); err != nil {
 				s.sendErrResponse(wsConn, err.Error())
@@ -363,6 +381,27 @@ readLoop:
 	}
 }
 
+// checkPolicy checks the message against the access policy, it sends the policy
+// error to the client and returns false if the message is rejected.
+func (s *websocketsServer) checkPolicy(wsConn *wsConn, mb []byte) bool {
+	if s.policy == nil {
+		return true
+	}
+	// the messages which can't be checked are rejected, as they would be forwarded
+	// to the HTTP server unchecked
+	rpcErr := policy.ErrParse
+	if methods, err := policy.RequestMethods(mb); err == nil {
+		rpcErr = s.policy.Check(wsConn.client, methods)
+	}
+	if rpcErr == nil {
+		return true
+	}
+	if err := wsConn.WriteJSON(json.RawMessage(policy.ErrorResponse(mb, rpcErr))); err != nil {
+		s.logger.Debug("error writing policy error response", "error", err.Error())
+	}
+	return false
+}
+
 // tcpGetAndSendResponse sends error response to client if params is invalid
 func (s *websocketsServer) getParamsAndCheckValid(msg map[string]any, wsConn *wsConn) ([]any, bool) {
 	params, ok := msg["params"].([]any)
@@ -388,6 +427,8 @@ func (s *websocketsServer) tcpGetAndSendResponse(wsConn *wsConn, mb []byte) erro
 	}
 
 	req.Header.Set("Content-Type", "application/json")
+	// the message has been checked by the policy already
+	s.policy.MarkForwarded(req)
 	client := &http.Client{}
 	resp, err := client.Do(req)
 	if err != nil {
@@ -443,9 +484,17 @@ func (api *pubSubAPI) subscribe(wsConn *wsConn, subID rpc.ID, params []any) (con
 		}
 		return api.subscribeLogs(wsConn, subID, nil)
 	case "newPendingTransactions":
-		return api.subscribePendingTransactions(wsConn, subID)
+		if len(params) > 1 {
+			return api.subscribePendingTransactions(wsConn, subID, params[1])
+		}
+		return api.subscribePendingTransactions(wsConn, subID, nil)
 	case "syncing":
 		return api.subscribeSyncing(wsConn, subID)
+	case "cosmosEvents":
+		if len(params) > 1 {
+			return api.subscribeCosmosEvents(wsConn, subID, params[1])
+		}
+		return api.subscribeCosmosEvents(wsConn, subID, nil)
 	default:
 		return nil, errors.Errorf("unsupported method %s", method)
 	}
@@ -626,7 +675,17 @@ func (api *pubSubAPI) subscribeLogs(wsConn *wsConn, subID rpc.ID, extra any) (co
 	return cancel, nil
 }
 
-func (api *pubSubAPI) subscribePendingTransactions(wsConn *wsConn, subID rpc.ID) (context.CancelFunc, error) {
+func (api *pubSubAPI) subscribePendingTransactions(wsConn *wsConn, subID rpc.ID, extra any) (context.CancelFunc, error) {
+	filter, err := parsePendingTxFilter(extra)
+	if err != nil {
+		api.logger.Debug("invalid pending transactions criteria", "error", err.Error())
+		return nil, err
+	}
+
+	if filter.HasCriteria() {
+		return api.subscribeMempoolTransactions(wsConn, subID, filter)
+	}
+
 	ctx, cancel := context.WithCancel(context.Background())
 	//nolint: errcheck
 	go api.events.PendingTxStream().Subscribe(ctx, func(items []common.Hash, _ int) error {
@@ -659,6 +718,232 @@ func (api *pubSubAPI) subscribePendingTransactions(wsConn *wsConn, subID rpc.ID)
 	return cancel, nil
 }
 
+// subscribeMempoolTransactions streams the transactions inserted into the EVM
+// mempool that pass the filter.
+func (api *pubSubAPI) subscribeMempoolTransactions(wsConn *wsConn, subID rpc.ID, filter rpctypes.PendingTxFilter) (context.CancelFunc, error) {
+	txStream := api.events.MempoolTxStream()
+	if txStream == nil {
+		return nil, errors.New("pending transactions criteria require the EVM mempool")
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	//nolint: errcheck
+	go txStream.Subscribe(ctx, func(items []stream.MempoolTx, _ int) error {
+		rpcTxs := api.events.MempoolRPCTransactions(items)
+		for i, item := range items {
+			if !filter.Matches(rpcTxs[i], item.Queued) {
+				continue
+			}
+
+			var result any = rpcTxs[i].Hash
+			if filter.FullTx {
+				result = rpcTxs[i]
+			}
+
+			res := &SubscriptionNotification{
+				Jsonrpc: "2.0",
+				Method:  "eth_subscription",
+				Params: &SubscriptionResult{
+					Subscription: subID,
+					Result:       result,
+				},
+			}
+
+			if err := wsConn.WriteJSON(res); err != nil {
+				api.logger.Debug("error writing pending transaction, will drop peer", "error", err.Error())
+
+				try(func() {
+					if err != websocket.ErrCloseSent {
+						_ = wsConn.Close()
+					}
+				}, api.logger, "closing websocket peer sub")
+				return err
+			}
+		}
+		return nil
+	})
+
+	return cancel, nil
+}
+
+// parsePendingTxFilter parses the criteria of the newPendingTransactions
+// subscription, either geth's fullTx boolean or an object of
+// {fullTx, includeQueued, from, to, minTip, selector}.
+func parsePendingTxFilter(extra any) (rpctypes.PendingTxFilter, error) {
+	var filter rpctypes.PendingTxFilter
+
+	switch params := extra.(type) {
+	case nil:
+		return filter, nil
+	case bool:
+		filter.FullTx = params
+		return filter, nil
+	case map[string]any:
+		var ok bool
+		if params["fullTx"] != nil {
+			if filter.FullTx, ok = params["fullTx"].(bool); !ok {
+				return filter, errors.New("invalid fullTx; must be a boolean")
+			}
+		}
+		if params["includeQueued"] != nil {
+			if filter.IncludeQueued, ok = params["includeQueued"].(bool); !ok {
+				return filter, errors.New("invalid includeQueued; must be a boolean")
+			}
+		}
+
+		var err error
+		if filter.From, err = parseAddresses(params["from"]); err != nil {
+			return filter, errors.Wrap(err, "invalid from")
+		}
+		if filter.To, err = parseAddresses(params["to"]); err != nil {
+			return filter, errors.Wrap(err, "invalid to")
+		}
+
+		if params["minTip"] != nil {
+			minTip, ok := params["minTip"].(string)
+			if !ok {
+				return filter, errors.New("invalid minTip; must be a hex quantity")
+			}
+			if filter.MinTip, err = hexutil.DecodeBig(minTip); err != nil {
+				return filter, errors.Wrap(err, "invalid minTip")
+			}
+		}
+
+		if params["selector"] != nil {
+			selector, ok := params["selector"].(string)
+			if !ok {
+				return filter, errors.New("invalid selector; must be hex data")
+			}
+			if filter.Selector, err = hexutil.Decode(selector); err != nil {
+				return filter, errors.Wrap(err, "invalid selector")
+			}
+		}
+		return filter, nil
+	default:
+		return filter, errors.New("invalid criteria; must be a boolean or an object")
+	}
+}
+
+// parseAddresses parses an address or an array of addresses.
+func parseAddresses(value any) ([]common.Address, error) {
+	switch value := value.(type) {
+	case nil:
+		return nil, nil
+	case string:
+		if !common.IsHexAddress(value) {
+			return nil, errors.Errorf("invalid address %s", value)
+		}
+		return []common.Address{common.HexToAddress(value)}, nil
+	case []any:
+		addresses := make([]common.Address, 0, len(value))
+		for _, addr := range value {
+			addr, ok := addr.(string)
+			if !ok || !common.IsHexAddress(addr) {
+				return nil, errors.New("invalid address")
+			}
+			addresses = append(addresses, common.HexToAddress(addr))
+		}
+		return addresses, nil
+	default:
+		return nil, errors.New("must be address or array of addresses")
+	}
+}
+
+func (api *pubSubAPI) subscribeCosmosEvents(wsConn *wsConn, subID rpc.ID, extra any) (context.CancelFunc, error) {
+	filter, err := parseCosmosEventFilter(extra)
+	if err != nil {
+		api.logger.Debug("invalid cosmos events criteria", "error", err.Error())
+		return nil, err
+	}
+
+	cosmosStream, err := api.events.CosmosEventStream()
+	if err != nil {
+		api.logger.Error("failed to subscribe to cosmos events", "error", err.Error())
+		return nil, err
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	//nolint: errcheck
+	go cosmosStream.Subscribe(ctx, func(events []rpctypes.CosmosEvent, _ int) error {
+		for _, event := range events {
+			if !filter.Matches(event) {
+				continue
+			}
+
+			res := &SubscriptionNotification{
+				Jsonrpc: "2.0",
+				Method:  "eth_subscription",
+				Params: &SubscriptionResult{
+					Subscription: subID,
+					Result:       event,
+				},
+			}
+
+			if err := wsConn.WriteJSON(res); err != nil {
+				api.logger.Debug("error writing cosmos event, will drop peer", "error", err.Error())
+
+				try(func() {
+					if err != websocket.ErrCloseSent {
+						_ = wsConn.Close()
+					}
+				}, api.logger, "closing websocket peer sub")
+				return err
+			}
+		}
+		return nil
+	})
+
+	return cancel, nil
+}
+
+// parseCosmosEventFilter parses the {types, attributes} criteria of the
+// cosmosEvents subscription.
+func parseCosmosEventFilter(extra any) (rpctypes.CosmosEventFilter, error) {
+	if extra == nil {
+		return rpctypes.NewCosmosEventFilter(nil, nil), nil
+	}
+
+	params, ok := extra.(map[string]any)
+	if !ok {
+		return rpctypes.CosmosEventFilter{}, errors.New("invalid criteria")
+	}
+
+	var eventTypes []string
+	switch types := params["types"].(type) {
+	case nil:
+	case string:
+		eventTypes = []string{types}
+	case []any:
+		for _, typ := range types {
+			typ, ok := typ.(string)
+			if !ok {
+				return rpctypes.CosmosEventFilter{}, errors.New("invalid event type")
+			}
+			eventTypes = append(eventTypes, typ)
+		}
+	default:
+		return rpctypes.CosmosEventFilter{}, errors.New("invalid types; must be event type or array of event types")
+	}
+
+	var attributes map[string]string
+	switch attrs := params["attributes"].(type) {
+	case nil:
+	case map[string]any:
+		attributes = make(map[string]string, len(attrs))
+		for key, value := range attrs {
+			value, ok := value.(string)
+			if !ok {
+				return rpctypes.CosmosEventFilter{}, errors.Errorf("invalid value of attribute %s", key)
+			}
+			attributes[key] = value
+		}
+	default:
+		return rpctypes.CosmosEventFilter{}, errors.New("invalid attributes; must be an object of attribute values")
+	}
+
+	return rpctypes.NewCosmosEventFilter(eventTypes, attributes), nil
+}
+
 func (api *pubSubAPI) subscribeSyncing(_ *wsConn, _ rpc.ID) (context.CancelFunc, error) {
 	return nil, errors.New("syncing subscription is not implemented")
 }
@@ -10,6 +10,7 @@ import (
 	"github.com/gorilla/websocket"
 	"github.com/stretchr/testify/require"
 
+	"github.com/cosmos/evm/rpc/policy"
 	"github.com/cosmos/evm/rpc/stream"
 	"github.com/cosmos/evm/server/config"
 
@@ -62,6 +63,35 @@ func TestWebsocketPayloadLimit(t *testing.T) {
 	require.Error(t, readErr, "expected connection to close on oversized message")
 }
 
+func TestWebsocketPolicyRejectsUnparsableMessages(t *testing.T) {
+	cfg := config.DefaultJSONRPCConfig()
+	cfg.DeniedMethods = []string{"admin_addPeer"}
+	rpcPolicy, err := policy.New(*cfg)
+	require.NoError(t, err)
+
+	srv := newTestWebsocketServer()
+	srv.policy = rpcPolicy
+	ts := httptest.NewServer(srv)
+	defer ts.Close()
+
+	u, _ := url.Parse(ts.URL)
+	u.Scheme = "ws"
+	conn, _, err := websocket.DefaultDialer.Dial(u.String(), nil)
+	require.NoError(t, err)
+	defer conn.Close()
+
+	// the messages the policy can't check are not forwarded to the HTTP server
+	for _, msg := range []string{
+		`{"jsonrpc":"2.0","id":1,"method":"admin_addPeer","params":["x"]} x`,
+		`[{"jsonrpc":"2.0","id":1,"method":"admin_addPeer","params":["x"]}] x`,
+	} {
+		require.NoError(t, conn.WriteMessage(websocket.TextMessage, []byte(msg)))
+		_, res, err := conn.ReadMessage()
+		require.NoError(t, err)
+		require.JSONEq(t, `{"jsonrpc":"2.0","id":null,"error":{"code":-32700,"message":"parse error"}}`, string(res))
+	}
+}
+
 func TestCheckOrigin(t *testing.T) {
 	logger := log.NewNopLogger()
 	tests := []struct {
@@ -214,3 +244,34 @@ func TestSanitizeOriginForLogging(t *testing.T) {
 		})
 	}
 }
+
+func TestParsePendingTxFilter(t *testing.T) {
+	filter, err := parsePendingTxFilter(true)
+	require.NoError(t, err)
+	require.True(t, filter.FullTx)
+
+	filter, err = parsePendingTxFilter(map[string]any{
+		"fullTx":        true,
+		"includeQueued": true,
+		"from":          "0x57f96e6B86CdeFdB3d412547816a82E3E0EbF9D2",
+		"to":            []any{"0x0000000000000000000000000000000000000001"},
+		"minTip":        "0x3b9aca00",
+		"selector":      "0xa9059cbb",
+	})
+	require.NoError(t, err)
+	require.True(t, filter.FullTx)
+	require.True(t, filter.IncludeQueued)
+	require.Len(t, filter.From, 1)
+	require.Len(t, filter.To, 1)
+	require.Equal(t, int64(1_000_000_000), filter.MinTip.Int64())
+	require.Equal(t, []byte{0xa9, 0x05, 0x9c, 0xbb}, filter.Selector)
+
+	_, err = parsePendingTxFilter(map[string]any{"from": "not an address"})
+	require.Error(t, err)
+
+	_, err = parsePendingTxFilter(map[string]any{"minTip": 1})
+	require.Error(t, err)
+
+	_, err = parsePendingTxFilter("fullTx")
+	require.Error(t, err)
+}
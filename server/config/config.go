@@ -3,14 +3,17 @@ package config
 import (
 	"errors"
 	"fmt"
+	"net"
 	"net/netip"
+	"net/url"
 	"path"
+	"slices"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/spf13/viper"
 
-	"github.com/cometbft/cometbft/libs/strings"
-
 	errorsmod "cosmossdk.io/errors"
 
 	"github.com/cosmos/cosmos-sdk/server/config"
@@ -49,6 +52,9 @@ const (
 	// DefaultJSONRPCWsAddress is the default address the JSON-RPC WebSocket server binds to.
 	DefaultJSONRPCWsAddress = "127.0.0.1:8546"
 
+	// DefaultGraphQLAddress is the default address the GraphQL server binds to.
+	DefaultGraphQLAddress = "127.0.0.1:8547"
+
 	// DefaultJsonRPCMetricsAddress is the default address the JSON-RPC Metrics server binds to.
 	DefaultJSONRPCMetricsAddress = "127.0.0.1:6065"
 
@@ -76,12 +82,28 @@ const (
 	// DefaultJSONRPCAllowInsecureUnlock is true
 	DefaultJSONRPCAllowInsecureUnlock bool = true
 
+	// IndexerBackendKV selects the key-value store implementation of the custom indexer
+	IndexerBackendKV = "kv"
+
+	// IndexerBackendSQL selects the relational database implementation of the custom indexer
+	IndexerBackendSQL = "sql"
+
+	// DefaultIndexerBackend is the default implementation of the custom indexer
+	DefaultIndexerBackend = IndexerBackendKV
+
+	// DefaultIndexerSQLDriver is the default database/sql driver of the SQL indexer
+	DefaultIndexerSQLDriver = "sqlite"
+
 	// DefaultFilterCap is the default cap for total number of filters that can be created
 	DefaultFilterCap int32 = 200
 
 	// DefaultFeeHistoryCap is the default cap for total number of blocks that can be fetched
 	DefaultFeeHistoryCap int32 = 100
 
+	// DefaultFeeHistoryIndexedCap is the default cap for total number of blocks that can be fetched
+	// from the fee records of the custom indexer
+	DefaultFeeHistoryIndexedCap int32 = 4096
+
 	// DefaultLogsCap is the default cap of results returned from single 'eth_getLogs' query
 	DefaultLogsCap int32 = 10000
 
@@ -114,6 +136,24 @@ const (
 	// DefaultMaxOpenConnections represents the amount of open connections (unlimited = 0)
 	DefaultMaxOpenConnections = 0
 
+	// DefaultResponseCacheSize is the default size in megabytes of the JSON-RPC block and receipt cache
+	DefaultResponseCacheSize = 64
+
+	// DefaultGasPriceOracleBlocks is the default number of recent blocks sampled by the gas price oracle
+	DefaultGasPriceOracleBlocks = 20
+
+	// DefaultGasPriceOraclePercentile is the default percentile of the sampled tips suggested by the gas price oracle
+	DefaultGasPriceOraclePercentile = 60
+
+	// DefaultGasPriceOracleMaxTip is the default maximum tip suggested by the gas price oracle (500 gwei)
+	DefaultGasPriceOracleMaxTip uint64 = 500_000_000_000
+
+	// DefaultPendingStateMaxTxs is the default maximum number of mempool transactions applied to the pending state
+	DefaultPendingStateMaxTxs = 1000
+
+	// DefaultMethodCost is the rate limit cost of the JSON-RPC methods without a configured cost
+	DefaultMethodCost = 1
+
 	// DefaultGasAdjustment value to use as default in gas-adjustment flag
 	DefaultGasAdjustment = 1.2
 
@@ -171,18 +211,41 @@ type MempoolConfig struct {
 	GlobalQueue uint64 `mapstructure:"global-queue"`
 	// Lifetime is the maximum amount of time non-executable transaction are queued
 	Lifetime time.Duration `mapstructure:"lifetime"`
+	// MaxBundles is the maximum number of bundles of the eth_sendBundle method, zero disables the bundles
+	MaxBundles uint64 `mapstructure:"max-bundles"`
+	// Journal is the file of the journal of the transactions submitted through the JSON-RPC of
+	// this node, relative to the data directory unless absolute, empty disables the journal
+	Journal string `mapstructure:"journal"`
+	// Rejournal is the time interval to regenerate the local transaction journal
+	Rejournal time.Duration `mapstructure:"rejournal"`
+	// GossipPeers are the JSON-RPC endpoints of the peers the queued (nonce-gapped) transactions are
+	// sent to, as they are not broadcast through CometBFT until executable, empty disables the gossip
+	GossipPeers []string `mapstructure:"gossip-peers"`
+	// GossipMaxPerAccount is the maximum number of queued transactions gossiped per account
+	GossipMaxPerAccount uint64 `mapstructure:"gossip-max-per-account"`
+	// EVMReservedGasShare is the percentage of the block gas reserved to the EVM transactions
+	EVMReservedGasShare uint64 `mapstructure:"evm-reserved-gas-share"`
+	// CosmosReservedGasShare is the percentage of the block gas reserved to the Cosmos transactions
+	CosmosReservedGasShare uint64 `mapstructure:"cosmos-reserved-gas-share"`
 }
 
 // DefaultMempoolConfig returns the default mempool configuration
 func DefaultMempoolConfig() MempoolConfig {
 	return MempoolConfig{
-		PriceLimit:   1,             // Minimum gas price of 1 wei
-		PriceBump:    10,            // 10% price bump to replace transaction
-		AccountSlots: 16,            // 16 executable transaction slots per account
-		GlobalSlots:  5120,          // 4096 + 1024 = 5120 global executable slots
-		AccountQueue: 64,            // 64 non-executable transaction slots per account
-		GlobalQueue:  1024,          // 1024 global non-executable slots
-		Lifetime:     3 * time.Hour, // 3 hour lifetime for queued transactions
+		PriceLimit:             1,                  // Minimum gas price of 1 wei
+		PriceBump:              10,                 // 10% price bump to replace transaction
+		AccountSlots:           16,                 // 16 executable transaction slots per account
+		GlobalSlots:            5120,               // 4096 + 1024 = 5120 global executable slots
+		AccountQueue:           64,                 // 64 non-executable transaction slots per account
+		GlobalQueue:            1024,               // 1024 global non-executable slots
+		Lifetime:               3 * time.Hour,      // 3 hour lifetime for queued transactions
+		MaxBundles:             0,                  // bundles are disabled
+		Journal:                "transactions.rlp", // journal under the data directory
+		Rejournal:              time.Hour,          // regenerate the journal every hour
+		GossipPeers:            []string{},         // the gossip of the queued transactions is disabled
+		GossipMaxPerAccount:    16,                 // same as the executable transaction slots per account
+		EVMReservedGasShare:    0,                  // no block gas reserved to the EVM transactions
+		CosmosReservedGasShare: 0,                  // no block gas reserved to the Cosmos transactions
 	}
 }
 
@@ -209,6 +272,26 @@ func (c MempoolConfig) Validate() error {
 	if c.Lifetime < 1 {
 		return fmt.Errorf("lifetime must be at least 1 nanosecond, got %s", c.Lifetime)
 	}
+	if c.Journal != "" && c.Rejournal < time.Second {
+		return fmt.Errorf("rejournal must be at least 1 second, got %s", c.Rejournal)
+	}
+	for _, peer := range c.GossipPeers {
+		u, err := url.Parse(peer)
+		if err != nil {
+			return fmt.Errorf("invalid gossip peer %q: %w", peer, err)
+		}
+		switch u.Scheme {
+		case "http", "https", "ws", "wss":
+		default:
+			return fmt.Errorf("invalid gossip peer %q: unsupported scheme %q", peer, u.Scheme)
+		}
+	}
+	if len(c.GossipPeers) > 0 && c.GossipMaxPerAccount < 1 {
+		return fmt.Errorf("gossip max per account must be at least 1, got %d", c.GossipMaxPerAccount)
+	}
+	if c.EVMReservedGasShare > 100 || c.CosmosReservedGasShare > 100 || c.EVMReservedGasShare+c.CosmosReservedGasShare > 100 {
+		return fmt.Errorf("reserved gas shares must sum up to at most 100, got %d and %d", c.EVMReservedGasShare, c.CosmosReservedGasShare)
+	}
 	return nil
 }
 
@@ -220,6 +303,10 @@ type JSONRPCConfig struct {
 	Address string `mapstructure:"address"`
 	// WsAddress defines the WebSocket server to listen on
 	WsAddress string `mapstructure:"ws-address"`
+	// EnableGraphQL defines if the GraphQL server (EIP-1767) should be enabled.
+	EnableGraphQL bool `mapstructure:"enable-graphql"`
+	// GraphQLAddress defines the GraphQL server to listen on
+	GraphQLAddress string `mapstructure:"graphql-address"`
 	// GasCap is the global gas cap for eth-call variants.
 	GasCap uint64 `mapstructure:"gas-cap"`
 	// AllowInsecureUnlock toggles if account unlocking is enabled when account-related RPCs are exposed by http.
@@ -232,6 +319,9 @@ type JSONRPCConfig struct {
 	FilterCap int32 `mapstructure:"filter-cap"`
 	// FeeHistoryCap is the global cap for total number of blocks that can be fetched
 	FeeHistoryCap int32 `mapstructure:"feehistory-cap"`
+	// FeeHistoryIndexedCap is the global cap for total number of blocks that can be fetched
+	// when the whole range is served from the fee records of the custom indexer
+	FeeHistoryIndexedCap int32 `mapstructure:"feehistory-indexed-cap"`
 	// Enable defines if the EVM RPC server should be enabled.
 	Enable bool `mapstructure:"enable"`
 	// LogsCap defines the max number of results can be returned from single `eth_getLogs` query.
@@ -252,8 +342,60 @@ type JSONRPCConfig struct {
 	// MaxOpenConnections sets the maximum number of simultaneous connections
 	// for the server listener.
 	MaxOpenConnections int `mapstructure:"max-open-connections"`
+	// ResponseCacheSize defines the size in megabytes of the cache of the blocks, receipts and
+	// bloom filters shared by the JSON-RPC namespaces and the GraphQL server (disabled = 0).
+	ResponseCacheSize int `mapstructure:"response-cache-size"`
+	// GasPriceOracleBlocks defines the number of recent blocks sampled by the gas price oracle.
+	GasPriceOracleBlocks int `mapstructure:"gpo-blocks"`
+	// GasPriceOraclePercentile defines the percentile of the sampled tips suggested by the gas price
+	// oracle, it is raised when the pending transactions exceed the block gas limit.
+	GasPriceOraclePercentile int `mapstructure:"gpo-percentile"`
+	// GasPriceOracleMaxTip defines the maximum tip in wei suggested by the gas price oracle (unlimited = 0).
+	GasPriceOracleMaxTip uint64 `mapstructure:"gpo-max-tip"`
+	// PendingStateMaxTxs defines the maximum number of mempool transactions applied on top of the
+	// latest state to serve the "pending" block queries (unlimited = 0).
+	PendingStateMaxTxs int `mapstructure:"pending-state-max-txs"`
+	// PendingStateMaxGas defines the maximum gas of the mempool transactions applied to the pending
+	// state (block gas limit = 0).
+	PendingStateMaxGas uint64 `mapstructure:"pending-state-max-gas"`
+	// AllowedMethods defines the JSON-RPC methods served, all the methods of the enabled namespaces
+	// if empty. A trailing "*" matches any suffix, e.g. "eth_*".
+	AllowedMethods []string `mapstructure:"allowed-methods"`
+	// DeniedMethods defines the JSON-RPC methods rejected, it takes precedence over AllowedMethods.
+	DeniedMethods []string `mapstructure:"denied-methods"`
+	// MethodCosts defines the rate limit cost of the JSON-RPC methods as "method=cost" entries.
+	MethodCosts []string `mapstructure:"method-costs"`
+	// IPRateLimit defines the cost per second allowed to each client IP (unlimited = 0).
+	IPRateLimit float64 `mapstructure:"ip-rate-limit"`
+	// IPRateBurst defines the maximum cost of the requests of a client IP in a burst.
+	IPRateBurst int `mapstructure:"ip-rate-burst"`
+	// APIKeys defines the API keys accepted in the X-API-Key header or the apikey query parameter.
+	APIKeys []string `mapstructure:"api-keys"`
+	// APIKeyRateLimit defines the cost per second allowed to each API key (unlimited = 0).
+	APIKeyRateLimit float64 `mapstructure:"api-key-rate-limit"`
+	// APIKeyRateBurst defines the maximum cost of the requests of an API key in a burst.
+	APIKeyRateBurst int `mapstructure:"api-key-rate-burst"`
+	// TrustedProxies defines the IPs or CIDR ranges of the reverse proxies in front of the JSON-RPC
+	// servers, the client IP of their requests is read from the X-Forwarded-For or Forwarded headers.
+	TrustedProxies []string `mapstructure:"trusted-proxies"`
+	// AdminToken defines the shared secret required in the X-Admin-Token header to call the admin
+	// methods, on top of a connection from the local host. It must be set to enable the admin namespace,
+	// which is only served on a loopback address.
+	AdminToken string `mapstructure:"admin-token"`
 	// EnableIndexer defines if enable the custom indexer service.
 	EnableIndexer bool `mapstructure:"enable-indexer"`
+	// EnableAddressIndex defines if the custom indexer maintains the transaction history of the addresses.
+	EnableAddressIndex bool `mapstructure:"enable-address-index"`
+	// IndexInternalTxs defines if the address index includes the contracts created and the recipients
+	// of internal transfers, it traces every indexed block.
+	IndexInternalTxs bool `mapstructure:"index-internal-txs"`
+	// IndexerBackend defines the implementation of the custom indexer, "kv" or "sql".
+	IndexerBackend string `mapstructure:"indexer-backend"`
+	// IndexerSQLDriver defines the database/sql driver used by the SQL indexer, "sqlite" or "postgres".
+	IndexerSQLDriver string `mapstructure:"indexer-sql-driver"`
+	// IndexerSQLDSN defines the data source name of the SQL indexer database. If empty, a SQLite
+	// database is created in the node data directory.
+	IndexerSQLDSN string `mapstructure:"indexer-sql-dsn"`
 	// MetricsAddress defines the metrics server to listen on
 	MetricsAddress string `mapstructure:"metrics-address"`
 	// WSOrigins defines the allowed origins for WebSocket connections
@@ -285,7 +427,7 @@ func DefaultEVMConfig() *EVMConfig {
 
 // Validate returns an error if the tracer type is invalid.
 func (c EVMConfig) Validate() error {
-	if c.Tracer != "" && !strings.StringInSlice(c.Tracer, evmTracers) {
+	if c.Tracer != "" && !slices.Contains(evmTracers, c.Tracer) {
 		return fmt.Errorf("invalid tracer type %s, available types: %v", c.Tracer, evmTracers)
 	}
 
@@ -307,7 +449,7 @@ func GetDefaultAPINamespaces() []string {
 
 // GetAPINamespaces returns the all the available JSON-RPC API namespaces.
 func GetAPINamespaces() []string {
-	return []string{"web3", "eth", "personal", "net", "txpool", "debug", "miner"}
+	return []string{"web3", "eth", "personal", "net", "txpool", "debug", "miner", "cosmos", "admin"}
 }
 
 // GetDefaultWSOrigins returns the default WebSocket origins.
@@ -315,31 +457,120 @@ func GetDefaultWSOrigins() []string {
 	return []string{DefaultWSOrigins, "localhost"}
 }
 
+// GetDefaultMethodCosts returns the default rate limit cost of the expensive JSON-RPC methods.
+func GetDefaultMethodCosts() []string {
+	return []string{
+		"debug_traceBlockByNumber=100",
+		"debug_traceBlockByHash=100",
+		"debug_traceTransaction=20",
+		"debug_traceCall=20",
+		"eth_getLogs=10",
+		"eth_getBlockReceipts=10",
+		"eth_call=5",
+		"eth_estimateGas=5",
+		"eth_createAccessList=5",
+	}
+}
+
+// ParseMethodCosts parses the "method=cost" entries of the JSON-RPC method costs.
+func ParseMethodCosts(entries []string) (map[string]int, error) {
+	costs := make(map[string]int, len(entries))
+	for _, entry := range entries {
+		method, value, ok := strings.Cut(entry, "=")
+		method = strings.TrimSpace(method)
+		if !ok || method == "" {
+			return nil, fmt.Errorf("invalid JSON-RPC method cost %q, expected method=cost", entry)
+		}
+		cost, err := strconv.Atoi(strings.TrimSpace(value))
+		if err != nil || cost <= 0 {
+			return nil, fmt.Errorf("invalid JSON-RPC method cost %q, the cost must be a positive integer", entry)
+		}
+		costs[method] = cost
+	}
+	return costs, nil
+}
+
+// ParseTrustedProxies parses the IPs and CIDR ranges of the JSON-RPC trusted proxies.
+func ParseTrustedProxies(entries []string) ([]netip.Prefix, error) {
+	prefixes := make([]netip.Prefix, 0, len(entries))
+	for _, entry := range entries {
+		entry = strings.TrimSpace(entry)
+		if prefix, err := netip.ParsePrefix(entry); err == nil {
+			prefixes = append(prefixes, prefix.Masked())
+			continue
+		}
+		addr, err := netip.ParseAddr(entry)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON-RPC trusted proxy %q, expected an IP or a CIDR range", entry)
+		}
+		prefixes = append(prefixes, netip.PrefixFrom(addr.Unmap(), addr.Unmap().BitLen()))
+	}
+	return prefixes, nil
+}
+
+// isLoopbackAddress returns true if the host of a listen address is localhost or a
+// loopback IP, an empty host listens on every interface.
+func isLoopbackAddress(addr string) bool {
+	host, _, err := net.SplitHostPort(addr)
+	if err != nil {
+		return false
+	}
+	if host == "localhost" {
+		return true
+	}
+	ip, err := netip.ParseAddr(host)
+	return err == nil && ip.IsLoopback()
+}
+
 // DefaultJSONRPCConfig returns an EVM config with the JSON-RPC API enabled by default
 func DefaultJSONRPCConfig() *JSONRPCConfig {
 	return &JSONRPCConfig{
-		Enable:               false,
-		API:                  GetDefaultAPINamespaces(),
-		Address:              DefaultJSONRPCAddress,
-		WsAddress:            DefaultJSONRPCWsAddress,
-		GasCap:               DefaultGasCap,
-		AllowInsecureUnlock:  DefaultJSONRPCAllowInsecureUnlock,
-		EVMTimeout:           DefaultEVMTimeout,
-		TxFeeCap:             DefaultTxFeeCap,
-		FilterCap:            DefaultFilterCap,
-		FeeHistoryCap:        DefaultFeeHistoryCap,
-		BlockRangeCap:        DefaultBlockRangeCap,
-		LogsCap:              DefaultLogsCap,
-		HTTPTimeout:          DefaultHTTPTimeout,
-		HTTPIdleTimeout:      DefaultHTTPIdleTimeout,
-		AllowUnprotectedTxs:  DefaultAllowUnprotectedTxs,
-		BatchRequestLimit:    DefaultBatchRequestLimit,
-		BatchResponseMaxSize: DefaultBatchResponseMaxSize,
-		MaxOpenConnections:   DefaultMaxOpenConnections,
-		EnableIndexer:        false,
-		MetricsAddress:       DefaultJSONRPCMetricsAddress,
-		WSOrigins:            GetDefaultWSOrigins(),
-		EnableProfiling:      DefaultEnableProfiling,
+		Enable:                   false,
+		API:                      GetDefaultAPINamespaces(),
+		Address:                  DefaultJSONRPCAddress,
+		WsAddress:                DefaultJSONRPCWsAddress,
+		EnableGraphQL:            false,
+		GraphQLAddress:           DefaultGraphQLAddress,
+		GasCap:                   DefaultGasCap,
+		AllowInsecureUnlock:      DefaultJSONRPCAllowInsecureUnlock,
+		EVMTimeout:               DefaultEVMTimeout,
+		TxFeeCap:                 DefaultTxFeeCap,
+		FilterCap:                DefaultFilterCap,
+		FeeHistoryCap:            DefaultFeeHistoryCap,
+		FeeHistoryIndexedCap:     DefaultFeeHistoryIndexedCap,
+		BlockRangeCap:            DefaultBlockRangeCap,
+		LogsCap:                  DefaultLogsCap,
+		HTTPTimeout:              DefaultHTTPTimeout,
+		HTTPIdleTimeout:          DefaultHTTPIdleTimeout,
+		AllowUnprotectedTxs:      DefaultAllowUnprotectedTxs,
+		BatchRequestLimit:        DefaultBatchRequestLimit,
+		BatchResponseMaxSize:     DefaultBatchResponseMaxSize,
+		MaxOpenConnections:       DefaultMaxOpenConnections,
+		ResponseCacheSize:        DefaultResponseCacheSize,
+		GasPriceOracleBlocks:     DefaultGasPriceOracleBlocks,
+		GasPriceOraclePercentile: DefaultGasPriceOraclePercentile,
+		GasPriceOracleMaxTip:     DefaultGasPriceOracleMaxTip,
+		PendingStateMaxTxs:       DefaultPendingStateMaxTxs,
+		PendingStateMaxGas:       0,
+		AllowedMethods:           []string{},
+		DeniedMethods:            []string{},
+		MethodCosts:              GetDefaultMethodCosts(),
+		IPRateLimit:              0,
+		IPRateBurst:              0,
+		APIKeys:                  []string{},
+		APIKeyRateLimit:          0,
+		APIKeyRateBurst:          0,
+		TrustedProxies:           []string{},
+		AdminToken:               "",
+		EnableIndexer:            false,
+		EnableAddressIndex:       false,
+		IndexInternalTxs:         false,
+		IndexerBackend:           DefaultIndexerBackend,
+		IndexerSQLDriver:         DefaultIndexerSQLDriver,
+		IndexerSQLDSN:            "",
+		MetricsAddress:           DefaultJSONRPCMetricsAddress,
+		WSOrigins:                GetDefaultWSOrigins(),
+		EnableProfiling:          DefaultEnableProfiling,
 	}
 }
 
@@ -349,6 +580,10 @@ func (c JSONRPCConfig) Validate() error {
 		return errors.New("cannot enable JSON-RPC without defining any API namespace")
 	}
 
+	if c.EnableGraphQL && c.GraphQLAddress == "" {
+		return errors.New("cannot enable GraphQL without defining its address")
+	}
+
 	if c.FilterCap < 0 {
 		return errors.New("JSON-RPC filter-cap cannot be negative")
 	}
@@ -357,6 +592,10 @@ func (c JSONRPCConfig) Validate() error {
 		return errors.New("JSON-RPC feehistory-cap cannot be negative or 0")
 	}
 
+	if c.FeeHistoryIndexedCap < 0 {
+		return errors.New("JSON-RPC feehistory-indexed-cap cannot be negative")
+	}
+
 	if c.TxFeeCap < 0 {
 		return errors.New("JSON-RPC tx fee cap cannot be negative")
 	}
@@ -389,6 +628,63 @@ func (c JSONRPCConfig) Validate() error {
 		return errors.New("JSON-RPC batch response max size cannot be negative")
 	}
 
+	if c.ResponseCacheSize < 0 {
+		return errors.New("JSON-RPC response cache size cannot be negative")
+	}
+
+	if c.GasPriceOracleBlocks <= 0 {
+		return errors.New("JSON-RPC gpo-blocks must be positive")
+	}
+
+	if c.GasPriceOraclePercentile < 0 || c.GasPriceOraclePercentile > 100 {
+		return fmt.Errorf("JSON-RPC gpo-percentile must be between 0 and 100, got %d", c.GasPriceOraclePercentile)
+	}
+
+	if c.PendingStateMaxTxs < 0 {
+		return errors.New("JSON-RPC pending-state-max-txs cannot be negative")
+	}
+
+	if _, err := ParseMethodCosts(c.MethodCosts); err != nil {
+		return err
+	}
+
+	if _, err := ParseTrustedProxies(c.TrustedProxies); err != nil {
+		return err
+	}
+
+	if slices.Contains(c.API, "admin") && c.AdminToken == "" {
+		return errors.New("JSON-RPC admin-token cannot be empty with the admin namespace enabled")
+	}
+
+	if slices.Contains(c.API, "admin") && !isLoopbackAddress(c.Address) {
+		return fmt.Errorf("JSON-RPC admin namespace can only be enabled on a loopback address, got %q", c.Address)
+	}
+
+	if c.IPRateLimit < 0 || c.APIKeyRateLimit < 0 {
+		return errors.New("JSON-RPC rate limits cannot be negative")
+	}
+
+	if c.IPRateBurst < 0 || c.APIKeyRateBurst < 0 {
+		return errors.New("JSON-RPC rate bursts cannot be negative")
+	}
+
+	if c.IndexInternalTxs && !c.EnableAddressIndex {
+		return errors.New("JSON-RPC index-internal-txs requires enable-address-index")
+	}
+
+	switch c.IndexerBackend {
+	case IndexerBackendKV:
+	case IndexerBackendSQL:
+		if c.IndexerSQLDriver == "" {
+			return errors.New("JSON-RPC indexer-sql-driver cannot be empty with the sql indexer backend")
+		}
+		if c.IndexInternalTxs {
+			return errors.New("JSON-RPC index-internal-txs is not supported by the sql indexer backend")
+		}
+	default:
+		return fmt.Errorf("invalid JSON-RPC indexer backend %q, available backends: %s, %s", c.IndexerBackend, IndexerBackendKV, IndexerBackendSQL)
+	}
+
 	// check for duplicates
 	seenAPIs := make(map[string]bool)
 	for _, api := range c.API {
@@ -69,3 +69,21 @@ func TestGetConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestJSONRPCConfigAdminNamespace(t *testing.T) {
+	cfg := serverconfig.DefaultJSONRPCConfig()
+	cfg.API = append(cfg.API, "admin")
+	require.Error(t, cfg.Validate(), "the admin namespace requires an admin token")
+
+	cfg.AdminToken = "secret"
+	require.NoError(t, cfg.Validate())
+
+	for _, addr := range []string{"localhost:8545", "[::1]:8545"} {
+		cfg.Address = addr
+		require.NoError(t, cfg.Validate(), addr)
+	}
+	for _, addr := range []string{"0.0.0.0:8545", ":8545", "192.0.2.1:8545", "node.example.com:8545"} {
+		cfg.Address = addr
+		require.Error(t, cfg.Validate(), addr)
+	}
+}
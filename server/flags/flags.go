@@ -41,6 +41,8 @@ const (
 	JSONRPCAPI                  = "json-rpc.api"
 	JSONRPCAddress              = "json-rpc.address"
 	JSONWsAddress               = "json-rpc.ws-address"
+	JSONRPCEnableGraphQL        = "json-rpc.enable-graphql"
+	JSONRPCGraphQLAddress       = "json-rpc.graphql-address"
 	JSONRPCWSOrigins            = "json-rpc.ws-origins"
 	JSONRPCGasCap               = "json-rpc.gas-cap"
 	JSONRPCAllowInsecureUnlock  = "json-rpc.allow-insecure-unlock"
@@ -53,7 +55,28 @@ const (
 	JSONRPCHTTPIdleTimeout      = "json-rpc.http-idle-timeout"
 	JSONRPCAllowUnprotectedTxs  = "json-rpc.allow-unprotected-txs"
 	JSONRPCMaxOpenConnections   = "json-rpc.max-open-connections"
+	JSONRPCResponseCacheSize    = "json-rpc.response-cache-size"
+	JSONRPCGPOBlocks            = "json-rpc.gpo-blocks"
+	JSONRPCGPOPercentile        = "json-rpc.gpo-percentile"
+	JSONRPCGPOMaxTip            = "json-rpc.gpo-max-tip"
+	JSONRPCPendingStateMaxTxs   = "json-rpc.pending-state-max-txs"
+	JSONRPCPendingStateMaxGas   = "json-rpc.pending-state-max-gas"
+	JSONRPCAllowedMethods       = "json-rpc.allowed-methods"
+	JSONRPCDeniedMethods        = "json-rpc.denied-methods"
+	JSONRPCMethodCosts          = "json-rpc.method-costs"
+	JSONRPCIPRateLimit          = "json-rpc.ip-rate-limit"
+	JSONRPCIPRateBurst          = "json-rpc.ip-rate-burst"
+	JSONRPCAPIKeys              = "json-rpc.api-keys"
+	JSONRPCAPIKeyRateLimit      = "json-rpc.api-key-rate-limit"
+	JSONRPCAPIKeyRateBurst      = "json-rpc.api-key-rate-burst"
+	JSONRPCTrustedProxies       = "json-rpc.trusted-proxies"
+	JSONRPCAdminToken           = "json-rpc.admin-token"
 	JSONRPCEnableIndexer        = "json-rpc.enable-indexer"
+	JSONRPCEnableAddressIndex   = "json-rpc.enable-address-index"
+	JSONRPCIndexInternalTxs     = "json-rpc.index-internal-txs"
+	JSONRPCIndexerBackend       = "json-rpc.indexer-backend"
+	JSONRPCIndexerSQLDriver     = "json-rpc.indexer-sql-driver"
+	JSONRPCIndexerSQLDSN        = "json-rpc.indexer-sql-dsn"
 	JSONRPCBatchRequestLimit    = "json-rpc.batch-request-limit"
 	JSONRPCBatchResponseMaxSize = "json-rpc.batch-response-max-size"
 	JSONRPCEnableProfiling      = "json-rpc.enable-profiling"
@@ -72,13 +95,20 @@ const (
 	EVMMinTip                  = "evm.min-tip"
 	EvmGethMetricsAddress      = "evm.geth-metrics-address"
 
-	EVMMempoolPriceLimit   = "evm.mempool.price-limit"
-	EVMMempoolPriceBump    = "evm.mempool.price-bump"
-	EVMMempoolAccountSlots = "evm.mempool.account-slots"
-	EVMMempoolGlobalSlots  = "evm.mempool.global-slots"
-	EVMMempoolAccountQueue = "evm.mempool.account-queue"
-	EVMMempoolGlobalQueue  = "evm.mempool.global-queue"
-	EVMMempoolLifetime     = "evm.mempool.lifetime"
+	EVMMempoolPriceLimit             = "evm.mempool.price-limit"
+	EVMMempoolPriceBump              = "evm.mempool.price-bump"
+	EVMMempoolAccountSlots           = "evm.mempool.account-slots"
+	EVMMempoolGlobalSlots            = "evm.mempool.global-slots"
+	EVMMempoolAccountQueue           = "evm.mempool.account-queue"
+	EVMMempoolGlobalQueue            = "evm.mempool.global-queue"
+	EVMMempoolLifetime               = "evm.mempool.lifetime"
+	EVMMempoolMaxBundles             = "evm.mempool.max-bundles"
+	EVMMempoolJournal                = "evm.mempool.journal"
+	EVMMempoolRejournal              = "evm.mempool.rejournal"
+	EVMMempoolGossipPeers            = "evm.mempool.gossip-peers"
+	EVMMempoolGossipMaxPerAccount    = "evm.mempool.gossip-max-per-account"
+	EVMMempoolEVMReservedGasShare    = "evm.mempool.evm-reserved-gas-share"
+	EVMMempoolCosmosReservedGasShare = "evm.mempool.cosmos-reserved-gas-share"
 )
 
 // TLS flags
@@ -0,0 +1,93 @@
+package server
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/rs/cors"
+	"golang.org/x/sync/errgroup"
+
+	evmmempool "github.com/cosmos/evm/mempool"
+	"github.com/cosmos/evm/rpc/backend"
+	"github.com/cosmos/evm/rpc/graphql"
+	"github.com/cosmos/evm/rpc/policy"
+	serverconfig "github.com/cosmos/evm/server/config"
+	"github.com/cosmos/evm/server/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/server"
+)
+
+// StartGraphQL starts the EIP-1767 GraphQL server, the requests are subject to
+// the policy of the JSON-RPC server and share its response and pending state caches.
+func StartGraphQL(
+	ctx context.Context,
+	srvCtx *server.Context,
+	clientCtx client.Context,
+	g *errgroup.Group,
+	config *serverconfig.Config,
+	indexer types.EVMTxIndexer,
+	mempool *evmmempool.ExperimentalEVMMempool,
+	rpcPolicy *policy.Policy,
+	cache *backend.ResponseCache,
+	pending *backend.PendingStateCache,
+) (*http.Server, error) {
+	logger := srvCtx.Logger.With("module", "graphql")
+
+	evmBackend := backend.NewBackend(srvCtx, logger, clientCtx, config.JSONRPC.AllowUnprotectedTxs, indexer, mempool, cache, pending)
+	handler, err := graphql.NewHandler(evmBackend, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	r := mux.NewRouter()
+	r.Handle("/graphql", rpcPolicy.GraphQLHandler(handler)).Methods("POST")
+
+	handlerWithCors := cors.Default()
+	if config.API.EnableUnsafeCORS {
+		handlerWithCors = cors.AllowAll()
+	}
+
+	httpSrv := &http.Server{
+		Addr:              config.JSONRPC.GraphQLAddress,
+		Handler:           handlerWithCors.Handler(r),
+		ReadHeaderTimeout: config.JSONRPC.HTTPTimeout,
+		ReadTimeout:       config.JSONRPC.HTTPTimeout,
+		WriteTimeout:      config.JSONRPC.HTTPTimeout,
+		IdleTimeout:       config.JSONRPC.HTTPIdleTimeout,
+	}
+
+	ln, err := Listen(httpSrv.Addr, config)
+	if err != nil {
+		return nil, err
+	}
+
+	g.Go(func() error {
+		srvCtx.Logger.Info("Starting GraphQL server", "address", config.JSONRPC.GraphQLAddress)
+		errCh := make(chan error)
+		go func() {
+			errCh <- httpSrv.Serve(ln)
+		}()
+
+		select {
+		case <-ctx.Done():
+			logger.Info("stopping GraphQL server...", "address", config.JSONRPC.GraphQLAddress, "timeout", shutdownTimeout)
+			ctxShutdown, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+			defer cancel()
+			if err := httpSrv.Shutdown(ctxShutdown); err != nil {
+				logger.Error("failed to shutdown GraphQL server", "error", err.Error())
+			}
+			return nil
+		case err := <-errCh:
+			if err == http.ErrServerClosed {
+				return nil
+			}
+
+			srvCtx.Logger.Error("failed to start GraphQL server", "error", err.Error())
+			return err
+		}
+	})
+
+	return httpSrv, nil
+}
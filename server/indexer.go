@@ -0,0 +1,65 @@
+package server
+
+import (
+	"database/sql"
+	"path/filepath"
+
+	// database/sql drivers of the SQL indexer
+	_ "github.com/lib/pq"
+	_ "modernc.org/sqlite"
+
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/evm/indexer"
+	cosmosevmserverconfig "github.com/cosmos/evm/server/config"
+	servertypes "github.com/cosmos/evm/server/types"
+
+	"cosmossdk.io/log"
+
+	"github.com/cosmos/cosmos-sdk/client"
+)
+
+// NewEVMIndexer opens the custom eth tx indexer selected by the json-rpc config. The options
+// only apply to the kv indexer backend.
+func NewEVMIndexer(
+	rootDir string,
+	backendType dbm.BackendType,
+	cfg cosmosevmserverconfig.JSONRPCConfig,
+	logger log.Logger,
+	clientCtx client.Context,
+	opts ...indexer.KVIndexerOption,
+) (servertypes.EVMTxIndexer, error) {
+	if cfg.IndexerBackend != cosmosevmserverconfig.IndexerBackendSQL {
+		idxDB, err := OpenIndexerDB(rootDir, backendType)
+		if err != nil {
+			return nil, err
+		}
+		return indexer.NewKVIndexer(idxDB, logger, clientCtx, opts...), nil
+	}
+
+	db, err := OpenIndexerSQLDB(rootDir, cfg.IndexerSQLDriver, cfg.IndexerSQLDSN)
+	if err != nil {
+		return nil, err
+	}
+	return indexer.NewSQLIndexer(db, cfg.IndexerSQLDriver, logger, clientCtx)
+}
+
+// OpenIndexerSQLDB opens the database of the SQL indexer, if the dsn is empty a SQLite
+// database is created in the node data directory.
+func OpenIndexerSQLDB(rootDir, driverName, dsn string) (*sql.DB, error) {
+	if dsn == "" {
+		dsn = filepath.Join(rootDir, "data", "evmindexer.sqlite")
+	}
+	db, err := sql.Open(driverName, dsn)
+	if err != nil {
+		return nil, err
+	}
+	if err := db.Ping(); err != nil {
+		_ = db.Close()
+		return nil, err
+	}
+	if driverName == cosmosevmserverconfig.DefaultIndexerSQLDriver {
+		// SQLite only supports a single writer
+		db.SetMaxOpenConns(1)
+	}
+	return db, nil
+}
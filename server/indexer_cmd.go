@@ -1,80 +1,72 @@
 package server
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"runtime"
+	"sort"
+	"strconv"
+	"sync"
 
 	"github.com/spf13/cobra"
+	"golang.org/x/sync/errgroup"
 
+	abci "github.com/cometbft/cometbft/abci/types"
 	cmtconfig "github.com/cometbft/cometbft/config"
 	sm "github.com/cometbft/cometbft/state"
 	cmtstore "github.com/cometbft/cometbft/store"
+	cmttypes "github.com/cometbft/cometbft/types"
 
 	"github.com/cosmos/evm/indexer"
+	cosmosevmserverconfig "github.com/cosmos/evm/server/config"
+	servertypes "github.com/cosmos/evm/server/types"
+
+	"cosmossdk.io/log"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/server"
 )
 
+const (
+	flagStartHeight = "start-height"
+	flagEndHeight   = "end-height"
+	flagWorkers     = "workers"
+	flagFix         = "fix"
+)
+
 // NewIndexTxCmd creates a new Cobra command to index historical Ethereum transactions.
 func NewIndexTxCmd() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "index-eth-tx [backward|forward]",
+		Use:   "index-eth-tx [backward|forward|rebuild]",
 		Short: "Index historical eth txs",
 		Long: `Index historical eth txs, it only support two traverse direction to avoid creating gaps in the indexer db if using arbitrary block ranges:
 		- backward: index the blocks from the first indexed block to the earliest block in the chain, if indexer db is empty, start from the latest block.
 		- forward: index the blocks from the latest indexed block to latest block in the chain.
+		- rebuild: re-index all the blocks available in the block store, it populates the indexes added
+		  in newer versions (e.g. the log index used by eth_getLogs or the fee records used by
+		  eth_feeHistory) for the already indexed blocks.
 
 		When start the node, the indexer start from the latest indexed block to avoid creating gap.
         Backward mode should be used most of the time, so the latest indexed block is always up-to-date.
+
+		The verify, prune and reindex sub-commands maintain an existing indexer db.
 		`,
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			serverCtx := server.GetServerContextFromCmd(cmd)
-			clientCtx, err := client.GetClientQueryContext(cmd)
-			if err != nil {
-				return err
-			}
-
 			direction := args[0]
-			if direction != "backward" && direction != "forward" {
-				return fmt.Errorf("unknown index direction, expect: backward|forward, got: %s", direction)
+			if direction != "backward" && direction != "forward" && direction != "rebuild" {
+				return fmt.Errorf("unknown index direction, expect: backward|forward|rebuild, got: %s", direction)
 			}
 
-			cfg := serverCtx.Config
-			home := cfg.RootDir
-			logger := serverCtx.Logger
-			idxDB, err := OpenIndexerDB(home, server.GetAppDBBackend(serverCtx.Viper))
+			env, err := openIndexerEnv(cmd)
 			if err != nil {
-				logger.Error("failed to open evm indexer DB", "error", err.Error())
 				return err
 			}
-			idxer := indexer.NewKVIndexer(idxDB, logger.With("module", "evmindex"), clientCtx)
-
-			// open local CometBFT db, because the local rpc won't be available.
-			tmdb, err := cmtconfig.DefaultDBProvider(&cmtconfig.DBContext{ID: "blockstore", Config: cfg})
-			if err != nil {
-				return err
-			}
-			blockStore := cmtstore.NewBlockStore(tmdb)
-
-			stateDB, err := cmtconfig.DefaultDBProvider(&cmtconfig.DBContext{ID: "state", Config: cfg})
-			if err != nil {
-				return err
-			}
-			stateStore := sm.NewStore(stateDB, sm.StoreOptions{
-				DiscardABCIResponses: cfg.Storage.DiscardABCIResponses,
-			})
+			idxer, blockStore := env.idxer, env.blockStore
 
 			indexBlock := func(height int64) error {
-				blk := blockStore.LoadBlock(height)
-				if blk == nil {
-					return fmt.Errorf("block not found %d", height)
-				}
-				resBlk, err := stateStore.LoadFinalizeBlockResponse(height)
-				if err != nil {
-					return err
-				}
-				if err := idxer.IndexBlock(blk, resBlk.TxResults); err != nil {
+				if err := env.indexBlock(height); err != nil {
 					return err
 				}
 				fmt.Println(height)
@@ -110,6 +102,12 @@ func NewIndexTxCmd() *cobra.Command {
 						return err
 					}
 				}
+			case "rebuild":
+				for i := env.baseHeight(); i <= blockStore.Height(); i++ {
+					if err := indexBlock(i); err != nil {
+						return err
+					}
+				}
 			default:
 				return fmt.Errorf("unknown direction %s", args[0])
 			}
@@ -117,5 +115,337 @@ func NewIndexTxCmd() *cobra.Command {
 			return nil
 		},
 	}
+	cmd.AddCommand(
+		newVerifyIndexCmd(),
+		newPruneIndexCmd(),
+		newReindexCmd(),
+	)
+	return cmd
+}
+
+// newVerifyIndexCmd creates the command checking the indexer db against the block results.
+func newVerifyIndexCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "verify",
+		Short: "Verify the indexed eth txs against the CometBFT block results",
+		Long: `Verify the indexed eth txs of a block range against the CometBFT block results, along with
+the indexed block markers and the log index postings, and report the inconsistent blocks, e.g. the
+gaps left by a crash of the node. Use --fix to reindex them, which rewrites the markers and postings.
+The range defaults to the blocks between the first indexed block and the latest block of the block store.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			env, err := openIndexerEnv(cmd)
+			if err != nil {
+				return err
+			}
+			first, err := env.idxer.FirstIndexedBlock()
+			if err != nil {
+				return err
+			}
+			if first == -1 {
+				first = env.baseHeight()
+			}
+			// the indexed blocks may stop at a gap, the blocks above it are verified too
+			start, end, workers, err := rangeFlags(cmd, max(first, env.baseHeight()), env.blockStore.Height())
+			if err != nil {
+				return err
+			}
+
+			var (
+				mtx     sync.Mutex
+				invalid []int64
+			)
+			err = forEachHeight(cmd.Context(), start, end, workers, func(height int64) error {
+				block, txResults, err := env.loadBlock(height)
+				if err != nil {
+					return err
+				}
+				issues := indexer.VerifyBlock(env.idxer, env.clientCtx, env.logger, block, txResults)
+				if len(issues) == 0 {
+					return nil
+				}
+				mtx.Lock()
+				defer mtx.Unlock()
+				invalid = append(invalid, height)
+				for _, issue := range issues {
+					fmt.Printf("block %d: %s\n", height, issue)
+				}
+				return nil
+			})
+			if err != nil {
+				return err
+			}
+
+			sort.Slice(invalid, func(i, j int) bool { return invalid[i] < invalid[j] })
+			for _, gap := range heightRanges(invalid) {
+				fmt.Printf("gap: %d-%d\n", gap[0], gap[1])
+			}
+			fmt.Printf("verified blocks %d-%d, %d inconsistent\n", start, end, len(invalid))
+
+			if len(invalid) == 0 {
+				return nil
+			}
+			if fix, _ := cmd.Flags().GetBool(flagFix); !fix {
+				return fmt.Errorf("found %d inconsistent blocks, run with --%s to reindex them", len(invalid), flagFix)
+			}
+			if err := forEachHeight(cmd.Context(), 0, int64(len(invalid)-1), workers, func(i int64) error {
+				return env.indexBlock(invalid[i])
+			}); err != nil {
+				return err
+			}
+			fmt.Printf("reindexed %d blocks\n", len(invalid))
+			return nil
+		},
+	}
+	addRangeFlags(cmd)
+	cmd.Flags().Bool(flagFix, false, "Reindex the inconsistent blocks")
+	return cmd
+}
+
+// newPruneIndexCmd creates the command removing the indexed data of the old blocks.
+func newPruneIndexCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "prune [height]",
+		Short: "Remove the indexed data of the blocks below the height",
+		Long: `Remove the indexed data of the blocks below the height, the height defaults to the
+base of the CometBFT block store to match the block pruning.`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			env, err := openIndexerEnv(cmd)
+			if err != nil {
+				return err
+			}
+			pruner, ok := env.idxer.(servertypes.EVMIndexPruner)
+			if !ok {
+				return errors.New("the indexer backend doesn't support pruning")
+			}
+
+			height := env.blockStore.Base()
+			if len(args) > 0 {
+				height, err = strconv.ParseInt(args[0], 10, 64)
+				if err != nil {
+					return fmt.Errorf("invalid height %s: %w", args[0], err)
+				}
+			}
+			if err := pruner.PruneBlocks(height); err != nil {
+				return err
+			}
+
+			first, err := env.idxer.FirstIndexedBlock()
+			if err != nil {
+				return err
+			}
+			fmt.Printf("pruned blocks below %d, first indexed block: %d\n", height, first)
+			return nil
+		},
+	}
+}
+
+// newReindexCmd creates the command indexing a block range with concurrent workers.
+func newReindexCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reindex",
+		Short: "Index a block range with concurrent workers",
+		Long: `Index a block range with concurrent workers, the existing entries of the blocks are overwritten.
+The range defaults to all the blocks available in the block store.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			env, err := openIndexerEnv(cmd)
+			if err != nil {
+				return err
+			}
+			start, end, workers, err := rangeFlags(cmd, env.baseHeight(), env.blockStore.Height())
+			if err != nil {
+				return err
+			}
+
+			if err := forEachHeight(cmd.Context(), start, end, workers, env.indexBlock); err != nil {
+				// the workers don't complete the blocks in order, the range may be partially indexed
+				return fmt.Errorf("reindex interrupted, run verify --%s on blocks %d-%d: %w", flagFix, start, end, err)
+			}
+			fmt.Printf("reindexed blocks %d-%d\n", start, end)
+			return nil
+		},
+	}
+	addRangeFlags(cmd)
 	return cmd
 }
+
+// indexerEnv holds the evm indexer and the local CometBFT stores used by the index-eth-tx commands.
+type indexerEnv struct {
+	idxer      servertypes.EVMTxIndexer
+	blockStore *cmtstore.BlockStore
+	stateStore sm.Store
+	clientCtx  client.Context
+	logger     log.Logger
+}
+
+// openIndexerEnv opens the evm indexer and the local CometBFT db, because the local rpc won't be available.
+func openIndexerEnv(cmd *cobra.Command) (*indexerEnv, error) {
+	serverCtx := server.GetServerContextFromCmd(cmd)
+	clientCtx, err := client.GetClientQueryContext(cmd)
+	if err != nil {
+		return nil, err
+	}
+
+	cfg := serverCtx.Config
+	home := cfg.RootDir
+	logger := serverCtx.Logger
+	config, err := cosmosevmserverconfig.GetConfig(serverCtx.Viper)
+	if err != nil {
+		return nil, err
+	}
+	// internal txs are only traced by the running node, as tracing requires the app state.
+	var idxOpts []indexer.KVIndexerOption
+	if config.JSONRPC.EnableAddressIndex {
+		idxOpts = append(idxOpts, indexer.WithAddressIndex())
+	}
+	idxLogger := logger.With("module", "evmindex")
+	idxer, err := NewEVMIndexer(home, server.GetAppDBBackend(serverCtx.Viper), config.JSONRPC, idxLogger, clientCtx, idxOpts...)
+	if err != nil {
+		logger.Error("failed to open evm indexer DB", "error", err.Error())
+		return nil, err
+	}
+
+	tmdb, err := cmtconfig.DefaultDBProvider(&cmtconfig.DBContext{ID: "blockstore", Config: cfg})
+	if err != nil {
+		return nil, err
+	}
+	stateDB, err := cmtconfig.DefaultDBProvider(&cmtconfig.DBContext{ID: "state", Config: cfg})
+	if err != nil {
+		return nil, err
+	}
+
+	return &indexerEnv{
+		idxer:      idxer,
+		blockStore: cmtstore.NewBlockStore(tmdb),
+		stateStore: sm.NewStore(stateDB, sm.StoreOptions{
+			DiscardABCIResponses: cfg.Storage.DiscardABCIResponses,
+		}),
+		clientCtx: clientCtx,
+		logger:    idxLogger,
+	}, nil
+}
+
+// baseHeight returns the first block available in the block store.
+func (env *indexerEnv) baseHeight() int64 {
+	return max(env.blockStore.Base(), 1)
+}
+
+// loadBlock loads the block and its results from the local CometBFT db.
+func (env *indexerEnv) loadBlock(height int64) (*cmttypes.Block, []*abci.ExecTxResult, error) {
+	blk, resBlk, err := env.loadFinalizedBlock(height)
+	if err != nil {
+		return nil, nil, err
+	}
+	return blk, resBlk.TxResults, nil
+}
+
+// loadFinalizedBlock loads the block and its finalize block response from the local CometBFT db.
+func (env *indexerEnv) loadFinalizedBlock(height int64) (*cmttypes.Block, *abci.ResponseFinalizeBlock, error) {
+	blk := env.blockStore.LoadBlock(height)
+	if blk == nil {
+		return nil, nil, fmt.Errorf("block not found %d", height)
+	}
+	resBlk, err := env.stateStore.LoadFinalizeBlockResponse(height)
+	if err != nil {
+		return nil, nil, err
+	}
+	return blk, resBlk, nil
+}
+
+func (env *indexerEnv) indexBlock(height int64) error {
+	blk, resBlk, err := env.loadFinalizedBlock(height)
+	if err != nil {
+		return err
+	}
+	if err := env.idxer.IndexBlock(blk, resBlk.TxResults); err != nil {
+		return err
+	}
+	return env.indexBlockFees(blk, resBlk)
+}
+
+// indexBlockFees stores the fee record of the block if the indexer persists them, it backfills
+// the fee history served by eth_feeHistory.
+func (env *indexerEnv) indexBlockFees(blk *cmttypes.Block, resBlk *abci.ResponseFinalizeBlock) error {
+	feeIdxer, ok := env.idxer.(servertypes.EVMFeeHistoryIndexer)
+	if !ok {
+		return nil
+	}
+	consParams, err := env.stateStore.LoadConsensusParams(blk.Height)
+	if err != nil {
+		return err
+	}
+	return feeIdxer.IndexBlockFees(blk, resBlk.TxResults, resBlk.Events, consParams.Block.MaxGas)
+}
+
+func addRangeFlags(cmd *cobra.Command) {
+	cmd.Flags().Int64(flagStartHeight, 0, "First block of the range, 0 for the default")
+	cmd.Flags().Int64(flagEndHeight, 0, "Last block of the range, 0 for the default")
+	cmd.Flags().Int(flagWorkers, runtime.NumCPU(), "Number of blocks processed concurrently")
+}
+
+// rangeFlags returns the block range and the number of workers set by the flags.
+func rangeFlags(cmd *cobra.Command, defaultStart, defaultEnd int64) (start, end int64, workers int, err error) {
+	start, _ = cmd.Flags().GetInt64(flagStartHeight)
+	end, _ = cmd.Flags().GetInt64(flagEndHeight)
+	workers, _ = cmd.Flags().GetInt(flagWorkers)
+	if start == 0 {
+		start = defaultStart
+	}
+	if end == 0 {
+		end = defaultEnd
+	}
+	if start < 1 || start > end {
+		return 0, 0, 0, fmt.Errorf("invalid block range, start: %d, end: %d", start, end)
+	}
+	if workers < 1 {
+		return 0, 0, 0, fmt.Errorf("invalid number of workers %d", workers)
+	}
+	return start, end, workers, nil
+}
+
+// forEachHeight calls fn for every height within [start, end] from the given number of
+// workers, it stops at the first error.
+func forEachHeight(ctx context.Context, start, end int64, workers int, fn func(int64) error) error {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	g, ctx := errgroup.WithContext(ctx)
+	heights := make(chan int64)
+	g.Go(func() error {
+		defer close(heights)
+		for height := start; height <= end; height++ {
+			select {
+			case heights <- height:
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
+		return nil
+	})
+	for i := 0; i < workers; i++ {
+		g.Go(func() error {
+			for height := range heights {
+				if err := fn(height); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+	}
+	return g.Wait()
+}
+
+// heightRanges groups the sorted heights into contiguous [first, last] ranges.
+func heightRanges(heights []int64) [][2]int64 {
+	var ranges [][2]int64
+	for _, height := range heights {
+		if n := len(ranges); n > 0 && ranges[n-1][1]+1 == height {
+			ranges[n-1][1] = height
+			continue
+		}
+		ranges = append(ranges, [2]int64{height, height})
+	}
+	return ranges
+}
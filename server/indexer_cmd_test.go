@@ -0,0 +1,41 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestHeightRanges(t *testing.T) {
+	require.Empty(t, heightRanges(nil))
+	require.Equal(t, [][2]int64{{1, 3}, {5, 5}, {7, 8}}, heightRanges([]int64{1, 2, 3, 5, 7, 8}))
+}
+
+func TestForEachHeight(t *testing.T) {
+	var (
+		mtx  sync.Mutex
+		seen = make(map[int64]bool)
+	)
+	err := forEachHeight(context.Background(), 3, 100, 4, func(height int64) error {
+		mtx.Lock()
+		defer mtx.Unlock()
+		seen[height] = true
+		return nil
+	})
+	require.NoError(t, err)
+	require.Len(t, seen, 98)
+	require.True(t, seen[3])
+	require.True(t, seen[100])
+
+	failure := errors.New("failure")
+	err = forEachHeight(context.Background(), 1, 1000, 4, func(height int64) error {
+		if height == 10 {
+			return failure
+		}
+		return nil
+	})
+	require.ErrorIs(t, err, failure)
+}
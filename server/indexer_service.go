@@ -118,7 +118,27 @@ func (eis *EVMIndexerService) OnStart() error {
 			if err := eis.txIdxr.IndexBlock(block.Block, blockResult.TxsResults); err != nil {
 				eis.Logger.Error("failed to index block", "height", i, "err", err)
 			}
+			eis.indexBlockFees(ctx, block.Block, blockResult)
 			lastBlock = blockResult.Height
 		}
 	}
 }
+
+// indexBlockFees stores the fee record of the block if the indexer persists them, the failures
+// are logged as the fee history falls back to the block data.
+func (eis *EVMIndexerService) indexBlockFees(ctx context.Context, block *types.Block, blockResult *coretypes.ResultBlockResults) {
+	feeIdxr, ok := eis.txIdxr.(servertypes.EVMFeeHistoryIndexer)
+	if !ok {
+		return
+	}
+	consParams, err := eis.client.ConsensusParams(ctx, &block.Height)
+	if err != nil {
+		eis.Logger.Error("failed to fetch consensus params", "height", block.Height, "err", err)
+		return
+	}
+	if err := feeIdxr.IndexBlockFees(
+		block, blockResult.TxsResults, blockResult.FinalizeBlockEvents, consParams.ConsensusParams.Block.MaxGas,
+	); err != nil {
+		eis.Logger.Error("failed to index block fees", "height", block.Height, "err", err)
+	}
+}
@@ -17,6 +17,8 @@ import (
 
 	evmmempool "github.com/cosmos/evm/mempool"
 	"github.com/cosmos/evm/rpc"
+	"github.com/cosmos/evm/rpc/backend"
+	"github.com/cosmos/evm/rpc/policy"
 	"github.com/cosmos/evm/rpc/stream"
 	serverconfig "github.com/cosmos/evm/server/config"
 	"github.com/cosmos/evm/server/types"
@@ -41,6 +43,9 @@ func StartJSONRPC(
 	indexer types.EVMTxIndexer,
 	app AppWithPendingTxStream,
 	mempool *evmmempool.ExperimentalEVMMempool,
+	rpcPolicy *policy.Policy,
+	cache *backend.ResponseCache,
+	pending *backend.PendingStateCache,
 ) (*http.Server, error) {
 	logger := srvCtx.Logger.With("module", "geth")
 
@@ -51,6 +56,10 @@ func StartJSONRPC(
 
 	stream := stream.NewRPCStreams(evtClient, logger, clientCtx.TxConfig.TxDecoder())
 	app.RegisterPendingTxListener(stream.ListenPendingTx)
+	if mempool != nil {
+		stream.EnableMempoolTxStream(mempool.GetBlockchain().CurrentBlock)
+		mempool.RegisterTxListener(stream.ListenMempoolTx)
+	}
 
 	// Set Geth's global logger to use this handler
 	handler := &CustomSlogHandler{logger: logger}
@@ -62,7 +71,7 @@ func StartJSONRPC(
 	allowUnprotectedTxs := config.JSONRPC.AllowUnprotectedTxs
 	rpcAPIArr := config.JSONRPC.API
 
-	apis := rpc.GetRPCAPIs(srvCtx, clientCtx, stream, allowUnprotectedTxs, indexer, rpcAPIArr, mempool)
+	apis := rpc.GetRPCAPIs(srvCtx, clientCtx, stream, allowUnprotectedTxs, indexer, rpcAPIArr, mempool, cache, pending)
 
 	for _, api := range apis {
 		if err := rpcServer.RegisterName(api.Namespace, api.Service); err != nil {
@@ -76,7 +85,7 @@ func StartJSONRPC(
 	}
 
 	r := mux.NewRouter()
-	r.HandleFunc("/", rpcServer.ServeHTTP).Methods("POST")
+	r.Handle("/", rpcPolicy.Handler(rpcServer)).Methods("POST")
 
 	handlerWithCors := cors.Default()
 	if config.API.EnableUnsafeCORS {
@@ -131,7 +140,7 @@ func StartJSONRPC(
 
 	srvCtx.Logger.Info("Starting JSON WebSocket server", "address", config.JSONRPC.WsAddress)
 
-	wsSrv := rpc.NewWebsocketsServer(clientCtx, logger, stream, config)
+	wsSrv := rpc.NewWebsocketsServer(clientCtx, logger, stream, config, rpcPolicy)
 	wsSrv.Start()
 	return httpSrv, nil
 }
@@ -7,7 +7,9 @@ import (
 	"github.com/holiman/uint256"
 	"github.com/spf13/cast"
 
+	evmmempool "github.com/cosmos/evm/mempool"
 	"github.com/cosmos/evm/mempool/txpool/legacypool"
+	cosmosevmserverconfig "github.com/cosmos/evm/server/config"
 	srvflags "github.com/cosmos/evm/server/flags"
 
 	"cosmossdk.io/log"
@@ -140,10 +142,65 @@ func GetLegacyPoolConfig(appOpts servertypes.AppOptions, logger log.Logger) *leg
 	if lifetime := cast.ToDuration(appOpts.Get(srvflags.EVMMempoolLifetime)); lifetime != 0 {
 		legacyConfig.Lifetime = lifetime
 	}
+	if journal := cast.ToString(appOpts.Get(srvflags.EVMMempoolJournal)); journal != "" && !filepath.IsAbs(journal) {
+		homeDir := cast.ToString(appOpts.Get(flags.FlagHome))
+		legacyConfig.Journal = filepath.Join(homeDir, "data", journal)
+	} else {
+		legacyConfig.Journal = journal
+	}
+	if rejournal := cast.ToDuration(appOpts.Get(srvflags.EVMMempoolRejournal)); rejournal != 0 {
+		legacyConfig.Rejournal = rejournal
+	}
 
 	return &legacyConfig
 }
 
+// GetMaxBundles reads the maximum number of bundles of the bundle pool from
+// appOpts, zero disables the bundles.
+func GetMaxBundles(appOpts servertypes.AppOptions, logger log.Logger) int {
+	if appOpts == nil {
+		logger.Error("app options is nil, disabling the bundles")
+		return 0
+	}
+
+	return cast.ToInt(appOpts.Get(srvflags.EVMMempoolMaxBundles))
+}
+
+// GetQueuedGossipConfig reads the JSON-RPC endpoints of the peers the queued
+// transactions are gossiped to and the per-account cap of the gossip from appOpts.
+func GetQueuedGossipConfig(appOpts servertypes.AppOptions, logger log.Logger) ([]string, int) {
+	if appOpts == nil {
+		logger.Error("app options is nil, disabling the gossip of the queued transactions")
+		return nil, 0
+	}
+
+	peers := cast.ToStringSlice(appOpts.Get(srvflags.EVMMempoolGossipPeers))
+	maxPerAccount := cast.ToInt(appOpts.Get(srvflags.EVMMempoolGossipMaxPerAccount))
+	if maxPerAccount < 1 {
+		maxPerAccount = int(cosmosevmserverconfig.DefaultMempoolConfig().GossipMaxPerAccount)
+	}
+	return peers, maxPerAccount
+}
+
+// GetReservedGasShares reads the percentages of the block gas reserved to the EVM
+// and Cosmos transactions from appOpts, invalid shares are ignored.
+func GetReservedGasShares(appOpts servertypes.AppOptions, logger log.Logger) evmmempool.ReservedGasShares {
+	if appOpts == nil {
+		logger.Error("app options is nil, reserving no block gas")
+		return evmmempool.ReservedGasShares{}
+	}
+
+	shares := evmmempool.ReservedGasShares{
+		EVM:    cast.ToUint64(appOpts.Get(srvflags.EVMMempoolEVMReservedGasShare)),
+		Cosmos: cast.ToUint64(appOpts.Get(srvflags.EVMMempoolCosmosReservedGasShare)),
+	}
+	if err := shares.Validate(); err != nil {
+		logger.Error("invalid reserved gas shares, reserving no block gas", "error", err)
+		return evmmempool.ReservedGasShares{}
+	}
+	return shares
+}
+
 func GetCosmosPoolMaxTx(appOpts servertypes.AppOptions, logger log.Logger) int {
 	if appOpts == nil {
 		// we don't want to return 0 here, as then appOpts.Get() will return nil and that will be
@@ -29,7 +29,9 @@ import (
 	"github.com/cosmos/evm/indexer"
 	evmmempool "github.com/cosmos/evm/mempool"
 	evmmetrics "github.com/cosmos/evm/metrics"
+	"github.com/cosmos/evm/rpc/backend"
 	ethdebug "github.com/cosmos/evm/rpc/namespaces/ethereum/debug"
+	"github.com/cosmos/evm/rpc/policy"
 	cosmosevmserverconfig "github.com/cosmos/evm/server/config"
 	srvflags "github.com/cosmos/evm/server/flags"
 	servertypes "github.com/cosmos/evm/server/types"
@@ -199,6 +201,8 @@ which accepts a path for the resulting pprof file.
 	cmd.Flags().StringSlice(srvflags.JSONRPCAPI, cosmosevmserverconfig.GetDefaultAPINamespaces(), "Defines a list of JSON-RPC namespaces that should be enabled")
 	cmd.Flags().String(srvflags.JSONRPCAddress, cosmosevmserverconfig.DefaultJSONRPCAddress, "the JSON-RPC server address to listen on")
 	cmd.Flags().String(srvflags.JSONWsAddress, cosmosevmserverconfig.DefaultJSONRPCWsAddress, "the JSON-RPC WS server address to listen on")
+	cmd.Flags().Bool(srvflags.JSONRPCEnableGraphQL, false, "Define if the GraphQL server (EIP-1767) should be enabled")
+	cmd.Flags().String(srvflags.JSONRPCGraphQLAddress, cosmosevmserverconfig.DefaultGraphQLAddress, "the GraphQL server address to listen on")
 	cmd.Flags().StringSlice(srvflags.JSONRPCWSOrigins, cosmosevmserverconfig.GetDefaultWSOrigins(), "Defines a list of WebSocket origins that should be allowed to connect")
 	cmd.Flags().Uint64(srvflags.JSONRPCGasCap, cosmosevmserverconfig.DefaultGasCap, "Sets a cap on gas that can be used in eth_call/estimateGas unit is aatom (0=infinite)")                         //nolint:lll
 	cmd.Flags().Bool(srvflags.JSONRPCAllowInsecureUnlock, cosmosevmserverconfig.DefaultJSONRPCAllowInsecureUnlock, "Allow insecure account unlocking when account-related RPCs are exposed by http") //nolint:lll
@@ -213,7 +217,28 @@ which accepts a path for the resulting pprof file.
 	cmd.Flags().Int32(srvflags.JSONRPCLogsCap, cosmosevmserverconfig.DefaultLogsCap, "Sets the max number of results can be returned from single `eth_getLogs` query")
 	cmd.Flags().Int32(srvflags.JSONRPCBlockRangeCap, cosmosevmserverconfig.DefaultBlockRangeCap, "Sets the max block range allowed for `eth_getLogs` query")
 	cmd.Flags().Int(srvflags.JSONRPCMaxOpenConnections, cosmosevmserverconfig.DefaultMaxOpenConnections, "Sets the maximum number of simultaneous connections for the server listener") //nolint:lll
+	cmd.Flags().Int(srvflags.JSONRPCResponseCacheSize, cosmosevmserverconfig.DefaultResponseCacheSize, "Sets the size in megabytes of the JSON-RPC block and receipt cache (disabled = 0)")
+	cmd.Flags().Int(srvflags.JSONRPCGPOBlocks, cosmosevmserverconfig.DefaultGasPriceOracleBlocks, "Sets the number of recent blocks sampled by the gas price oracle")
+	cmd.Flags().Int(srvflags.JSONRPCGPOPercentile, cosmosevmserverconfig.DefaultGasPriceOraclePercentile, "Sets the percentile of the sampled tips suggested by the gas price oracle")
+	cmd.Flags().Uint64(srvflags.JSONRPCGPOMaxTip, cosmosevmserverconfig.DefaultGasPriceOracleMaxTip, "Sets the maximum tip in wei suggested by the gas price oracle (unlimited = 0)")
+	cmd.Flags().Int(srvflags.JSONRPCPendingStateMaxTxs, cosmosevmserverconfig.DefaultPendingStateMaxTxs, "Sets the maximum number of mempool transactions applied to the pending state (unlimited = 0)")
+	cmd.Flags().Uint64(srvflags.JSONRPCPendingStateMaxGas, 0, "Sets the maximum gas of the mempool transactions applied to the pending state (block gas limit = 0)")
+	cmd.Flags().StringSlice(srvflags.JSONRPCAllowedMethods, []string{}, "Defines the JSON-RPC methods served, all the methods of the enabled namespaces if empty")
+	cmd.Flags().StringSlice(srvflags.JSONRPCDeniedMethods, []string{}, "Defines the JSON-RPC methods rejected")
+	cmd.Flags().StringSlice(srvflags.JSONRPCMethodCosts, cosmosevmserverconfig.GetDefaultMethodCosts(), "Defines the rate limit cost of the JSON-RPC methods as method=cost entries")
+	cmd.Flags().Float64(srvflags.JSONRPCIPRateLimit, 0, "Sets the JSON-RPC cost per second allowed to each client IP (unlimited = 0)")
+	cmd.Flags().Int(srvflags.JSONRPCIPRateBurst, 0, "Sets the maximum JSON-RPC cost of a client IP in a burst")
+	cmd.Flags().StringSlice(srvflags.JSONRPCAPIKeys, []string{}, "Defines the JSON-RPC API keys, rate limited per key instead of per IP")
+	cmd.Flags().Float64(srvflags.JSONRPCAPIKeyRateLimit, 0, "Sets the JSON-RPC cost per second allowed to each API key (unlimited = 0)")
+	cmd.Flags().Int(srvflags.JSONRPCAPIKeyRateBurst, 0, "Sets the maximum JSON-RPC cost of an API key in a burst")
+	cmd.Flags().StringSlice(srvflags.JSONRPCTrustedProxies, []string{}, "Defines the IPs or CIDR ranges of the reverse proxies, the client IP of their requests is read from the forwarded headers")
+	cmd.Flags().String(srvflags.JSONRPCAdminToken, "", "Defines the shared secret of the X-Admin-Token header required by the admin namespace")
 	cmd.Flags().Bool(srvflags.JSONRPCEnableIndexer, false, "Enable the custom tx indexer for json-rpc")
+	cmd.Flags().Bool(srvflags.JSONRPCEnableAddressIndex, false, "Enable the address transaction history in the custom tx indexer")
+	cmd.Flags().Bool(srvflags.JSONRPCIndexInternalTxs, false, "Trace the indexed blocks to add contract creations and internal transfers to the address index")
+	cmd.Flags().String(srvflags.JSONRPCIndexerBackend, cosmosevmserverconfig.DefaultIndexerBackend, "Implementation of the custom tx indexer (kv|sql)")
+	cmd.Flags().String(srvflags.JSONRPCIndexerSQLDriver, cosmosevmserverconfig.DefaultIndexerSQLDriver, "Database driver of the sql tx indexer (sqlite|postgres)")
+	cmd.Flags().String(srvflags.JSONRPCIndexerSQLDSN, "", "Data source name of the sql tx indexer database, defaults to a SQLite database in the data directory")
 	cmd.Flags().Bool(srvflags.JSONRPCEnableMetrics, false, "Define if EVM rpc metrics server should be enabled")
 	cmd.Flags().Bool(srvflags.JSONRPCEnableProfiling, false, "Enables the profiling in the debug namespace")
 
@@ -231,6 +256,13 @@ which accepts a path for the resulting pprof file.
 	cmd.Flags().Uint64(srvflags.EVMMempoolAccountQueue, cosmosevmserverconfig.DefaultMempoolConfig().AccountQueue, "the maximum number of non-executable transaction slots permitted per account")
 	cmd.Flags().Uint64(srvflags.EVMMempoolGlobalQueue, cosmosevmserverconfig.DefaultMempoolConfig().GlobalQueue, "the maximum number of non-executable transaction slots for all accounts")
 	cmd.Flags().Duration(srvflags.EVMMempoolLifetime, cosmosevmserverconfig.DefaultMempoolConfig().Lifetime, "the maximum amount of time non-executable transaction are queued")
+	cmd.Flags().String(srvflags.EVMMempoolJournal, cosmosevmserverconfig.DefaultMempoolConfig().Journal, "the journal of the transactions submitted through the JSON-RPC of this node, relative to the data directory, empty disables it")
+	cmd.Flags().Duration(srvflags.EVMMempoolRejournal, cosmosevmserverconfig.DefaultMempoolConfig().Rejournal, "the time interval to regenerate the local transaction journal")
+	cmd.Flags().StringSlice(srvflags.EVMMempoolGossipPeers, cosmosevmserverconfig.DefaultMempoolConfig().GossipPeers, "the JSON-RPC endpoints of the peers the queued (nonce-gapped) transactions are sent to, empty disables the gossip")
+	cmd.Flags().Uint64(srvflags.EVMMempoolGossipMaxPerAccount, cosmosevmserverconfig.DefaultMempoolConfig().GossipMaxPerAccount, "the maximum number of queued transactions gossiped per account")
+	cmd.Flags().Uint64(srvflags.EVMMempoolEVMReservedGasShare, cosmosevmserverconfig.DefaultMempoolConfig().EVMReservedGasShare, "the percentage of the block gas reserved to the EVM transactions")
+	cmd.Flags().Uint64(srvflags.EVMMempoolCosmosReservedGasShare, cosmosevmserverconfig.DefaultMempoolConfig().CosmosReservedGasShare, "the percentage of the block gas reserved to the Cosmos transactions")
+	cmd.Flags().Uint64(srvflags.EVMMempoolMaxBundles, cosmosevmserverconfig.DefaultMempoolConfig().MaxBundles, "the maximum number of bundles submitted with eth_sendBundle, zero disables the bundles")
 
 	cmd.Flags().String(srvflags.TLSCertPath, "", "the cert.pem file path for the server TLS configuration")
 	cmd.Flags().String(srvflags.TLSKeyPath, "", "the key.pem file path for the server TLS configuration")
@@ -475,16 +507,25 @@ func startInProcess(svrCtx *server.Context, clientCtx client.Context, opts Start
 		ethmetricsexp.Setup(config.JSONRPC.MetricsAddress)
 	}
 
+	// the JSON-RPC, GraphQL and tracing backends share the cache of the responses
+	rpcCache := backend.NewResponseCache(int64(config.JSONRPC.ResponseCacheSize) * 1024 * 1024)
+
 	var idxer servertypes.EVMTxIndexer
 	if config.JSONRPC.EnableIndexer {
-		idxDB, err := OpenIndexerDB(home, server.GetAppDBBackend(svrCtx.Viper))
+		idxLogger := svrCtx.Logger.With("indexer", "evm")
+		var idxOpts []indexer.KVIndexerOption
+		if config.JSONRPC.EnableAddressIndex {
+			idxOpts = append(idxOpts, indexer.WithAddressIndex())
+		}
+		if config.JSONRPC.IndexInternalTxs {
+			traceBackend := backend.NewBackend(svrCtx, idxLogger, clientCtx, config.JSONRPC.AllowUnprotectedTxs, nil, nil, rpcCache, nil)
+			idxOpts = append(idxOpts, indexer.WithInternalTxTracer(traceBackend.InternalTxRecipients))
+		}
+		idxer, err = NewEVMIndexer(home, server.GetAppDBBackend(svrCtx.Viper), config.JSONRPC, idxLogger, clientCtx, idxOpts...)
 		if err != nil {
 			logger.Error("failed to open evm indexer DB", "error", err.Error())
 			return err
 		}
-
-		idxLogger := svrCtx.Logger.With("indexer", "evm")
-		idxer = indexer.NewKVIndexer(idxDB, idxLogger, clientCtx)
 		indexerService := NewEVMIndexerService(idxer, clientCtx.Client.(rpcclient.Client))
 		indexerService.SetLogger(servercmtlog.CometLoggerWrapper{Logger: idxLogger})
 
@@ -537,10 +578,24 @@ func startInProcess(svrCtx *server.Context, clientCtx client.Context, opts Start
 		if !ok {
 			return fmt.Errorf("json-rpc server requires AppWithPendingTxStream")
 		}
-		_, err = StartJSONRPC(ctx, svrCtx, clientCtx, g, &config, idxer, txApp, evmApp.GetMempool().(*evmmempool.ExperimentalEVMMempool))
+		// the servers share the policy, and so the rate limits of the clients
+		rpcPolicy, err := policy.New(config.JSONRPC)
+		if err != nil {
+			return err
+		}
+		// and the pending state of the mempool, built once for all of them
+		rpcPending := backend.NewPendingStateCache()
+		_, err = StartJSONRPC(ctx, svrCtx, clientCtx, g, &config, idxer, txApp, evmApp.GetMempool().(*evmmempool.ExperimentalEVMMempool), rpcPolicy, rpcCache, rpcPending)
 		if err != nil {
 			return err
 		}
+
+		if config.JSONRPC.EnableGraphQL {
+			_, err = StartGraphQL(ctx, svrCtx, clientCtx, g, &config, idxer, evmApp.GetMempool().(*evmmempool.ExperimentalEVMMempool), rpcPolicy, rpcCache, rpcPending)
+			if err != nil {
+				return err
+			}
+		}
 	}
 
 	// At this point it is safe to block the process if we're in query only mode as
@@ -1,6 +1,8 @@
 package types
 
 import (
+	"math/big"
+
 	"github.com/ethereum/go-ethereum/common"
 
 	abci "github.com/cometbft/cometbft/abci/types"
@@ -9,8 +11,12 @@ import (
 
 // EVMTxIndexer defines the interface of custom eth tx indexer.
 type EVMTxIndexer interface {
-	// LastIndexedBlock returns -1 if indexer db is empty
+	// LastIndexedBlock returns the last block of the contiguous range of indexed
+	// blocks starting at the first one, so that the indexing resumes at the first
+	// gap. It returns -1 if indexer db is empty
 	LastIndexedBlock() (int64, error)
+	// FirstIndexedBlock returns -1 if indexer db is empty
+	FirstIndexedBlock() (int64, error)
 	IndexBlock(*cmttypes.Block, []*abci.ExecTxResult) error
 
 	// GetByTxHash returns nil if tx not found.
@@ -18,3 +24,97 @@ type EVMTxIndexer interface {
 	// GetByBlockAndIndex returns nil if tx not found.
 	GetByBlockAndIndex(int64, int32) (*TxResult, error)
 }
+
+// EVMLogIndexer defines the optional interface of an indexer that maintains
+// a log index by emitter address and topics.
+type EVMLogIndexer interface {
+	// GetLogBlocks returns the block numbers within [from, to] that contain logs matching
+	// the criteria. It returns false if the index can't answer the query.
+	GetLogBlocks(from, to int64, addresses []common.Address, topics [][]common.Hash) ([]int64, bool, error)
+}
+
+// EVMIndexPruner defines the optional interface of an indexer that can remove the
+// data of old blocks.
+type EVMIndexPruner interface {
+	// PruneBlocks removes the indexed data of the blocks below the height.
+	PruneBlocks(height int64) error
+}
+
+// TxTip is the effective tip paid by eth txs of a block, along with the gas they used.
+type TxTip struct {
+	Tip     *big.Int `json:"tip"`
+	GasUsed uint64   `json:"gas_used"`
+}
+
+// BlockFees is the compact fee record of a block, it holds what eth_feeHistory needs without
+// fetching the block and its results.
+type BlockFees struct {
+	Time       uint64
+	BaseFee    *big.Int
+	GasLimit   uint64
+	GasUsed    uint64
+	EVMGasUsed uint64
+	// Tips are the effective tips of the eth txs in ascending order, the txs paying the same tip
+	// are merged.
+	Tips []TxTip
+}
+
+// EVMFeeHistoryIndexer defines the optional interface of an indexer that persists the fee
+// records of the blocks.
+type EVMFeeHistoryIndexer interface {
+	// IndexBlockFees stores the fee record of the block built from its results, the events of
+	// the block and the max gas of its consensus params.
+	IndexBlockFees(block *cmttypes.Block, txResults []*abci.ExecTxResult, events []abci.Event, maxGas int64) error
+	// GetBlockFees returns the fee records of the blocks within [from, to]. It returns false if
+	// the range is not fully recorded.
+	GetBlockFees(from, to int64) ([]*BlockFees, bool, error)
+}
+
+// AddressRole is a bit set describing how an address is involved in an eth tx.
+type AddressRole uint8
+
+const (
+	// AddressRoleFrom is set when the address is the sender of the tx.
+	AddressRoleFrom AddressRole = 1 << iota
+	// AddressRoleTo is set when the address is the recipient of the tx.
+	AddressRoleTo
+	// AddressRoleCreate is set when the address is a contract created by the tx.
+	AddressRoleCreate
+	// AddressRoleInternal is set when the address received value from an internal call of the tx.
+	AddressRoleInternal
+)
+
+// Strings returns the names of the roles set in r.
+func (r AddressRole) Strings() []string {
+	names := make([]string, 0, 4)
+	if r&AddressRoleFrom != 0 {
+		names = append(names, "from")
+	}
+	if r&AddressRoleTo != 0 {
+		names = append(names, "to")
+	}
+	if r&AddressRoleCreate != 0 {
+		names = append(names, "create")
+	}
+	if r&AddressRoleInternal != 0 {
+		names = append(names, "internal")
+	}
+	return names
+}
+
+// AddressTxResult is an entry of the transaction history of an address.
+type AddressTxResult struct {
+	Hash       common.Hash
+	Height     int64
+	EthTxIndex int32
+	Roles      AddressRole
+}
+
+// EVMAddressIndexer defines the optional interface of an indexer that maintains
+// the transaction history of the addresses.
+type EVMAddressIndexer interface {
+	// GetTxsByAddress returns up to limit txs involving the address within [from, to], in
+	// ascending (height, eth tx index) order, starting strictly after the cursor position if
+	// not nil. It also returns the cursor of the next page, nil if there are no more results.
+	GetTxsByAddress(address common.Address, from, to int64, cursor []byte, limit int) ([]*AddressTxResult, []byte, error)
+}
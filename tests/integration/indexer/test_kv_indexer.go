@@ -163,23 +163,19 @@ func TestKVIndexer(t *testing.T, create network.CreateEvmApp, options ...network
 
 			err = idxer.IndexBlock(tc.block, tc.blockResult)
 			require.NoError(t, err)
-			if !tc.expSuccess {
-				first, err := idxer.FirstIndexedBlock()
-				require.NoError(t, err)
-				require.Equal(t, int64(-1), first)
-
-				last, err := idxer.LastIndexedBlock()
-				require.NoError(t, err)
-				require.Equal(t, int64(-1), last)
-			} else {
-				first, err := idxer.FirstIndexedBlock()
-				require.NoError(t, err)
-				require.Equal(t, tc.block.Height, first)
+			// the block is marked as indexed, whether it has eth txs or not
+			first, err := idxer.FirstIndexedBlock()
+			require.NoError(t, err)
+			require.Equal(t, tc.block.Height, first)
 
-				last, err := idxer.LastIndexedBlock()
-				require.NoError(t, err)
-				require.Equal(t, tc.block.Height, last)
+			last, err := idxer.LastIndexedBlock()
+			require.NoError(t, err)
+			require.Equal(t, tc.block.Height, last)
 
+			if !tc.expSuccess {
+				_, err := idxer.GetByTxHash(txHash)
+				require.Error(t, err)
+			} else {
 				res1, err := idxer.GetByTxHash(txHash)
 				require.NoError(t, err)
 				require.NotNil(t, res1)
@@ -8,6 +8,7 @@ import (
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/stretchr/testify/require"
 
+	evmmempool "github.com/cosmos/evm/mempool"
 	"github.com/cosmos/evm/testutil/integration/evm/network"
 	"github.com/cosmos/evm/testutil/keyring"
 	evmtypes "github.com/cosmos/evm/x/vm/types"
@@ -1279,3 +1280,43 @@ func (s *IntegrationTestSuite) TestNonceGappedEVMTransactions() {
 		})
 	}
 }
+
+// TestMempoolPendingState tests the pending state built from the executable transactions
+func (s *IntegrationTestSuite) TestMempoolPendingState() {
+	mpool, ok := s.network.App.GetMempool().(*evmmempool.ExperimentalEVMMempool)
+	s.Require().True(ok)
+	revision := mpool.Revision()
+
+	sender, recipient := s.keyring.GetKey(0), s.keyring.GetKey(1)
+	for nonce := range 2 {
+		tx := s.createEVMValueTransferTx(sender, nonce, big.NewInt(1000000000))
+		s.Require().NoError(mpool.Insert(s.network.GetContext(), tx))
+	}
+	s.Require().Greater(mpool.Revision(), revision)
+
+	latest, err := s.network.GetEvmClient().Balance(s.network.GetContext(), &evmtypes.QueryBalanceRequest{Address: recipient.Addr.Hex()})
+	s.Require().NoError(err)
+
+	state, err := mpool.PendingState(0, 0)
+	s.Require().NoError(err)
+	s.Require().Equal(mpool.LatestHeight(), state.Height)
+	s.Require().Len(state.Txs, 2)
+	s.Require().Len(state.Results, 2)
+	s.Require().Equal(state.Results[0].GasUsed+state.Results[1].GasUsed, state.GasUsed)
+
+	// the value transfers are applied on top of the latest state
+	pending, err := state.Balance(&evmtypes.QueryBalanceRequest{Address: recipient.Addr.Hex()})
+	s.Require().NoError(err)
+	latestBalance, ok := new(big.Int).SetString(latest.Balance, 10)
+	s.Require().True(ok)
+	s.Require().Equal(new(big.Int).Add(latestBalance, big.NewInt(2000)).String(), pending.Balance)
+
+	// the number of applied transactions and their gas are bounded
+	state, err = mpool.PendingState(1, 0)
+	s.Require().NoError(err)
+	s.Require().Len(state.Txs, 1)
+
+	state, err = mpool.PendingState(0, TxGas)
+	s.Require().NoError(err)
+	s.Require().Len(state.Txs, 1)
+}
@@ -633,7 +633,7 @@ func (s *TestSuite) TestGetTransactionReceipt() {
 				},
 			},
 			expPass: false,
-			expErr:  fmt.Errorf("block result not found at height 1: some error"),
+			expErr:  fmt.Errorf("block result not found at height 1: failed to fetch block result from CometBFT 1: some error"),
 		},
 		{
 			"happy path",
@@ -1,11 +1,16 @@
 package feemarket
 
 import (
+	"github.com/cosmos/evm/testutil"
 	"github.com/cosmos/evm/testutil/integration/evm/network"
+	"github.com/cosmos/evm/x/feemarket/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
 
+	"cosmossdk.io/math"
 	storetypes "cosmossdk.io/store/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 )
 
 func (s *KeeperTestSuite) TestEndBlock() {
@@ -58,3 +63,280 @@ func (s *KeeperTestSuite) TestEndBlock() {
 		})
 	}
 }
+
+func (s *KeeperTestSuite) TestEndBlockBurnBaseFees() {
+	var (
+		nw  *network.UnitTestNetwork
+		ctx sdk.Context
+	)
+
+	const (
+		gasUsed    = uint64(100_000)
+		evmGasUsed = uint64(40_000)
+	)
+	feeCollector := authtypes.NewModuleAddress(authtypes.FeeCollectorName)
+	factor := evmtypes.GetEVMCoinDecimals().ConversionFactor()
+
+	testCases := []struct {
+		name         string
+		fraction     math.LegacyDec
+		noBaseFee    bool
+		feeLanes     bool
+		feeCollector math.Int // in the EVM denom
+		expBurned    func(params types.Params, balance math.Int) math.Int
+	}{
+		{
+			"no burn - zero fraction",
+			math.LegacyZeroDec(),
+			false,
+			false,
+			math.NewInt(1_000_000_000_000_000_000),
+			func(types.Params, math.Int) math.Int { return math.ZeroInt() },
+		},
+		{
+			"no burn - nil fraction",
+			math.LegacyDec{},
+			false,
+			false,
+			math.NewInt(1_000_000_000_000_000_000),
+			func(types.Params, math.Int) math.Int { return math.ZeroInt() },
+		},
+		{
+			"no burn - base fee disabled",
+			math.LegacyNewDecWithPrec(5, 1),
+			true,
+			false,
+			math.NewInt(1_000_000_000_000_000_000),
+			func(types.Params, math.Int) math.Int { return math.ZeroInt() },
+		},
+		{
+			"burn half of the base fee revenue",
+			math.LegacyNewDecWithPrec(5, 1),
+			false,
+			false,
+			math.NewInt(1_000_000_000_000_000_000),
+			func(params types.Params, _ math.Int) math.Int {
+				return params.BaseFee.MulInt(factor).MulInt64(int64(gasUsed)).QuoInt64(2).TruncateInt()
+			},
+		},
+		{
+			"burn half of the base fee revenue of each fee lane",
+			math.LegacyNewDecWithPrec(5, 1),
+			false,
+			true,
+			math.NewInt(1_000_000_000_000_000_000),
+			func(params types.Params, _ math.Int) math.Int {
+				evmRevenue := params.BaseFee.MulInt64(int64(evmGasUsed))
+				cosmosRevenue := params.CosmosBaseFee.MulInt64(int64(gasUsed - evmGasUsed))
+				return evmRevenue.Add(cosmosRevenue).MulInt(factor).QuoInt64(2).TruncateInt()
+			},
+		},
+		{
+			"burn capped to the fee collector balance",
+			math.LegacyOneDec(),
+			false,
+			false,
+			math.NewInt(1),
+			func(_ types.Params, balance math.Int) math.Int { return balance },
+		},
+	}
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			nw = network.NewUnitTestNetwork(s.create, s.options...)
+			ctx = nw.GetContext()
+			fmk := nw.App.GetFeeMarketKeeper()
+			pbk := nw.App.GetPreciseBankKeeper()
+
+			params := fmk.GetParams(ctx)
+			params.NoBaseFee = tc.noBaseFee
+			params.BaseFeeBurnFraction = tc.fraction
+			params.EnableFeeLanes = tc.feeLanes
+			params.CosmosBaseFee = params.BaseFee.MulInt64(2)
+			s.Require().NoError(fmk.SetParams(ctx, params))
+			fmk.AddEVMLaneGas(ctx, evmGasUsed, evmGasUsed)
+
+			coins := sdk.NewCoins(sdk.NewCoin(evmtypes.GetEVMCoinDenom(), tc.feeCollector))
+			s.Require().NoError(testutil.FundModuleAccount(ctx, nw.App.GetBankKeeper(), authtypes.FeeCollectorName, coins))
+
+			extendedDenom := evmtypes.GetEVMCoinExtendedDenom()
+			balanceBefore := pbk.GetBalance(ctx, feeCollector, extendedDenom).Amount
+			supplyBefore := pbk.GetSupply(ctx, extendedDenom).Amount
+
+			meter := storetypes.NewGasMeter(uint64(1_000_000_000))
+			ctx = ctx.WithBlockGasMeter(meter).WithBlockGasWanted(gasUsed).WithBlockGasUsed(gasUsed)
+
+			s.Require().NoError(fmk.EndBlock(ctx))
+
+			expBurned := tc.expBurned(params, balanceBefore)
+			s.Require().Equal(expBurned.String(), fmk.GetTotalBurnedFees(ctx).String())
+			s.Require().Equal(balanceBefore.Sub(expBurned).String(), pbk.GetBalance(ctx, feeCollector, extendedDenom).Amount.String())
+			s.Require().Equal(supplyBefore.Sub(expBurned).String(), pbk.GetSupply(ctx, extendedDenom).Amount.String())
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestEndBlockBurnBaseFeesFeeTokens() {
+	const (
+		gasUsed    = uint64(100_000)
+		evmGasUsed = uint64(40_000)
+		// gas of the txs paying their fees in a fee token
+		evmFeeTokenGas    = uint64(15_000)
+		cosmosFeeTokenGas = uint64(20_000)
+	)
+	factor := evmtypes.GetEVMCoinDecimals().ConversionFactor()
+
+	testCases := []struct {
+		name      string
+		feeLanes  bool
+		expBurned func(params types.Params) math.Int
+	}{
+		{
+			"fee lanes disabled - the fee token gas is left out of the block gas",
+			false,
+			func(params types.Params) math.Int {
+				return params.BaseFee.MulInt64(int64(gasUsed - evmFeeTokenGas - cosmosFeeTokenGas)).MulInt(factor).TruncateInt()
+			},
+		},
+		{
+			"fee lanes enabled - the fee token gas is left out of its fee lane",
+			true,
+			func(params types.Params) math.Int {
+				evmRevenue := params.BaseFee.MulInt64(int64(evmGasUsed - evmFeeTokenGas))
+				cosmosRevenue := params.CosmosBaseFee.MulInt64(int64(gasUsed - evmGasUsed - cosmosFeeTokenGas))
+				return evmRevenue.Add(cosmosRevenue).MulInt(factor).TruncateInt()
+			},
+		},
+	}
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			nw := network.NewUnitTestNetwork(s.create, s.options...)
+			ctx := nw.GetContext()
+			fmk := nw.App.GetFeeMarketKeeper()
+
+			params := fmk.GetParams(ctx)
+			params.BaseFeeBurnFraction = math.LegacyOneDec()
+			params.EnableFeeLanes = tc.feeLanes
+			params.CosmosBaseFee = params.BaseFee.MulInt64(2)
+			s.Require().NoError(fmk.SetParams(ctx, params))
+
+			coins := sdk.NewCoins(sdk.NewCoin(evmtypes.GetEVMCoinDenom(), math.NewInt(1_000_000_000_000_000_000)))
+			s.Require().NoError(testutil.FundModuleAccount(ctx, nw.App.GetBankKeeper(), authtypes.FeeCollectorName, coins))
+
+			// a block mixing txs paying their fees in the EVM denom and in a
+			// fee token, on both fee lanes
+			txs := []struct {
+				evm      bool
+				gasUsed  uint64
+				feeToken bool
+			}{
+				{true, evmGasUsed - evmFeeTokenGas, false},
+				{true, evmFeeTokenGas, true},
+				{false, cosmosFeeTokenGas, true},
+				{false, gasUsed - evmGasUsed - cosmosFeeTokenGas, false},
+			}
+			for i, tx := range txs {
+				txCtx := ctx.WithTxIndex(i)
+				if tx.evm {
+					fmk.AddEVMLaneGas(txCtx, tx.gasUsed, tx.gasUsed)
+				}
+				if tx.feeToken {
+					// the gas meter holds the gas of the whole tx, the store
+					// gas aside
+					txCtx = txCtx.
+						WithGasMeter(storetypes.NewGasMeter(tx.gasUsed)).
+						WithKVGasConfig(storetypes.GasConfig{}).
+						WithTransientKVGasConfig(storetypes.GasConfig{})
+					fmk.SetFeeTokenGasMeter(txCtx, tx.evm)
+					txCtx.GasMeter().ConsumeGas(tx.gasUsed, "tx")
+				}
+			}
+
+			meter := storetypes.NewGasMeter(uint64(1_000_000_000))
+			ctx = ctx.WithBlockGasMeter(meter).WithBlockGasWanted(gasUsed).WithBlockGasUsed(gasUsed)
+			s.Require().NoError(fmk.EndBlock(ctx))
+			s.Require().Equal(tc.expBurned(params).String(), fmk.GetTotalBurnedFees(ctx).String())
+
+			// the gas of the block is reset, so all the gas of the next block is
+			// burned at the Cosmos base fee
+			burned := fmk.GetTotalBurnedFees(ctx)
+			s.Require().NoError(fmk.EndBlock(ctx))
+			expBurned := fmk.GetCosmosBaseFee(ctx).MulInt64(int64(gasUsed)).MulInt(factor).TruncateInt()
+			s.Require().Equal(burned.Add(expBurned).String(), fmk.GetTotalBurnedFees(ctx).String())
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestEndBlockFeeLanes() {
+	testCases := []struct {
+		name           string
+		enableFeeLanes bool
+		expEVMGas      uint64
+		expCosmosGas   uint64
+	}{
+		{
+			"fee lanes disabled - no lane gas",
+			false,
+			0,
+			0,
+		},
+		{
+			// EVM lane: max(1000 * 0.5, 800), Cosmos lane: max(4000 * 0.5, 1000)
+			"fee lanes enabled - the Cosmos lane gets the block gas not accounted to EVM",
+			true,
+			800,
+			2000,
+		},
+	}
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			nw := network.NewUnitTestNetwork(s.create, s.options...)
+			ctx := nw.GetContext()
+			fmk := nw.App.GetFeeMarketKeeper()
+
+			params := fmk.GetParams(ctx)
+			params.EnableFeeLanes = tc.enableFeeLanes
+			s.Require().NoError(fmk.SetParams(ctx, params))
+
+			fmk.AddEVMLaneGas(ctx, 600, 500)
+			fmk.AddEVMLaneGas(ctx, 400, 300)
+
+			meter := storetypes.NewGasMeter(uint64(1_000_000_000))
+			ctx = ctx.WithBlockGasMeter(meter).WithBlockGasWanted(5000).WithBlockGasUsed(1800)
+			s.Require().NoError(fmk.EndBlock(ctx))
+
+			s.Require().Equal(tc.expEVMGas, fmk.GetEVMLaneGas(ctx))
+			s.Require().Equal(tc.expCosmosGas, fmk.GetCosmosLaneGas(ctx))
+
+			// the EVM gas of the block is reset, so an empty block attributes
+			// no gas to the EVM lane
+			ctx = ctx.WithBlockGasWanted(0).WithBlockGasUsed(0)
+			s.Require().NoError(fmk.EndBlock(ctx))
+			s.Require().Zero(fmk.GetEVMLaneGas(ctx))
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestBeginBlockFeeLanes() {
+	nw := network.NewUnitTestNetwork(s.create, s.options...)
+	ctx := nw.GetContext()
+	fmk := nw.App.GetFeeMarketKeeper()
+
+	params := fmk.GetParams(ctx)
+	s.Require().Equal(params.BaseFee, fmk.GetCosmosBaseFee(ctx))
+
+	params.EnableFeeLanes = true
+	params.CosmosBaseFee = math.LegacyNewDec(2_000_000_000)
+	params.MinGasPrice = math.LegacyZeroDec()
+	s.Require().NoError(fmk.SetParams(ctx, params))
+
+	// the Cosmos lane was at its target, the EVM lane was empty
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	consParams := ctx.ConsensusParams()
+	blockGasLimit := uint64(consParams.Block.MaxGas) //nolint:gosec // test only
+	fmk.SetLaneGas(ctx, 0, params.CosmosLaneGasLimit(blockGasLimit)/uint64(params.CosmosElasticityMultiplier))
+
+	s.Require().NoError(fmk.BeginBlock(ctx))
+
+	s.Require().True(fmk.GetBaseFee(ctx).LT(params.BaseFee))
+	s.Require().Equal(params.CosmosBaseFee, fmk.GetCosmosBaseFee(ctx))
+}
@@ -464,3 +464,88 @@ func (s *KeeperTestSuite) TestCalculateBaseFeeEdgeCases() {
 		})
 	}
 }
+
+func (s *KeeperTestSuite) TestCalculateBaseFeeFeeLanes() {
+	var (
+		nw             *network.UnitTestNetwork
+		ctx            sdk.Context
+		initialBaseFee math.LegacyDec
+	)
+
+	testCases := []struct {
+		name           string
+		enableFeeLanes bool
+		evmLaneGas     uint64
+		cosmosLaneGas  uint64
+		expBaseFee     func() math.LegacyDec
+		expCosmosFee   func() math.LegacyDec
+	}{
+		{
+			"fee lanes disabled - no cosmos base fee",
+			false,
+			50,
+			0,
+			func() math.LegacyDec { return initialBaseFee },
+			func() math.LegacyDec { return math.LegacyDec{} },
+		},
+		{
+			"fee lanes enabled - both lanes at their target",
+			true,
+			25,
+			25,
+			func() math.LegacyDec { return initialBaseFee },
+			func() math.LegacyDec { return initialBaseFee },
+		},
+		{
+			"fee lanes enabled - full EVM lane and empty Cosmos lane",
+			true,
+			50,
+			0,
+			func() math.LegacyDec { return initialBaseFee.Add(initialBaseFee.QuoInt64(8)) },
+			func() math.LegacyDec { return initialBaseFee.Sub(initialBaseFee.QuoInt64(8)) },
+		},
+		{
+			"fee lanes enabled - empty EVM lane and full Cosmos lane",
+			true,
+			0,
+			50,
+			func() math.LegacyDec { return initialBaseFee.Sub(initialBaseFee.QuoInt64(8)) },
+			func() math.LegacyDec { return initialBaseFee.Add(initialBaseFee.QuoInt64(8)) },
+		},
+	}
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			// reset network and context
+			nw = network.NewUnitTestNetwork(s.create, s.options...)
+			ctx = nw.GetContext()
+			fmk := nw.App.GetFeeMarketKeeper()
+
+			params := fmk.GetParams(ctx)
+			params.MinGasPrice = math.LegacyZeroDec()
+			params.EnableFeeLanes = tc.enableFeeLanes
+			params.CosmosBaseFee = params.BaseFee
+			err := fmk.SetParams(ctx, params)
+			s.NoError(err)
+
+			initialBaseFee = params.BaseFee
+
+			ctx = ctx.WithBlockHeight(1)
+
+			// the block gas wanted is only used when fee lanes are disabled,
+			// where the block is at its target
+			fmk.SetBlockGasWanted(ctx, 50)
+			fmk.SetLaneGas(ctx, tc.evmLaneGas, tc.cosmosLaneGas)
+
+			// each lane gets half of the block gas limit, with a target of 25
+			blockParams := tmproto.BlockParams{
+				MaxGas:   100,
+				MaxBytes: 10,
+			}
+			consParams := tmproto.ConsensusParams{Block: &blockParams}
+			ctx = ctx.WithConsensusParams(consParams)
+
+			s.Equal(tc.expBaseFee(), fmk.CalculateBaseFee(ctx))
+			s.Equal(tc.expCosmosFee(), fmk.CalculateCosmosBaseFee(ctx))
+		})
+	}
+}
@@ -2,6 +2,7 @@ package vm
 
 import (
 	"github.com/cosmos/evm/testutil/config"
+	"github.com/cosmos/evm/x/vm/keeper"
 	"github.com/cosmos/evm/x/vm/types"
 )
 
@@ -86,6 +87,29 @@ func (s *KeeperTestSuite) TestParams() {
 			},
 			expected: true,
 		},
+		{
+			name: "success - Authz limits are updated when setting params",
+			paramsFun: func() interface{} {
+				params := defaultChainEVMParams
+				params.AuthzLimits = types.AuthzLimits{MaxInnerMsgs: 5}
+				err := s.Network.App.GetEVMKeeper().SetParams(s.Network.GetContext(), params)
+				s.Require().NoError(err, "expected no error when setting params")
+				s.Require().Equal(params.AuthzLimits, s.Network.App.GetEVMKeeper().GetAuthzLimits(s.Network.GetContext()))
+
+				// the cached limits are replaced by the updated ones
+				params.AuthzLimits = types.AuthzLimits{
+					DisabledMsgTypes: []string{"/cosmos.bank.v1beta1.MsgSend"},
+					MaxNestingDepth:  3,
+				}
+				err = s.Network.App.GetEVMKeeper().SetParams(s.Network.GetContext(), params)
+				s.Require().NoError(err, "expected no error when setting params")
+				return params.AuthzLimits
+			},
+			getFun: func() interface{} {
+				return s.Network.App.GetEVMKeeper().GetAuthzLimits(s.Network.GetContext())
+			},
+			expected: true,
+		},
 	}
 	for _, tc := range testCases {
 		s.Run(tc.name, func() {
@@ -95,3 +119,23 @@ func (s *KeeperTestSuite) TestParams() {
 		})
 	}
 }
+
+func (s *KeeperTestSuite) TestMigrate1to2() {
+	ctx := s.Network.GetContext()
+	evmKeeper := s.Network.App.GetEVMKeeper()
+
+	// the params stored before version 2 have no authz limits
+	params := evmKeeper.GetParams(ctx)
+	params.AuthzLimits = types.AuthzLimits{}
+	s.Require().NoError(evmKeeper.SetParams(ctx, params))
+
+	s.Require().NoError(keeper.NewMigrator(evmKeeper).Migrate1to2(ctx))
+
+	migrated := evmKeeper.GetParams(ctx)
+	s.Require().Equal(types.DefaultAuthzLimits, migrated.AuthzLimits)
+	s.Require().True(evmKeeper.GetAuthzLimits(ctx).IsDisabledMsg("/cosmos.vesting.v1beta1.MsgCreateVestingAccount"))
+
+	// the other params are kept
+	params.AuthzLimits = types.DefaultAuthzLimits
+	s.Require().Equal(params, migrated)
+}
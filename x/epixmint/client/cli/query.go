@@ -26,6 +26,7 @@ func GetQueryCmd() *cobra.Command {
 		GetCurrentSupplyCmd(),
 		GetMaxSupplyCmd(),
 		GetSupplyOfCmd(),
+		GetNetIssuanceCmd(),
 	)
 	return cmd
 }
@@ -200,3 +201,31 @@ func GetSupplyOfCmd() *cobra.Command {
 
 	return cmd
 }
+
+// GetNetIssuanceCmd returns the command for querying the net issuance.
+func GetNetIssuanceCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "net-issuance",
+		Short: "Query the tokens minted net of the base fees burned by the fee market, since the tracking was activated",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.NetIssuance(cmd.Context(), &types.QueryNetIssuanceRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -24,6 +24,10 @@ func InitGenesis(ctx sdk.Context, keeper keeper.Keeper, genState *types.GenesisS
 	if err := keeper.SetParams(ctx, genState.Params); err != nil {
 		panic(err)
 	}
+
+	if !genState.TotalMinted.IsNil() {
+		keeper.SetTotalMinted(ctx, genState.TotalMinted)
+	}
 }
 
 // ExportGenesis returns the epixmint module's exported genesis.
@@ -31,6 +35,7 @@ func ExportGenesis(ctx sdk.Context, keeper keeper.Keeper) *types.GenesisState {
 	params := keeper.GetParams(ctx)
 
 	return &types.GenesisState{
-		Params: params,
+		Params:      params,
+		TotalMinted: keeper.GetTotalMinted(ctx),
 	}
 }
@@ -58,6 +58,7 @@ func (k Keeper) MintCoins(ctx context.Context) error {
 	if err != nil {
 		return err
 	}
+	k.SetTotalMinted(ctx, k.GetTotalMinted(ctx).Add(tokensPerBlock))
 
 	// Distribute minted tokens according to configured rates
 	err = k.distributeMintedTokens(ctx, mintedCoins, params)
@@ -130,3 +130,21 @@ func (k Keeper) SupplyOf(c context.Context, req *types.QuerySupplyOfRequest) (*t
 		return nil, status.Errorf(codes.InvalidArgument, "unsupported denomination: %s. Supported denominations are 'aepix' and 'epix'", req.Denom)
 	}
 }
+
+// NetIssuance returns the tokens minted by the module net of the base fees burned by the fee market.
+// Both totals are denominated in the mint denomination (aepix), which is also the extended EVM denom,
+// and counted since the tracking was activated, so the net issuance is the issuance since then.
+func (k Keeper) NetIssuance(c context.Context, req *types.QueryNetIssuanceRequest) (*types.QueryNetIssuanceResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	totalMinted := k.GetTotalMinted(c)
+	totalBurned := k.GetTotalBurned(c)
+
+	return &types.QueryNetIssuanceResponse{
+		TotalMinted: totalMinted,
+		TotalBurned: totalBurned,
+		NetIssuance: totalMinted.Sub(totalBurned),
+	}, nil
+}
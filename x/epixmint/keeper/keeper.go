@@ -21,6 +21,7 @@ type Keeper struct {
 	accountKeeper      types.AccountKeeper
 	distributionKeeper types.DistributionKeeper
 	stakingKeeper      types.StakingKeeper
+	feeMarketKeeper    types.FeeMarketKeeper
 
 	// the address capable of executing a MsgUpdateParams message. Typically, this
 	// should be the x/gov module account.
@@ -35,6 +36,7 @@ func NewKeeper(
 	accountKeeper types.AccountKeeper,
 	distributionKeeper types.DistributionKeeper,
 	stakingKeeper types.StakingKeeper,
+	feeMarketKeeper types.FeeMarketKeeper,
 	authority string,
 ) Keeper {
 	return Keeper{
@@ -44,6 +46,7 @@ func NewKeeper(
 		accountKeeper:      accountKeeper,
 		distributionKeeper: distributionKeeper,
 		stakingKeeper:      stakingKeeper,
+		feeMarketKeeper:    feeMarketKeeper,
 		authority:          authority,
 	}
 }
@@ -83,6 +86,41 @@ func (k Keeper) SetParams(ctx context.Context, params types.Params) error {
 	return nil
 }
 
+// GetTotalMinted returns the cumulative amount of the mint denomination minted by the module.
+func (k Keeper) GetTotalMinted(ctx context.Context) math.Int {
+	store := k.storeService(ctx)
+	bz := store.Get(types.TotalMintedKey)
+	if bz == nil {
+		return math.ZeroInt()
+	}
+
+	var amount math.Int
+	if err := amount.Unmarshal(bz); err != nil {
+		panic(err)
+	}
+	return amount
+}
+
+// SetTotalMinted sets the cumulative amount of the mint denomination minted by the module.
+func (k Keeper) SetTotalMinted(ctx context.Context, amount math.Int) {
+	bz, err := amount.Marshal()
+	if err != nil {
+		panic(err)
+	}
+
+	store := k.storeService(ctx)
+	store.Set(types.TotalMintedKey, bz)
+}
+
+// GetTotalBurned returns the cumulative amount of base fees burned by the fee market,
+// or zero if no fee market keeper is set.
+func (k Keeper) GetTotalBurned(ctx context.Context) math.Int {
+	if k.feeMarketKeeper == nil {
+		return math.ZeroInt()
+	}
+	return k.feeMarketKeeper.GetTotalBurnedFees(sdk.UnwrapSDKContext(ctx))
+}
+
 // storeService returns the store service for the epixmint module.
 func (k Keeper) storeService(ctx context.Context) storetypes.KVStore {
 	return sdk.UnwrapSDKContext(ctx).KVStore(k.storeKey)
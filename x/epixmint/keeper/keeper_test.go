@@ -24,11 +24,12 @@ import (
 type KeeperTestSuite struct {
 	suite.Suite
 
-	ctx           sdk.Context
-	keeper        keeper.Keeper
-	bankKeeper    *MockBankKeeper
-	accountKeeper *MockAccountKeeper
-	cdc           codec.BinaryCodec
+	ctx             sdk.Context
+	keeper          keeper.Keeper
+	bankKeeper      *MockBankKeeper
+	accountKeeper   *MockAccountKeeper
+	feeMarketKeeper *MockFeeMarketKeeper
+	cdc             codec.BinaryCodec
 }
 
 func TestKeeperTestSuite(t *testing.T) {
@@ -44,6 +45,7 @@ func (s *KeeperTestSuite) SetupTest() {
 	s.cdc = encCfg.Codec
 	s.bankKeeper = &MockBankKeeper{}
 	s.accountKeeper = &MockAccountKeeper{}
+	s.feeMarketKeeper = &MockFeeMarketKeeper{}
 
 	s.keeper = keeper.NewKeeper(
 		s.cdc,
@@ -52,6 +54,7 @@ func (s *KeeperTestSuite) SetupTest() {
 		s.accountKeeper,
 		&MockDistributionKeeper{}, // Add mock distribution keeper
 		&MockStakingKeeper{},      // Add mock staking keeper
+		s.feeMarketKeeper,
 		authtypes.NewModuleAddress("gov").String(),
 	)
 }
@@ -133,6 +136,48 @@ func (s *KeeperTestSuite) TestMintCoins_NearMaxSupply() {
 	s.Require().Equal(expectedMintAmount, s.bankKeeper.LastMintedAmount)
 }
 
+func (s *KeeperTestSuite) TestMintCoins_TracksTotalMinted() {
+	params := types.DefaultParams()
+	err := s.keeper.SetParams(s.ctx, params)
+	s.Require().NoError(err)
+
+	currentSupply, _ := math.NewIntFromString("1000000000000000000000000000") // 1B EPIX in aepix
+	s.bankKeeper.SetSupply(params.MintDenom, currentSupply)
+	s.keeper.SetTotalMinted(s.ctx, math.NewInt(100))
+
+	err = s.keeper.MintCoins(s.ctx)
+	s.Require().NoError(err)
+
+	s.Require().Equal(math.NewInt(100).Add(s.bankKeeper.LastMintedAmount), s.keeper.GetTotalMinted(s.ctx))
+}
+
+func (s *KeeperTestSuite) TestNetIssuance() {
+	s.keeper.SetTotalMinted(s.ctx, math.NewInt(1000))
+
+	// no burned fees
+	res, err := s.keeper.NetIssuance(s.ctx, &types.QueryNetIssuanceRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal(math.NewInt(1000), res.TotalMinted)
+	s.Require().Equal(math.ZeroInt(), res.TotalBurned)
+	s.Require().Equal(math.NewInt(1000), res.NetIssuance)
+
+	// more minted than burned
+	s.feeMarketKeeper.totalBurnedFees = math.NewInt(400)
+	res, err = s.keeper.NetIssuance(s.ctx, &types.QueryNetIssuanceRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal(math.NewInt(400), res.TotalBurned)
+	s.Require().Equal(math.NewInt(600), res.NetIssuance)
+
+	// more burned than minted
+	s.feeMarketKeeper.totalBurnedFees = math.NewInt(1500)
+	res, err = s.keeper.NetIssuance(s.ctx, &types.QueryNetIssuanceRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal(math.NewInt(-500), res.NetIssuance)
+
+	_, err = s.keeper.NetIssuance(s.ctx, nil)
+	s.Require().Error(err)
+}
+
 // Mock implementations
 type MockBankKeeper struct {
 	supply           map[string]math.Int
@@ -199,3 +244,15 @@ func (m *MockStakingKeeper) GetAllValidators(ctx context.Context) (validators []
 func (m *MockStakingKeeper) BondedRatio(ctx context.Context) (ratio math.LegacyDec, err error) {
 	return math.LegacyNewDec(1), nil
 }
+
+// MockFeeMarketKeeper implements the FeeMarketKeeper interface for testing
+type MockFeeMarketKeeper struct {
+	totalBurnedFees math.Int
+}
+
+func (m *MockFeeMarketKeeper) GetTotalBurnedFees(ctx sdk.Context) math.Int {
+	if m.totalBurnedFees.IsNil() {
+		return math.ZeroInt()
+	}
+	return m.totalBurnedFees
+}
@@ -2,12 +2,15 @@ package types
 
 import (
 	"fmt"
+
+	"cosmossdk.io/math"
 )
 
 // NewGenesisState creates a new genesis state.
 func NewGenesisState(params Params) *GenesisState {
 	return &GenesisState{
-		Params: params,
+		Params:      params,
+		TotalMinted: math.ZeroInt(),
 	}
 }
 
@@ -23,5 +26,9 @@ func (gs *GenesisState) Validate() error {
 		return fmt.Errorf("invalid params: %w", err)
 	}
 
+	if !gs.TotalMinted.IsNil() && gs.TotalMinted.IsNegative() {
+		return fmt.Errorf("total minted cannot be negative: %s", gs.TotalMinted)
+	}
+
 	return nil
 }
@@ -29,6 +29,8 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type GenesisState struct {
 	// params defines all the parameters of the module.
 	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	// total_minted is the cumulative amount of the mint denomination minted by the module.
+	TotalMinted cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=total_minted,json=totalMinted,proto3,customtype=cosmossdk.io/math.Int" json:"total_minted"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -146,39 +148,40 @@ func init() {
 func init() { proto.RegisterFile("epixmint/v1/genesis.proto", fileDescriptor_703b4a855aa1ce6e) }
 
 var fileDescriptor_703b4a855aa1ce6e = []byte{
-	// 507 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x93, 0x3f, 0x6f, 0xd3, 0x40,
-	0x18, 0xc6, 0x6d, 0x48, 0x43, 0x73, 0x65, 0x00, 0xa7, 0x15, 0x6e, 0x50, 0x9d, 0xaa, 0x03, 0xaa,
-	0x04, 0xd8, 0x0a, 0x6c, 0x4c, 0x24, 0x8a, 0x84, 0x8a, 0x40, 0xaa, 0x1c, 0xc4, 0xc0, 0x72, 0xba,
-	0xd8, 0x6f, 0x9d, 0x53, 0xee, 0x8f, 0xe5, 0x3b, 0x87, 0xe4, 0x5b, 0x30, 0x32, 0xf6, 0x43, 0xf4,
-	0x43, 0x74, 0xac, 0x98, 0x10, 0x43, 0x85, 0x92, 0x85, 0x8f, 0x81, 0x7c, 0xe7, 0x44, 0x95, 0x3a,
-	0xb5, 0x9b, 0xfd, 0x3e, 0x8f, 0x7f, 0x8f, 0x9f, 0x57, 0x7a, 0xd1, 0x3e, 0xe4, 0x74, 0xce, 0xa9,
-	0xd0, 0xd1, 0xac, 0x17, 0x65, 0x20, 0x40, 0x51, 0x15, 0xe6, 0x85, 0xd4, 0xd2, 0xdb, 0x59, 0x4b,
-	0xe1, 0xac, 0xd7, 0xd9, 0x4f, 0xa4, 0xe2, 0x52, 0x61, 0x23, 0x45, 0xf6, 0xc5, 0xfa, 0x3a, 0xbb,
-	0x99, 0xcc, 0xa4, 0x9d, 0x57, 0x4f, 0x76, 0x7a, 0xd4, 0x47, 0x8f, 0x3f, 0x58, 0xdc, 0x48, 0x13,
-	0x0d, 0x5e, 0x0f, 0x35, 0x73, 0x52, 0x10, 0xae, 0x7c, 0xf7, 0xd0, 0x3d, 0xde, 0x79, 0xd3, 0x0e,
-	0x6f, 0xe0, 0xc3, 0x53, 0x23, 0x0d, 0x1a, 0x97, 0xd7, 0x5d, 0x27, 0xae, 0x8d, 0x47, 0x17, 0x5b,
-	0xa8, 0x69, 0x05, 0xef, 0x00, 0xa1, 0xca, 0x8a, 0x53, 0x10, 0x92, 0x1b, 0x42, 0x2b, 0x6e, 0x55,
-	0x93, 0x61, 0x35, 0xf0, 0x26, 0xa8, 0x43, 0x05, 0xd5, 0x94, 0x30, 0x4c, 0x84, 0x28, 0x09, 0xc3,
-	0xc6, 0x4d, 0xb8, 0x2c, 0x85, 0xf6, 0x1f, 0x54, 0xf6, 0xc1, 0xcb, 0x8a, 0xfd, 0xe7, 0xba, 0xbb,
-	0x67, 0x7f, 0x5e, 0xa5, 0xd3, 0x90, 0xca, 0x88, 0x13, 0x3d, 0x09, 0x4f, 0x84, 0xfe, 0x75, 0xf1,
-	0x1a, 0xd5, 0xad, 0x4e, 0x84, 0x8e, 0x9f, 0xd5, 0xb8, 0xbe, 0xa1, 0x7d, 0xa6, 0x42, 0xf7, 0x0d,
-	0xcb, 0x03, 0xb4, 0x57, 0x27, 0x14, 0x90, 0x96, 0x89, 0xa6, 0x52, 0xe0, 0x82, 0x68, 0xf0, 0x1f,
-	0x9a, 0x90, 0x5e, 0x1d, 0xf2, 0xfc, 0x76, 0xc8, 0x27, 0xc8, 0x48, 0xb2, 0x18, 0x42, 0x72, 0x23,
-	0x6a, 0x08, 0x49, 0xdc, 0xb6, 0xbc, 0x78, 0x8d, 0x8b, 0xab, 0x6d, 0xbd, 0x42, 0xde, 0x98, 0xc9,
-	0x64, 0x8a, 0x35, 0xe5, 0x80, 0x15, 0x24, 0x52, 0xa4, 0xca, 0x6f, 0x1c, 0xba, 0xc7, 0x8d, 0xf8,
-	0x89, 0x51, 0xbe, 0x50, 0x0e, 0x23, 0x3b, 0xf7, 0x3e, 0x22, 0xc4, 0xc9, 0x1c, 0xab, 0x32, 0xcf,
-	0xd9, 0xc2, 0xdf, 0xba, 0x7b, 0xdd, 0x16, 0x27, 0xf3, 0x91, 0xf9, 0xda, 0x23, 0xa8, 0x9d, 0x48,
-	0xce, 0x4b, 0x41, 0xf5, 0x02, 0xe7, 0x52, 0x32, 0x5b, 0xaf, 0x79, 0xdf, 0x7a, 0x4f, 0x37, 0xb4,
-	0x53, 0x29, 0x99, 0x29, 0x97, 0xa0, 0x5d, 0xa5, 0xc9, 0x94, 0x8a, 0x0c, 0x17, 0xf0, 0x9d, 0x14,
-	0xa9, 0xb2, 0x19, 0x8f, 0xee, 0x9b, 0xe1, 0xd5, 0xb8, 0xd8, 0xd2, 0x4c, 0x88, 0x40, 0x07, 0x9c,
-	0x0a, 0x3c, 0x23, 0x8c, 0xa6, 0x44, 0xcb, 0x02, 0x2b, 0x60, 0x67, 0x38, 0x05, 0x06, 0x19, 0xa9,
-	0xd6, 0xec, 0x6f, 0xdf, 0x7d, 0x4d, 0x1d, 0x4e, 0xc5, 0xd7, 0x35, 0x70, 0x04, 0xec, 0x6c, 0xb8,
-	0xc1, 0xbd, 0xdb, 0xfe, 0x79, 0xde, 0x75, 0xfe, 0x9d, 0x77, 0xdd, 0xc1, 0xfb, 0xcb, 0x65, 0xe0,
-	0x5e, 0x2d, 0x03, 0xf7, 0xef, 0x32, 0x70, 0x7f, 0xac, 0x02, 0xe7, 0x6a, 0x15, 0x38, 0xbf, 0x57,
-	0x81, 0xf3, 0xed, 0x45, 0x46, 0xf5, 0xa4, 0x1c, 0x87, 0x89, 0xe4, 0xf5, 0x09, 0x45, 0x30, 0xe3,
-	0xd1, 0x3c, 0xda, 0x1c, 0xa1, 0x5e, 0xe4, 0xa0, 0xc6, 0x4d, 0x73, 0x42, 0x6f, 0xff, 0x07, 0x00,
-	0x00, 0xff, 0xff, 0xe9, 0x6d, 0xf3, 0xc6, 0x9d, 0x03, 0x00, 0x00,
+	// 527 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x93, 0x31, 0x6f, 0xd3, 0x40,
+	0x14, 0xc7, 0x6d, 0x48, 0x43, 0x73, 0xe9, 0x00, 0x4e, 0x2b, 0xdc, 0xa0, 0x3a, 0x51, 0x07, 0x14,
+	0x09, 0xb0, 0x15, 0xd8, 0x98, 0x68, 0x14, 0x09, 0x15, 0x01, 0xaa, 0x1c, 0xc4, 0xc0, 0x72, 0xba,
+	0xd8, 0xaf, 0xce, 0x29, 0xbe, 0x3b, 0xcb, 0x77, 0x0e, 0xc9, 0xb7, 0x80, 0x8d, 0xb1, 0x1f, 0xa2,
+	0x1f, 0xa2, 0x63, 0xc5, 0x84, 0x18, 0x2a, 0x94, 0x2c, 0x7c, 0x0c, 0xe4, 0x3b, 0x27, 0xaa, 0xc4,
+	0xd4, 0x6c, 0xf6, 0xff, 0xbd, 0xfb, 0xfd, 0xff, 0xef, 0x49, 0x0f, 0x1d, 0x42, 0x46, 0xe7, 0x8c,
+	0x72, 0x15, 0xcc, 0xfa, 0x41, 0x02, 0x1c, 0x24, 0x95, 0x7e, 0x96, 0x0b, 0x25, 0x9c, 0xe6, 0xba,
+	0xe4, 0xcf, 0xfa, 0xed, 0xc3, 0x48, 0x48, 0x26, 0x24, 0xd6, 0xa5, 0xc0, 0xfc, 0x98, 0xbe, 0xf6,
+	0x7e, 0x22, 0x12, 0x61, 0xf4, 0xf2, 0xcb, 0xa8, 0xc7, 0xdf, 0x6d, 0xb4, 0xf7, 0xd6, 0xf0, 0x46,
+	0x8a, 0x28, 0x70, 0xfa, 0xa8, 0x9e, 0x91, 0x9c, 0x30, 0xe9, 0xda, 0x5d, 0xbb, 0xd7, 0x7c, 0xd9,
+	0xf2, 0x6f, 0xf1, 0xfd, 0x33, 0x5d, 0x1a, 0xd4, 0xae, 0x6e, 0x3a, 0x56, 0x58, 0x35, 0x3a, 0x1f,
+	0xd1, 0x9e, 0x12, 0x8a, 0xa4, 0xb8, 0xec, 0x82, 0xd8, 0xbd, 0xd7, 0xb5, 0x7b, 0x8d, 0xc1, 0xb3,
+	0xb2, 0xe7, 0xf7, 0x4d, 0xe7, 0xc0, 0xa4, 0x90, 0xf1, 0xd4, 0xa7, 0x22, 0x60, 0x44, 0x4d, 0xfc,
+	0x53, 0xae, 0x7e, 0x5e, 0xbe, 0x40, 0x55, 0xbc, 0x53, 0xae, 0xc2, 0xa6, 0x06, 0x7c, 0xd0, 0xef,
+	0x8f, 0x2f, 0x77, 0x50, 0xdd, 0x18, 0x39, 0x47, 0x08, 0x95, 0x50, 0x1c, 0x03, 0x17, 0x4c, 0x27,
+	0x6a, 0x84, 0x8d, 0x52, 0x19, 0x96, 0x82, 0x33, 0x41, 0x6d, 0xca, 0xa9, 0xa2, 0x24, 0xc5, 0x84,
+	0xf3, 0xa2, 0x8a, 0x80, 0x09, 0x13, 0x05, 0x57, 0xdb, 0xe4, 0x78, 0x5c, 0xe1, 0x4e, 0x34, 0xad,
+	0xcc, 0x73, 0xa2, 0x59, 0x0e, 0xa0, 0x83, 0xca, 0x21, 0x87, 0xb8, 0x88, 0x14, 0x15, 0x1c, 0xe7,
+	0x44, 0x81, 0x7b, 0x5f, 0x9b, 0xf4, 0x2b, 0x93, 0x27, 0xff, 0x9b, 0xbc, 0x87, 0x84, 0x44, 0x8b,
+	0x21, 0x44, 0xb7, 0xac, 0x86, 0x10, 0x85, 0x2d, 0xc3, 0x0b, 0xd7, 0xb8, 0xb0, 0xdc, 0xfe, 0x73,
+	0xe4, 0x8c, 0x53, 0x11, 0x4d, 0xb1, 0xa2, 0x0c, 0xb0, 0x84, 0x48, 0xf0, 0x58, 0xba, 0xb5, 0xae,
+	0xdd, 0xab, 0x85, 0x0f, 0x75, 0xe5, 0x13, 0x65, 0x30, 0x32, 0xba, 0xf3, 0x0e, 0x21, 0x46, 0xe6,
+	0x58, 0x16, 0x59, 0x96, 0x2e, 0xdc, 0x9d, 0xbb, 0x8f, 0xdb, 0x60, 0x64, 0x3e, 0xd2, 0xaf, 0x1d,
+	0x82, 0x5a, 0x91, 0x60, 0xac, 0xe0, 0x54, 0x2d, 0x70, 0x26, 0x44, 0x6a, 0xc6, 0xab, 0x6f, 0x3b,
+	0xde, 0xa3, 0x0d, 0xed, 0x4c, 0x88, 0x54, 0x0f, 0x17, 0xa1, 0x7d, 0xa9, 0xc8, 0x94, 0xf2, 0x04,
+	0xe7, 0xf0, 0x95, 0xe4, 0xb1, 0x34, 0x1e, 0x0f, 0xb6, 0xf5, 0x70, 0x2a, 0x5c, 0x68, 0x68, 0xda,
+	0x84, 0xa3, 0x23, 0x46, 0x39, 0x9e, 0x91, 0x94, 0xc6, 0x44, 0x89, 0x1c, 0x4b, 0x48, 0xcf, 0x71,
+	0x0c, 0x29, 0x24, 0xa4, 0x5c, 0xb3, 0xbb, 0x7b, 0xf7, 0x35, 0xb5, 0x19, 0xe5, 0x9f, 0xd7, 0xc0,
+	0x11, 0xa4, 0xe7, 0xc3, 0x0d, 0xee, 0xf5, 0xee, 0x8f, 0x8b, 0x8e, 0xf5, 0xf7, 0xa2, 0x63, 0x0f,
+	0xde, 0x5c, 0x2d, 0x3d, 0xfb, 0x7a, 0xe9, 0xd9, 0x7f, 0x96, 0x9e, 0xfd, 0x6d, 0xe5, 0x59, 0xd7,
+	0x2b, 0xcf, 0xfa, 0xb5, 0xf2, 0xac, 0x2f, 0x4f, 0x13, 0xaa, 0x26, 0xc5, 0xd8, 0x8f, 0x04, 0xab,
+	0x6e, 0x32, 0x80, 0x19, 0x0b, 0xe6, 0xc1, 0xe6, 0xaa, 0xd5, 0x22, 0x03, 0x39, 0xae, 0xeb, 0x9b,
+	0x7c, 0xf5, 0x2f, 0x00, 0x00, 0xff, 0xff, 0x2b, 0x61, 0x93, 0xf2, 0xee, 0x03, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -246,6 +249,16 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.TotalMinted.Size()
+		i -= size
+		if _, err := m.TotalMinted.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
 	{
 		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -373,6 +386,8 @@ func (m *GenesisState) Size() (n int) {
 	_ = l
 	l = m.Params.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	l = m.TotalMinted.Size()
+	n += 1 + l + sovGenesis(uint64(l))
 	return n
 }
 
@@ -472,6 +487,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalMinted", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalMinted.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -49,6 +49,14 @@ func TestGenesisStateValidate(t *testing.T) {
 			}),
 			expError: false,
 		},
+		{
+			name: "negative total minted",
+			genState: &types.GenesisState{
+				Params:      types.DefaultParams(),
+				TotalMinted: math.NewInt(-1),
+			},
+			expError: true,
+		},
 		{
 			name: "invalid params in genesis state",
 			genState: types.NewGenesisState(types.Params{
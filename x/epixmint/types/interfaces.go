@@ -33,3 +33,9 @@ type StakingKeeper interface {
 	GetAllValidators(ctx context.Context) (validators []stakingtypes.Validator, err error)
 	BondedRatio(ctx context.Context) (ratio math.LegacyDec, err error)
 }
+
+// FeeMarketKeeper defines the expected fee market keeper interface, used to
+// report the issuance net of the burned base fees
+type FeeMarketKeeper interface {
+	GetTotalBurnedFees(ctx sdk.Context) math.Int
+}
@@ -12,5 +12,6 @@ const (
 
 // key prefixes for store
 var (
-	ParamsKey = []byte{0x01} // module parameters
+	ParamsKey      = []byte{0x01} // module parameters
+	TotalMintedKey = []byte{0x02} // cumulative amount minted
 )
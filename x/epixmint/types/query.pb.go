@@ -504,6 +504,88 @@ func (m *QuerySupplyOfResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QuerySupplyOfResponse proto.InternalMessageInfo
 
+// QueryNetIssuanceRequest defines the request type for querying the net issuance.
+type QueryNetIssuanceRequest struct {
+}
+
+func (m *QueryNetIssuanceRequest) Reset()         { *m = QueryNetIssuanceRequest{} }
+func (m *QueryNetIssuanceRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryNetIssuanceRequest) ProtoMessage()    {}
+func (*QueryNetIssuanceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_820281408f8aeaeb, []int{12}
+}
+func (m *QueryNetIssuanceRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryNetIssuanceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryNetIssuanceRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryNetIssuanceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryNetIssuanceRequest.Merge(m, src)
+}
+func (m *QueryNetIssuanceRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryNetIssuanceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryNetIssuanceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryNetIssuanceRequest proto.InternalMessageInfo
+
+// QueryNetIssuanceResponse defines the response type for querying the net issuance.
+type QueryNetIssuanceResponse struct {
+	// total_minted is the cumulative amount of the mint denomination minted by the module
+	// since the tracking was activated, not since genesis on the chains that predate it
+	TotalMinted cosmossdk_io_math.Int `protobuf:"bytes,1,opt,name=total_minted,json=totalMinted,proto3,customtype=cosmossdk.io/math.Int" json:"total_minted"`
+	// total_burned is the cumulative amount of base fees burned by the fee market
+	TotalBurned cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=total_burned,json=totalBurned,proto3,customtype=cosmossdk.io/math.Int" json:"total_burned"`
+	// net_issuance is total_minted minus total_burned, i.e. the issuance since the tracking
+	// was activated, negative if more was burned than minted
+	NetIssuance cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=net_issuance,json=netIssuance,proto3,customtype=cosmossdk.io/math.Int" json:"net_issuance"`
+}
+
+func (m *QueryNetIssuanceResponse) Reset()         { *m = QueryNetIssuanceResponse{} }
+func (m *QueryNetIssuanceResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryNetIssuanceResponse) ProtoMessage()    {}
+func (*QueryNetIssuanceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_820281408f8aeaeb, []int{13}
+}
+func (m *QueryNetIssuanceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryNetIssuanceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryNetIssuanceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryNetIssuanceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryNetIssuanceResponse.Merge(m, src)
+}
+func (m *QueryNetIssuanceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryNetIssuanceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryNetIssuanceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryNetIssuanceResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "epixmint.v1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "epixmint.v1.QueryParamsResponse")
@@ -517,55 +599,64 @@ func init() {
 	proto.RegisterType((*QueryMaxSupplyResponse)(nil), "epixmint.v1.QueryMaxSupplyResponse")
 	proto.RegisterType((*QuerySupplyOfRequest)(nil), "epixmint.v1.QuerySupplyOfRequest")
 	proto.RegisterType((*QuerySupplyOfResponse)(nil), "epixmint.v1.QuerySupplyOfResponse")
+	proto.RegisterType((*QueryNetIssuanceRequest)(nil), "epixmint.v1.QueryNetIssuanceRequest")
+	proto.RegisterType((*QueryNetIssuanceResponse)(nil), "epixmint.v1.QueryNetIssuanceResponse")
 }
 
 func init() { proto.RegisterFile("epixmint/v1/query.proto", fileDescriptor_820281408f8aeaeb) }
 
 var fileDescriptor_820281408f8aeaeb = []byte{
-	// 684 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x95, 0x41, 0x4f, 0x13, 0x41,
-	0x18, 0x86, 0xbb, 0x26, 0x34, 0xf6, 0x23, 0x18, 0x18, 0x8a, 0xc8, 0x02, 0x5b, 0x58, 0x10, 0x05,
-	0x71, 0x27, 0xc5, 0xc4, 0xb3, 0x02, 0x17, 0x8c, 0x04, 0xac, 0x17, 0x63, 0x4c, 0xc8, 0xb0, 0x0c,
-	0xcb, 0xc6, 0xee, 0xcc, 0xd2, 0x9d, 0x36, 0xad, 0xe8, 0xc5, 0xab, 0x17, 0x13, 0x13, 0xfd, 0x0b,
-	0x1e, 0x3d, 0xf8, 0x23, 0x38, 0x12, 0xbd, 0x18, 0x0f, 0xc4, 0x80, 0x89, 0x7f, 0xc3, 0x74, 0x66,
-	0xb6, 0xb4, 0xdd, 0x2d, 0x12, 0xbc, 0x34, 0x9d, 0x79, 0xbf, 0xf9, 0xde, 0x67, 0x67, 0xe6, 0xcd,
-	0xc0, 0x28, 0x0d, 0xfd, 0x7a, 0xe0, 0x33, 0x81, 0x6b, 0x45, 0xbc, 0x5f, 0xa5, 0x95, 0x86, 0x13,
-	0x56, 0xb8, 0xe0, 0xa8, 0x3f, 0x16, 0x9c, 0x5a, 0xd1, 0x1c, 0x22, 0x81, 0xcf, 0x38, 0x96, 0xbf,
-	0x4a, 0x37, 0xc7, 0x5c, 0x1e, 0x05, 0x3c, 0xda, 0x92, 0x23, 0xac, 0x06, 0xb1, 0xd4, 0xde, 0xd3,
-	0xa3, 0x8c, 0x46, 0x7e, 0x2c, 0xe5, 0x3d, 0xee, 0x71, 0xb5, 0xa4, 0xf9, 0x4f, 0xcf, 0x4e, 0x78,
-	0x9c, 0x7b, 0x65, 0x8a, 0x49, 0xe8, 0x63, 0xc2, 0x18, 0x17, 0x44, 0xf8, 0x9c, 0xe9, 0x35, 0x76,
-	0x1e, 0xd0, 0x93, 0x26, 0xd8, 0x26, 0xa9, 0x90, 0x20, 0x2a, 0xd1, 0xfd, 0x2a, 0x8d, 0x84, 0xbd,
-	0x0e, 0xc3, 0x1d, 0xb3, 0x51, 0xc8, 0x59, 0x44, 0xd1, 0x7d, 0xc8, 0x86, 0x72, 0xe6, 0x86, 0x31,
-	0x65, 0xdc, 0xee, 0x5f, 0x1a, 0x76, 0xda, 0xbe, 0xc3, 0x51, 0xc5, 0xcb, 0xb9, 0xc3, 0xe3, 0x42,
-	0xe6, 0xf3, 0x9f, 0x2f, 0x0b, 0x46, 0x49, 0x57, 0xdb, 0xa3, 0x30, 0x22, 0xdb, 0xad, 0xb1, 0xdd,
-	0xb2, 0x74, 0x8f, 0x7d, 0x7c, 0xb8, 0xde, 0x2d, 0x68, 0xab, 0x0d, 0xc8, 0xf9, 0xf1, 0xa4, 0x74,
-	0xcb, 0x2d, 0x17, 0x9b, 0x8d, 0x7f, 0x1e, 0x17, 0xc6, 0xd5, 0x7e, 0x44, 0x3b, 0x2f, 0x1d, 0x9f,
-	0xe3, 0x80, 0x88, 0x3d, 0xe7, 0x31, 0xf5, 0x88, 0xdb, 0x58, 0xa5, 0xee, 0xb7, 0xaf, 0x77, 0x41,
-	0x6f, 0xd7, 0x2a, 0x75, 0x4b, 0x67, 0x3d, 0x6c, 0x0b, 0x26, 0xa4, 0xd5, 0x43, 0xc6, 0xaa, 0xa4,
-	0xbc, 0x59, 0xe1, 0x35, 0x3f, 0x6a, 0xee, 0x43, 0x8c, 0xd2, 0x80, 0xc9, 0x1e, 0xba, 0x26, 0x7a,
-	0x06, 0x43, 0x44, 0x6a, 0xcd, 0x53, 0xd1, 0xa2, 0x26, 0xbb, 0xa3, 0xc9, 0x46, 0x92, 0x64, 0x6b,
-	0x4c, 0xb4, 0x31, 0xad, 0x31, 0x51, 0x1a, 0x24, 0x5d, 0x0e, 0xf6, 0x38, 0x8c, 0x49, 0xeb, 0x95,
-	0x6a, 0xa5, 0x42, 0x99, 0x78, 0x5a, 0x0d, 0xc3, 0x72, 0x23, 0xe6, 0x0a, 0xc1, 0x4c, 0x13, 0x35,
-	0x54, 0x09, 0xae, 0xb9, 0x4a, 0xd8, 0x8a, 0xa4, 0x72, 0x19, 0xa2, 0x01, 0xb7, 0xbd, 0x77, 0xeb,
-	0xb4, 0xd6, 0x49, 0xbd, 0x13, 0x65, 0x47, 0x9f, 0x56, 0x9b, 0xa0, 0x31, 0x1e, 0x01, 0x04, 0xa4,
-	0xfe, 0x1f, 0x08, 0xb9, 0x20, 0xee, 0x69, 0x2f, 0x42, 0x5e, 0xba, 0xa8, 0xe1, 0xc6, 0xae, 0x76,
-	0x47, 0x79, 0xe8, 0xdb, 0xa1, 0x8c, 0x07, 0xaa, 0x7d, 0x49, 0x0d, 0xec, 0x17, 0x1a, 0xf6, 0xac,
-	0x5a, 0x23, 0xad, 0x40, 0xf6, 0xf2, 0x38, 0x7a, 0xe9, 0xd2, 0xc7, 0x2c, 0xf4, 0xc9, 0xf6, 0x88,
-	0x41, 0x56, 0xdd, 0x6f, 0x54, 0xe8, 0xb8, 0xf4, 0xc9, 0xf0, 0x98, 0x53, 0xbd, 0x0b, 0x14, 0x9b,
-	0x3d, 0xfd, 0xf6, 0xfb, 0xef, 0x0f, 0x57, 0xc6, 0xd1, 0x18, 0x6e, 0x56, 0x62, 0x9d, 0xe6, 0x6d,
-	0x2a, 0x48, 0x11, 0xab, 0xc8, 0xa0, 0x57, 0x90, 0x6b, 0x85, 0x02, 0xd9, 0xc9, 0x8e, 0xdd, 0x51,
-	0x32, 0x67, 0xce, 0xad, 0xd1, 0xc6, 0xb3, 0xd2, 0xd8, 0x42, 0x13, 0x29, 0xc6, 0xad, 0xa8, 0xa0,
-	0x4f, 0x06, 0x0c, 0x76, 0xc7, 0x00, 0xcd, 0x27, 0xfb, 0xf7, 0x88, 0x92, 0xb9, 0x70, 0x91, 0x52,
-	0x4d, 0xb4, 0x28, 0x89, 0xe6, 0xd0, 0x6c, 0x0a, 0x51, 0x22, 0x6e, 0xe8, 0x9d, 0x01, 0x03, 0x1d,
-	0x41, 0x40, 0x73, 0x49, 0xaf, 0xb4, 0x18, 0x99, 0xb7, 0xfe, 0x59, 0xa7, 0x81, 0xe6, 0x25, 0xd0,
-	0x0c, 0x9a, 0x4e, 0x01, 0xea, 0x8c, 0x1a, 0x3a, 0x80, 0x5c, 0x2b, 0x0a, 0x69, 0x67, 0xd4, 0x1d,
-	0xa0, 0xb4, 0x33, 0x4a, 0x64, 0xc9, 0xbe, 0x29, 0x01, 0x0a, 0x68, 0x32, 0x05, 0xe0, 0x2c, 0x64,
-	0xe8, 0x35, 0x5c, 0x8d, 0xef, 0x3c, 0x9a, 0x4e, 0xf6, 0xed, 0x4a, 0x8f, 0x69, 0x9f, 0x57, 0x72,
-	0x81, 0x4f, 0x57, 0xae, 0xf8, 0x40, 0xa6, 0xee, 0xcd, 0xf2, 0x83, 0xc3, 0x13, 0xcb, 0x38, 0x3a,
-	0xb1, 0x8c, 0x5f, 0x27, 0x96, 0xf1, 0xfe, 0xd4, 0xca, 0x1c, 0x9d, 0x5a, 0x99, 0x1f, 0xa7, 0x56,
-	0xe6, 0xf9, 0x9c, 0xe7, 0x8b, 0xbd, 0xea, 0xb6, 0xe3, 0xf2, 0x40, 0x3f, 0x5c, 0x98, 0xd6, 0x02,
-	0x5c, 0xc7, 0xad, 0x77, 0x4b, 0x34, 0x42, 0x1a, 0x6d, 0x67, 0xe5, 0xfb, 0x73, 0xef, 0x6f, 0x00,
-	0x00, 0x00, 0xff, 0xff, 0x3e, 0x08, 0x66, 0x10, 0x24, 0x07, 0x00, 0x00,
+	// 788 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x96, 0x3d, 0x6f, 0xd3, 0x40,
+	0x18, 0xc7, 0xe3, 0xa2, 0x06, 0x72, 0xa1, 0xa8, 0xbd, 0xa6, 0x34, 0x71, 0x5b, 0xa7, 0x71, 0xdf,
+	0x68, 0x29, 0xb1, 0x52, 0x24, 0x66, 0x48, 0xbb, 0x04, 0xd1, 0x17, 0xc2, 0x82, 0x10, 0x52, 0x74,
+	0x71, 0xae, 0xa9, 0x45, 0x7c, 0xe7, 0xc6, 0xe7, 0x28, 0xa1, 0xb0, 0xc0, 0x06, 0x0b, 0x12, 0x03,
+	0x0b, 0x1f, 0x80, 0x91, 0x81, 0x0f, 0xd1, 0xb1, 0x82, 0x05, 0x31, 0x54, 0xa8, 0x45, 0xe2, 0x6b,
+	0x20, 0x9f, 0xcf, 0x79, 0xb3, 0xfb, 0xa2, 0xb0, 0x44, 0xb9, 0x7b, 0x9e, 0xfb, 0xff, 0x7f, 0x7e,
+	0x4e, 0xfe, 0xcb, 0x60, 0x12, 0x5b, 0x46, 0xd3, 0x34, 0x08, 0xd3, 0x1a, 0x39, 0x6d, 0xdf, 0xc1,
+	0xf5, 0x56, 0xd6, 0xaa, 0x53, 0x46, 0x61, 0xdc, 0x2f, 0x64, 0x1b, 0x39, 0x79, 0x0c, 0x99, 0x06,
+	0xa1, 0x1a, 0xff, 0xf5, 0xea, 0x72, 0x4a, 0xa7, 0xb6, 0x49, 0xed, 0x12, 0x5f, 0x69, 0xde, 0xc2,
+	0x2f, 0x75, 0x6b, 0x56, 0x31, 0xc1, 0xb6, 0xe1, 0x97, 0x12, 0x55, 0x5a, 0xa5, 0xde, 0x11, 0xf7,
+	0x9f, 0xd8, 0x9d, 0xae, 0x52, 0x5a, 0xad, 0x61, 0x0d, 0x59, 0x86, 0x86, 0x08, 0xa1, 0x0c, 0x31,
+	0x83, 0x12, 0x71, 0x46, 0x4d, 0x00, 0xf8, 0xd8, 0x05, 0xdb, 0x41, 0x75, 0x64, 0xda, 0x45, 0xbc,
+	0xef, 0x60, 0x9b, 0xa9, 0x9b, 0x60, 0xbc, 0x67, 0xd7, 0xb6, 0x28, 0xb1, 0x31, 0xbc, 0x07, 0xa2,
+	0x16, 0xdf, 0x49, 0x4a, 0xb3, 0xd2, 0xad, 0xf8, 0xda, 0x78, 0xb6, 0xeb, 0x39, 0xb2, 0x5e, 0x73,
+	0x3e, 0x76, 0x78, 0x9c, 0x8e, 0x7c, 0xf9, 0xfb, 0x75, 0x45, 0x2a, 0x8a, 0x6e, 0x75, 0x12, 0x4c,
+	0x70, 0xb9, 0x02, 0xd9, 0xad, 0x71, 0x77, 0xdf, 0xc7, 0x00, 0x37, 0xfb, 0x0b, 0xc2, 0x6a, 0x1b,
+	0xc4, 0x0c, 0x7f, 0x93, 0xbb, 0xc5, 0xf2, 0x39, 0x57, 0xf8, 0xd7, 0x71, 0x7a, 0xca, 0x9b, 0x87,
+	0x5d, 0x79, 0x91, 0x35, 0xa8, 0x66, 0x22, 0xb6, 0x97, 0x7d, 0x84, 0xab, 0x48, 0x6f, 0x6d, 0x60,
+	0xfd, 0xfb, 0xb7, 0x3b, 0x40, 0x8c, 0x6b, 0x03, 0xeb, 0xc5, 0x8e, 0x86, 0xaa, 0x80, 0x69, 0x6e,
+	0xf5, 0x80, 0x10, 0x07, 0xd5, 0x76, 0xea, 0xb4, 0x61, 0xd8, 0xee, 0x1c, 0x7c, 0x94, 0x16, 0x98,
+	0x39, 0xa3, 0x2e, 0x88, 0x9e, 0x82, 0x31, 0xc4, 0x6b, 0xee, 0xad, 0x88, 0xa2, 0x20, 0xbb, 0x2d,
+	0xc8, 0x26, 0x82, 0x64, 0x05, 0xc2, 0xba, 0x98, 0x0a, 0x84, 0x15, 0x47, 0x51, 0x9f, 0x83, 0x3a,
+	0x05, 0x52, 0xdc, 0x7a, 0xdd, 0xa9, 0xd7, 0x31, 0x61, 0x4f, 0x1c, 0xcb, 0xaa, 0xb5, 0x7c, 0x2e,
+	0x0b, 0xc8, 0x61, 0x45, 0x01, 0x55, 0x04, 0x37, 0x74, 0xaf, 0x50, 0xb2, 0x79, 0x65, 0x10, 0xa2,
+	0x11, 0xbd, 0x5b, 0xbb, 0x7d, 0x5b, 0x9b, 0xa8, 0xd9, 0x8b, 0x52, 0x11, 0xb7, 0xd5, 0x55, 0x10,
+	0x18, 0x0f, 0x01, 0x30, 0x51, 0xf3, 0x3f, 0x10, 0x62, 0xa6, 0xaf, 0xa9, 0xae, 0x82, 0x04, 0x77,
+	0xf1, 0x96, 0xdb, 0xbb, 0xc2, 0x1d, 0x26, 0xc0, 0x70, 0x05, 0x13, 0x6a, 0x7a, 0xf2, 0x45, 0x6f,
+	0xa1, 0x3e, 0x17, 0xb0, 0x9d, 0x6e, 0x81, 0xb4, 0x0e, 0xa2, 0x83, 0xe3, 0x88, 0xa3, 0x6a, 0x0a,
+	0x4c, 0x72, 0xf5, 0x2d, 0xcc, 0x0a, 0xb6, 0xed, 0x20, 0xa2, 0x63, 0x7f, 0x18, 0xef, 0x86, 0x40,
+	0x32, 0x58, 0x13, 0xe6, 0x5b, 0xe0, 0x3a, 0xa3, 0x0c, 0xd5, 0x4a, 0xee, 0xbb, 0x81, 0x2b, 0x83,
+	0x20, 0xc4, 0xb9, 0xc0, 0x26, 0x3f, 0xdf, 0xd1, 0x2b, 0x3b, 0x75, 0x82, 0x2b, 0xc9, 0xa1, 0x41,
+	0xf5, 0xf2, 0xfc, 0xbc, 0xab, 0x47, 0x30, 0x2b, 0x19, 0x82, 0x3b, 0x79, 0x65, 0x00, 0x3d, 0xd2,
+	0x79, 0xee, 0xb5, 0xcf, 0x57, 0xc1, 0x30, 0x1f, 0x06, 0x24, 0x20, 0xea, 0xe5, 0x00, 0x4c, 0xf7,
+	0x84, 0x43, 0x30, 0x64, 0xe4, 0xd9, 0xb3, 0x1b, 0xbc, 0x31, 0xaa, 0x99, 0x37, 0x3f, 0xfe, 0x7c,
+	0x1c, 0x9a, 0x82, 0x29, 0xcd, 0xed, 0xd4, 0x44, 0xea, 0x95, 0x31, 0x43, 0x39, 0xcd, 0x8b, 0x16,
+	0xf8, 0x12, 0xc4, 0xda, 0xe1, 0x01, 0xd5, 0xa0, 0x62, 0x7f, 0xe4, 0xc8, 0x73, 0xe7, 0xf6, 0x08,
+	0xe3, 0x79, 0x6e, 0xac, 0xc0, 0xe9, 0x10, 0xe3, 0x76, 0xa4, 0xc0, 0x4f, 0x12, 0x18, 0xed, 0x8f,
+	0x0b, 0xb8, 0x1c, 0xd4, 0x3f, 0x23, 0x72, 0xe4, 0x95, 0xcb, 0xb4, 0x0a, 0xa2, 0x55, 0x4e, 0xb4,
+	0x08, 0xe7, 0x43, 0x88, 0x02, 0xb1, 0x04, 0xdf, 0x4b, 0x60, 0xa4, 0x27, 0x30, 0xe0, 0x62, 0xd0,
+	0x2b, 0x2c, 0x6e, 0xe4, 0xa5, 0x0b, 0xfb, 0x04, 0xd0, 0x32, 0x07, 0x9a, 0x83, 0x99, 0x10, 0xa0,
+	0xde, 0x48, 0x82, 0x07, 0x20, 0xd6, 0x8e, 0x8c, 0xb0, 0x3b, 0xea, 0x0f, 0x9a, 0xb0, 0x3b, 0x0a,
+	0x64, 0x8e, 0xba, 0xc0, 0x01, 0xd2, 0x70, 0x26, 0x04, 0xa0, 0x13, 0x46, 0xf0, 0x15, 0xb8, 0xe6,
+	0x67, 0x03, 0xcc, 0x04, 0x75, 0xfb, 0x52, 0x46, 0x56, 0xcf, 0x6b, 0xb9, 0xc4, 0xa3, 0x7b, 0xae,
+	0xda, 0x01, 0x4f, 0xa7, 0xd7, 0xf0, 0xad, 0x04, 0xe2, 0x5d, 0x01, 0x01, 0xe7, 0x83, 0xf2, 0xc1,
+	0x6c, 0x91, 0x17, 0x2e, 0xe8, 0x12, 0x1c, 0x4b, 0x9c, 0x23, 0x03, 0xd3, 0x21, 0x1c, 0xdd, 0xaf,
+	0x77, 0xfe, 0xfe, 0xe1, 0x89, 0x22, 0x1d, 0x9d, 0x28, 0xd2, 0xef, 0x13, 0x45, 0xfa, 0x70, 0xaa,
+	0x44, 0x8e, 0x4e, 0x95, 0xc8, 0xcf, 0x53, 0x25, 0xf2, 0x6c, 0xb1, 0x6a, 0xb0, 0x3d, 0xa7, 0x9c,
+	0xd5, 0xa9, 0x29, 0x3e, 0x33, 0x34, 0xdc, 0x30, 0xb5, 0xa6, 0xd6, 0xfe, 0xca, 0x60, 0x2d, 0x0b,
+	0xdb, 0xe5, 0x28, 0xff, 0x5a, 0xb8, 0xfb, 0x2f, 0x00, 0x00, 0xff, 0xff, 0x91, 0x78, 0x8e, 0x7c,
+	0xd2, 0x08, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -592,6 +683,8 @@ type QueryClient interface {
 	MaxSupply(ctx context.Context, in *QueryMaxSupplyRequest, opts ...grpc.CallOption) (*QueryMaxSupplyResponse, error)
 	// SupplyOf queries the supply of a specific denomination.
 	SupplyOf(ctx context.Context, in *QuerySupplyOfRequest, opts ...grpc.CallOption) (*QuerySupplyOfResponse, error)
+	// NetIssuance queries the tokens minted by the epixmint module net of the base fees burned.
+	NetIssuance(ctx context.Context, in *QueryNetIssuanceRequest, opts ...grpc.CallOption) (*QueryNetIssuanceResponse, error)
 }
 
 type queryClient struct {
@@ -656,6 +749,15 @@ func (c *queryClient) SupplyOf(ctx context.Context, in *QuerySupplyOfRequest, op
 	return out, nil
 }
 
+func (c *queryClient) NetIssuance(ctx context.Context, in *QueryNetIssuanceRequest, opts ...grpc.CallOption) (*QueryNetIssuanceResponse, error) {
+	out := new(QueryNetIssuanceResponse)
+	err := c.cc.Invoke(ctx, "/epixmint.v1.Query/NetIssuance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params queries the parameters of x/epixmint module.
@@ -670,6 +772,8 @@ type QueryServer interface {
 	MaxSupply(context.Context, *QueryMaxSupplyRequest) (*QueryMaxSupplyResponse, error)
 	// SupplyOf queries the supply of a specific denomination.
 	SupplyOf(context.Context, *QuerySupplyOfRequest) (*QuerySupplyOfResponse, error)
+	// NetIssuance queries the tokens minted by the epixmint module net of the base fees burned.
+	NetIssuance(context.Context, *QueryNetIssuanceRequest) (*QueryNetIssuanceResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -694,6 +798,9 @@ func (*UnimplementedQueryServer) MaxSupply(ctx context.Context, req *QueryMaxSup
 func (*UnimplementedQueryServer) SupplyOf(ctx context.Context, req *QuerySupplyOfRequest) (*QuerySupplyOfResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SupplyOf not implemented")
 }
+func (*UnimplementedQueryServer) NetIssuance(ctx context.Context, req *QueryNetIssuanceRequest) (*QueryNetIssuanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NetIssuance not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -807,6 +914,25 @@ func _Query_SupplyOf_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_NetIssuance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryNetIssuanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).NetIssuance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/epixmint.v1.Query/NetIssuance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).NetIssuance(ctx, req.(*QueryNetIssuanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "epixmint.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -835,6 +961,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SupplyOf",
 			Handler:    _Query_SupplyOf_Handler,
 		},
+		{
+			MethodName: "NetIssuance",
+			Handler:    _Query_NetIssuance_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "epixmint/v1/query.proto",
@@ -1183,6 +1313,82 @@ func (m *QuerySupplyOfResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryNetIssuanceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNetIssuanceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNetIssuanceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryNetIssuanceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNetIssuanceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNetIssuanceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.NetIssuance.Size()
+		i -= size
+		if _, err := m.NetIssuance.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.TotalBurned.Size()
+		i -= size
+		if _, err := m.TotalBurned.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.TotalMinted.Size()
+		i -= size
+		if _, err := m.TotalMinted.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -1318,6 +1524,30 @@ func (m *QuerySupplyOfResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryNetIssuanceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryNetIssuanceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TotalMinted.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.TotalBurned.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.NetIssuance.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2159,6 +2389,208 @@ func (m *QuerySupplyOfResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryNetIssuanceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryNetIssuanceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryNetIssuanceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryNetIssuanceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryNetIssuanceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryNetIssuanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalMinted", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalMinted.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalBurned", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalBurned.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NetIssuance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.NetIssuance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -22,7 +22,10 @@ func GetQueryCmd() *cobra.Command {
 	cmd.AddCommand(
 		GetBlockGasCmd(),
 		GetBaseFeeCmd(),
+		GetCosmosBaseFeeCmd(),
 		GetParamsCmd(),
+		GetBurnedFeesCmd(),
+		GetMsgFeeFloorsCmd(),
 	)
 	return cmd
 }
@@ -114,3 +117,90 @@ If the height is not provided, it will use the latest height from context.`,
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetCosmosBaseFeeCmd queries the base fee of the Cosmos lane at a given height
+func GetCosmosBaseFeeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cosmos-base-fee",
+		Short: "Get the base fee of the Cosmos lane at a given block height",
+		Long: `Get the base fee charged to Cosmos transactions at a given block height.
+It is the same as the base fee when fee lanes are disabled.
+If the height is not provided, it will use the latest height from context.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.CosmosBaseFee(cmd.Context(), &types.QueryCosmosBaseFeeRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetBurnedFeesCmd queries the cumulative amount of burned base fees
+func GetBurnedFeesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "burned-fees",
+		Short: "Get the cumulative amount of burned base fees",
+		Long:  "Get the cumulative amount of base fee revenue burned, in the extended EVM denom.",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.BurnedFees(cmd.Context(), &types.QueryBurnedFeesRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetMsgFeeFloorsCmd queries the fee floors of the message types
+func GetMsgFeeFloorsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "msg-fee-floors [msg-type-url]...",
+		Short: "Get the fee floors of the message types",
+		Long: `Get the fee floors of the cosmos transactions including the given message types,
+or of all the message types if none is provided.`,
+		Example: "msg-fee-floors /cosmos.bank.v1beta1.MsgSend /cosmos.gov.v1.MsgVote",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.MsgFeeFloors(cmd.Context(), &types.QueryMsgFeeFloorsRequest{MsgTypeUrls: args})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
@@ -24,6 +24,12 @@ func (k *Keeper) BeginBlock(ctx sdk.Context) error {
 
 	k.SetBaseFee(ctx, baseFee)
 
+	attrs := []sdk.Attribute{sdk.NewAttribute(types.AttributeKeyBaseFee, baseFee.String())}
+	if cosmosBaseFee := k.CalculateCosmosBaseFee(ctx); !cosmosBaseFee.IsNil() {
+		k.SetCosmosBaseFee(ctx, cosmosBaseFee)
+		attrs = append(attrs, sdk.NewAttribute(types.AttributeKeyCosmosBaseFee, cosmosBaseFee.String()))
+	}
+
 	defer func() {
 		floatBaseFee, err := baseFee.Float64()
 		if err != nil {
@@ -36,16 +42,14 @@ func (k *Keeper) BeginBlock(ctx sdk.Context) error {
 
 	// Store current base fee in event
 	ctx.EventManager().EmitEvents(sdk.Events{
-		sdk.NewEvent(
-			types.EventTypeFeeMarket,
-			sdk.NewAttribute(types.AttributeKeyBaseFee, baseFee.String()),
-		),
+		sdk.NewEvent(types.EventTypeFeeMarket, attrs...),
 	})
 
 	return nil
 }
 
-// EndBlock update block gas wanted.
+// EndBlock update block gas wanted and burns the configured fraction of the
+// base fee revenue of the block.
 // The EVM end block logic doesn't update the validator set, thus it returns
 // an empty slice.
 func (k *Keeper) EndBlock(ctx sdk.Context) error {
@@ -70,15 +74,21 @@ func (k *Keeper) EndBlock(ctx sdk.Context) error {
 		return err
 	}
 
-	// to prevent BaseFee manipulation we limit the gasWanted so that
-	// gasWanted = max(gasWanted * MinGasMultiplier, gasUsed)
-	// this will be keep BaseFee protected from un-penalized manipulation
-	// more info here https://github.com/evmos/ethermint/pull/1105#discussion_r888798925
-	minGasMultiplier := k.GetParams(ctx).MinGasMultiplier
-	limitedGasWanted := math.LegacyNewDec(int64(gasWanted)).Mul(minGasMultiplier)
-	updatedGasWanted := math.LegacyMaxDec(limitedGasWanted, math.LegacyNewDec(int64(gasUsed))).TruncateInt().Uint64()
+	params := k.GetParams(ctx)
+	updatedGasWanted := limitGasWanted(gasWanted, gasUsed, params.MinGasMultiplier)
 	k.SetBlockGasWanted(ctx, updatedGasWanted)
 
+	// the gas of each fee lane is limited the same way as the block gas, the
+	// Cosmos lane getting the block gas not wanted or used by EVM transactions
+	evmGasWanted, evmGasUsed := k.popBlockEVMGas(ctx)
+	if params.EnableFeeLanes {
+		k.SetLaneGas(
+			ctx,
+			limitGasWanted(evmGasWanted, evmGasUsed, params.MinGasMultiplier),
+			limitGasWanted(saturatingSub(gasWanted, evmGasWanted), saturatingSub(gasUsed, evmGasUsed), params.MinGasMultiplier),
+		)
+	}
+
 	defer func() {
 		telemetry.SetGauge(float32(updatedGasWanted), "feemarket", "block_gas") //nolint:staticcheck // TODO: fix
 	}()
@@ -89,5 +99,42 @@ func (k *Keeper) EndBlock(ctx sdk.Context) error {
 		sdk.NewAttribute("amount", fmt.Sprintf("%d", updatedGasWanted)),
 	))
 
+	// only the base fee collected in the EVM denom is burned, the gas of the
+	// transactions paying their fees in a fee token is left out. The EVM gas is
+	// only tracked with fee lanes enabled, all the gas is otherwise burned at
+	// the single base fee.
+	cosmosGasUsed := saturatingSub(gasUsed, evmGasUsed)
+	evmFeeTokenGas, cosmosFeeTokenGas := k.popBlockFeeTokenGas(ctx)
+	if !params.EnableFeeLanes {
+		cosmosFeeTokenGas = saturatingAdd(cosmosFeeTokenGas, evmFeeTokenGas)
+		evmFeeTokenGas = 0
+	}
+
+	// a failed burn must not halt the chain, the fees are then distributed as
+	// usual.
+	cacheCtx, writeCache := ctx.CacheContext()
+	if _, err := k.BurnBaseFees(cacheCtx, saturatingSub(evmGasUsed, evmFeeTokenGas), saturatingSub(cosmosGasUsed, cosmosFeeTokenGas)); err != nil {
+		k.Logger(ctx).Error("failed to burn base fees", "error", err.Error())
+		return nil
+	}
+	writeCache()
+
 	return nil
 }
+
+// limitGasWanted limits the gas wanted so that
+// gasWanted = max(gasWanted * MinGasMultiplier, gasUsed)
+// this will be keep BaseFee protected from un-penalized manipulation
+// more info here https://github.com/evmos/ethermint/pull/1105#discussion_r888798925
+// CONTRACT: gasWanted and gasUsed cannot be greater than MaxInt64.
+func limitGasWanted(gasWanted, gasUsed uint64, minGasMultiplier math.LegacyDec) uint64 {
+	limitedGasWanted := math.LegacyNewDec(int64(gasWanted)).Mul(minGasMultiplier)
+	return math.LegacyMaxDec(limitedGasWanted, math.LegacyNewDec(int64(gasUsed))).TruncateInt().Uint64()
+}
+
+func saturatingSub(a, b uint64) uint64 {
+	if b > a {
+		return 0
+	}
+	return a - b
+}
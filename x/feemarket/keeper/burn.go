@@ -0,0 +1,86 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/cosmos/evm/x/feemarket/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// BurnBaseFees burns the BaseFeeBurnFraction of the base fee revenue of the
+// current block from the fee collector and adds it to the total burned fees.
+// The revenue is the EVM base fee * EVM gas used plus the Cosmos base fee *
+// Cosmos gas used, the two base fees being the same when fee lanes are
+// disabled. The gas used by the transactions paying their fees in a fee token
+// must be left out, as they bring no base fee in the EVM denom to the fee
+// collector. The amount is computed and burned in the extended EVM denom so that
+// no fraction of it is lost to rounding, and is capped to the balance of the
+// fee collector. It returns the burned amount.
+// CONTRACT: this should be only called during EndBlock, once the fees of the
+// block have been collected.
+func (k Keeper) BurnBaseFees(ctx sdk.Context, evmGasUsed, cosmosGasUsed uint64) (sdkmath.Int, error) {
+	params := k.GetParams(ctx)
+	if !params.IsBaseFeeBurnEnabled(ctx.BlockHeight()) {
+		return sdkmath.ZeroInt(), nil
+	}
+
+	revenue := baseFeeRevenue(k.GetBaseFee(ctx), evmGasUsed).
+		Add(baseFeeRevenue(k.GetCosmosBaseFee(ctx), cosmosGasUsed))
+	if !revenue.IsPositive() {
+		return sdkmath.ZeroInt(), nil
+	}
+
+	factor := evmtypes.GetEVMCoinDecimals().ConversionFactor()
+	amount := revenue.
+		MulInt(factor).
+		Mul(params.BaseFeeBurnFraction).
+		TruncateInt()
+
+	denom := evmtypes.GetEVMCoinExtendedDenom()
+	feeCollector := authtypes.NewModuleAddress(authtypes.FeeCollectorName)
+	amount = sdkmath.MinInt(amount, k.bankKeeper.GetBalance(ctx, feeCollector, denom).Amount)
+	if !amount.IsPositive() {
+		return sdkmath.ZeroInt(), nil
+	}
+
+	// the fee collector has no burner permission, the fees are burned from the
+	// fee market module account instead. The permission is checked beforehand
+	// as burning without it panics.
+	moduleAcc := k.accountKeeper.GetModuleAccount(ctx, types.ModuleName)
+	if moduleAcc == nil || !moduleAcc.HasPermission(authtypes.Burner) {
+		return sdkmath.ZeroInt(), fmt.Errorf("module account %s does not have permissions to burn tokens", types.ModuleName)
+	}
+
+	coins := sdk.NewCoins(sdk.NewCoin(denom, amount))
+	if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, authtypes.FeeCollectorName, types.ModuleName, coins); err != nil {
+		return sdkmath.ZeroInt(), err
+	}
+	if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, coins); err != nil {
+		return sdkmath.ZeroInt(), err
+	}
+
+	total := k.GetTotalBurnedFees(ctx).Add(amount)
+	k.SetTotalBurnedFees(ctx, total)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeBurnBaseFee,
+		sdk.NewAttribute(types.AttributeKeyAmount, amount.String()),
+		sdk.NewAttribute(types.AttributeKeyTotalBurnedFees, total.String()),
+	))
+
+	return amount, nil
+}
+
+// baseFeeRevenue returns the base fee revenue of the gas used, zero if the base
+// fee is disabled.
+func baseFeeRevenue(baseFee sdkmath.LegacyDec, gasUsed uint64) sdkmath.LegacyDec {
+	if baseFee.IsNil() || !baseFee.IsPositive() {
+		return sdkmath.LegacyZeroDec()
+	}
+	return baseFee.MulInt(sdkmath.NewIntFromUint64(gasUsed))
+}
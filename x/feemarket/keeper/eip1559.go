@@ -13,6 +13,8 @@ import (
 
 // CalculateBaseFee calculates the base fee for the current block. This is only calculated once per
 // block during BeginBlock. If the NoBaseFee parameter is enabled or below activation height, this function returns nil.
+// When fee lanes are enabled, it is the base fee of the EVM lane, calculated from the gas of the EVM
+// transactions of the parent block against the EVM share of the block gas limit.
 // NOTE: This code is inspired from the go-ethereum EIP1559 implementation and adapted to Cosmos SDK-based
 // chains. For the canonical code refer to: https://github.com/ethereum/go-ethereum/blob/master/consensus/misc/eip1559.go
 func (k Keeper) CalculateBaseFee(ctx sdk.Context) sdkmath.LegacyDec {
@@ -23,8 +25,6 @@ func (k Keeper) CalculateBaseFee(ctx sdk.Context) sdkmath.LegacyDec {
 		return sdkmath.LegacyDec{}
 	}
 
-	consParams := ctx.ConsensusParams()
-
 	// If the current block is the first EIP-1559 block, return the base fee
 	// defined in the parameters (DefaultBaseFee if it hasn't been changed by
 	// governance).
@@ -36,34 +36,78 @@ func (k Keeper) CalculateBaseFee(ctx sdk.Context) sdkmath.LegacyDec {
 	// NOTE: this is not the parent's base fee but the current block's base fee,
 	// as it is retrieved from the transient store, which is committed to the
 	// persistent KVStore after EndBlock (ABCI Commit).
-	parentBaseFee := params.BaseFee
-	if parentBaseFee.IsNil() {
-		return sdkmath.LegacyDec{}
+	parentGasUsed := k.GetBlockGasWanted(ctx)
+	if params.EnableFeeLanes {
+		parentGasUsed = k.GetEVMLaneGas(ctx)
 	}
 
-	parentGasUsed := k.GetBlockGasWanted(ctx)
+	return calculateLaneBaseFee(
+		params,
+		params.BaseFee,
+		parentGasUsed,
+		params.EVMLaneGasLimit(blockGasLimit(ctx)),
+		params.ElasticityMultiplier,
+	)
+}
 
-	gasLimit := sdkmath.NewIntFromUint64(math.MaxUint64)
+// CalculateCosmosBaseFee calculates the base fee of the Cosmos lane for the
+// current block, from the gas of the Cosmos transactions of the parent block
+// against the Cosmos share of the block gas limit. It returns nil if the base
+// fee or the fee lanes are disabled.
+func (k Keeper) CalculateCosmosBaseFee(ctx sdk.Context) sdkmath.LegacyDec {
+	params := k.GetParams(ctx)
 
-	// NOTE: a MaxGas equal to -1 means that block gas is unlimited
-	if consParams.Block != nil && consParams.Block.MaxGas > -1 {
-		gasLimit = sdkmath.NewInt(consParams.Block.MaxGas)
+	if !params.EnableFeeLanes || !params.IsBaseFeeEnabled(ctx.BlockHeight()) {
+		return sdkmath.LegacyDec{}
+	}
+
+	if ctx.BlockHeight() == params.EnableHeight {
+		return params.CosmosBaseFee
 	}
 
-	// CONTRACT: ElasticityMultiplier cannot be 0 as it's checked in the params
-	// validation
-	parentGasTargetInt := gasLimit.Quo(sdkmath.NewIntFromUint64(uint64(params.ElasticityMultiplier)))
-	if !parentGasTargetInt.IsUint64() {
+	return calculateLaneBaseFee(
+		params,
+		params.CosmosBaseFee,
+		k.GetCosmosLaneGas(ctx),
+		params.CosmosLaneGasLimit(blockGasLimit(ctx)),
+		params.CosmosElasticityMultiplier,
+	)
+}
+
+// calculateLaneBaseFee calculates the base fee of a lane given its base fee,
+// gas used and gas limit on the parent block.
+func calculateLaneBaseFee(
+	params types.Params,
+	parentBaseFee sdkmath.LegacyDec,
+	parentGasUsed, gasLimit uint64,
+	elasticityMultiplier uint32,
+) sdkmath.LegacyDec {
+	if parentBaseFee.IsNil() {
 		return sdkmath.LegacyDec{}
 	}
 
+	// CONTRACT: the elasticity multipliers cannot be 0 as it's checked in the
+	// params validation
+	parentGasTarget := gasLimit / uint64(elasticityMultiplier)
+
 	factor := evmtypes.GetEVMCoinDecimals().ConversionFactor()
 	return types.CalcGasBaseFee(
 		parentGasUsed,
-		parentGasTargetInt.Uint64(),
+		parentGasTarget,
 		uint64(params.BaseFeeChangeDenominator),
 		parentBaseFee,
 		sdkmath.LegacyOneDec().QuoInt(factor),
 		params.MinGasPrice,
 	)
 }
+
+// blockGasLimit returns the block gas limit from the consensus params.
+func blockGasLimit(ctx sdk.Context) uint64 {
+	consParams := ctx.ConsensusParams()
+
+	// NOTE: a MaxGas equal to -1 means that block gas is unlimited
+	if consParams.Block != nil && consParams.Block.MaxGas > -1 {
+		return uint64(consParams.Block.MaxGas)
+	}
+	return math.MaxUint64
+}
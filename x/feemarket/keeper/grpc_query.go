@@ -34,6 +34,17 @@ func (k Keeper) BaseFee(c context.Context, _ *types.QueryBaseFeeRequest) (*types
 	return res, nil
 }
 
+// CosmosBaseFee implements the Query/CosmosBaseFee gRPC method
+func (k Keeper) CosmosBaseFee(c context.Context, _ *types.QueryCosmosBaseFeeRequest) (*types.QueryCosmosBaseFeeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	res := &types.QueryCosmosBaseFeeResponse{}
+	baseFee := k.GetCosmosBaseFee(ctx)
+	res.BaseFee = &baseFee
+
+	return res, nil
+}
+
 // BlockGas implements the Query/BlockGas gRPC method
 func (k Keeper) BlockGas(c context.Context, _ *types.QueryBlockGasRequest) (*types.QueryBlockGasResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
@@ -47,3 +58,32 @@ func (k Keeper) BlockGas(c context.Context, _ *types.QueryBlockGasRequest) (*typ
 		Gas: gas.Int64(),
 	}, nil
 }
+
+// BurnedFees implements the Query/BurnedFees gRPC method
+func (k Keeper) BurnedFees(c context.Context, _ *types.QueryBurnedFeesRequest) (*types.QueryBurnedFeesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryBurnedFeesResponse{
+		TotalBurnedFees: k.GetTotalBurnedFees(ctx),
+	}, nil
+}
+
+// MsgFeeFloors implements the Query/MsgFeeFloors gRPC method
+func (k Keeper) MsgFeeFloors(c context.Context, req *types.QueryMsgFeeFloorsRequest) (*types.QueryMsgFeeFloorsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	params := k.GetParams(ctx)
+
+	res := &types.QueryMsgFeeFloorsResponse{
+		MsgFeeFloors: params.MsgFeeFloors,
+		MinGasPrice:  params.MinGasPrice,
+	}
+	if req != nil && len(req.MsgTypeUrls) > 0 {
+		res.MsgFeeFloors = make([]types.MsgFeeFloor, 0, len(req.MsgTypeUrls))
+		for _, msgTypeURL := range req.MsgTypeUrls {
+			if floor, ok := params.MsgFeeFloor(msgTypeURL); ok {
+				res.MsgFeeFloors = append(res.MsgFeeFloors, floor)
+			}
+		}
+	}
+	return res, nil
+}
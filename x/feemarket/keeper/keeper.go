@@ -1,9 +1,13 @@
 package keeper
 
 import (
+	gomath "math"
+
 	"github.com/cosmos/evm/x/feemarket/types"
 
 	"cosmossdk.io/log"
+	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
 	storetypes "cosmossdk.io/store/types"
 
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -16,13 +20,20 @@ type Keeper struct {
 	cdc codec.BinaryCodec
 	// Store key required for the Fee Market Prefix KVStore.
 	storeKey storetypes.StoreKey
+	// Object store key holding the EVM lane gas and the fee token gas of the
+	// transactions of the block.
+	objectKey storetypes.StoreKey
+	// accountKeeper and bankKeeper burn the base fee revenue from the fee collector
+	accountKeeper types.AccountKeeper
+	bankKeeper    types.BankKeeper
 	// the address capable of executing a MsgUpdateParams message. Typically, this should be the x/gov module account.
 	authority sdk.AccAddress
 }
 
 // NewKeeper generates new fee market module keeper
 func NewKeeper(
-	cdc codec.BinaryCodec, authority sdk.AccAddress, storeKey storetypes.StoreKey,
+	cdc codec.BinaryCodec, authority sdk.AccAddress, storeKey, objectKey storetypes.StoreKey,
+	accountKeeper types.AccountKeeper, bankKeeper types.BankKeeper,
 ) Keeper {
 	// ensure authority account is correctly formatted
 	if err := sdk.VerifyAddressFormat(authority); err != nil {
@@ -30,9 +41,12 @@ func NewKeeper(
 	}
 
 	return Keeper{
-		cdc:       cdc,
-		storeKey:  storeKey,
-		authority: authority,
+		cdc:           cdc,
+		storeKey:      storeKey,
+		objectKey:     objectKey,
+		accountKeeper: accountKeeper,
+		bankKeeper:    bankKeeper,
+		authority:     authority,
 	}
 }
 
@@ -59,3 +73,143 @@ func (k Keeper) GetBlockGasWanted(ctx sdk.Context) uint64 {
 	store := ctx.KVStore(k.storeKey)
 	return sdk.BigEndianToUint64(store.Get(types.KeyPrefixBlockGasWanted))
 }
+
+// ----------------------------------------------------------------------------
+// Burned Fees
+// Cumulative base fee revenue burned, in the extended EVM denom.
+// ----------------------------------------------------------------------------
+
+// SetTotalBurnedFees sets the cumulative amount of burned fees to the store.
+func (k Keeper) SetTotalBurnedFees(ctx sdk.Context, amount sdkmath.Int) {
+	bz, err := amount.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.KeyPrefixTotalBurnedFees, bz)
+}
+
+// GetTotalBurnedFees returns the cumulative amount of burned fees from the
+// store.
+func (k Keeper) GetTotalBurnedFees(ctx sdk.Context) sdkmath.Int {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyPrefixTotalBurnedFees)
+	if bz == nil {
+		return sdkmath.ZeroInt()
+	}
+
+	var amount sdkmath.Int
+	if err := amount.Unmarshal(bz); err != nil {
+		panic(err)
+	}
+	return amount
+}
+
+// ----------------------------------------------------------------------------
+// Fee Lanes Gas
+// Required by the base fee calculation of each fee lane.
+// ----------------------------------------------------------------------------
+
+// evmLaneGas is the gas wanted and used by the EVM messages of a transaction.
+type evmLaneGas struct {
+	wanted, used uint64
+}
+
+// AddEVMLaneGas accumulates the gas wanted and used by the EVM messages of the
+// current transaction in the object store, the rest of the block gas being
+// attributed to the Cosmos lane in EndBlock. It is a no-op when fee lanes are
+// disabled.
+func (k Keeper) AddEVMLaneGas(ctx sdk.Context, gasWanted, gasUsed uint64) {
+	if !k.GetParams(ctx).EnableFeeLanes {
+		return
+	}
+
+	store := ctx.ObjectStore(k.objectKey)
+	key := types.ObjectEVMLaneGasKey(ctx.TxIndex())
+	gas, _ := store.Get(key).(evmLaneGas)
+	store.Set(key, evmLaneGas{
+		wanted: saturatingAdd(gas.wanted, gasWanted),
+		used:   saturatingAdd(gas.used, gasUsed),
+	})
+}
+
+// popBlockEVMGas returns the gas wanted and used by the EVM transactions of
+// the current block and resets them.
+func (k Keeper) popBlockEVMGas(ctx sdk.Context) (gasWanted, gasUsed uint64) {
+	store := prefix.NewObjStore(ctx.ObjectStore(k.objectKey), types.KeyPrefixObjectEVMLaneGas)
+	it := store.Iterator(nil, nil)
+	defer it.Close()
+
+	for ; it.Valid(); it.Next() {
+		gas := it.Value().(evmLaneGas)
+		gasWanted = saturatingAdd(gasWanted, gas.wanted)
+		gasUsed = saturatingAdd(gasUsed, gas.used)
+		store.Delete(it.Key())
+	}
+	return gasWanted, gasUsed
+}
+
+// feeTokenGas is the gas meter of a transaction paying its fees in a fee token,
+// and the fee lane of the transaction.
+type feeTokenGas struct {
+	evm   bool
+	meter storetypes.GasMeter
+}
+
+// SetFeeTokenGasMeter holds the gas meter of the current transaction, which
+// pays its fees in a fee token, in the object store. Such a transaction brings
+// no base fee in the EVM denom to the fee collector, so the gas it consumes up
+// to the end of its execution is left out of the base fee burn in EndBlock.
+func (k Keeper) SetFeeTokenGasMeter(ctx sdk.Context, evm bool) {
+	store := ctx.ObjectStore(k.objectKey)
+	store.Set(types.ObjectFeeTokenGasKey(ctx.TxIndex()), feeTokenGas{evm: evm, meter: ctx.GasMeter()})
+}
+
+// popBlockFeeTokenGas returns the gas used by the EVM and Cosmos transactions
+// of the current block paying their fees in a fee token and resets them.
+func (k Keeper) popBlockFeeTokenGas(ctx sdk.Context) (evmGasUsed, cosmosGasUsed uint64) {
+	store := prefix.NewObjStore(ctx.ObjectStore(k.objectKey), types.KeyPrefixObjectFeeTokenGas)
+	it := store.Iterator(nil, nil)
+	defer it.Close()
+
+	for ; it.Valid(); it.Next() {
+		gas := it.Value().(feeTokenGas)
+		if gas.evm {
+			evmGasUsed = saturatingAdd(evmGasUsed, gas.meter.GasConsumedToLimit())
+		} else {
+			cosmosGasUsed = saturatingAdd(cosmosGasUsed, gas.meter.GasConsumedToLimit())
+		}
+		store.Delete(it.Key())
+	}
+	return evmGasUsed, cosmosGasUsed
+}
+
+// SetLaneGas sets the gas of the EVM and Cosmos lanes of the last block to the
+// store.
+// CONTRACT: this should be only called during EndBlock.
+func (k Keeper) SetLaneGas(ctx sdk.Context, evmGas, cosmosGas uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.KeyPrefixEVMLaneGas, sdk.Uint64ToBigEndian(evmGas))
+	store.Set(types.KeyPrefixCosmosLaneGas, sdk.Uint64ToBigEndian(cosmosGas))
+}
+
+// GetEVMLaneGas returns the gas of the EVM lane of the last block from the
+// store.
+func (k Keeper) GetEVMLaneGas(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	return sdk.BigEndianToUint64(store.Get(types.KeyPrefixEVMLaneGas))
+}
+
+// GetCosmosLaneGas returns the gas of the Cosmos lane of the last block from
+// the store.
+func (k Keeper) GetCosmosLaneGas(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	return sdk.BigEndianToUint64(store.Get(types.KeyPrefixCosmosLaneGas))
+}
+
+func saturatingAdd(a, b uint64) uint64 {
+	if a+b < a {
+		return gomath.MaxUint64
+	}
+	return a + b
+}
@@ -46,6 +46,26 @@ func (k Keeper) GetBaseFee(ctx sdk.Context) math.LegacyDec {
 	return params.BaseFee
 }
 
+// GetCosmosBaseFee gets the base fee of the Cosmos lane from the store, which
+// is the base fee when fee lanes are disabled.
+func (k Keeper) GetCosmosBaseFee(ctx sdk.Context) math.LegacyDec {
+	params := k.GetParams(ctx)
+	if params.NoBaseFee {
+		return math.LegacyDec{}
+	}
+	return params.CosmosLaneBaseFee()
+}
+
+// SetCosmosBaseFee sets the base fee of the Cosmos lane in the store
+func (k Keeper) SetCosmosBaseFee(ctx sdk.Context, baseFee math.LegacyDec) {
+	params := k.GetParams(ctx)
+	params.CosmosBaseFee = baseFee
+	err := k.SetParams(ctx, params)
+	if err != nil {
+		return
+	}
+}
+
 // SetBaseFee set's the base fee in the store
 func (k Keeper) SetBaseFee(ctx sdk.Context, baseFee math.LegacyDec) {
 	params := k.GetParams(ctx)
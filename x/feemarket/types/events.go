@@ -2,7 +2,11 @@ package types
 
 // feemarket module events
 const (
-	EventTypeFeeMarket = "fee_market"
+	EventTypeFeeMarket   = "fee_market"
+	EventTypeBurnBaseFee = "burn_base_fee"
 
-	AttributeKeyBaseFee = "base_fee"
+	AttributeKeyBaseFee         = "base_fee"
+	AttributeKeyCosmosBaseFee   = "cosmos_base_fee"
+	AttributeKeyAmount          = "amount"
+	AttributeKeyTotalBurnedFees = "total_burned_fees"
 )
@@ -46,6 +46,31 @@ type Params struct {
 	// min_gas_multiplier bounds the minimum gas used to be charged
 	// to senders based on gas limit
 	MinGasMultiplier cosmossdk_io_math.LegacyDec `protobuf:"bytes,8,opt,name=min_gas_multiplier,json=minGasMultiplier,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"min_gas_multiplier"`
+	// fee_tokens defines the tokens, other than the EVM denom, that the fees of
+	// cosmos and eth transactions can be paid with
+	FeeTokens []FeeToken `protobuf:"bytes,9,rep,name=fee_tokens,json=feeTokens,proto3" json:"fee_tokens"`
+	// base_fee_burn_fraction is the fraction, between 0 and 1, of the base fee
+	// revenue of each block (base fee * block gas used) that is burned from the
+	// fee collector instead of being distributed to the stakers
+	BaseFeeBurnFraction cosmossdk_io_math.LegacyDec `protobuf:"bytes,10,opt,name=base_fee_burn_fraction,json=baseFeeBurnFraction,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"base_fee_burn_fraction"`
+	// enable_fee_lanes splits the fee market into an EVM lane and a Cosmos lane,
+	// each with its own gas target, elasticity and base fee updated from the gas
+	// of its own transactions. base_fee and elasticity_multiplier then apply to
+	// the EVM lane.
+	EnableFeeLanes bool `protobuf:"varint,11,opt,name=enable_fee_lanes,json=enableFeeLanes,proto3" json:"enable_fee_lanes,omitempty"`
+	// cosmos_base_fee is the base fee of the Cosmos lane, used when fee lanes are
+	// enabled.
+	CosmosBaseFee cosmossdk_io_math.LegacyDec `protobuf:"bytes,12,opt,name=cosmos_base_fee,json=cosmosBaseFee,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"cosmos_base_fee"`
+	// cosmos_elasticity_multiplier bounds the maximum gas the Cosmos lane may
+	// use, used when fee lanes are enabled.
+	CosmosElasticityMultiplier uint32 `protobuf:"varint,13,opt,name=cosmos_elasticity_multiplier,json=cosmosElasticityMultiplier,proto3" json:"cosmos_elasticity_multiplier,omitempty"`
+	// evm_lane_gas_share is the share, between 0 and 1 exclusive, of the block
+	// gas limit allotted to the EVM lane when fee lanes are enabled. The rest is
+	// allotted to the Cosmos lane.
+	EvmLaneGasShare cosmossdk_io_math.LegacyDec `protobuf:"bytes,14,opt,name=evm_lane_gas_share,json=evmLaneGasShare,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"evm_lane_gas_share"`
+	// msg_fee_floors defines the fee floors of the cosmos transactions including
+	// messages of the given types, enforced on top of min_gas_price
+	MsgFeeFloors []MsgFeeFloor `protobuf:"bytes,15,rep,name=msg_fee_floors,json=msgFeeFloors,proto3" json:"msg_fee_floors"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -109,8 +134,166 @@ func (m *Params) GetEnableHeight() int64 {
 	return 0
 }
 
+func (m *Params) GetFeeTokens() []FeeToken {
+	if m != nil {
+		return m.FeeTokens
+	}
+	return nil
+}
+
+func (m *Params) GetEnableFeeLanes() bool {
+	if m != nil {
+		return m.EnableFeeLanes
+	}
+	return false
+}
+
+func (m *Params) GetCosmosElasticityMultiplier() uint32 {
+	if m != nil {
+		return m.CosmosElasticityMultiplier
+	}
+	return 0
+}
+
+func (m *Params) GetMsgFeeFloors() []MsgFeeFloor {
+	if m != nil {
+		return m.MsgFeeFloors
+	}
+	return nil
+}
+
+// MsgFeeFloor defines the minimum fee of the cosmos transactions including a
+// message type, either directly or nested in an authz MsgExec.
+type MsgFeeFloor struct {
+	// msg_type_url is the type url of the message, e.g.
+	// /cosmos.bank.v1beta1.MsgSend
+	MsgTypeUrl string `protobuf:"bytes,1,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
+	// min_fee is the minimum fee, in the EVM denom, paid for each message of the
+	// type included in the transaction
+	MinFee cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=min_fee,json=minFee,proto3,customtype=cosmossdk.io/math.Int" json:"min_fee"`
+	// gas_price_multiplier multiplies min_gas_price to get the minimum gas price
+	// of the transactions including the message type
+	GasPriceMultiplier cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=gas_price_multiplier,json=gasPriceMultiplier,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"gas_price_multiplier"`
+}
+
+func (m *MsgFeeFloor) Reset()         { *m = MsgFeeFloor{} }
+func (m *MsgFeeFloor) String() string { return proto.CompactTextString(m) }
+func (*MsgFeeFloor) ProtoMessage()    {}
+func (*MsgFeeFloor) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0fc4153d77de08e0, []int{1}
+}
+func (m *MsgFeeFloor) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFeeFloor) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFeeFloor.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgFeeFloor) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFeeFloor.Merge(m, src)
+}
+func (m *MsgFeeFloor) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFeeFloor) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFeeFloor.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgFeeFloor proto.InternalMessageInfo
+
+func (m *MsgFeeFloor) GetMsgTypeUrl() string {
+	if m != nil {
+		return m.MsgTypeUrl
+	}
+	return ""
+}
+
+// FeeToken defines a token that transaction fees can be paid with, converted
+// from the EVM denom either at a fixed rate or at the rate of an on-chain TWAP
+// oracle.
+type FeeToken struct {
+	// denom of the token, e.g. an IBC voucher or the denom of an ERC20 token
+	// pair
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// fixed_rate is the amount of the EVM denom (in its base unit) that one base
+	// unit of the token is worth. It must be set if twap_oracle is empty.
+	FixedRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=fixed_rate,json=fixedRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"fixed_rate"`
+	// twap_oracle is the hex address of the pool oracle contract providing the
+	// rate of the token, implementing
+	// `consult(address token, uint256 amountIn) returns (uint256 amountOut)`
+	// where amountOut is denominated in the EVM denom.
+	TwapOracle string `protobuf:"bytes,3,opt,name=twap_oracle,json=twapOracle,proto3" json:"twap_oracle,omitempty"`
+	// twap_token is the hex address of the ERC20 contract of the token, as
+	// registered on the oracle. It must be set if twap_oracle is set.
+	TwapToken string `protobuf:"bytes,4,opt,name=twap_token,json=twapToken,proto3" json:"twap_token,omitempty"`
+}
+
+func (m *FeeToken) Reset()         { *m = FeeToken{} }
+func (m *FeeToken) String() string { return proto.CompactTextString(m) }
+func (*FeeToken) ProtoMessage()    {}
+func (*FeeToken) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0fc4153d77de08e0, []int{2}
+}
+func (m *FeeToken) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeToken) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeToken.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeeToken) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeToken.Merge(m, src)
+}
+func (m *FeeToken) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeToken) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeToken.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeToken proto.InternalMessageInfo
+
+func (m *FeeToken) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *FeeToken) GetTwapOracle() string {
+	if m != nil {
+		return m.TwapOracle
+	}
+	return ""
+}
+
+func (m *FeeToken) GetTwapToken() string {
+	if m != nil {
+		return m.TwapToken
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "cosmos.evm.feemarket.v1.Params")
+	proto.RegisterType((*MsgFeeFloor)(nil), "cosmos.evm.feemarket.v1.MsgFeeFloor")
+	proto.RegisterType((*FeeToken)(nil), "cosmos.evm.feemarket.v1.FeeToken")
 }
 
 func init() {
@@ -118,34 +301,54 @@ func init() {
 }
 
 var fileDescriptor_0fc4153d77de08e0 = []byte{
-	// 423 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x52, 0xb1, 0x6e, 0xd4, 0x40,
-	0x10, 0xf5, 0x72, 0xc9, 0xc5, 0xd9, 0x70, 0xd2, 0xb1, 0x0a, 0xc2, 0x4a, 0x84, 0x63, 0x85, 0x22,
-	0x56, 0x0a, 0x5b, 0x51, 0x3a, 0x24, 0x0a, 0x2e, 0x11, 0x20, 0x14, 0xa4, 0xc8, 0x05, 0x05, 0xcd,
-	0x6a, 0xed, 0x4c, 0xec, 0x55, 0xbc, 0xbb, 0x96, 0x77, 0x63, 0x71, 0xbf, 0x40, 0xc5, 0x67, 0x50,
-	0xe6, 0x33, 0x52, 0xa6, 0x44, 0x14, 0x11, 0xba, 0x2b, 0x8e, 0xcf, 0x40, 0xe7, 0xe5, 0xce, 0xd7,
-	0x50, 0x5c, 0x63, 0x8d, 0xdf, 0x7b, 0xf3, 0x34, 0x3b, 0xf3, 0xf0, 0x51, 0xa6, 0xb4, 0x50, 0x3a,
-	0x86, 0x46, 0xc4, 0xd7, 0x00, 0x82, 0xd5, 0x37, 0x60, 0xe2, 0xe6, 0xa4, 0xfb, 0x89, 0xaa, 0x5a,
-	0x19, 0x45, 0x5e, 0x58, 0x61, 0x04, 0x8d, 0x88, 0x3a, 0xae, 0x39, 0xd9, 0x7b, 0xc6, 0x04, 0x97,
-	0x2a, 0x6e, 0xbf, 0x56, 0xbb, 0xb7, 0x9b, 0xab, 0x5c, 0xb5, 0x65, 0x3c, 0xaf, 0x2c, 0x7a, 0xf8,
-	0xa7, 0x87, 0xfb, 0x97, 0xac, 0x66, 0x42, 0x13, 0x1f, 0xef, 0x48, 0x45, 0x53, 0xa6, 0x81, 0x5e,
-	0x03, 0x78, 0x28, 0x40, 0xa1, 0x9b, 0x6c, 0x4b, 0x35, 0x62, 0x1a, 0xde, 0x01, 0x90, 0x37, 0x78,
-	0x7f, 0x41, 0xd2, 0xac, 0x60, 0x32, 0x07, 0x7a, 0x05, 0x52, 0x09, 0x2e, 0x99, 0x51, 0xb5, 0xf7,
-	0x24, 0x40, 0xe1, 0x20, 0xf1, 0x52, 0xab, 0x3e, 0x6b, 0x05, 0xe7, 0x1d, 0x4f, 0x4e, 0xf1, 0x73,
-	0x28, 0x99, 0x36, 0x3c, 0xe3, 0x66, 0x4c, 0xc5, 0x6d, 0x69, 0x78, 0x55, 0x72, 0xa8, 0xbd, 0x5e,
-	0xdb, 0xb8, 0xdb, 0x91, 0x9f, 0x96, 0x1c, 0x79, 0x85, 0x07, 0x20, 0x59, 0x5a, 0x02, 0x2d, 0x80,
-	0xe7, 0x85, 0xf1, 0x36, 0x03, 0x14, 0xf6, 0x92, 0xa7, 0x16, 0xfc, 0xd0, 0x62, 0xe4, 0x0c, 0xbb,
-	0xcb, 0xa9, 0xfb, 0x01, 0x0a, 0xb7, 0x47, 0xe1, 0xfd, 0xe3, 0x81, 0xf3, 0xeb, 0xf1, 0x60, 0xdf,
-	0xee, 0x47, 0x5f, 0xdd, 0x44, 0x5c, 0xc5, 0x82, 0x99, 0x22, 0xba, 0x80, 0x9c, 0x65, 0xe3, 0x73,
-	0xc8, 0x7e, 0xcc, 0xee, 0x8e, 0x51, 0xb2, 0xf5, 0x6f, 0x5e, 0x72, 0x81, 0x07, 0x82, 0x4b, 0x9a,
-	0x33, 0x4d, 0xab, 0x9a, 0x67, 0xe0, 0x6d, 0xad, 0xe9, 0xb4, 0x23, 0xb8, 0x7c, 0xcf, 0xf4, 0xe5,
-	0xbc, 0x99, 0x7c, 0xc6, 0x64, 0xe1, 0xb6, 0xf2, 0x52, 0x77, 0x4d, 0xcb, 0xa1, 0xb5, 0xec, 0xf6,
-	0xf1, 0xfa, 0xf0, 0xdb, 0xec, 0xee, 0xf8, 0xe5, 0x4a, 0x3c, 0xbe, 0xae, 0x04, 0xc4, 0xde, 0xf1,
-	0xe3, 0x86, 0xbb, 0x31, 0xdc, 0x4c, 0x86, 0x5c, 0x72, 0xc3, 0x59, 0xb9, 0x3c, 0xe8, 0xe8, 0xed,
-	0xfd, 0xc4, 0x47, 0x0f, 0x13, 0x1f, 0xfd, 0x9e, 0xf8, 0xe8, 0xfb, 0xd4, 0x77, 0x1e, 0xa6, 0xbe,
-	0xf3, 0x73, 0xea, 0x3b, 0x5f, 0x8e, 0x72, 0x6e, 0x8a, 0xdb, 0x34, 0xca, 0x94, 0x88, 0xff, 0xe3,
-	0x6d, 0xc6, 0x15, 0xe8, 0xb4, 0xdf, 0x86, 0xe6, 0xf4, 0x6f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x82,
-	0x59, 0x74, 0x66, 0xa1, 0x02, 0x00, 0x00,
+	// 752 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0xcd, 0x6e, 0x2b, 0x35,
+	0x14, 0xce, 0xdc, 0xa6, 0x49, 0xc6, 0x69, 0xda, 0x60, 0x72, 0x61, 0xd4, 0x4b, 0xd3, 0x90, 0x8b,
+	0x74, 0xa3, 0xbb, 0x98, 0xa8, 0x54, 0x62, 0x81, 0x84, 0x04, 0x69, 0x49, 0x01, 0xb5, 0x22, 0x1a,
+	0x5a, 0x16, 0x48, 0x68, 0xe4, 0x4c, 0x4f, 0x26, 0x56, 0xc7, 0x76, 0x34, 0x76, 0x42, 0xf3, 0x0a,
+	0xac, 0x78, 0x08, 0x16, 0x2c, 0xfb, 0x04, 0xac, 0xbb, 0xec, 0x12, 0xb1, 0xa8, 0x50, 0xbb, 0xe8,
+	0x1b, 0xb0, 0x46, 0xb6, 0x27, 0x3f, 0xe2, 0xb6, 0x52, 0xb3, 0x19, 0x8d, 0xcf, 0x39, 0x3e, 0xfe,
+	0xfc, 0x7d, 0xdf, 0x31, 0x7a, 0x13, 0x09, 0xc9, 0x84, 0x6c, 0xc3, 0x84, 0xb5, 0x07, 0x00, 0x8c,
+	0xa4, 0x17, 0xa0, 0xda, 0x93, 0xbd, 0xc5, 0xc2, 0x1f, 0xa5, 0x42, 0x09, 0xfc, 0xa1, 0x2d, 0xf4,
+	0x61, 0xc2, 0xfc, 0x45, 0x6e, 0xb2, 0xb7, 0xfd, 0x1e, 0x61, 0x94, 0x8b, 0xb6, 0xf9, 0xda, 0xda,
+	0xed, 0x5a, 0x2c, 0x62, 0x61, 0x7e, 0xdb, 0xfa, 0xcf, 0x46, 0x9b, 0xff, 0x16, 0x51, 0xa1, 0x47,
+	0x52, 0xc2, 0x24, 0xae, 0xa3, 0x32, 0x17, 0x61, 0x9f, 0x48, 0x08, 0x07, 0x00, 0x9e, 0xd3, 0x70,
+	0x5a, 0xa5, 0xc0, 0xe5, 0xa2, 0x43, 0x24, 0x74, 0x01, 0xf0, 0x17, 0xe8, 0xd5, 0x2c, 0x19, 0x46,
+	0x43, 0xc2, 0x63, 0x08, 0xcf, 0x81, 0x0b, 0x46, 0x39, 0x51, 0x22, 0xf5, 0x5e, 0x34, 0x9c, 0x56,
+	0x25, 0xf0, 0xfa, 0xb6, 0xfa, 0xc0, 0x14, 0x1c, 0x2e, 0xf2, 0x78, 0x1f, 0xbd, 0x84, 0x84, 0x48,
+	0x45, 0x23, 0xaa, 0xa6, 0x21, 0x1b, 0x27, 0x8a, 0x8e, 0x12, 0x0a, 0xa9, 0xb7, 0x66, 0x36, 0xd6,
+	0x16, 0xc9, 0x93, 0x79, 0x0e, 0xbf, 0x46, 0x15, 0xe0, 0xa4, 0x9f, 0x40, 0x38, 0x04, 0x1a, 0x0f,
+	0x95, 0xb7, 0xde, 0x70, 0x5a, 0x6b, 0xc1, 0x86, 0x0d, 0x7e, 0x63, 0x62, 0xf8, 0x00, 0x95, 0xe6,
+	0xa8, 0x0b, 0x0d, 0xa7, 0xe5, 0x76, 0x5a, 0xd7, 0xb7, 0xbb, 0xb9, 0xbf, 0x6f, 0x77, 0x5f, 0x59,
+	0x7e, 0xe4, 0xf9, 0x85, 0x4f, 0x45, 0x9b, 0x11, 0x35, 0xf4, 0x8f, 0x21, 0x26, 0xd1, 0xf4, 0x10,
+	0xa2, 0x3f, 0x1e, 0xae, 0xde, 0x3a, 0x41, 0x31, 0xc3, 0x8b, 0x8f, 0x51, 0x85, 0x51, 0x1e, 0xc6,
+	0x44, 0x86, 0xa3, 0x94, 0x46, 0xe0, 0x15, 0x57, 0xec, 0x54, 0x66, 0x94, 0x1f, 0x11, 0xd9, 0xd3,
+	0x9b, 0xf1, 0x8f, 0x08, 0xcf, 0xba, 0x2d, 0xdd, 0xb4, 0xb4, 0x62, 0xcb, 0xaa, 0x6d, 0xb9, 0xc4,
+	0x47, 0x17, 0x21, 0x4d, 0xbf, 0x12, 0x17, 0xc0, 0xa5, 0xe7, 0x36, 0xd6, 0x5a, 0xe5, 0x4f, 0x3f,
+	0xf6, 0x9f, 0x70, 0x81, 0xdf, 0x05, 0x38, 0xd5, 0x95, 0x9d, 0xbc, 0x3e, 0x32, 0x70, 0x07, 0xd9,
+	0x5a, 0xe2, 0x9f, 0xd1, 0x07, 0x73, 0x2d, 0xfb, 0xe3, 0x94, 0x87, 0x83, 0x94, 0x44, 0x8a, 0x0a,
+	0xee, 0xa1, 0x15, 0x31, 0xbe, 0x9f, 0x11, 0xd8, 0x19, 0xa7, 0xbc, 0x9b, 0x35, 0xc1, 0x2d, 0x54,
+	0xcd, 0x64, 0xd3, 0x07, 0x24, 0x84, 0x83, 0xf4, 0xca, 0xc6, 0x4f, 0x9b, 0x36, 0xde, 0x05, 0x38,
+	0xd6, 0x51, 0xdc, 0x43, 0x5b, 0xf6, 0x88, 0x85, 0xf1, 0x36, 0x56, 0x44, 0x50, 0xb1, 0x15, 0x33,
+	0x9b, 0x7e, 0x89, 0x3e, 0xca, 0x3a, 0x3e, 0x6e, 0xb7, 0x8a, 0xb1, 0xdb, 0xb6, 0xad, 0xf9, 0xfa,
+	0x31, 0xd3, 0x9d, 0x21, 0x0c, 0x13, 0x66, 0x60, 0x1b, 0x05, 0xe5, 0x90, 0xa4, 0xe0, 0x6d, 0xae,
+	0x08, 0x6b, 0x0b, 0x26, 0x4c, 0xdf, 0xf1, 0x88, 0xc8, 0x1f, 0x74, 0x03, 0xdc, 0x43, 0x9b, 0x4c,
+	0xc6, 0x86, 0x91, 0x41, 0x22, 0x44, 0x2a, 0xbd, 0x2d, 0xa3, 0xdf, 0x27, 0x4f, 0xea, 0x77, 0x22,
+	0xe3, 0x2e, 0x40, 0x57, 0x17, 0x67, 0x12, 0x6e, 0xb0, 0x45, 0x48, 0x7e, 0xde, 0xfc, 0xf5, 0xe1,
+	0xea, 0xed, 0xce, 0xd2, 0x63, 0x71, 0xb9, 0xf4, 0x5c, 0xd8, 0xa9, 0xfe, 0x2e, 0x5f, 0xca, 0x57,
+	0xd7, 0x83, 0x2a, 0xe5, 0x54, 0x51, 0x92, 0xcc, 0x59, 0x6e, 0xfe, 0xe9, 0xa0, 0xf2, 0x52, 0x7f,
+	0xdc, 0x40, 0xba, 0x77, 0xa8, 0xa6, 0x23, 0x08, 0xc7, 0x69, 0x62, 0xc6, 0xdf, 0x0d, 0x10, 0x93,
+	0xf1, 0xe9, 0x74, 0x04, 0x67, 0x69, 0x82, 0x3f, 0x43, 0x45, 0xed, 0x69, 0x2d, 0xd1, 0x0b, 0xc3,
+	0xc5, 0x4e, 0xc6, 0xc5, 0xcb, 0x77, 0xb9, 0xf8, 0x96, 0xab, 0xa0, 0xc0, 0x28, 0xd7, 0x82, 0x9c,
+	0xa1, 0xda, 0x7c, 0xaa, 0xfe, 0x3f, 0xf7, 0x6e, 0xe7, 0xf5, 0x33, 0x08, 0x0d, 0x70, 0x9c, 0x0d,
+	0xd6, 0x42, 0xa5, 0xe6, 0xef, 0x0e, 0x2a, 0xcd, 0x0c, 0x8e, 0x6b, 0x68, 0xdd, 0xbc, 0x45, 0x19,
+	0x6c, 0xbb, 0xc0, 0x1d, 0x84, 0x06, 0xf4, 0x12, 0xce, 0xc3, 0x94, 0xa8, 0x19, 0xe8, 0x67, 0x9d,
+	0xe7, 0x9a, 0x6d, 0x01, 0x51, 0x80, 0x77, 0x51, 0x59, 0xfd, 0x42, 0x46, 0xa1, 0x48, 0x49, 0x94,
+	0x80, 0x05, 0x1d, 0x20, 0x1d, 0xfa, 0xde, 0x44, 0xf0, 0x0e, 0x32, 0x2b, 0x3b, 0x93, 0x5e, 0xde,
+	0xe4, 0x5d, 0x1d, 0xb1, 0xa3, 0xf7, 0xd5, 0xf5, 0x5d, 0xdd, 0xb9, 0xb9, 0xab, 0x3b, 0xff, 0xdc,
+	0xd5, 0x9d, 0xdf, 0xee, 0xeb, 0xb9, 0x9b, 0xfb, 0x7a, 0xee, 0xaf, 0xfb, 0x7a, 0xee, 0xa7, 0x37,
+	0x31, 0x55, 0xc3, 0x71, 0xdf, 0x8f, 0x04, 0x6b, 0x3f, 0xa1, 0xa1, 0x56, 0x43, 0xf6, 0x0b, 0xe6,
+	0xa9, 0xde, 0xff, 0x2f, 0x00, 0x00, 0xff, 0xff, 0xf7, 0x2f, 0x57, 0x38, 0x17, 0x06, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -168,6 +371,79 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.MsgFeeFloors) > 0 {
+		for iNdEx := len(m.MsgFeeFloors) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MsgFeeFloors[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFeemarket(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	{
+		size := m.EvmLaneGasShare.Size()
+		i -= size
+		if _, err := m.EvmLaneGasShare.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFeemarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x72
+	if m.CosmosElasticityMultiplier != 0 {
+		i = encodeVarintFeemarket(dAtA, i, uint64(m.CosmosElasticityMultiplier))
+		i--
+		dAtA[i] = 0x68
+	}
+	{
+		size := m.CosmosBaseFee.Size()
+		i -= size
+		if _, err := m.CosmosBaseFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFeemarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x62
+	if m.EnableFeeLanes {
+		i--
+		if m.EnableFeeLanes {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x58
+	}
+	{
+		size := m.BaseFeeBurnFraction.Size()
+		i -= size
+		if _, err := m.BaseFeeBurnFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFeemarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	if len(m.FeeTokens) > 0 {
+		for iNdEx := len(m.FeeTokens) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeTokens[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFeemarket(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
 	{
 		size := m.MinGasMultiplier.Size()
 		i -= size
@@ -226,6 +502,110 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgFeeFloor) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFeeFloor) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFeeFloor) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.GasPriceMultiplier.Size()
+		i -= size
+		if _, err := m.GasPriceMultiplier.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFeemarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.MinFee.Size()
+		i -= size
+		if _, err := m.MinFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFeemarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintFeemarket(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FeeToken) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeToken) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeToken) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TwapToken) > 0 {
+		i -= len(m.TwapToken)
+		copy(dAtA[i:], m.TwapToken)
+		i = encodeVarintFeemarket(dAtA, i, uint64(len(m.TwapToken)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.TwapOracle) > 0 {
+		i -= len(m.TwapOracle)
+		copy(dAtA[i:], m.TwapOracle)
+		i = encodeVarintFeemarket(dAtA, i, uint64(len(m.TwapOracle)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size := m.FixedRate.Size()
+		i -= size
+		if _, err := m.FixedRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFeemarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintFeemarket(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintFeemarket(dAtA []byte, offset int, v uint64) int {
 	offset -= sovFeemarket(v)
 	base := offset
@@ -261,6 +641,70 @@ func (m *Params) Size() (n int) {
 	n += 1 + l + sovFeemarket(uint64(l))
 	l = m.MinGasMultiplier.Size()
 	n += 1 + l + sovFeemarket(uint64(l))
+	if len(m.FeeTokens) > 0 {
+		for _, e := range m.FeeTokens {
+			l = e.Size()
+			n += 1 + l + sovFeemarket(uint64(l))
+		}
+	}
+	l = m.BaseFeeBurnFraction.Size()
+	n += 1 + l + sovFeemarket(uint64(l))
+	if m.EnableFeeLanes {
+		n += 2
+	}
+	l = m.CosmosBaseFee.Size()
+	n += 1 + l + sovFeemarket(uint64(l))
+	if m.CosmosElasticityMultiplier != 0 {
+		n += 1 + sovFeemarket(uint64(m.CosmosElasticityMultiplier))
+	}
+	l = m.EvmLaneGasShare.Size()
+	n += 1 + l + sovFeemarket(uint64(l))
+	if len(m.MsgFeeFloors) > 0 {
+		for _, e := range m.MsgFeeFloors {
+			l = e.Size()
+			n += 1 + l + sovFeemarket(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgFeeFloor) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovFeemarket(uint64(l))
+	}
+	l = m.MinFee.Size()
+	n += 1 + l + sovFeemarket(uint64(l))
+	l = m.GasPriceMultiplier.Size()
+	n += 1 + l + sovFeemarket(uint64(l))
+	return n
+}
+
+func (m *FeeToken) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovFeemarket(uint64(l))
+	}
+	l = m.FixedRate.Size()
+	n += 1 + l + sovFeemarket(uint64(l))
+	l = len(m.TwapOracle)
+	if l > 0 {
+		n += 1 + l + sovFeemarket(uint64(l))
+	}
+	l = len(m.TwapToken)
+	if l > 0 {
+		n += 1 + l + sovFeemarket(uint64(l))
+	}
 	return n
 }
 
@@ -478,6 +922,545 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeTokens", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeTokens = append(m.FeeTokens, FeeToken{})
+			if err := m.FeeTokens[len(m.FeeTokens)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFeeBurnFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BaseFeeBurnFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnableFeeLanes", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.EnableFeeLanes = bool(v != 0)
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CosmosBaseFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CosmosBaseFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CosmosElasticityMultiplier", wireType)
+			}
+			m.CosmosElasticityMultiplier = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CosmosElasticityMultiplier |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvmLaneGasShare", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EvmLaneGasShare.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgFeeFloors", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgFeeFloors = append(m.MsgFeeFloors, MsgFeeFloor{})
+			if err := m.MsgFeeFloors[len(m.MsgFeeFloors)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeemarket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgFeeFloor) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeemarket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFeeFloor: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFeeFloor: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasPriceMultiplier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GasPriceMultiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeemarket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeeToken) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeemarket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeToken: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeToken: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FixedRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FixedRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TwapOracle", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TwapOracle = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TwapToken", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TwapToken = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipFeemarket(dAtA[iNdEx:])
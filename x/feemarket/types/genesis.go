@@ -1,23 +1,35 @@
 package types
 
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+)
+
 // DefaultGenesisState sets default fee market genesis state.
 func DefaultGenesisState() *GenesisState {
 	return &GenesisState{
-		Params:   DefaultParams(),
-		BlockGas: 0,
+		Params:          DefaultParams(),
+		BlockGas:        0,
+		TotalBurnedFees: math.ZeroInt(),
 	}
 }
 
 // NewGenesisState creates a new genesis state.
 func NewGenesisState(params Params, blockGas uint64) *GenesisState {
 	return &GenesisState{
-		Params:   params,
-		BlockGas: blockGas,
+		Params:          params,
+		BlockGas:        blockGas,
+		TotalBurnedFees: math.ZeroInt(),
 	}
 }
 
 // Validate performs basic genesis state validation returning an error upon any
 // failure.
 func (gs GenesisState) Validate() error {
+	if !gs.TotalBurnedFees.IsNil() && gs.TotalBurnedFees.IsNegative() {
+		return fmt.Errorf("total burned fees cannot be negative: %s", gs.TotalBurnedFees)
+	}
+
 	return gs.Params.Validate()
 }
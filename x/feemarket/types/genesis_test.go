@@ -4,6 +4,8 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/suite"
+
+	"cosmossdk.io/math"
 )
 
 type GenesisTestSuite struct {
@@ -30,6 +32,7 @@ func (suite *GenesisTestSuite) TestValidateGenesis() {
 			&GenesisState{
 				DefaultParams(),
 				uint64(1),
+				math.NewInt(100),
 			},
 			true,
 		},
@@ -41,6 +44,14 @@ func (suite *GenesisTestSuite) TestValidateGenesis() {
 			),
 			true,
 		},
+		{
+			"negative total burned fees",
+			&GenesisState{
+				Params:          DefaultParams(),
+				TotalBurnedFees: math.NewInt(-1),
+			},
+			false,
+		},
 		{
 			"empty genesis",
 			&GenesisState{
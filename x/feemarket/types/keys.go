@@ -1,5 +1,7 @@
 package types
 
+import "encoding/binary"
+
 const (
 	// ModuleName string name of module
 	ModuleName = "feemarket"
@@ -8,6 +10,10 @@ const (
 	// The Fee Market module should use a prefix store.
 	StoreKey = ModuleName
 
+	// ObjectKey is the key to access the fee market object store, that is reset
+	// during the Commit phase.
+	ObjectKey = "object:" + ModuleName
+
 	// RouterKey uses module name for routing
 	RouterKey = ModuleName
 )
@@ -16,18 +22,53 @@ const (
 const (
 	prefixBlockGasWanted    = iota + 1
 	deprecatedPrefixBaseFee // unused
+	prefixTotalBurnedFees
+	prefixEVMLaneGas
+	prefixCosmosLaneGas
 )
 
 const (
 	prefixTransientBlockGasUsed = iota + 1
 )
 
+// prefix bytes for the feemarket object store
+const (
+	prefixObjectEVMLaneGas = iota + 1
+	prefixObjectFeeTokenGas
+)
+
 // KVStore key prefixes
 var (
-	KeyPrefixBlockGasWanted = []byte{prefixBlockGasWanted}
+	KeyPrefixBlockGasWanted  = []byte{prefixBlockGasWanted}
+	KeyPrefixTotalBurnedFees = []byte{prefixTotalBurnedFees}
+	KeyPrefixEVMLaneGas      = []byte{prefixEVMLaneGas}
+	KeyPrefixCosmosLaneGas   = []byte{prefixCosmosLaneGas}
 )
 
 // Transient Store key prefixes
 var (
 	KeyPrefixTransientBlockGasWanted = []byte{prefixTransientBlockGasUsed}
 )
+
+// Object Store key prefixes
+var (
+	KeyPrefixObjectEVMLaneGas  = []byte{prefixObjectEVMLaneGas}
+	KeyPrefixObjectFeeTokenGas = []byte{prefixObjectFeeTokenGas}
+)
+
+// ObjectEVMLaneGasKey returns the object store key of the EVM lane gas of a tx.
+func ObjectEVMLaneGasKey(txIndex int) []byte {
+	var key [1 + 8]byte
+	key[0] = prefixObjectEVMLaneGas
+	binary.BigEndian.PutUint64(key[1:], uint64(txIndex)) //nolint:gosec
+	return key[:]
+}
+
+// ObjectFeeTokenGasKey returns the object store key of the gas meter of a tx
+// paying its fees in a fee token.
+func ObjectFeeTokenGasKey(txIndex int) []byte {
+	var key [1 + 8]byte
+	key[0] = prefixObjectFeeTokenGas
+	binary.BigEndian.PutUint64(key[1:], uint64(txIndex)) //nolint:gosec
+	return key[:]
+}
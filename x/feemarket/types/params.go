@@ -3,9 +3,12 @@ package types
 import (
 	"fmt"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/params"
 
 	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 var (
@@ -19,10 +22,20 @@ var (
 	DefaultEnableHeight = int64(0)
 	// DefaultNoBaseFee is false
 	DefaultNoBaseFee = false
+	// DefaultBaseFeeBurnFraction is 0 (i.e all the fees are distributed)
+	DefaultBaseFeeBurnFraction = math.LegacyZeroDec()
+	// DefaultEnableFeeLanes is false (i.e a single base fee for all transactions)
+	DefaultEnableFeeLanes = false
+	// DefaultEVMLaneGasShare is 0.5 or 50%
+	DefaultEVMLaneGasShare = math.LegacyNewDecWithPrec(50, 2)
 
 	ParamsKey = []byte("Params")
 )
 
+// MaxFeeTokens caps the number of whitelisted fee tokens, which bounds the
+// consultations of their TWAP oracles per block.
+const MaxFeeTokens = 10
+
 // NewParams creates a new Params instance
 func NewParams(
 	noBaseFee bool,
@@ -34,26 +47,36 @@ func NewParams(
 	minGasPriceMultiplier math.LegacyDec,
 ) Params {
 	return Params{
-		NoBaseFee:                noBaseFee,
-		BaseFeeChangeDenominator: baseFeeChangeDenom,
-		ElasticityMultiplier:     elasticityMultiplier,
-		BaseFee:                  baseFee,
-		EnableHeight:             enableHeight,
-		MinGasPrice:              minGasPrice,
-		MinGasMultiplier:         minGasPriceMultiplier,
+		NoBaseFee:                  noBaseFee,
+		BaseFeeChangeDenominator:   baseFeeChangeDenom,
+		ElasticityMultiplier:       elasticityMultiplier,
+		BaseFee:                    baseFee,
+		EnableHeight:               enableHeight,
+		MinGasPrice:                minGasPrice,
+		MinGasMultiplier:           minGasPriceMultiplier,
+		BaseFeeBurnFraction:        DefaultBaseFeeBurnFraction,
+		EnableFeeLanes:             DefaultEnableFeeLanes,
+		CosmosBaseFee:              baseFee,
+		CosmosElasticityMultiplier: elasticityMultiplier,
+		EvmLaneGasShare:            DefaultEVMLaneGasShare,
 	}
 }
 
 // DefaultParams returns default evm parameters
 func DefaultParams() Params {
 	return Params{
-		NoBaseFee:                DefaultNoBaseFee,
-		BaseFeeChangeDenominator: params.DefaultBaseFeeChangeDenominator,
-		ElasticityMultiplier:     params.DefaultElasticityMultiplier,
-		BaseFee:                  DefaultBaseFee,
-		EnableHeight:             DefaultEnableHeight,
-		MinGasPrice:              DefaultMinGasPrice,
-		MinGasMultiplier:         DefaultMinGasMultiplier,
+		NoBaseFee:                  DefaultNoBaseFee,
+		BaseFeeChangeDenominator:   params.DefaultBaseFeeChangeDenominator,
+		ElasticityMultiplier:       params.DefaultElasticityMultiplier,
+		BaseFee:                    DefaultBaseFee,
+		EnableHeight:               DefaultEnableHeight,
+		MinGasPrice:                DefaultMinGasPrice,
+		MinGasMultiplier:           DefaultMinGasMultiplier,
+		BaseFeeBurnFraction:        DefaultBaseFeeBurnFraction,
+		EnableFeeLanes:             DefaultEnableFeeLanes,
+		CosmosBaseFee:              DefaultBaseFee,
+		CosmosElasticityMultiplier: params.DefaultElasticityMultiplier,
+		EvmLaneGasShare:            DefaultEVMLaneGasShare,
 	}
 }
 
@@ -79,6 +102,22 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := validateFeeTokens(p.FeeTokens); err != nil {
+		return err
+	}
+
+	if err := validateBaseFeeBurnFraction(p.BaseFeeBurnFraction); err != nil {
+		return err
+	}
+
+	if err := p.validateFeeLanes(); err != nil {
+		return err
+	}
+
+	if err := validateMsgFeeFloors(p.MsgFeeFloors); err != nil {
+		return err
+	}
+
 	return validateMinGasPrice(p.MinGasPrice)
 }
 
@@ -86,6 +125,144 @@ func (p Params) IsBaseFeeEnabled(height int64) bool {
 	return !p.NoBaseFee && height >= p.EnableHeight
 }
 
+// IsBaseFeeBurnEnabled returns true if a fraction of the base fee revenue is
+// burned at the given height.
+func (p Params) IsBaseFeeBurnEnabled(height int64) bool {
+	return p.IsBaseFeeEnabled(height) && !p.BaseFeeBurnFraction.IsNil() && p.BaseFeeBurnFraction.IsPositive()
+}
+
+// CosmosLaneBaseFee returns the base fee charged to Cosmos transactions, which
+// is the base fee of the Cosmos lane when fee lanes are enabled.
+func (p Params) CosmosLaneBaseFee() math.LegacyDec {
+	if p.EnableFeeLanes {
+		return p.CosmosBaseFee
+	}
+	return p.BaseFee
+}
+
+// EVMLaneGasLimit returns the part of the block gas limit allotted to the EVM
+// lane, which is the whole block gas limit when fee lanes are disabled.
+func (p Params) EVMLaneGasLimit(gasLimit uint64) uint64 {
+	if !p.EnableFeeLanes {
+		return gasLimit
+	}
+	return math.LegacyNewDecFromInt(math.NewIntFromUint64(gasLimit)).Mul(p.EvmLaneGasShare).TruncateInt().Uint64()
+}
+
+// CosmosLaneGasLimit returns the part of the block gas limit allotted to the
+// Cosmos lane, which is the whole block gas limit when fee lanes are disabled.
+func (p Params) CosmosLaneGasLimit(gasLimit uint64) uint64 {
+	if !p.EnableFeeLanes {
+		return gasLimit
+	}
+	return gasLimit - p.EVMLaneGasLimit(gasLimit)
+}
+
+// FeeToken returns the whitelisted fee token of the given denom.
+func (p Params) FeeToken(denom string) (FeeToken, bool) {
+	for _, token := range p.FeeTokens {
+		if token.Denom == denom {
+			return token, true
+		}
+	}
+	return FeeToken{}, false
+}
+
+// HasFixedRate returns true if the token is converted at the fixed rate, rather
+// than at the rate of a TWAP oracle.
+func (t FeeToken) HasFixedRate() bool {
+	return t.TwapOracle == ""
+}
+
+// Validate performs basic validation on a fee token.
+func (t FeeToken) Validate() error {
+	if err := sdk.ValidateDenom(t.Denom); err != nil {
+		return fmt.Errorf("invalid fee token denom: %w", err)
+	}
+
+	if t.HasFixedRate() {
+		if t.TwapToken != "" {
+			return fmt.Errorf("fee token %s: twap token set without twap oracle", t.Denom)
+		}
+		if t.FixedRate.IsNil() || !t.FixedRate.IsPositive() {
+			return fmt.Errorf("fee token %s: fixed rate must be positive when no twap oracle is set", t.Denom)
+		}
+		return nil
+	}
+
+	if !t.FixedRate.IsNil() && !t.FixedRate.IsZero() {
+		return fmt.Errorf("fee token %s: fixed rate and twap oracle cannot be both set", t.Denom)
+	}
+	if !common.IsHexAddress(t.TwapOracle) {
+		return fmt.Errorf("fee token %s: invalid twap oracle address %s", t.Denom, t.TwapOracle)
+	}
+	if !common.IsHexAddress(t.TwapToken) {
+		return fmt.Errorf("fee token %s: invalid twap token address %s", t.Denom, t.TwapToken)
+	}
+	return nil
+}
+
+// MsgFeeFloor returns the fee floor of the given message type.
+func (p Params) MsgFeeFloor(msgTypeURL string) (MsgFeeFloor, bool) {
+	for _, floor := range p.MsgFeeFloors {
+		if floor.MsgTypeUrl == msgTypeURL {
+			return floor, true
+		}
+	}
+	return MsgFeeFloor{}, false
+}
+
+// Validate performs basic validation on a message fee floor.
+func (f MsgFeeFloor) Validate() error {
+	if f.MsgTypeUrl == "" || f.MsgTypeUrl[0] != '/' {
+		return fmt.Errorf("invalid msg fee floor type url %q", f.MsgTypeUrl)
+	}
+
+	if !f.MinFee.IsNil() && f.MinFee.IsNegative() {
+		return fmt.Errorf("msg fee floor %s: min fee cannot be negative: %s", f.MsgTypeUrl, f.MinFee)
+	}
+
+	if !f.GasPriceMultiplier.IsNil() && f.GasPriceMultiplier.IsNegative() {
+		return fmt.Errorf("msg fee floor %s: gas price multiplier cannot be negative: %s", f.MsgTypeUrl, f.GasPriceMultiplier)
+	}
+
+	if (f.MinFee.IsNil() || f.MinFee.IsZero()) && (f.GasPriceMultiplier.IsNil() || f.GasPriceMultiplier.IsZero()) {
+		return fmt.Errorf("msg fee floor %s: min fee or gas price multiplier must be set", f.MsgTypeUrl)
+	}
+	return nil
+}
+
+func validateMsgFeeFloors(floors []MsgFeeFloor) error {
+	seen := make(map[string]bool, len(floors))
+	for _, floor := range floors {
+		if err := floor.Validate(); err != nil {
+			return err
+		}
+		if seen[floor.MsgTypeUrl] {
+			return fmt.Errorf("duplicate msg fee floor %s", floor.MsgTypeUrl)
+		}
+		seen[floor.MsgTypeUrl] = true
+	}
+	return nil
+}
+
+func validateFeeTokens(tokens []FeeToken) error {
+	if len(tokens) > MaxFeeTokens {
+		return fmt.Errorf("too many fee tokens, got %d, max %d", len(tokens), MaxFeeTokens)
+	}
+	seen := make(map[string]bool, len(tokens))
+	for _, token := range tokens {
+		if err := token.Validate(); err != nil {
+			return err
+		}
+		if seen[token.Denom] {
+			return fmt.Errorf("duplicate fee token %s", token.Denom)
+		}
+		seen[token.Denom] = true
+	}
+	return nil
+}
+
 func validateMinGasPrice(gasPrice math.LegacyDec) error {
 	if gasPrice.IsNil() {
 		return fmt.Errorf("invalid parameter: nil")
@@ -113,3 +290,51 @@ func validateMinGasMultiplier(multiplier math.LegacyDec) error {
 
 	return nil
 }
+
+// validateFeeLanes checks the lane parameters only when fee lanes are enabled,
+// so that the params stored before the lanes were introduced stay valid.
+func (p Params) validateFeeLanes() error {
+	if !p.EnableFeeLanes {
+		return nil
+	}
+
+	if p.CosmosBaseFee.IsNil() {
+		return fmt.Errorf("cosmos base fee cannot be nil when fee lanes are enabled")
+	}
+
+	if p.CosmosBaseFee.IsNegative() {
+		return fmt.Errorf("cosmos base fee cannot be negative: %s", p.CosmosBaseFee)
+	}
+
+	if p.CosmosElasticityMultiplier == 0 {
+		return fmt.Errorf("cosmos elasticity multiplier cannot be zero when fee lanes are enabled")
+	}
+
+	if p.EvmLaneGasShare.IsNil() {
+		return fmt.Errorf("evm lane gas share cannot be nil when fee lanes are enabled")
+	}
+
+	if !p.EvmLaneGasShare.IsPositive() || p.EvmLaneGasShare.GTE(math.LegacyOneDec()) {
+		return fmt.Errorf("evm lane gas share must be between 0 and 1 exclusive: %s", p.EvmLaneGasShare)
+	}
+
+	return nil
+}
+
+// validateBaseFeeBurnFraction accepts a nil fraction, which is the value of the
+// params stored before the fraction was introduced, and burns nothing.
+func validateBaseFeeBurnFraction(fraction math.LegacyDec) error {
+	if fraction.IsNil() {
+		return nil
+	}
+
+	if fraction.IsNegative() {
+		return fmt.Errorf("base fee burn fraction cannot be negative: %s", fraction)
+	}
+
+	if fraction.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("base fee burn fraction cannot be greater than 1: %s", fraction)
+	}
+
+	return nil
+}
@@ -1,6 +1,7 @@
 package types
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/suite"
@@ -113,3 +114,187 @@ func (suite *ParamsTestSuite) TestParamsValidateMinGasPrice() {
 		}
 	}
 }
+
+func (suite *ParamsTestSuite) TestParamsValidateFeeTokens() {
+	const (
+		oracle = "0x1000000000000000000000000000000000000001"
+		token  = "0x2000000000000000000000000000000000000002"
+	)
+
+	tooMany := make([]FeeToken, MaxFeeTokens+1)
+	for i := range tooMany {
+		tooMany[i] = FeeToken{Denom: fmt.Sprintf("ibc/token%d", i), FixedRate: math.LegacyOneDec()}
+	}
+
+	testCases := []struct {
+		name     string
+		tokens   []FeeToken
+		expError bool
+	}{
+		{"valid: none", nil, false},
+		{"valid: fixed rate", []FeeToken{{Denom: "ibc/usdc", FixedRate: math.LegacyNewDec(1_000_000_000_000)}}, false},
+		{"valid: twap oracle", []FeeToken{{Denom: "erc20/" + token, TwapOracle: oracle, TwapToken: token}}, false},
+		{"invalid: denom", []FeeToken{{Denom: "", FixedRate: math.LegacyOneDec()}}, true},
+		{"invalid: no rate source", []FeeToken{{Denom: "ibc/usdc"}}, true},
+		{"invalid: zero fixed rate", []FeeToken{{Denom: "ibc/usdc", FixedRate: math.LegacyZeroDec()}}, true},
+		{"invalid: negative fixed rate", []FeeToken{{Denom: "ibc/usdc", FixedRate: math.LegacyNewDec(-1)}}, true},
+		{"invalid: both rate sources", []FeeToken{{Denom: "ibc/usdc", FixedRate: math.LegacyOneDec(), TwapOracle: oracle, TwapToken: token}}, true},
+		{"invalid: twap token without oracle", []FeeToken{{Denom: "ibc/usdc", FixedRate: math.LegacyOneDec(), TwapToken: token}}, true},
+		{"invalid: oracle address", []FeeToken{{Denom: "ibc/usdc", TwapOracle: "oracle", TwapToken: token}}, true},
+		{"invalid: missing twap token", []FeeToken{{Denom: "ibc/usdc", TwapOracle: oracle}}, true},
+		{
+			"invalid: duplicate",
+			[]FeeToken{
+				{Denom: "ibc/usdc", FixedRate: math.LegacyOneDec()},
+				{Denom: "ibc/usdc", TwapOracle: oracle, TwapToken: token},
+			},
+			true,
+		},
+		{"valid: max fee tokens", tooMany[:MaxFeeTokens], false},
+		{"invalid: too many fee tokens", tooMany, true},
+	}
+
+	for _, tc := range testCases {
+		params := DefaultParams()
+		params.FeeTokens = tc.tokens
+		err := params.Validate()
+
+		if tc.expError {
+			suite.Require().Error(err, tc.name)
+		} else {
+			suite.Require().NoError(err, tc.name)
+		}
+	}
+}
+
+func (suite *ParamsTestSuite) TestParamsValidateBaseFeeBurnFraction() {
+	testCases := []struct {
+		name     string
+		value    math.LegacyDec
+		expError bool
+	}{
+		{"default", DefaultParams().BaseFeeBurnFraction, false},
+		{"valid - nil", math.LegacyDec{}, false},
+		{"valid - half", math.LegacyNewDecWithPrec(5, 1), false},
+		{"valid - all", math.LegacyOneDec(), false},
+		{"invalid - is negative", math.LegacyNewDecWithPrec(-1, 1), true},
+		{"invalid - greater than 1", math.LegacyNewDecWithPrec(11, 1), true},
+	}
+
+	for _, tc := range testCases {
+		params := DefaultParams()
+		params.BaseFeeBurnFraction = tc.value
+		err := params.Validate()
+
+		if tc.expError {
+			suite.Require().Error(err, tc.name)
+		} else {
+			suite.Require().NoError(err, tc.name)
+		}
+	}
+}
+
+func (suite *ParamsTestSuite) TestParamsValidateFeeLanes() {
+	testCases := []struct {
+		name     string
+		malleate func(params *Params)
+		expError bool
+	}{
+		{"default", func(_ *Params) {}, false},
+		{"valid - disabled with unset lane params", func(params *Params) {
+			params.CosmosBaseFee = math.LegacyDec{}
+			params.CosmosElasticityMultiplier = 0
+			params.EvmLaneGasShare = math.LegacyDec{}
+		}, false},
+		{"valid - enabled", func(params *Params) {
+			params.EnableFeeLanes = true
+		}, false},
+		{"invalid - enabled with nil cosmos base fee", func(params *Params) {
+			params.EnableFeeLanes = true
+			params.CosmosBaseFee = math.LegacyDec{}
+		}, true},
+		{"invalid - enabled with negative cosmos base fee", func(params *Params) {
+			params.EnableFeeLanes = true
+			params.CosmosBaseFee = math.LegacyNewDec(-1)
+		}, true},
+		{"invalid - enabled with zero cosmos elasticity multiplier", func(params *Params) {
+			params.EnableFeeLanes = true
+			params.CosmosElasticityMultiplier = 0
+		}, true},
+		{"invalid - enabled with zero evm lane gas share", func(params *Params) {
+			params.EnableFeeLanes = true
+			params.EvmLaneGasShare = math.LegacyZeroDec()
+		}, true},
+		{"invalid - enabled with whole evm lane gas share", func(params *Params) {
+			params.EnableFeeLanes = true
+			params.EvmLaneGasShare = math.LegacyOneDec()
+		}, true},
+	}
+
+	for _, tc := range testCases {
+		params := DefaultParams()
+		tc.malleate(&params)
+		err := params.Validate()
+
+		if tc.expError {
+			suite.Require().Error(err, tc.name)
+		} else {
+			suite.Require().NoError(err, tc.name)
+		}
+	}
+}
+
+func (suite *ParamsTestSuite) TestParamsValidateMsgFeeFloors() {
+	const msgSend = "/cosmos.bank.v1beta1.MsgSend"
+
+	testCases := []struct {
+		name     string
+		floors   []MsgFeeFloor
+		expError bool
+	}{
+		{"valid: none", nil, false},
+		{"valid: min fee", []MsgFeeFloor{{MsgTypeUrl: msgSend, MinFee: math.NewInt(1000)}}, false},
+		{"valid: gas price multiplier", []MsgFeeFloor{{MsgTypeUrl: msgSend, GasPriceMultiplier: math.LegacyNewDec(2)}}, false},
+		{"valid: both", []MsgFeeFloor{{MsgTypeUrl: msgSend, MinFee: math.NewInt(1000), GasPriceMultiplier: math.LegacyNewDec(2)}}, false},
+		{"invalid: empty type url", []MsgFeeFloor{{MinFee: math.NewInt(1000)}}, true},
+		{"invalid: type url", []MsgFeeFloor{{MsgTypeUrl: "cosmos.bank.v1beta1.MsgSend", MinFee: math.NewInt(1000)}}, true},
+		{"invalid: no floor", []MsgFeeFloor{{MsgTypeUrl: msgSend}}, true},
+		{"invalid: zero floor", []MsgFeeFloor{{MsgTypeUrl: msgSend, MinFee: math.ZeroInt(), GasPriceMultiplier: math.LegacyZeroDec()}}, true},
+		{"invalid: negative min fee", []MsgFeeFloor{{MsgTypeUrl: msgSend, MinFee: math.NewInt(-1), GasPriceMultiplier: math.LegacyNewDec(2)}}, true},
+		{"invalid: negative gas price multiplier", []MsgFeeFloor{{MsgTypeUrl: msgSend, MinFee: math.NewInt(1), GasPriceMultiplier: math.LegacyNewDec(-2)}}, true},
+		{
+			"invalid: duplicate",
+			[]MsgFeeFloor{
+				{MsgTypeUrl: msgSend, MinFee: math.NewInt(1000)},
+				{MsgTypeUrl: msgSend, GasPriceMultiplier: math.LegacyNewDec(2)},
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		params := DefaultParams()
+		params.MsgFeeFloors = tc.floors
+		err := params.Validate()
+
+		if tc.expError {
+			suite.Require().Error(err, tc.name)
+		} else {
+			suite.Require().NoError(err, tc.name)
+		}
+	}
+}
+
+func (suite *ParamsTestSuite) TestParamsLaneGasLimits() {
+	params := DefaultParams()
+	suite.Require().Equal(uint64(100), params.EVMLaneGasLimit(100))
+	suite.Require().Equal(uint64(100), params.CosmosLaneGasLimit(100))
+	suite.Require().Equal(params.BaseFee, params.CosmosLaneBaseFee())
+
+	params.EnableFeeLanes = true
+	params.EvmLaneGasShare = math.LegacyNewDecWithPrec(3, 1)
+	params.CosmosBaseFee = math.LegacyNewDec(7)
+	suite.Require().Equal(uint64(30), params.EVMLaneGasLimit(100))
+	suite.Require().Equal(uint64(70), params.CosmosLaneGasLimit(100))
+	suite.Require().Equal(math.LegacyNewDec(7), params.CosmosLaneBaseFee())
+}
@@ -191,6 +191,83 @@ func (m *QueryBaseFeeResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryBaseFeeResponse proto.InternalMessageInfo
 
+// QueryCosmosBaseFeeRequest defines the request type for querying the base fee
+// of the Cosmos lane.
+type QueryCosmosBaseFeeRequest struct {
+}
+
+func (m *QueryCosmosBaseFeeRequest) Reset()         { *m = QueryCosmosBaseFeeRequest{} }
+func (m *QueryCosmosBaseFeeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCosmosBaseFeeRequest) ProtoMessage()    {}
+func (*QueryCosmosBaseFeeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2c588b2369eb47d1, []int{4}
+}
+func (m *QueryCosmosBaseFeeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCosmosBaseFeeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCosmosBaseFeeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCosmosBaseFeeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCosmosBaseFeeRequest.Merge(m, src)
+}
+func (m *QueryCosmosBaseFeeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCosmosBaseFeeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCosmosBaseFeeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCosmosBaseFeeRequest proto.InternalMessageInfo
+
+// QueryCosmosBaseFeeResponse returns the base fee of the Cosmos lane.
+type QueryCosmosBaseFeeResponse struct {
+	// base_fee is the base fee of the Cosmos lane
+	BaseFee *cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=base_fee,json=baseFee,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"base_fee,omitempty"`
+}
+
+func (m *QueryCosmosBaseFeeResponse) Reset()         { *m = QueryCosmosBaseFeeResponse{} }
+func (m *QueryCosmosBaseFeeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCosmosBaseFeeResponse) ProtoMessage()    {}
+func (*QueryCosmosBaseFeeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2c588b2369eb47d1, []int{5}
+}
+func (m *QueryCosmosBaseFeeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCosmosBaseFeeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCosmosBaseFeeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCosmosBaseFeeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCosmosBaseFeeResponse.Merge(m, src)
+}
+func (m *QueryCosmosBaseFeeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCosmosBaseFeeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCosmosBaseFeeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCosmosBaseFeeResponse proto.InternalMessageInfo
+
 // QueryBlockGasRequest defines the request type for querying the EIP1559 base
 // fee.
 type QueryBlockGasRequest struct {
@@ -200,7 +277,7 @@ func (m *QueryBlockGasRequest) Reset()         { *m = QueryBlockGasRequest{} }
 func (m *QueryBlockGasRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryBlockGasRequest) ProtoMessage()    {}
 func (*QueryBlockGasRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2c588b2369eb47d1, []int{4}
+	return fileDescriptor_2c588b2369eb47d1, []int{6}
 }
 func (m *QueryBlockGasRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -239,7 +316,7 @@ func (m *QueryBlockGasResponse) Reset()         { *m = QueryBlockGasResponse{} }
 func (m *QueryBlockGasResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryBlockGasResponse) ProtoMessage()    {}
 func (*QueryBlockGasResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2c588b2369eb47d1, []int{5}
+	return fileDescriptor_2c588b2369eb47d1, []int{7}
 }
 func (m *QueryBlockGasResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -275,13 +352,195 @@ func (m *QueryBlockGasResponse) GetGas() int64 {
 	return 0
 }
 
+// QueryBurnedFeesRequest defines the request type for querying the cumulative
+// amount of base fee revenue burned.
+type QueryBurnedFeesRequest struct {
+}
+
+func (m *QueryBurnedFeesRequest) Reset()         { *m = QueryBurnedFeesRequest{} }
+func (m *QueryBurnedFeesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBurnedFeesRequest) ProtoMessage()    {}
+func (*QueryBurnedFeesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2c588b2369eb47d1, []int{8}
+}
+func (m *QueryBurnedFeesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBurnedFeesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBurnedFeesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBurnedFeesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBurnedFeesRequest.Merge(m, src)
+}
+func (m *QueryBurnedFeesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBurnedFeesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBurnedFeesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBurnedFeesRequest proto.InternalMessageInfo
+
+// QueryBurnedFeesResponse returns the cumulative amount of base fee revenue
+// burned.
+type QueryBurnedFeesResponse struct {
+	// total_burned_fees is the cumulative amount burned, in the extended EVM
+	// denom
+	TotalBurnedFees cosmossdk_io_math.Int `protobuf:"bytes,1,opt,name=total_burned_fees,json=totalBurnedFees,proto3,customtype=cosmossdk.io/math.Int" json:"total_burned_fees"`
+}
+
+func (m *QueryBurnedFeesResponse) Reset()         { *m = QueryBurnedFeesResponse{} }
+func (m *QueryBurnedFeesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBurnedFeesResponse) ProtoMessage()    {}
+func (*QueryBurnedFeesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2c588b2369eb47d1, []int{9}
+}
+func (m *QueryBurnedFeesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBurnedFeesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBurnedFeesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBurnedFeesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBurnedFeesResponse.Merge(m, src)
+}
+func (m *QueryBurnedFeesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBurnedFeesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBurnedFeesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBurnedFeesResponse proto.InternalMessageInfo
+
+// QueryMsgFeeFloorsRequest defines the request type for querying the fee
+// floors of the message types.
+type QueryMsgFeeFloorsRequest struct {
+	// msg_type_urls filters the fee floors by message type url, all the fee
+	// floors are returned if empty
+	MsgTypeUrls []string `protobuf:"bytes,1,rep,name=msg_type_urls,json=msgTypeUrls,proto3" json:"msg_type_urls,omitempty"`
+}
+
+func (m *QueryMsgFeeFloorsRequest) Reset()         { *m = QueryMsgFeeFloorsRequest{} }
+func (m *QueryMsgFeeFloorsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryMsgFeeFloorsRequest) ProtoMessage()    {}
+func (*QueryMsgFeeFloorsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2c588b2369eb47d1, []int{10}
+}
+func (m *QueryMsgFeeFloorsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMsgFeeFloorsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMsgFeeFloorsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMsgFeeFloorsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMsgFeeFloorsRequest.Merge(m, src)
+}
+func (m *QueryMsgFeeFloorsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMsgFeeFloorsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMsgFeeFloorsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMsgFeeFloorsRequest proto.InternalMessageInfo
+
+func (m *QueryMsgFeeFloorsRequest) GetMsgTypeUrls() []string {
+	if m != nil {
+		return m.MsgTypeUrls
+	}
+	return nil
+}
+
+// QueryMsgFeeFloorsResponse returns the fee floors of the message types.
+type QueryMsgFeeFloorsResponse struct {
+	// msg_fee_floors are the fee floors of the message types
+	MsgFeeFloors []MsgFeeFloor `protobuf:"bytes,1,rep,name=msg_fee_floors,json=msgFeeFloors,proto3" json:"msg_fee_floors"`
+	// min_gas_price is the minimum gas price multiplied by the gas price
+	// multipliers of the fee floors
+	MinGasPrice cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=min_gas_price,json=minGasPrice,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"min_gas_price"`
+}
+
+func (m *QueryMsgFeeFloorsResponse) Reset()         { *m = QueryMsgFeeFloorsResponse{} }
+func (m *QueryMsgFeeFloorsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryMsgFeeFloorsResponse) ProtoMessage()    {}
+func (*QueryMsgFeeFloorsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2c588b2369eb47d1, []int{11}
+}
+func (m *QueryMsgFeeFloorsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMsgFeeFloorsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMsgFeeFloorsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMsgFeeFloorsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMsgFeeFloorsResponse.Merge(m, src)
+}
+func (m *QueryMsgFeeFloorsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMsgFeeFloorsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMsgFeeFloorsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMsgFeeFloorsResponse proto.InternalMessageInfo
+
+func (m *QueryMsgFeeFloorsResponse) GetMsgFeeFloors() []MsgFeeFloor {
+	if m != nil {
+		return m.MsgFeeFloors
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "cosmos.evm.feemarket.v1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "cosmos.evm.feemarket.v1.QueryParamsResponse")
 	proto.RegisterType((*QueryBaseFeeRequest)(nil), "cosmos.evm.feemarket.v1.QueryBaseFeeRequest")
 	proto.RegisterType((*QueryBaseFeeResponse)(nil), "cosmos.evm.feemarket.v1.QueryBaseFeeResponse")
+	proto.RegisterType((*QueryCosmosBaseFeeRequest)(nil), "cosmos.evm.feemarket.v1.QueryCosmosBaseFeeRequest")
+	proto.RegisterType((*QueryCosmosBaseFeeResponse)(nil), "cosmos.evm.feemarket.v1.QueryCosmosBaseFeeResponse")
 	proto.RegisterType((*QueryBlockGasRequest)(nil), "cosmos.evm.feemarket.v1.QueryBlockGasRequest")
 	proto.RegisterType((*QueryBlockGasResponse)(nil), "cosmos.evm.feemarket.v1.QueryBlockGasResponse")
+	proto.RegisterType((*QueryBurnedFeesRequest)(nil), "cosmos.evm.feemarket.v1.QueryBurnedFeesRequest")
+	proto.RegisterType((*QueryBurnedFeesResponse)(nil), "cosmos.evm.feemarket.v1.QueryBurnedFeesResponse")
+	proto.RegisterType((*QueryMsgFeeFloorsRequest)(nil), "cosmos.evm.feemarket.v1.QueryMsgFeeFloorsRequest")
+	proto.RegisterType((*QueryMsgFeeFloorsResponse)(nil), "cosmos.evm.feemarket.v1.QueryMsgFeeFloorsResponse")
 }
 
 func init() {
@@ -289,36 +548,54 @@ func init() {
 }
 
 var fileDescriptor_2c588b2369eb47d1 = []byte{
-	// 458 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0x31, 0x6f, 0xd3, 0x40,
-	0x14, 0xce, 0x11, 0x91, 0xb6, 0xc7, 0x02, 0x47, 0x0a, 0xc8, 0x20, 0x9b, 0xba, 0x48, 0xa1, 0x05,
-	0xee, 0xd4, 0xb2, 0xb1, 0x61, 0x21, 0x58, 0x18, 0xc0, 0x1b, 0x2c, 0xd5, 0xc5, 0xbc, 0x5e, 0xad,
-	0xf4, 0x7c, 0x6e, 0xee, 0x12, 0x91, 0x95, 0x99, 0x01, 0x84, 0xf8, 0x0f, 0x8c, 0xfc, 0x06, 0xa6,
-	0x8e, 0x95, 0x58, 0x10, 0x43, 0x85, 0x12, 0x24, 0xfe, 0x06, 0xca, 0xdd, 0xb9, 0xd4, 0x54, 0x56,
-	0xb3, 0x58, 0x4f, 0xcf, 0xdf, 0xfb, 0xbe, 0xef, 0x7d, 0x4f, 0x87, 0xd7, 0x33, 0xa5, 0xa5, 0xd2,
-	0x0c, 0xc6, 0x92, 0xed, 0x02, 0x48, 0x3e, 0x1c, 0x80, 0x61, 0xe3, 0x2d, 0x76, 0x30, 0x82, 0xe1,
-	0x84, 0x96, 0x43, 0x65, 0x14, 0xb9, 0xee, 0x40, 0x14, 0xc6, 0x92, 0x9e, 0x80, 0xe8, 0x78, 0x2b,
-	0xb8, 0xc2, 0x65, 0x5e, 0x28, 0x66, 0xbf, 0x0e, 0x1b, 0xf4, 0x9a, 0x08, 0xff, 0x0d, 0x3a, 0x60,
-	0x57, 0x28, 0xa1, 0x6c, 0xc9, 0xe6, 0x95, 0xef, 0xde, 0x12, 0x4a, 0x89, 0x7d, 0x60, 0xbc, 0xcc,
-	0x19, 0x2f, 0x0a, 0x65, 0xb8, 0xc9, 0x55, 0xa1, 0xdd, 0xdf, 0xb8, 0x8b, 0xc9, 0xcb, 0xb9, 0xaf,
-	0x17, 0x7c, 0xc8, 0xa5, 0x4e, 0xe1, 0x60, 0x04, 0xda, 0xc4, 0xaf, 0xf0, 0xd5, 0x5a, 0x57, 0x97,
-	0xaa, 0xd0, 0x40, 0x12, 0xdc, 0x29, 0x6d, 0xe7, 0x06, 0xba, 0x8d, 0xee, 0x5e, 0xda, 0x8e, 0x68,
-	0xc3, 0x1a, 0xd4, 0x0d, 0x26, 0x2b, 0x87, 0xc7, 0x51, 0xeb, 0xcb, 0x9f, 0xaf, 0x9b, 0x28, 0xf5,
-	0x93, 0xf1, 0xaa, 0xa7, 0x4e, 0xb8, 0x86, 0xa7, 0x00, 0x95, 0x62, 0x8a, 0xbb, 0xf5, 0xb6, 0x97,
-	0x7c, 0x84, 0x97, 0xfb, 0x5c, 0xc3, 0xce, 0x2e, 0x80, 0x15, 0x5d, 0x49, 0xa2, 0x9f, 0xc7, 0xd1,
-	0x4d, 0xa7, 0xab, 0xdf, 0x0c, 0x68, 0xae, 0x98, 0xe4, 0x66, 0x8f, 0x3e, 0x07, 0xc1, 0xb3, 0xc9,
-	0x13, 0xc8, 0xd2, 0xa5, 0xbe, 0xe3, 0x88, 0xaf, 0x55, 0x9c, 0xfb, 0x2a, 0x1b, 0x3c, 0xe3, 0x27,
-	0xdb, 0x6d, 0xe0, 0xd5, 0xff, 0xfa, 0x5e, 0xec, 0x32, 0x6e, 0x0b, 0xee, 0x96, 0x6b, 0xa7, 0xf3,
-	0x72, 0xfb, 0x5b, 0x1b, 0x5f, 0xb4, 0x58, 0xf2, 0x1e, 0xe1, 0x8e, 0xdb, 0x8a, 0xdc, 0x6b, 0x5c,
-	0xfb, 0x6c, 0x94, 0xc1, 0xfd, 0xc5, 0xc0, 0xce, 0x41, 0xdc, 0x7b, 0xf7, 0xfd, 0xf7, 0xa7, 0x0b,
-	0x6b, 0x24, 0x62, 0x4d, 0x47, 0x77, 0x31, 0x92, 0x8f, 0x08, 0x2f, 0xf9, 0xac, 0xc8, 0x39, 0x12,
-	0xf5, 0xa4, 0x83, 0x07, 0x0b, 0xa2, 0xbd, 0xa3, 0x0d, 0xeb, 0x68, 0x9d, 0xac, 0x35, 0x3a, 0xaa,
-	0xee, 0x43, 0x3e, 0x23, 0xbc, 0x5c, 0x65, 0x4a, 0xce, 0x93, 0xa9, 0xdf, 0x24, 0xa0, 0x8b, 0xc2,
-	0xbd, 0xad, 0x4d, 0x6b, 0xeb, 0x0e, 0x89, 0x9b, 0x6d, 0xcd, 0x47, 0x76, 0x04, 0xd7, 0xc9, 0xe3,
-	0xc3, 0x69, 0x88, 0x8e, 0xa6, 0x21, 0xfa, 0x35, 0x0d, 0xd1, 0x87, 0x59, 0xd8, 0x3a, 0x9a, 0x85,
-	0xad, 0x1f, 0xb3, 0xb0, 0xf5, 0xba, 0x27, 0x72, 0xb3, 0x37, 0xea, 0xd3, 0x4c, 0xc9, 0xd3, 0x3c,
-	0x6f, 0x4f, 0x31, 0x99, 0x49, 0x09, 0xba, 0xdf, 0xb1, 0xaf, 0xe5, 0xe1, 0xdf, 0x00, 0x00, 0x00,
-	0xff, 0xff, 0x7d, 0x9a, 0xf3, 0x49, 0xdd, 0x03, 0x00, 0x00,
+	// 741 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x95, 0xcd, 0x4f, 0x13, 0x4f,
+	0x18, 0xc7, 0xbb, 0x90, 0x1f, 0x2f, 0x53, 0xf8, 0x29, 0x23, 0x2f, 0x75, 0x31, 0xad, 0x2c, 0xc4,
+	0x16, 0xc4, 0x5d, 0x28, 0x37, 0x0f, 0x26, 0x56, 0x53, 0x62, 0x82, 0x06, 0x1b, 0x49, 0xd4, 0xcb,
+	0x66, 0x5a, 0x9e, 0x2e, 0x1b, 0xba, 0x3b, 0xcb, 0xce, 0xb6, 0xb1, 0x57, 0xcf, 0x1e, 0x34, 0xc6,
+	0xa3, 0x57, 0x63, 0x3c, 0x79, 0xf4, 0xe8, 0x91, 0x23, 0x89, 0x17, 0xc3, 0x81, 0x18, 0x30, 0xf1,
+	0xdf, 0x30, 0x3b, 0x33, 0x7d, 0x59, 0x60, 0x69, 0x49, 0xbc, 0x34, 0x9b, 0x99, 0xe7, 0xf9, 0x7e,
+	0x3f, 0xf3, 0xcc, 0xf3, 0x4c, 0xd1, 0x7c, 0x85, 0x32, 0x87, 0x32, 0x03, 0x1a, 0x8e, 0x51, 0x05,
+	0x70, 0x88, 0xbf, 0x0b, 0x81, 0xd1, 0x58, 0x35, 0xf6, 0xea, 0xe0, 0x37, 0x75, 0xcf, 0xa7, 0x01,
+	0xc5, 0x33, 0x22, 0x48, 0x87, 0x86, 0xa3, 0xb7, 0x83, 0xf4, 0xc6, 0xaa, 0x3a, 0x41, 0x1c, 0xdb,
+	0xa5, 0x06, 0xff, 0x15, 0xb1, 0x6a, 0x36, 0x4e, 0xb0, 0x93, 0x28, 0x02, 0x27, 0x2d, 0x6a, 0x51,
+	0xfe, 0x69, 0x84, 0x5f, 0x72, 0xf5, 0x86, 0x45, 0xa9, 0x55, 0x03, 0x83, 0x78, 0xb6, 0x41, 0x5c,
+	0x97, 0x06, 0x24, 0xb0, 0xa9, 0xcb, 0xc4, 0xae, 0x36, 0x89, 0xf0, 0xd3, 0x90, 0x6b, 0x93, 0xf8,
+	0xc4, 0x61, 0x25, 0xd8, 0xab, 0x03, 0x0b, 0xb4, 0x17, 0xe8, 0x5a, 0x64, 0x95, 0x79, 0xd4, 0x65,
+	0x80, 0x0b, 0x68, 0xc8, 0xe3, 0x2b, 0x29, 0xe5, 0xa6, 0x92, 0x4b, 0xe6, 0x33, 0x7a, 0xcc, 0x31,
+	0x74, 0x91, 0x58, 0x18, 0xdd, 0x3f, 0xca, 0x24, 0x3e, 0xff, 0xf9, 0xba, 0xa4, 0x94, 0x64, 0xa6,
+	0x36, 0x25, 0xa5, 0x0b, 0x84, 0x41, 0x11, 0xa0, 0xe5, 0x58, 0x42, 0x93, 0xd1, 0x65, 0x69, 0x79,
+	0x17, 0x8d, 0x94, 0x09, 0x03, 0xb3, 0x0a, 0xc0, 0x4d, 0x47, 0x0b, 0x99, 0xc3, 0xa3, 0xcc, 0xac,
+	0xf0, 0x65, 0xdb, 0xbb, 0xba, 0x4d, 0x0d, 0x87, 0x04, 0x3b, 0xfa, 0x06, 0x58, 0xa4, 0xd2, 0x7c,
+	0x08, 0x95, 0xd2, 0x70, 0x59, 0x68, 0x68, 0xb3, 0xe8, 0x3a, 0xd7, 0x7c, 0xc0, 0x83, 0x4f, 0x19,
+	0x3e, 0x47, 0xea, 0x79, 0x9b, 0xff, 0xc0, 0x76, 0xba, 0x75, 0x94, 0x1a, 0xad, 0xec, 0xae, 0x93,
+	0x76, 0x51, 0x17, 0xd1, 0xd4, 0xa9, 0x75, 0x69, 0x76, 0x15, 0x0d, 0x5a, 0x44, 0xd4, 0x74, 0xb0,
+	0x14, 0x7e, 0x6a, 0x29, 0x34, 0x2d, 0x42, 0xeb, 0xbe, 0x0b, 0xdb, 0x45, 0x80, 0xb6, 0x88, 0x8d,
+	0x66, 0xce, 0xec, 0x48, 0x99, 0x27, 0x68, 0x22, 0xa0, 0x01, 0xa9, 0x99, 0x65, 0xbe, 0x17, 0xb2,
+	0x33, 0x09, 0xaf, 0x85, 0xf7, 0x70, 0x78, 0x94, 0x99, 0x3a, 0x7b, 0x80, 0x47, 0x6e, 0x20, 0x2e,
+	0xe8, 0x0a, 0x4f, 0xee, 0xe8, 0x6a, 0xf7, 0x50, 0x8a, 0x5b, 0x3d, 0x66, 0x56, 0x11, 0xa0, 0x58,
+	0xa3, 0xd4, 0x6f, 0x61, 0x60, 0x0d, 0x8d, 0x3b, 0xcc, 0x32, 0x83, 0xa6, 0x07, 0x66, 0xdd, 0xaf,
+	0x85, 0x3e, 0x83, 0xb9, 0xd1, 0x52, 0xd2, 0x61, 0xd6, 0xb3, 0xa6, 0x07, 0x5b, 0x7e, 0x8d, 0x69,
+	0xdf, 0x15, 0x59, 0xff, 0xa8, 0x80, 0xa4, 0xdd, 0x42, 0xff, 0x87, 0x0a, 0x55, 0x00, 0xb3, 0xca,
+	0x77, 0xb8, 0x44, 0x32, 0xbf, 0x10, 0xdb, 0x53, 0x5d, 0x32, 0xdd, 0x8d, 0x35, 0xe6, 0x74, 0xc9,
+	0xe3, 0x0d, 0x34, 0xee, 0xd8, 0xae, 0x69, 0x11, 0x66, 0x7a, 0xbe, 0x5d, 0x81, 0xd4, 0x00, 0x2f,
+	0x40, 0x4e, 0x16, 0xe0, 0xa2, 0x1b, 0x14, 0x72, 0x49, 0xc7, 0x76, 0xd7, 0x09, 0xdb, 0x0c, 0x93,
+	0xf3, 0xdf, 0x86, 0xd1, 0x7f, 0xfc, 0x08, 0xf8, 0x8d, 0x82, 0x86, 0x44, 0x53, 0xe3, 0xdb, 0xb1,
+	0x84, 0x67, 0x27, 0x49, 0x5d, 0xee, 0x2f, 0x58, 0x14, 0x45, 0xcb, 0xbe, 0xfe, 0xf1, 0xfb, 0xfd,
+	0xc0, 0x1c, 0xce, 0x18, 0x71, 0x33, 0x2f, 0xa6, 0x08, 0xbf, 0x53, 0xd0, 0xb0, 0xec, 0x59, 0xdc,
+	0xc3, 0x22, 0xda, 0xf7, 0xea, 0x9d, 0x3e, 0xa3, 0x25, 0xd1, 0x22, 0x27, 0x9a, 0xc7, 0x73, 0xb1,
+	0x44, 0xad, 0x39, 0xc1, 0x5f, 0x14, 0x34, 0x1e, 0x99, 0x26, 0x9c, 0xbf, 0xd8, 0xeb, 0xbc, 0xb9,
+	0x54, 0xd7, 0x2e, 0x95, 0x23, 0x29, 0x57, 0x38, 0xe5, 0x12, 0xce, 0xc5, 0x52, 0x8a, 0x75, 0xb3,
+	0x0d, 0xfb, 0x41, 0x41, 0x23, 0xad, 0x41, 0xc4, 0xbd, 0x6a, 0x12, 0x1d, 0x64, 0x55, 0xef, 0x37,
+	0x5c, 0xd2, 0x2d, 0x71, 0xba, 0x05, 0xac, 0xc5, 0xd7, 0x30, 0x4c, 0x09, 0x9b, 0x16, 0x7f, 0x54,
+	0x10, 0xea, 0xcc, 0x20, 0x36, 0x7a, 0x58, 0x9d, 0x7e, 0x1f, 0xd4, 0x95, 0xfe, 0x13, 0x24, 0xdd,
+	0x32, 0xa7, 0xbb, 0x85, 0x17, 0xe2, 0xe9, 0x3a, 0xef, 0x09, 0xfe, 0xa4, 0xa0, 0xb1, 0xee, 0x79,
+	0xc6, 0xab, 0x17, 0x1b, 0x9e, 0xf3, 0x78, 0xa8, 0xf9, 0xcb, 0xa4, 0x48, 0x4a, 0x83, 0x53, 0x2e,
+	0xe2, 0x6c, 0x2c, 0x65, 0xf4, 0x35, 0x29, 0xdc, 0xdf, 0x3f, 0x4e, 0x2b, 0x07, 0xc7, 0x69, 0xe5,
+	0xd7, 0x71, 0x5a, 0x79, 0x7b, 0x92, 0x4e, 0x1c, 0x9c, 0xa4, 0x13, 0x3f, 0x4f, 0xd2, 0x89, 0x97,
+	0x59, 0xcb, 0x0e, 0x76, 0xea, 0x65, 0xbd, 0x42, 0x9d, 0x6e, 0xb1, 0x57, 0x5d, 0x72, 0xe1, 0xbb,
+	0xc6, 0xca, 0x43, 0xfc, 0x2f, 0x72, 0xed, 0x6f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x1e, 0x57, 0x6c,
+	0xdc, 0xd2, 0x07, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -337,8 +614,16 @@ type QueryClient interface {
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
 	// BaseFee queries the base fee of the parent block of the current block.
 	BaseFee(ctx context.Context, in *QueryBaseFeeRequest, opts ...grpc.CallOption) (*QueryBaseFeeResponse, error)
+	// CosmosBaseFee queries the base fee of the Cosmos lane of the current
+	// block. It is the same as the base fee when fee lanes are disabled.
+	CosmosBaseFee(ctx context.Context, in *QueryCosmosBaseFeeRequest, opts ...grpc.CallOption) (*QueryCosmosBaseFeeResponse, error)
 	// BlockGas queries the gas used at a given block height
 	BlockGas(ctx context.Context, in *QueryBlockGasRequest, opts ...grpc.CallOption) (*QueryBlockGasResponse, error)
+	// BurnedFees queries the cumulative amount of base fee revenue burned
+	BurnedFees(ctx context.Context, in *QueryBurnedFeesRequest, opts ...grpc.CallOption) (*QueryBurnedFeesResponse, error)
+	// MsgFeeFloors queries the fee floors of the message types, so that the fee
+	// of a cosmos transaction can be estimated from the messages it includes.
+	MsgFeeFloors(ctx context.Context, in *QueryMsgFeeFloorsRequest, opts ...grpc.CallOption) (*QueryMsgFeeFloorsResponse, error)
 }
 
 type queryClient struct {
@@ -367,6 +652,15 @@ func (c *queryClient) BaseFee(ctx context.Context, in *QueryBaseFeeRequest, opts
 	return out, nil
 }
 
+func (c *queryClient) CosmosBaseFee(ctx context.Context, in *QueryCosmosBaseFeeRequest, opts ...grpc.CallOption) (*QueryCosmosBaseFeeResponse, error) {
+	out := new(QueryCosmosBaseFeeResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.evm.feemarket.v1.Query/CosmosBaseFee", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) BlockGas(ctx context.Context, in *QueryBlockGasRequest, opts ...grpc.CallOption) (*QueryBlockGasResponse, error) {
 	out := new(QueryBlockGasResponse)
 	err := c.cc.Invoke(ctx, "/cosmos.evm.feemarket.v1.Query/BlockGas", in, out, opts...)
@@ -376,14 +670,40 @@ func (c *queryClient) BlockGas(ctx context.Context, in *QueryBlockGasRequest, op
 	return out, nil
 }
 
+func (c *queryClient) BurnedFees(ctx context.Context, in *QueryBurnedFeesRequest, opts ...grpc.CallOption) (*QueryBurnedFeesResponse, error) {
+	out := new(QueryBurnedFeesResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.evm.feemarket.v1.Query/BurnedFees", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MsgFeeFloors(ctx context.Context, in *QueryMsgFeeFloorsRequest, opts ...grpc.CallOption) (*QueryMsgFeeFloorsResponse, error) {
+	out := new(QueryMsgFeeFloorsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.evm.feemarket.v1.Query/MsgFeeFloors", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params queries the parameters of x/feemarket module.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
 	// BaseFee queries the base fee of the parent block of the current block.
 	BaseFee(context.Context, *QueryBaseFeeRequest) (*QueryBaseFeeResponse, error)
+	// CosmosBaseFee queries the base fee of the Cosmos lane of the current
+	// block. It is the same as the base fee when fee lanes are disabled.
+	CosmosBaseFee(context.Context, *QueryCosmosBaseFeeRequest) (*QueryCosmosBaseFeeResponse, error)
 	// BlockGas queries the gas used at a given block height
 	BlockGas(context.Context, *QueryBlockGasRequest) (*QueryBlockGasResponse, error)
+	// BurnedFees queries the cumulative amount of base fee revenue burned
+	BurnedFees(context.Context, *QueryBurnedFeesRequest) (*QueryBurnedFeesResponse, error)
+	// MsgFeeFloors queries the fee floors of the message types, so that the fee
+	// of a cosmos transaction can be estimated from the messages it includes.
+	MsgFeeFloors(context.Context, *QueryMsgFeeFloorsRequest) (*QueryMsgFeeFloorsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -396,9 +716,18 @@ func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsReq
 func (*UnimplementedQueryServer) BaseFee(ctx context.Context, req *QueryBaseFeeRequest) (*QueryBaseFeeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BaseFee not implemented")
 }
+func (*UnimplementedQueryServer) CosmosBaseFee(ctx context.Context, req *QueryCosmosBaseFeeRequest) (*QueryCosmosBaseFeeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CosmosBaseFee not implemented")
+}
 func (*UnimplementedQueryServer) BlockGas(ctx context.Context, req *QueryBlockGasRequest) (*QueryBlockGasResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BlockGas not implemented")
 }
+func (*UnimplementedQueryServer) BurnedFees(ctx context.Context, req *QueryBurnedFeesRequest) (*QueryBurnedFeesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BurnedFees not implemented")
+}
+func (*UnimplementedQueryServer) MsgFeeFloors(ctx context.Context, req *QueryMsgFeeFloorsRequest) (*QueryMsgFeeFloorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MsgFeeFloors not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -440,6 +769,24 @@ func _Query_BaseFee_Handler(srv interface{}, ctx context.Context, dec func(inter
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_CosmosBaseFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCosmosBaseFeeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CosmosBaseFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.evm.feemarket.v1.Query/CosmosBaseFee",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CosmosBaseFee(ctx, req.(*QueryCosmosBaseFeeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_BlockGas_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryBlockGasRequest)
 	if err := dec(in); err != nil {
@@ -458,6 +805,42 @@ func _Query_BlockGas_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_BurnedFees_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBurnedFeesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BurnedFees(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.evm.feemarket.v1.Query/BurnedFees",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BurnedFees(ctx, req.(*QueryBurnedFeesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MsgFeeFloors_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMsgFeeFloorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MsgFeeFloors(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.evm.feemarket.v1.Query/MsgFeeFloors",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MsgFeeFloors(ctx, req.(*QueryMsgFeeFloorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Query_serviceDesc = _Query_serviceDesc
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.evm.feemarket.v1.Query",
@@ -471,10 +854,22 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "BaseFee",
 			Handler:    _Query_BaseFee_Handler,
 		},
+		{
+			MethodName: "CosmosBaseFee",
+			Handler:    _Query_CosmosBaseFee_Handler,
+		},
 		{
 			MethodName: "BlockGas",
 			Handler:    _Query_BlockGas_Handler,
 		},
+		{
+			MethodName: "BurnedFees",
+			Handler:    _Query_BurnedFees_Handler,
+		},
+		{
+			MethodName: "MsgFeeFloors",
+			Handler:    _Query_MsgFeeFloors_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/evm/feemarket/v1/query.proto",
@@ -594,7 +989,7 @@ func (m *QueryBaseFeeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryBlockGasRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryCosmosBaseFeeRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -604,12 +999,12 @@ func (m *QueryBlockGasRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryBlockGasRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryCosmosBaseFeeRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryBlockGasRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryCosmosBaseFeeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -617,7 +1012,7 @@ func (m *QueryBlockGasRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryBlockGasResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryCosmosBaseFeeResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -627,47 +1022,240 @@ func (m *QueryBlockGasResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryBlockGasResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryCosmosBaseFeeResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryBlockGasResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryCosmosBaseFeeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Gas != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Gas))
+	if m.BaseFee != nil {
+		{
+			size := m.BaseFee.Size()
+			i -= size
+			if _, err := m.BaseFee.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryBlockGasRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+
+func (m *QueryBlockGasRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryBlockGasRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBlockGasResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBlockGasResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBlockGasResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Gas != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Gas))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBurnedFeesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBurnedFeesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBurnedFeesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBurnedFeesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBurnedFeesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBurnedFeesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.TotalBurnedFees.Size()
+		i -= size
+		if _, err := m.TotalBurnedFees.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMsgFeeFloorsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMsgFeeFloorsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMsgFeeFloorsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MsgTypeUrls) > 0 {
+		for iNdEx := len(m.MsgTypeUrls) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.MsgTypeUrls[iNdEx])
+			copy(dAtA[i:], m.MsgTypeUrls[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.MsgTypeUrls[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMsgFeeFloorsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMsgFeeFloorsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMsgFeeFloorsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MinGasPrice.Size()
+		i -= size
+		if _, err := m.MinGasPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.MsgFeeFloors) > 0 {
+		for iNdEx := len(m.MsgFeeFloors) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MsgFeeFloors[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
 	}
 	var l int
 	_ = l
@@ -698,6 +1286,28 @@ func (m *QueryBaseFeeResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryCosmosBaseFeeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryCosmosBaseFeeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BaseFee != nil {
+		l = m.BaseFee.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func (m *QueryBlockGasRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -719,6 +1329,58 @@ func (m *QueryBlockGasResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryBurnedFeesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryBurnedFeesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TotalBurnedFees.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryMsgFeeFloorsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.MsgTypeUrls) > 0 {
+		for _, s := range m.MsgTypeUrls {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryMsgFeeFloorsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.MsgFeeFloors) > 0 {
+		for _, e := range m.MsgFeeFloors {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = m.MinGasPrice.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -994,7 +1656,7 @@ func (m *QueryBaseFeeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBlockGasRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryCosmosBaseFeeRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1017,10 +1679,10 @@ func (m *QueryBlockGasRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBlockGasRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCosmosBaseFeeRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBlockGasRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCosmosBaseFeeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1044,7 +1706,7 @@ func (m *QueryBlockGasRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBlockGasResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryCosmosBaseFeeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1067,17 +1729,17 @@ func (m *QueryBlockGasResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBlockGasResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCosmosBaseFeeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBlockGasResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCosmosBaseFeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Gas", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFee", wireType)
 			}
-			m.Gas = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1087,11 +1749,481 @@ func (m *QueryBlockGasResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Gas |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.LegacyDec
+			m.BaseFee = &v
+			if err := m.BaseFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBlockGasRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBlockGasRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBlockGasRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBlockGasResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBlockGasResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBlockGasResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Gas", wireType)
+			}
+			m.Gas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Gas |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBurnedFeesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBurnedFeesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBurnedFeesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBurnedFeesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBurnedFeesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBurnedFeesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalBurnedFees", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalBurnedFees.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMsgFeeFloorsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMsgFeeFloorsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMsgFeeFloorsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrls", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrls = append(m.MsgTypeUrls, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMsgFeeFloorsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMsgFeeFloorsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMsgFeeFloorsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgFeeFloors", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgFeeFloors = append(m.MsgFeeFloors, MsgFeeFloor{})
+			if err := m.MsgFeeFloors[len(m.MsgFeeFloors)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinGasPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinGasPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
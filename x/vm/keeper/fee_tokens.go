@@ -0,0 +1,184 @@
+package keeper
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	feemarkettypes "github.com/cosmos/evm/x/feemarket/types"
+	"github.com/cosmos/evm/x/vm/types"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// feeTokenOracleGasCap is the gas limit of the calls to the TWAP oracles of
+// the fee tokens.
+const feeTokenOracleGasCap = 200_000
+
+// feeTokenRate is the result of the consultation of the TWAP oracle of a fee token.
+type feeTokenRate struct {
+	rate math.LegacyDec
+	err  error
+}
+
+// GetFeeTokenRate returns the rate of a whitelisted fee token, i.e. the amount
+// of the EVM denom one unit of the token is worth, either fixed by governance
+// or consulted on the TWAP oracle of the token.
+//
+// The oracle is consulted on the state at the first request of a block, and its
+// rate is kept in the object store for the rest of the block, so its gas isn't
+// charged to anyone but bounded to feeTokenOracleGasCap per fee token and block.
+func (k Keeper) GetFeeTokenRate(ctx sdk.Context, token feemarkettypes.FeeToken) (math.LegacyDec, error) {
+	if token.HasFixedRate() {
+		return token.FixedRate, nil
+	}
+
+	return k.cachedFeeTokenRate(ctx, token, func() (math.LegacyDec, error) {
+		return k.consultFeeTokenOracle(ctx, token)
+	})
+}
+
+// cachedFeeTokenRate returns the rate of the fee token held by the object store
+// for the block, consulting it if it isn't held yet. The failed consultations
+// are held too, so that a broken oracle isn't consulted for every transaction.
+func (k Keeper) cachedFeeTokenRate(ctx sdk.Context, token feemarkettypes.FeeToken, consult func() (math.LegacyDec, error)) (math.LegacyDec, error) {
+	store := ctx.ObjectStore(k.objectKey)
+	key := types.ObjectFeeTokenRateKey(token.Denom, token.TwapOracle, token.TwapToken)
+	if v := store.Get(key); v != nil {
+		cached := v.(feeTokenRate)
+		return cached.rate, cached.err
+	}
+
+	rate, err := consult()
+	store.Set(key, feeTokenRate{rate: rate, err: err})
+	return rate, err
+}
+
+// consultFeeTokenOracle returns the rate of a fee token from its TWAP oracle.
+func (k Keeper) consultFeeTokenOracle(ctx sdk.Context, token feemarkettypes.FeeToken) (math.LegacyDec, error) {
+	data, err := types.FeeTokenOracleABI.Pack("consult", common.HexToAddress(token.TwapToken), types.FeeTokenOracleAmountIn)
+	if err != nil {
+		return math.LegacyDec{}, errorsmod.Wrap(types.ErrABIPack, err.Error())
+	}
+
+	oracle := common.HexToAddress(token.TwapOracle)
+	msg := core.Message{
+		From:       common.BytesToAddress(k.accountKeeper.GetModuleAddress(types.ModuleName)),
+		To:         &oracle,
+		Value:      big.NewInt(0),
+		GasLimit:   feeTokenOracleGasCap,
+		GasPrice:   big.NewInt(0),
+		GasTipCap:  big.NewInt(0),
+		GasFeeCap:  big.NewInt(0),
+		Data:       data,
+		AccessList: ethtypes.AccessList{},
+	}
+
+	// the oracle is consulted on a branch of the state, without charging the gas
+	// of the transaction that pays the fees
+	cacheCtx, _ := ctx.WithGasMeter(storetypes.NewInfiniteGasMeter()).CacheContext()
+	res, err := k.ApplyMessage(cacheCtx, msg, nil, false, true)
+	if err != nil {
+		return math.LegacyDec{}, errorsmod.Wrapf(err, "failed to consult the twap oracle %s of fee token %s", oracle, token.Denom)
+	}
+	if res.Failed() {
+		return math.LegacyDec{}, errorsmod.Wrapf(types.ErrVMExecution, "failed to consult the twap oracle %s of fee token %s: %s", oracle, token.Denom, res.VmError)
+	}
+
+	var amountOut *big.Int
+	if err := types.FeeTokenOracleABI.UnpackIntoInterface(&amountOut, "consult", res.Ret); err != nil {
+		return math.LegacyDec{}, errorsmod.Wrapf(err, "invalid response of the twap oracle %s of fee token %s", oracle, token.Denom)
+	}
+	if amountOut.Sign() <= 0 {
+		return math.LegacyDec{}, fmt.Errorf("twap oracle %s returned a zero rate for fee token %s", oracle, token.Denom)
+	}
+
+	// the oracle is consulted with 10^18 units of the token
+	return math.LegacyNewDecFromBigIntWithPrec(amountOut, math.LegacyPrecision), nil
+}
+
+// GetFeeTokenRates returns the rates of all the whitelisted fee tokens, by
+// denom. The tokens whose rate can't be resolved are left out.
+func (k Keeper) GetFeeTokenRates(ctx sdk.Context) map[string]math.LegacyDec {
+	tokens := k.feeMarketWrapper.GetParams(ctx).FeeTokens
+	if len(tokens) == 0 {
+		return nil
+	}
+
+	rates := make(map[string]math.LegacyDec, len(tokens))
+	for _, token := range tokens {
+		rate, err := k.GetFeeTokenRate(ctx, token)
+		if err != nil {
+			k.Logger(ctx).Debug("failed to get fee token rate", "denom", token.Denom, "error", err)
+			continue
+		}
+		rates[token.Denom] = rate
+	}
+	return rates
+}
+
+// SelectFeeToken returns the first whitelisted fee token, in the order of the
+// fee market params, the sender has enough spendable balance of to pay the
+// given fees in the EVM denom with. It returns nil if there is none.
+func (k Keeper) SelectFeeToken(ctx sdk.Context, from common.Address, fees math.Int) *types.FeeTokenPayment {
+	for _, token := range k.feeMarketWrapper.GetParams(ctx).FeeTokens {
+		rate, err := k.GetFeeTokenRate(ctx, token)
+		if err != nil {
+			k.Logger(ctx).Debug("failed to get fee token rate", "denom", token.Denom, "error", err)
+			continue
+		}
+
+		balance := k.bankKeeper.SpendableCoin(ctx, from.Bytes(), token.Denom)
+		if balance.Amount.GTE(types.ConvertFeeToFeeToken(fees, rate)) {
+			return &types.FeeTokenPayment{Denom: token.Denom, Rate: rate}
+		}
+	}
+	return nil
+}
+
+// GetFeeTokenBalance returns the value, in the EVM denom, of the largest
+// spendable balance the address holds of a whitelisted fee token, i.e. the most
+// fees it can pay in a fee token.
+func (k Keeper) GetFeeTokenBalance(ctx sdk.Context, addr common.Address) math.Int {
+	value := math.ZeroInt()
+	for _, token := range k.feeMarketWrapper.GetParams(ctx).FeeTokens {
+		rate, err := k.GetFeeTokenRate(ctx, token)
+		if err != nil {
+			continue
+		}
+
+		balance := k.bankKeeper.SpendableCoin(ctx, addr.Bytes(), token.Denom)
+		value = math.MaxInt(value, types.ConvertFeeTokenToEVMDenom(balance.Amount, rate))
+	}
+	return value
+}
+
+// GetFeeTokenPayment returns the fee token the fees of the current Ethereum
+// transaction were paid with, or nil if they were paid in the EVM denom.
+func (k Keeper) GetFeeTokenPayment(ctx sdk.Context) *types.FeeTokenPayment {
+	store := ctx.ObjectStore(k.objectKey)
+	v := store.Get(types.ObjectFeeTokenPaymentKey(ctx.TxIndex()))
+	if v == nil {
+		return nil
+	}
+	return v.(*types.FeeTokenPayment)
+}
+
+// SetFeeTokenPayment records the fee token the fees of the current Ethereum
+// transaction were paid with. A nil payment clears the record, as the fees are
+// paid in the EVM denom.
+func (k Keeper) SetFeeTokenPayment(ctx sdk.Context, payment *types.FeeTokenPayment) {
+	store := ctx.ObjectStore(k.objectKey)
+	if payment == nil {
+		store.Delete(types.ObjectFeeTokenPaymentKey(ctx.TxIndex()))
+		return
+	}
+	store.Set(types.ObjectFeeTokenPaymentKey(ctx.TxIndex()), payment)
+}
@@ -0,0 +1,67 @@
+package keeper
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	feemarkettypes "github.com/cosmos/evm/x/feemarket/types"
+	"github.com/cosmos/evm/x/vm/types"
+
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+)
+
+func TestCachedFeeTokenRate(t *testing.T) {
+	newBlock := func() (Keeper, testutil.TestContext) {
+		objectKey := storetypes.NewObjectStoreKey(types.ObjectKey)
+		testCtx := testutil.DefaultContextWithObjectStore(t, storetypes.NewKVStoreKey(types.StoreKey), storetypes.NewTransientStoreKey("transient_test"), objectKey)
+		return Keeper{objectKey: objectKey}, testCtx
+	}
+	token := feemarkettypes.FeeToken{
+		Denom:      "ibc/usdc",
+		TwapOracle: "0x1000000000000000000000000000000000000001",
+		TwapToken:  "0x2000000000000000000000000000000000000002",
+	}
+
+	consults := 0
+	consult := func() (math.LegacyDec, error) {
+		consults++
+		return math.LegacyNewDec(int64(consults)), nil
+	}
+
+	k, testCtx := newBlock()
+	for i := 0; i < 3; i++ {
+		rate, err := k.cachedFeeTokenRate(testCtx.Ctx, token, consult)
+		require.NoError(t, err)
+		require.Equal(t, math.LegacyNewDec(1), rate, "the oracle is consulted once per block")
+	}
+
+	// an updated oracle is consulted within the block
+	updated := token
+	updated.TwapOracle = "0x3000000000000000000000000000000000000003"
+	rate, err := k.cachedFeeTokenRate(testCtx.Ctx, updated, consult)
+	require.NoError(t, err)
+	require.Equal(t, math.LegacyNewDec(2), rate)
+
+	// the failed consultations are held too
+	failing := feemarkettypes.FeeToken{Denom: "ibc/atom", TwapOracle: token.TwapOracle, TwapToken: token.TwapToken}
+	fail := func() (math.LegacyDec, error) {
+		consults++
+		return math.LegacyDec{}, errors.New("oracle reverted")
+	}
+	_, err = k.cachedFeeTokenRate(testCtx.Ctx, failing, fail)
+	require.Error(t, err)
+	_, err = k.cachedFeeTokenRate(testCtx.Ctx, failing, fail)
+	require.Error(t, err)
+	require.Equal(t, 3, consults)
+
+	// the rates are consulted again in the next block, whose object store is empty
+	k, testCtx = newBlock()
+	rate, err = k.cachedFeeTokenRate(testCtx.Ctx, token, consult)
+	require.NoError(t, err)
+	require.Equal(t, math.LegacyNewDec(4), rate)
+}
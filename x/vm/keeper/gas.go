@@ -47,6 +47,13 @@ func (k *Keeper) RefundGas(ctx sdk.Context, msg core.Message, leftoverGas uint64
 	// Return EVM tokens for remaining gas, exchanged at the original rate.
 	remaining := new(big.Int).Mul(new(big.Int).SetUint64(leftoverGas), msg.GasPrice)
 
+	// Fees paid in a whitelisted fee token are refunded in that token, at the
+	// rate they were paid at.
+	if payment := k.GetFeeTokenPayment(ctx); payment != nil && remaining.Sign() > 0 {
+		denom = payment.Denom
+		remaining = types.ConvertRefundToFeeToken(sdkmath.NewIntFromBigInt(remaining), payment.Rate).BigInt()
+	}
+
 	switch remaining.Sign() {
 	case -1:
 		// negative refund errors
@@ -34,6 +34,8 @@ import (
 
 var tracer = otel.Tracer("evm/x/vm/keeper")
 
+var _ evmmempool.PendingKeeperI = &Keeper{}
+
 // Keeper grants access to the EVM module state and implements the go-ethereum StateDB interface.
 type Keeper struct {
 	// Protobuf codec
@@ -60,6 +62,8 @@ type Keeper struct {
 	// bankWrapper is used to convert the Cosmos SDK coin used in the EVM to the
 	// proper decimal representation.
 	bankWrapper types.BankWrapper
+	// access to the balances of the other tokens, e.g. the fee tokens
+	bankKeeper types.BankKeeper
 
 	// access historical headers for EVM state transition execution
 	stakingKeeper types.StakingKeeper
@@ -95,6 +99,10 @@ type Keeper struct {
 	// defaultEvmCoinInfo is the default EVM coin info used when evmCoinInfo is not initialized in the state,
 	// mainly for historical queries.
 	defaultEvmCoinInfo types.EvmCoinInfo
+
+	// authzLimits caches the authz limits decoded from the params, read by the
+	// ante handler for every cosmos tx
+	authzLimits *authzLimitsCache
 }
 
 // NewKeeper generates new evm module keeper
@@ -134,6 +142,7 @@ func NewKeeper(
 		authority:        authority,
 		accountKeeper:    ak,
 		bankWrapper:      bankWrapper,
+		bankKeeper:       bankKeeper,
 		stakingKeeper:    sk,
 		feeMarketWrapper: feeMarketWrapper,
 		storeKey:         storeKey,
@@ -142,6 +151,7 @@ func NewKeeper(
 		consensusKeeper:  consensusKeeper,
 		erc20Keeper:      erc20Keeper,
 		storeKeys:        storeKeys,
+		authzLimits:      &authzLimitsCache{},
 	}
 }
 
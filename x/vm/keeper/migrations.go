@@ -0,0 +1,26 @@
+package keeper
+
+import (
+	"github.com/cosmos/evm/x/vm/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper *Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper *Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 migrates the store from consensus version 1 to 2. The params
+// stored before version 2 have no authz limits, so they are set to the default
+// ones, which keep blocking the vesting account creation within authz.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	params := m.keeper.GetParams(ctx)
+	params.AuthzLimits = types.DefaultAuthzLimits
+	return m.keeper.SetParams(ctx, params)
+}
@@ -1,9 +1,11 @@
 package keeper
 
 import (
+	"bytes"
 	"fmt"
 	"slices"
 	"sort"
+	"sync"
 
 	"github.com/ethereum/go-ethereum/common"
 	"go.opentelemetry.io/otel/attribute"
@@ -29,6 +31,39 @@ func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
 	return params
 }
 
+// authzLimitsCache holds the authz limits decoded from the params stored in the
+// given bytes.
+type authzLimitsCache struct {
+	mu     sync.RWMutex
+	bz     []byte
+	limits types.AuthzLimits
+}
+
+// GetAuthzLimits returns the authz limits of the evm parameters. The limits are
+// cached until the stored parameters change, so they must not be modified.
+func (k Keeper) GetAuthzLimits(ctx sdk.Context) types.AuthzLimits {
+	bz := ctx.KVStore(k.storeKey).Get(types.KeyPrefixParams)
+	if bz == nil {
+		return types.AuthzLimits{}
+	}
+
+	k.authzLimits.mu.RLock()
+	if bytes.Equal(bz, k.authzLimits.bz) {
+		defer k.authzLimits.mu.RUnlock()
+		return k.authzLimits.limits
+	}
+	k.authzLimits.mu.RUnlock()
+
+	var params types.Params
+	k.cdc.MustUnmarshal(bz, &params)
+
+	k.authzLimits.mu.Lock()
+	defer k.authzLimits.mu.Unlock()
+	k.authzLimits.bz = bytes.Clone(bz)
+	k.authzLimits.limits = params.AuthzLimits
+	return params.AuthzLimits
+}
+
 // SetParams sets the EVM params each in their individual key for better get performance
 func (k Keeper) SetParams(ctx sdk.Context, params types.Params) (err error) {
 	ctx, span := ctx.StartSpan(tracer, "SetParams", trace.WithAttributes(
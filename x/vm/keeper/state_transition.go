@@ -329,6 +329,9 @@ func (k *Keeper) ApplyTransaction(ctx sdk.Context, tx *ethtypes.Transaction) (_
 		return nil, errorsmod.Wrap(err, "failed to add transient gas used")
 	}
 
+	// account the gas of the tx to the EVM fee lane
+	k.feeMarketWrapper.AddEVMLaneGas(ctx, msg.GasLimit, res.GasUsed)
+
 	// reset the gas meter for current cosmos transaction
 	k.ResetGasMeterAndConsumeGas(ctx, totalGasUsed)
 	return res, nil
@@ -73,6 +73,9 @@ type Params struct {
 	ActiveStaticPrecompiles []string              `protobuf:"bytes,9,rep,name=active_static_precompiles,json=activeStaticPrecompiles,proto3" json:"active_static_precompiles,omitempty"`
 	HistoryServeWindow      uint64                `protobuf:"varint,10,opt,name=history_serve_window,json=historyServeWindow,proto3" json:"history_serve_window,omitempty"`
 	ExtendedDenomOptions    *ExtendedDenomOptions `protobuf:"bytes,11,opt,name=extended_denom_options,json=extendedDenomOptions,proto3" json:"extended_denom_options,omitempty"`
+	// authz_limits defines the limits on the messages granted or executed
+	// through the authz module
+	AuthzLimits AuthzLimits `protobuf:"bytes,12,opt,name=authz_limits,json=authzLimits,proto3" json:"authz_limits"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -157,6 +160,82 @@ func (m *Params) GetExtendedDenomOptions() *ExtendedDenomOptions {
 	return nil
 }
 
+func (m *Params) GetAuthzLimits() AuthzLimits {
+	if m != nil {
+		return m.AuthzLimits
+	}
+	return AuthzLimits{}
+}
+
+// AuthzLimits defines the limits on the messages granted or executed through
+// the authz module, enforced by the ante handler of the cosmos transactions.
+type AuthzLimits struct {
+	// disabled_msg_types is the type urls of the messages that cannot be granted
+	// or executed through authz
+	DisabledMsgTypes []string `protobuf:"bytes,1,rep,name=disabled_msg_types,json=disabledMsgTypes,proto3" json:"disabled_msg_types,omitempty"`
+	// max_nesting_depth caps the nesting depth of the authz MsgExec messages of
+	// a transaction, the default depth of 7 is used if 0, otherwise it must be at
+	// least 2
+	MaxNestingDepth uint32 `protobuf:"varint,2,opt,name=max_nesting_depth,json=maxNestingDepth,proto3" json:"max_nesting_depth,omitempty"`
+	// max_inner_msgs caps the number of messages nested in the authz MsgExec
+	// messages of a transaction, there is no cap if 0
+	MaxInnerMsgs uint32 `protobuf:"varint,3,opt,name=max_inner_msgs,json=maxInnerMsgs,proto3" json:"max_inner_msgs,omitempty"`
+}
+
+func (m *AuthzLimits) Reset()         { *m = AuthzLimits{} }
+func (m *AuthzLimits) String() string { return proto.CompactTextString(m) }
+func (*AuthzLimits) ProtoMessage()    {}
+func (*AuthzLimits) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d1129b8db63d55c7, []int{1}
+}
+func (m *AuthzLimits) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AuthzLimits) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AuthzLimits.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AuthzLimits) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuthzLimits.Merge(m, src)
+}
+func (m *AuthzLimits) XXX_Size() int {
+	return m.Size()
+}
+func (m *AuthzLimits) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuthzLimits.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AuthzLimits proto.InternalMessageInfo
+
+func (m *AuthzLimits) GetDisabledMsgTypes() []string {
+	if m != nil {
+		return m.DisabledMsgTypes
+	}
+	return nil
+}
+
+func (m *AuthzLimits) GetMaxNestingDepth() uint32 {
+	if m != nil {
+		return m.MaxNestingDepth
+	}
+	return 0
+}
+
+func (m *AuthzLimits) GetMaxInnerMsgs() uint32 {
+	if m != nil {
+		return m.MaxInnerMsgs
+	}
+	return 0
+}
+
 type ExtendedDenomOptions struct {
 	ExtendedDenom string `protobuf:"bytes,1,opt,name=extended_denom,json=extendedDenom,proto3" json:"extended_denom,omitempty"`
 }
@@ -165,7 +244,7 @@ func (m *ExtendedDenomOptions) Reset()         { *m = ExtendedDenomOptions{} }
 func (m *ExtendedDenomOptions) String() string { return proto.CompactTextString(m) }
 func (*ExtendedDenomOptions) ProtoMessage()    {}
 func (*ExtendedDenomOptions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d1129b8db63d55c7, []int{1}
+	return fileDescriptor_d1129b8db63d55c7, []int{2}
 }
 func (m *ExtendedDenomOptions) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -214,7 +293,7 @@ func (m *AccessControl) Reset()         { *m = AccessControl{} }
 func (m *AccessControl) String() string { return proto.CompactTextString(m) }
 func (*AccessControl) ProtoMessage()    {}
 func (*AccessControl) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d1129b8db63d55c7, []int{2}
+	return fileDescriptor_d1129b8db63d55c7, []int{3}
 }
 func (m *AccessControl) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -275,7 +354,7 @@ func (m *AccessControlType) Reset()         { *m = AccessControlType{} }
 func (m *AccessControlType) String() string { return proto.CompactTextString(m) }
 func (*AccessControlType) ProtoMessage()    {}
 func (*AccessControlType) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d1129b8db63d55c7, []int{3}
+	return fileDescriptor_d1129b8db63d55c7, []int{4}
 }
 func (m *AccessControlType) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -385,7 +464,7 @@ func (m *ChainConfig) Reset()         { *m = ChainConfig{} }
 func (m *ChainConfig) String() string { return proto.CompactTextString(m) }
 func (*ChainConfig) ProtoMessage()    {}
 func (*ChainConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d1129b8db63d55c7, []int{4}
+	return fileDescriptor_d1129b8db63d55c7, []int{5}
 }
 func (m *ChainConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -454,7 +533,7 @@ func (m *State) Reset()         { *m = State{} }
 func (m *State) String() string { return proto.CompactTextString(m) }
 func (*State) ProtoMessage()    {}
 func (*State) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d1129b8db63d55c7, []int{5}
+	return fileDescriptor_d1129b8db63d55c7, []int{6}
 }
 func (m *State) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -511,7 +590,7 @@ func (m *TransactionLogs) Reset()         { *m = TransactionLogs{} }
 func (m *TransactionLogs) String() string { return proto.CompactTextString(m) }
 func (*TransactionLogs) ProtoMessage()    {}
 func (*TransactionLogs) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d1129b8db63d55c7, []int{6}
+	return fileDescriptor_d1129b8db63d55c7, []int{7}
 }
 func (m *TransactionLogs) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -589,7 +668,7 @@ func (m *Log) Reset()         { *m = Log{} }
 func (m *Log) String() string { return proto.CompactTextString(m) }
 func (*Log) ProtoMessage()    {}
 func (*Log) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d1129b8db63d55c7, []int{7}
+	return fileDescriptor_d1129b8db63d55c7, []int{8}
 }
 func (m *Log) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -711,7 +790,7 @@ func (m *TxResult) Reset()         { *m = TxResult{} }
 func (m *TxResult) String() string { return proto.CompactTextString(m) }
 func (*TxResult) ProtoMessage()    {}
 func (*TxResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d1129b8db63d55c7, []int{8}
+	return fileDescriptor_d1129b8db63d55c7, []int{9}
 }
 func (m *TxResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -752,7 +831,7 @@ func (m *AccessTuple) Reset()         { *m = AccessTuple{} }
 func (m *AccessTuple) String() string { return proto.CompactTextString(m) }
 func (*AccessTuple) ProtoMessage()    {}
 func (*AccessTuple) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d1129b8db63d55c7, []int{9}
+	return fileDescriptor_d1129b8db63d55c7, []int{10}
 }
 func (m *AccessTuple) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -812,7 +891,7 @@ func (m *TraceConfig) Reset()         { *m = TraceConfig{} }
 func (m *TraceConfig) String() string { return proto.CompactTextString(m) }
 func (*TraceConfig) ProtoMessage()    {}
 func (*TraceConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d1129b8db63d55c7, []int{10}
+	return fileDescriptor_d1129b8db63d55c7, []int{11}
 }
 func (m *TraceConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -933,7 +1012,7 @@ func (m *Preinstall) Reset()         { *m = Preinstall{} }
 func (m *Preinstall) String() string { return proto.CompactTextString(m) }
 func (*Preinstall) ProtoMessage()    {}
 func (*Preinstall) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d1129b8db63d55c7, []int{11}
+	return fileDescriptor_d1129b8db63d55c7, []int{12}
 }
 func (m *Preinstall) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -994,7 +1073,7 @@ func (m *EvmCoinInfo) Reset()         { *m = EvmCoinInfo{} }
 func (m *EvmCoinInfo) String() string { return proto.CompactTextString(m) }
 func (*EvmCoinInfo) ProtoMessage()    {}
 func (*EvmCoinInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d1129b8db63d55c7, []int{12}
+	return fileDescriptor_d1129b8db63d55c7, []int{13}
 }
 func (m *EvmCoinInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1054,6 +1133,7 @@ func (m *EvmCoinInfo) GetDecimals() uint32 {
 func init() {
 	proto.RegisterEnum("cosmos.evm.vm.v1.AccessType", AccessType_name, AccessType_value)
 	proto.RegisterType((*Params)(nil), "cosmos.evm.vm.v1.Params")
+	proto.RegisterType((*AuthzLimits)(nil), "cosmos.evm.vm.v1.AuthzLimits")
 	proto.RegisterType((*ExtendedDenomOptions)(nil), "cosmos.evm.vm.v1.ExtendedDenomOptions")
 	proto.RegisterType((*AccessControl)(nil), "cosmos.evm.vm.v1.AccessControl")
 	proto.RegisterType((*AccessControlType)(nil), "cosmos.evm.vm.v1.AccessControlType")
@@ -1071,139 +1151,146 @@ func init() {
 func init() { proto.RegisterFile("cosmos/evm/vm/v1/evm.proto", fileDescriptor_d1129b8db63d55c7) }
 
 var fileDescriptor_d1129b8db63d55c7 = []byte{
-	// 2111 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x58, 0x4d, 0x6f, 0x1b, 0xc7,
-	0x19, 0x16, 0xc5, 0x95, 0xb4, 0x1c, 0x52, 0xd4, 0x7a, 0x44, 0xcb, 0x34, 0xed, 0x68, 0xd5, 0x4d,
-	0x5b, 0xa8, 0x46, 0x2a, 0x59, 0x72, 0xd4, 0x1a, 0x4e, 0xd3, 0x42, 0x94, 0x98, 0x56, 0xaa, 0x6c,
+	// 2218 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x58, 0xdb, 0x6e, 0x1b, 0xc7,
+	0x19, 0x16, 0xc5, 0x95, 0xb4, 0x1c, 0x52, 0xd4, 0x6a, 0x44, 0xcb, 0x34, 0x9d, 0x68, 0xd5, 0x4d,
+	0x5a, 0xa8, 0x46, 0x2a, 0x59, 0x4a, 0xd4, 0x1a, 0x4e, 0xd3, 0x42, 0x94, 0xe8, 0x56, 0xaa, 0x64,
 	0x0b, 0x43, 0x35, 0x46, 0x8a, 0x14, 0x8b, 0xe1, 0xee, 0x78, 0xb9, 0xd1, 0xee, 0x0e, 0xb1, 0xb3,
-	0xa4, 0xc5, 0xfe, 0x81, 0x06, 0xee, 0x25, 0xed, 0xdd, 0x40, 0x80, 0x5e, 0x72, 0xcc, 0x4f, 0xe8,
-	0x31, 0xc7, 0x1c, 0x8b, 0x02, 0x5d, 0x14, 0xf4, 0x21, 0x80, 0x8e, 0xfa, 0x05, 0xc5, 0x7c, 0xf0,
-	0x5b, 0x61, 0x15, 0x40, 0xb0, 0xe7, 0x79, 0x3f, 0x9e, 0x67, 0x3e, 0xde, 0xdd, 0x79, 0x97, 0xa0,
-	0xe2, 0x50, 0x16, 0x52, 0xb6, 0x4d, 0x3a, 0xe1, 0x36, 0xff, 0xdb, 0xe1, 0xa3, 0xad, 0x56, 0x4c,
-	0x13, 0x0a, 0x0d, 0xe9, 0xdb, 0xe2, 0x16, 0xfe, 0xb7, 0x53, 0xb9, 0x85, 0x43, 0x3f, 0xa2, 0xdb,
-	0xe2, 0x5f, 0x19, 0x54, 0x29, 0x79, 0xd4, 0xa3, 0x62, 0xb8, 0xcd, 0x47, 0xd2, 0x6a, 0xfd, 0x5d,
-	0x03, 0x8b, 0xa7, 0x38, 0xc6, 0x21, 0x83, 0x3b, 0x20, 0x47, 0x3a, 0xa1, 0xed, 0x92, 0x88, 0x86,
-	0xe5, 0xcc, 0x46, 0x66, 0x33, 0x57, 0x2d, 0x5d, 0xa5, 0xa6, 0xd1, 0xc5, 0x61, 0xf0, 0xc4, 0x1a,
-	0xb8, 0x2c, 0xa4, 0x93, 0x4e, 0x78, 0xc8, 0x87, 0x70, 0x1f, 0x00, 0x72, 0x91, 0xc4, 0xd8, 0x26,
-	0x7e, 0x8b, 0x95, 0xb5, 0x8d, 0xec, 0x66, 0xb6, 0x6a, 0xf5, 0x52, 0x33, 0x57, 0xe3, 0xd6, 0xda,
-	0xd1, 0x29, 0xbb, 0x4a, 0xcd, 0x5b, 0x8a, 0x60, 0x10, 0x68, 0xa1, 0x9c, 0x00, 0x35, 0xbf, 0xc5,
-	0xe0, 0x2e, 0x28, 0x70, 0x6a, 0xa7, 0x89, 0xa3, 0x88, 0x04, 0xac, 0xbc, 0xb4, 0x91, 0xdd, 0xcc,
-	0x55, 0x57, 0x7a, 0xa9, 0x99, 0xaf, 0x7d, 0xfc, 0xf4, 0x40, 0x99, 0x51, 0x9e, 0x74, 0xc2, 0x3e,
-	0x80, 0x7f, 0x02, 0x45, 0xec, 0x38, 0x84, 0x31, 0xdb, 0xa1, 0x51, 0x12, 0xd3, 0xa0, 0xac, 0x6f,
-	0x64, 0x36, 0xf3, 0xbb, 0xe6, 0xd6, 0xe4, 0x46, 0x6c, 0xed, 0x8b, 0xb8, 0x03, 0x19, 0x56, 0xbd,
-	0xfd, 0x4d, 0x6a, 0xce, 0xf5, 0x52, 0x73, 0x79, 0xcc, 0x8c, 0x96, 0xf1, 0x28, 0x84, 0x4f, 0xc0,
-	0x5d, 0xec, 0x24, 0x7e, 0x87, 0xd8, 0x2c, 0xc1, 0x89, 0xef, 0xd8, 0xad, 0x98, 0x38, 0x34, 0x6c,
-	0xf9, 0x01, 0x61, 0xe5, 0x1c, 0x9f, 0x1f, 0xba, 0x23, 0x03, 0xea, 0xc2, 0x7f, 0x3a, 0x74, 0xc3,
-	0x87, 0xa0, 0xd4, 0xf4, 0x59, 0x42, 0xe3, 0xae, 0xcd, 0x48, 0xdc, 0x21, 0xf6, 0x2b, 0x3f, 0x72,
-	0xe9, 0xab, 0x32, 0xd8, 0xc8, 0x6c, 0x6a, 0x08, 0x2a, 0x5f, 0x9d, 0xbb, 0x5e, 0x08, 0x0f, 0xfc,
-	0x14, 0xac, 0x91, 0x8b, 0x84, 0x44, 0x2e, 0x71, 0xe5, 0x06, 0xdb, 0xb4, 0x95, 0xf8, 0x34, 0x62,
-	0xe5, 0xbc, 0x58, 0xd4, 0x4f, 0xa7, 0x17, 0x55, 0x53, 0xf1, 0xe2, 0x10, 0x9e, 0xcb, 0x68, 0x54,
-	0x22, 0xd7, 0x58, 0x9f, 0xdc, 0x7b, 0xfd, 0xdd, 0xd7, 0x0f, 0xd6, 0x46, 0x6a, 0xe7, 0x82, 0x57,
-	0x8f, 0x3c, 0xf1, 0x63, 0x4d, 0x9f, 0x37, 0xb2, 0xc7, 0x9a, 0x9e, 0x35, 0xb4, 0x63, 0x4d, 0x5f,
-	0x30, 0x16, 0x8f, 0x35, 0x7d, 0xd1, 0x58, 0xb2, 0x3e, 0x04, 0xa5, 0xeb, 0x24, 0xe0, 0x4f, 0x40,
-	0x71, 0x7c, 0xaa, 0xb2, 0x4c, 0xd0, 0xf2, 0x98, 0xb4, 0xf5, 0xb7, 0x0c, 0x18, 0xdf, 0x60, 0xb8,
-	0x0f, 0x16, 0x9d, 0x98, 0xe0, 0x84, 0x88, 0x84, 0xfc, 0xee, 0xbb, 0xff, 0xe7, 0xa0, 0xce, 0xba,
-	0x2d, 0x52, 0xd5, 0xf8, 0x61, 0x21, 0x95, 0x08, 0x3f, 0x04, 0x9a, 0x83, 0x83, 0xa0, 0x3c, 0xff,
-	0x43, 0x09, 0x44, 0x9a, 0xf5, 0x9f, 0x0c, 0xb8, 0x35, 0x15, 0x01, 0x1d, 0x90, 0x57, 0x85, 0x94,
-	0x74, 0x5b, 0x72, 0x72, 0xc5, 0xdd, 0xfb, 0xdf, 0xc7, 0x2d, 0x48, 0x7f, 0xdc, 0x4b, 0x4d, 0x30,
-	0xc4, 0x57, 0xa9, 0x09, 0x65, 0x7d, 0x8f, 0x10, 0x59, 0x08, 0xe0, 0x41, 0x04, 0x74, 0xc0, 0xea,
-	0x78, 0xb5, 0xda, 0x81, 0xcf, 0x92, 0xf2, 0xbc, 0x28, 0xf4, 0x47, 0xbd, 0xd4, 0x1c, 0x9f, 0xd8,
-	0x89, 0xcf, 0x92, 0xab, 0xd4, 0xac, 0x8c, 0xb1, 0x8e, 0x66, 0x5a, 0xe8, 0x16, 0x9e, 0x4c, 0xb0,
-	0xbe, 0x32, 0x40, 0xfe, 0xa0, 0x89, 0xfd, 0xe8, 0x80, 0x46, 0x2f, 0x7d, 0x0f, 0x7e, 0x0a, 0x56,
-	0x9a, 0x34, 0x24, 0x2c, 0x21, 0xd8, 0xb5, 0x1b, 0x01, 0x75, 0xce, 0xd5, 0x23, 0xfd, 0xe8, 0xdf,
-	0xa9, 0x79, 0x5b, 0x2e, 0x90, 0xb9, 0xe7, 0x5b, 0x3e, 0xdd, 0x0e, 0x71, 0xd2, 0xdc, 0x3a, 0x8a,
-	0xb8, 0xe8, 0x9a, 0x14, 0x9d, 0xc8, 0xb4, 0x50, 0x71, 0x60, 0xa9, 0x72, 0x03, 0x6c, 0x82, 0xa2,
-	0x8b, 0xa9, 0xfd, 0x92, 0xc6, 0xe7, 0x8a, 0x7c, 0x5e, 0x90, 0x57, 0xbf, 0x97, 0xbc, 0x97, 0x9a,
-	0x85, 0xc3, 0xfd, 0xe7, 0x1f, 0xd1, 0xf8, 0x5c, 0x50, 0x5c, 0xa5, 0xe6, 0x6d, 0x29, 0x36, 0x4e,
-	0x64, 0xa1, 0x82, 0x8b, 0xe9, 0x20, 0x0c, 0xbe, 0x00, 0xc6, 0x20, 0x80, 0xb5, 0x5b, 0x2d, 0x1a,
-	0x27, 0xe5, 0xec, 0x46, 0x66, 0x53, 0xaf, 0xfe, 0xbc, 0x97, 0x9a, 0x45, 0x45, 0x59, 0x97, 0x9e,
-	0xab, 0xd4, 0xbc, 0x33, 0x41, 0xaa, 0x72, 0x2c, 0x54, 0x54, 0xb4, 0x2a, 0x14, 0x36, 0x40, 0x81,
-	0xf8, 0xad, 0x9d, 0xbd, 0x87, 0x6a, 0x01, 0x9a, 0x58, 0xc0, 0x6f, 0x66, 0x2d, 0x20, 0x5f, 0x3b,
-	0x3a, 0xdd, 0xd9, 0x7b, 0xd8, 0x9f, 0xff, 0xaa, 0x7a, 0xaf, 0x8d, 0xb0, 0x58, 0x28, 0x2f, 0xa1,
-	0x9c, 0x7c, 0x5f, 0x63, 0x4f, 0x69, 0x2c, 0xde, 0x54, 0x63, 0xef, 0x3a, 0x8d, 0xbd, 0x71, 0x8d,
-	0xbd, 0x71, 0x8d, 0xc7, 0x4a, 0x63, 0xe9, 0xa6, 0x1a, 0x8f, 0xaf, 0xd3, 0x78, 0x3c, 0xae, 0x21,
-	0x63, 0x78, 0x31, 0x35, 0xba, 0x7f, 0xc6, 0x51, 0xe2, 0xb7, 0x43, 0x25, 0xa3, 0xdf, 0xb8, 0x98,
-	0x26, 0x32, 0x2d, 0x54, 0x1c, 0x58, 0x24, 0xfb, 0x39, 0x28, 0x39, 0x34, 0x62, 0x09, 0xb7, 0x45,
-	0xb4, 0x15, 0x10, 0x25, 0x91, 0x13, 0x12, 0x8f, 0x67, 0x49, 0xdc, 0x93, 0x12, 0xd7, 0xa5, 0x5b,
-	0x68, 0x75, 0xdc, 0x2c, 0xc5, 0x6c, 0x60, 0xb4, 0x48, 0x42, 0x62, 0xd6, 0x68, 0xc7, 0x9e, 0x12,
-	0x02, 0x42, 0xe8, 0xfd, 0x59, 0x42, 0xaa, 0xac, 0x26, 0x53, 0x2d, 0xb4, 0x32, 0x34, 0x49, 0x81,
-	0x4f, 0x40, 0xd1, 0xe7, 0xaa, 0x8d, 0x76, 0xa0, 0xe8, 0xf3, 0x82, 0x7e, 0x77, 0x16, 0xbd, 0x7a,
-	0x14, 0xc6, 0x13, 0x2d, 0xb4, 0xdc, 0x37, 0x48, 0x6a, 0x17, 0xc0, 0xb0, 0xed, 0xc7, 0xb6, 0x17,
-	0x60, 0xc7, 0x27, 0xb1, 0xa2, 0x2f, 0x08, 0xfa, 0x5f, 0xcc, 0xa2, 0xbf, 0x2b, 0xe9, 0xa7, 0x93,
-	0x2d, 0x64, 0x70, 0xe3, 0x6f, 0xa5, 0x4d, 0xaa, 0xd4, 0x41, 0xa1, 0x41, 0xe2, 0xc0, 0x8f, 0x14,
-	0xff, 0xb2, 0xe0, 0x7f, 0x38, 0x8b, 0x5f, 0x55, 0xd0, 0x68, 0x9a, 0x85, 0xf2, 0x12, 0x0e, 0x48,
-	0x03, 0x1a, 0xb9, 0xb4, 0x4f, 0x7a, 0xeb, 0xc6, 0xa4, 0xa3, 0x69, 0x16, 0xca, 0x4b, 0x28, 0x49,
-	0x3d, 0xb0, 0x8a, 0xe3, 0x98, 0xbe, 0x9a, 0xd8, 0x10, 0x28, 0xb8, 0x7f, 0x39, 0x8b, 0xbb, 0xff,
-	0x72, 0x9d, 0xce, 0xe6, 0x2f, 0x57, 0x6e, 0x1d, 0xdb, 0x12, 0x17, 0x40, 0x2f, 0xc6, 0xdd, 0x09,
-	0x9d, 0xd2, 0x8d, 0x37, 0x7e, 0x3a, 0xd9, 0x42, 0x06, 0x37, 0x8e, 0xa9, 0x7c, 0x06, 0x4a, 0x21,
-	0x89, 0x3d, 0x62, 0x47, 0x24, 0x61, 0xad, 0xc0, 0x4f, 0x94, 0xce, 0xed, 0x1b, 0x3f, 0x07, 0xd7,
-	0xa5, 0x5b, 0x08, 0x0a, 0xf3, 0x33, 0x65, 0x95, 0x5a, 0x77, 0x81, 0xee, 0xf0, 0xdb, 0xc2, 0xf6,
-	0xdd, 0x72, 0x59, 0xb4, 0x26, 0x4b, 0x02, 0x1f, 0xb9, 0xb0, 0x04, 0x16, 0xe4, 0xdd, 0x7e, 0x57,
-	0xdc, 0xed, 0x12, 0xc0, 0x0a, 0xd0, 0x5d, 0xe2, 0xf8, 0x21, 0x0e, 0x58, 0xb9, 0x22, 0x12, 0x06,
-	0x18, 0x7e, 0x0c, 0x96, 0x59, 0x13, 0x47, 0x5e, 0x13, 0xfb, 0x76, 0xe2, 0x87, 0xa4, 0x7c, 0x4f,
-	0xcc, 0x78, 0x67, 0xd6, 0x8c, 0x4b, 0x72, 0xc6, 0x63, 0x79, 0x16, 0x2a, 0xf4, 0xf1, 0x99, 0x1f,
-	0x12, 0x78, 0x0a, 0xf2, 0x0e, 0x8e, 0x9c, 0x76, 0x24, 0x59, 0xef, 0x0b, 0xd6, 0xed, 0x59, 0xac,
-	0xea, 0x2a, 0x1e, 0xc9, 0xb2, 0x10, 0x90, 0xa8, 0xcf, 0xd8, 0x8a, 0xb1, 0xd7, 0x26, 0x92, 0xf1,
-	0x9d, 0x1b, 0x33, 0x8e, 0x64, 0x59, 0x08, 0x48, 0xd4, 0x67, 0xec, 0x90, 0xf8, 0x3c, 0x50, 0x8c,
-	0xeb, 0x37, 0x66, 0x1c, 0xc9, 0xb2, 0x10, 0x90, 0x48, 0x30, 0x3e, 0x05, 0x80, 0x32, 0x7c, 0x8e,
-	0x25, 0xa1, 0x29, 0x08, 0xb7, 0x66, 0x11, 0xaa, 0xfe, 0x7a, 0x98, 0x64, 0xa1, 0x9c, 0x00, 0x9c,
-	0x6e, 0xd0, 0xd7, 0xad, 0x19, 0x77, 0x8e, 0x35, 0xfd, 0x8e, 0x51, 0xb6, 0xb6, 0xc1, 0x02, 0xef,
-	0x5b, 0x09, 0x34, 0x40, 0xf6, 0x9c, 0x74, 0x55, 0x0f, 0xc7, 0x87, 0xfc, 0xec, 0x3b, 0x38, 0x68,
-	0x13, 0x79, 0x9d, 0x23, 0x09, 0xac, 0x53, 0xb0, 0x72, 0x16, 0xe3, 0x88, 0xf1, 0x9e, 0x97, 0x46,
-	0x27, 0xd4, 0x63, 0x10, 0x02, 0xad, 0x89, 0x59, 0x53, 0xe5, 0x8a, 0x31, 0xfc, 0x19, 0xd0, 0x02,
-	0xea, 0x31, 0xd1, 0xd8, 0xe4, 0x77, 0x6f, 0x4f, 0x77, 0x51, 0x27, 0xd4, 0x43, 0x22, 0xc4, 0xfa,
-	0x4b, 0x16, 0x64, 0x4f, 0xa8, 0x07, 0xcb, 0x60, 0x09, 0xbb, 0x6e, 0x4c, 0x18, 0x53, 0x4c, 0x7d,
-	0x08, 0xd7, 0xc0, 0x62, 0x42, 0x5b, 0xbe, 0x23, 0xe9, 0x72, 0x48, 0x21, 0x2e, 0xec, 0xe2, 0x04,
-	0x8b, 0x1e, 0xa0, 0x80, 0xc4, 0x98, 0x7f, 0x42, 0x88, 0x52, 0xb7, 0xa3, 0x76, 0xd8, 0x20, 0xb1,
-	0xb8, 0xca, 0xb5, 0xea, 0xca, 0x65, 0x6a, 0xe6, 0x85, 0xfd, 0x99, 0x30, 0xa3, 0x51, 0x00, 0xdf,
-	0x03, 0x4b, 0xc9, 0x85, 0x2d, 0xd6, 0xb0, 0x20, 0xb6, 0x78, 0xf5, 0x32, 0x35, 0x57, 0x92, 0xe1,
-	0x32, 0x7f, 0x87, 0x59, 0x13, 0x2d, 0x26, 0x17, 0xfc, 0x7f, 0xb8, 0x0d, 0xf4, 0xe4, 0xc2, 0xf6,
-	0x23, 0x97, 0x5c, 0x88, 0x4b, 0x5c, 0xab, 0x96, 0x2e, 0x53, 0xd3, 0x18, 0x09, 0x3f, 0xe2, 0x3e,
-	0xb4, 0x94, 0x5c, 0x88, 0x01, 0x7c, 0x0f, 0x00, 0x39, 0x25, 0xa1, 0x20, 0xef, 0xe4, 0xe5, 0xcb,
-	0xd4, 0xcc, 0x09, 0xab, 0xe0, 0x1e, 0x0e, 0xa1, 0x05, 0x16, 0x24, 0xb7, 0x2e, 0xb8, 0x0b, 0x97,
-	0xa9, 0xa9, 0x07, 0xd4, 0x93, 0x9c, 0xd2, 0xc5, 0xb7, 0x2a, 0x26, 0x21, 0xed, 0x10, 0x57, 0x5c,
-	0x8c, 0x3a, 0xea, 0x43, 0xf8, 0x01, 0x58, 0x91, 0x5a, 0xfc, 0xec, 0x59, 0x82, 0xc3, 0x96, 0xfc,
-	0xda, 0xa8, 0xc2, 0xcb, 0xd4, 0x2c, 0x0a, 0xd7, 0x59, 0xdf, 0x83, 0x26, 0xb0, 0xf5, 0xc5, 0x3c,
-	0xd0, 0xcf, 0x2e, 0x10, 0x61, 0xed, 0x20, 0x81, 0x1f, 0x01, 0x43, 0x34, 0x9a, 0xd8, 0x49, 0xec,
-	0xb1, 0x73, 0xa9, 0xde, 0x1b, 0xde, 0x81, 0x93, 0x11, 0x16, 0x5a, 0xe9, 0x9b, 0xf6, 0xd5, 0xe1,
-	0x95, 0xc0, 0x42, 0x23, 0xa0, 0x34, 0x14, 0x65, 0x54, 0x40, 0x12, 0xc0, 0x17, 0x62, 0xcb, 0x45,
-	0x89, 0x64, 0x45, 0x13, 0xff, 0xa3, 0xe9, 0x12, 0x99, 0xa8, 0xb3, 0xea, 0x3d, 0xde, 0xc2, 0x5f,
-	0xa5, 0x66, 0x51, 0x6a, 0xab, 0x7c, 0xeb, 0xab, 0xef, 0xbe, 0x7e, 0x90, 0xe1, 0xa7, 0x23, 0x8a,
-	0xd1, 0x00, 0xd9, 0x98, 0x24, 0xe2, 0xd8, 0x0b, 0x88, 0x0f, 0xf9, 0xdb, 0x2a, 0x26, 0x1d, 0x12,
-	0x27, 0xc4, 0x15, 0xc7, 0xab, 0xa3, 0x01, 0xe6, 0xaf, 0x3e, 0x0f, 0x33, 0xbb, 0xcd, 0x88, 0x2b,
-	0xcf, 0x12, 0x2d, 0x79, 0x98, 0xfd, 0x81, 0x11, 0xf7, 0x89, 0xf6, 0xf9, 0x97, 0xe6, 0x9c, 0x85,
-	0x41, 0x5e, 0xf5, 0xf7, 0xed, 0x56, 0x40, 0x66, 0xd4, 0xe8, 0x2e, 0x28, 0xf0, 0xaf, 0x39, 0xec,
-	0x11, 0xfb, 0x9c, 0x74, 0x55, 0xa5, 0xca, 0xba, 0x53, 0xf6, 0xdf, 0x93, 0x2e, 0x43, 0xa3, 0x40,
-	0x49, 0x7c, 0xa9, 0x81, 0xfc, 0x59, 0x8c, 0x1d, 0xa2, 0xba, 0x75, 0x5e, 0xed, 0x1c, 0xc6, 0x4a,
-	0x42, 0x21, 0xae, 0xcd, 0x0f, 0x95, 0xb6, 0x13, 0xf5, 0x44, 0xf6, 0x21, 0xcf, 0x88, 0x09, 0xb9,
-	0x20, 0x8e, 0xd8, 0x4b, 0x0d, 0x29, 0x04, 0xf7, 0xc0, 0xb2, 0xeb, 0x33, 0xdc, 0x08, 0xc4, 0xc7,
-	0xab, 0x73, 0x2e, 0x97, 0x5f, 0x35, 0x2e, 0x53, 0xb3, 0xa0, 0x1c, 0x75, 0x6e, 0x47, 0x63, 0x88,
-	0xd7, 0xd0, 0x30, 0x4d, 0xcc, 0x56, 0xec, 0x8d, 0x2e, 0x6b, 0x68, 0x10, 0x2a, 0x3c, 0x68, 0x02,
-	0xcb, 0x1b, 0xa3, 0xd1, 0xf6, 0x44, 0xf9, 0xea, 0x48, 0x02, 0x6e, 0x0d, 0xfc, 0xd0, 0x4f, 0x44,
-	0xb9, 0x2e, 0x20, 0x09, 0xe0, 0x07, 0x20, 0x47, 0x3b, 0x24, 0x8e, 0x7d, 0x97, 0x30, 0x51, 0xa6,
-	0xf9, 0xdd, 0x77, 0xa6, 0xcb, 0x60, 0xe4, 0x4b, 0x06, 0x0d, 0xe3, 0xf9, 0xe2, 0x48, 0x24, 0x26,
-	0x19, 0x92, 0x90, 0xc6, 0x5d, 0xd1, 0x5a, 0xa9, 0xc5, 0x49, 0xc7, 0x53, 0x61, 0x47, 0x63, 0x08,
-	0x56, 0x01, 0x54, 0x69, 0x31, 0x49, 0xda, 0x71, 0x64, 0x8b, 0x37, 0x48, 0x41, 0xe4, 0x8a, 0xe7,
-	0x58, 0x7a, 0x91, 0x70, 0x1e, 0xe2, 0x04, 0xa3, 0x29, 0x0b, 0xfc, 0x35, 0x80, 0xf2, 0x4c, 0xec,
-	0xcf, 0x18, 0x8d, 0xf8, 0xf7, 0xd8, 0x4b, 0xdf, 0x53, 0xbd, 0x91, 0xd0, 0x97, 0x5e, 0x35, 0x67,
-	0x43, 0xa2, 0x63, 0x46, 0xd5, 0x2a, 0x8e, 0x35, 0x5d, 0x33, 0x16, 0x8e, 0x35, 0x7d, 0xc9, 0xd0,
-	0x07, 0xfb, 0xa7, 0x56, 0x81, 0x56, 0xfb, 0x78, 0x64, 0x7a, 0xd6, 0x33, 0x00, 0x4e, 0x63, 0xe2,
-	0xf3, 0x0e, 0x36, 0x08, 0xf8, 0x6b, 0x2f, 0xc2, 0x21, 0xe9, 0xbf, 0x6f, 0xf9, 0x78, 0xb4, 0x30,
-	0xe7, 0xc7, 0x0b, 0x13, 0x02, 0xcd, 0xa1, 0x2e, 0x11, 0xa5, 0x91, 0x43, 0x62, 0x6c, 0xfd, 0x35,
-	0x03, 0xf2, 0xb5, 0x4e, 0x78, 0x40, 0xfd, 0xe8, 0x28, 0x7a, 0x49, 0x87, 0xd7, 0x7c, 0x66, 0xf4,
-	0x9a, 0x9f, 0xfe, 0xc2, 0x9f, 0xbf, 0xe6, 0x0b, 0x1f, 0xbe, 0x2b, 0xaa, 0xac, 0x15, 0xe0, 0xae,
-	0x8a, 0x92, 0x4a, 0x05, 0x65, 0x3c, 0x9c, 0x6a, 0x19, 0xf8, 0xb3, 0xb9, 0x3c, 0x6c, 0x19, 0x1e,
-	0xfc, 0x33, 0x03, 0x46, 0x3e, 0xa2, 0xe1, 0xaf, 0x40, 0x65, 0xff, 0xe0, 0xa0, 0x56, 0xaf, 0xdb,
-	0x67, 0x9f, 0x9c, 0xd6, 0xec, 0xd3, 0x1a, 0x7a, 0x7a, 0x54, 0xaf, 0x1f, 0x3d, 0x7f, 0x76, 0x52,
-	0xab, 0xd7, 0x8d, 0xb9, 0xca, 0xfd, 0xd7, 0x6f, 0x36, 0xca, 0xc3, 0xf8, 0x53, 0x12, 0x87, 0x3e,
-	0x63, 0x3e, 0x8d, 0x02, 0xbe, 0xdc, 0xf7, 0xc1, 0xda, 0x68, 0x36, 0xaa, 0xd5, 0xcf, 0xd0, 0xd1,
-	0xc1, 0x59, 0xed, 0xd0, 0xc8, 0x54, 0xca, 0xaf, 0xdf, 0x6c, 0x94, 0x86, 0x99, 0x88, 0xb0, 0x24,
-	0xf6, 0x1d, 0xfe, 0x1e, 0x78, 0x0c, 0xca, 0xd7, 0x6b, 0xd6, 0x0e, 0x8d, 0xf9, 0x4a, 0xe5, 0xf5,
-	0x9b, 0x8d, 0xb5, 0xeb, 0x14, 0x89, 0x5b, 0xd1, 0x3e, 0xff, 0xc7, 0xfa, 0x5c, 0xf5, 0xc9, 0x37,
-	0xbd, 0xf5, 0xcc, 0xb7, 0xbd, 0xf5, 0xcc, 0x7f, 0x7b, 0xeb, 0x99, 0x2f, 0xde, 0xae, 0xcf, 0x7d,
-	0xfb, 0x76, 0x7d, 0xee, 0x5f, 0x6f, 0xd7, 0xe7, 0xfe, 0xb8, 0xe1, 0xf9, 0x49, 0xb3, 0xdd, 0xd8,
-	0x72, 0x68, 0xb8, 0x3d, 0xf9, 0xcb, 0x4b, 0xd2, 0x6d, 0x11, 0xd6, 0x58, 0x14, 0x3f, 0xbe, 0x3d,
-	0xfa, 0x5f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x48, 0x59, 0x0f, 0x70, 0xd5, 0x13, 0x00, 0x00,
+	0xa4, 0xa9, 0xbc, 0x40, 0x03, 0x17, 0x05, 0xd2, 0x07, 0x30, 0x10, 0xa0, 0x37, 0xb9, 0xcc, 0x23,
+	0xf4, 0x32, 0x97, 0xb9, 0x2c, 0x02, 0x74, 0x51, 0xc8, 0x17, 0x01, 0x74, 0xa9, 0x27, 0x28, 0xe6,
+	0xc0, 0xa3, 0x18, 0x56, 0x05, 0x04, 0x7b, 0xbe, 0xff, 0xf0, 0x7d, 0x73, 0xf8, 0x77, 0x0e, 0x04,
+	0x15, 0x87, 0xb2, 0x90, 0xb2, 0x2d, 0xd2, 0x09, 0xb7, 0xf8, 0xdf, 0x36, 0x6f, 0x6d, 0xb6, 0x62,
+	0x9a, 0x50, 0x68, 0x48, 0xdf, 0x26, 0xb7, 0xf0, 0xbf, 0xed, 0xca, 0x32, 0x0e, 0xfd, 0x88, 0x6e,
+	0x89, 0x7f, 0x65, 0x50, 0xa5, 0xe4, 0x51, 0x8f, 0x8a, 0xe6, 0x16, 0x6f, 0x49, 0xab, 0xf5, 0xbd,
+	0x06, 0xe6, 0x4f, 0x71, 0x8c, 0x43, 0x06, 0xb7, 0x41, 0x8e, 0x74, 0x42, 0xdb, 0x25, 0x11, 0x0d,
+	0xcb, 0x99, 0xf5, 0xcc, 0x46, 0xae, 0x5a, 0xba, 0x4e, 0x4d, 0xe3, 0x02, 0x87, 0xc1, 0x63, 0xab,
+	0xef, 0xb2, 0x90, 0x4e, 0x3a, 0xe1, 0x01, 0x6f, 0xc2, 0x3d, 0x00, 0x48, 0x37, 0x89, 0xb1, 0x4d,
+	0xfc, 0x16, 0x2b, 0x6b, 0xeb, 0xd9, 0x8d, 0x6c, 0xd5, 0xba, 0x4c, 0xcd, 0x5c, 0x8d, 0x5b, 0x6b,
+	0x87, 0xa7, 0xec, 0x3a, 0x35, 0x97, 0x15, 0x41, 0x3f, 0xd0, 0x42, 0x39, 0x01, 0x6a, 0x7e, 0x8b,
+	0xc1, 0x1d, 0x50, 0xe0, 0xd4, 0x4e, 0x13, 0x47, 0x11, 0x09, 0x58, 0x79, 0x61, 0x3d, 0xbb, 0x91,
+	0xab, 0x2e, 0x5d, 0xa6, 0x66, 0xbe, 0xf6, 0xf1, 0xc9, 0xbe, 0x32, 0xa3, 0x3c, 0xe9, 0x84, 0x3d,
+	0x00, 0xff, 0x0c, 0x8a, 0xd8, 0x71, 0x08, 0x63, 0xb6, 0x43, 0xa3, 0x24, 0xa6, 0x41, 0x59, 0x5f,
+	0xcf, 0x6c, 0xe4, 0x77, 0xcc, 0xcd, 0xf1, 0x89, 0xd8, 0xdc, 0x13, 0x71, 0xfb, 0x32, 0xac, 0x7a,
+	0xe7, 0xdb, 0xd4, 0x9c, 0xb9, 0x4c, 0xcd, 0xc5, 0x11, 0x33, 0x5a, 0xc4, 0xc3, 0x10, 0x3e, 0x06,
+	0xf7, 0xb0, 0x93, 0xf8, 0x1d, 0x62, 0xb3, 0x04, 0x27, 0xbe, 0x63, 0xb7, 0x62, 0xe2, 0xd0, 0xb0,
+	0xe5, 0x07, 0x84, 0x95, 0x73, 0xbc, 0x7f, 0xe8, 0xae, 0x0c, 0xa8, 0x0b, 0xff, 0xe9, 0xc0, 0x0d,
+	0x1f, 0x82, 0x52, 0xd3, 0x67, 0x09, 0x8d, 0x2f, 0x6c, 0x46, 0xe2, 0x0e, 0xb1, 0x5f, 0xfa, 0x91,
+	0x4b, 0x5f, 0x96, 0xc1, 0x7a, 0x66, 0x43, 0x43, 0x50, 0xf9, 0xea, 0xdc, 0xf5, 0x5c, 0x78, 0xe0,
+	0xa7, 0x60, 0x95, 0x74, 0x13, 0x12, 0xb9, 0xc4, 0x95, 0x13, 0x6c, 0xd3, 0x56, 0xe2, 0xd3, 0x88,
+	0x95, 0xf3, 0x62, 0x50, 0x3f, 0xbb, 0x39, 0xa8, 0x9a, 0x8a, 0x17, 0x8b, 0xf0, 0x4c, 0x46, 0xa3,
+	0x12, 0x99, 0x60, 0x85, 0x4f, 0x40, 0x01, 0xb7, 0x93, 0xe6, 0xe7, 0x76, 0xe0, 0x87, 0x7e, 0xc2,
+	0xca, 0x05, 0xc1, 0xf9, 0xf6, 0x84, 0x89, 0xe2, 0x51, 0xc7, 0x22, 0xa8, 0xaa, 0xf1, 0x69, 0x42,
+	0x79, 0x3c, 0x30, 0x3d, 0xbe, 0xff, 0xea, 0x87, 0x6f, 0x1e, 0xac, 0x0e, 0xd5, 0x60, 0x97, 0x57,
+	0xa1, 0xac, 0x9c, 0x23, 0x4d, 0x9f, 0x35, 0xb2, 0x47, 0x9a, 0x9e, 0x35, 0xb4, 0x23, 0x4d, 0x9f,
+	0x33, 0xe6, 0x8f, 0x34, 0x7d, 0xde, 0x58, 0xb0, 0xfe, 0x96, 0x01, 0xf9, 0x21, 0x5e, 0xf8, 0x1e,
+	0x80, 0xae, 0xcf, 0x70, 0x23, 0x20, 0xae, 0x1d, 0x32, 0xcf, 0x4e, 0x2e, 0x5a, 0x84, 0x95, 0x33,
+	0x62, 0x46, 0x8d, 0x9e, 0xe7, 0x84, 0x79, 0x67, 0xdc, 0x0e, 0x1f, 0x80, 0xe5, 0x10, 0x77, 0xed,
+	0x88, 0xb0, 0xc4, 0x8f, 0x3c, 0xdb, 0x25, 0xad, 0xa4, 0x59, 0x9e, 0x5d, 0xcf, 0x6c, 0x2c, 0xa2,
+	0xa5, 0x10, 0x77, 0x9f, 0x4a, 0xfb, 0x01, 0x37, 0xc3, 0x77, 0x41, 0x91, 0xc7, 0xfa, 0x51, 0x44,
+	0x62, 0x4e, 0xcd, 0xca, 0x59, 0x11, 0x58, 0x08, 0x71, 0xf7, 0x90, 0x1b, 0x4f, 0x98, 0xc7, 0xac,
+	0x8f, 0x40, 0x69, 0xd2, 0xd4, 0xc1, 0x9f, 0x82, 0xe2, 0xe8, 0x12, 0xc8, 0xf2, 0x47, 0x8b, 0x23,
+	0x53, 0x6a, 0xfd, 0x3d, 0x03, 0x46, 0x0b, 0x07, 0xee, 0x81, 0x79, 0x27, 0x26, 0x38, 0x21, 0x22,
+	0x21, 0xbf, 0xf3, 0xce, 0xff, 0x28, 0x40, 0x3e, 0x30, 0x35, 0xbb, 0x2a, 0x11, 0x7e, 0x04, 0x34,
+	0x07, 0x07, 0x81, 0x18, 0xd8, 0xff, 0x45, 0x20, 0xd2, 0xac, 0x7f, 0x67, 0xc0, 0xf2, 0x8d, 0x08,
+	0xe8, 0x80, 0xbc, 0xfa, 0x40, 0xf8, 0x14, 0x8b, 0xce, 0x15, 0x77, 0xde, 0xfa, 0x31, 0x6e, 0x41,
+	0xfa, 0xee, 0x65, 0x6a, 0x82, 0x01, 0xbe, 0x4e, 0x4d, 0x28, 0xbf, 0xdb, 0x21, 0x22, 0x0b, 0x01,
+	0xdc, 0x8f, 0x80, 0x0e, 0x58, 0x19, 0xfd, 0x0a, 0xed, 0xc0, 0x67, 0x49, 0x79, 0x56, 0x7c, 0xc0,
+	0xef, 0x5f, 0xa6, 0xe6, 0x68, 0xc7, 0x8e, 0x7d, 0x96, 0x5c, 0xa7, 0x66, 0x65, 0x84, 0x75, 0x38,
+	0xd3, 0x42, 0xcb, 0x78, 0x3c, 0xc1, 0xfa, 0xda, 0x00, 0xf9, 0xfd, 0x26, 0xf6, 0xa3, 0x7d, 0x1a,
+	0xbd, 0xf0, 0x3d, 0xf8, 0x29, 0x58, 0x6a, 0xd2, 0x90, 0xb0, 0x84, 0x60, 0xd7, 0x6e, 0x04, 0xd4,
+	0x39, 0x57, 0x5b, 0xd5, 0xfb, 0xdf, 0xa7, 0xe6, 0x1d, 0x39, 0x40, 0xe6, 0x9e, 0x6f, 0xfa, 0x74,
+	0x2b, 0xc4, 0x49, 0x73, 0xf3, 0x30, 0xe2, 0xa2, 0xab, 0x52, 0x74, 0x2c, 0xd3, 0x42, 0xc5, 0xbe,
+	0xa5, 0xca, 0x0d, 0xb0, 0x09, 0x8a, 0x2e, 0xa6, 0xf6, 0x0b, 0x1a, 0x9f, 0x2b, 0xf2, 0x59, 0x41,
+	0x5e, 0xfd, 0x51, 0xf2, 0xcb, 0xd4, 0x2c, 0x1c, 0xec, 0x3d, 0x7b, 0x42, 0xe3, 0x73, 0x41, 0x71,
+	0x9d, 0x9a, 0x77, 0xa4, 0xd8, 0x28, 0x91, 0x85, 0x0a, 0x2e, 0xa6, 0xfd, 0x30, 0xf8, 0x1c, 0x18,
+	0xfd, 0x00, 0xd6, 0x6e, 0xb5, 0x68, 0x9c, 0x88, 0x92, 0xd5, 0xab, 0xbf, 0xb8, 0x4c, 0xcd, 0xa2,
+	0xa2, 0xac, 0x4b, 0xcf, 0x75, 0x6a, 0xde, 0x1d, 0x23, 0x55, 0x39, 0x16, 0x2a, 0x2a, 0x5a, 0x15,
+	0x0a, 0x1b, 0xa0, 0x40, 0xfc, 0xd6, 0xf6, 0xee, 0x43, 0x35, 0x00, 0x4d, 0x0c, 0xe0, 0xb7, 0xd3,
+	0x06, 0x90, 0xaf, 0x1d, 0x9e, 0x6e, 0xef, 0x3e, 0xec, 0xf5, 0x7f, 0x45, 0xed, 0xd7, 0x43, 0x2c,
+	0x16, 0xca, 0x4b, 0x28, 0x3b, 0xdf, 0xd3, 0xd8, 0x55, 0x1a, 0xf3, 0xb7, 0xd5, 0xd8, 0x9d, 0xa4,
+	0xb1, 0x3b, 0xaa, 0xb1, 0x3b, 0xaa, 0xf1, 0x48, 0x69, 0x2c, 0xdc, 0x56, 0xe3, 0xd1, 0x24, 0x8d,
+	0x47, 0xa3, 0x1a, 0x32, 0x86, 0x17, 0x53, 0xe3, 0xe2, 0x73, 0x1c, 0x25, 0x7e, 0x3b, 0x54, 0x32,
+	0xfa, 0xad, 0x8b, 0x69, 0x2c, 0xd3, 0x42, 0xc5, 0xbe, 0x45, 0xb2, 0x9f, 0x83, 0x92, 0x43, 0x23,
+	0x96, 0x70, 0x5b, 0x44, 0x5b, 0x01, 0x51, 0x12, 0x39, 0x21, 0xf1, 0x68, 0x9a, 0xc4, 0x7d, 0x29,
+	0x31, 0x29, 0xdd, 0x42, 0x2b, 0xa3, 0x66, 0x29, 0x66, 0x03, 0xa3, 0x45, 0x12, 0x12, 0xb3, 0x46,
+	0x3b, 0xf6, 0x94, 0x10, 0x10, 0x42, 0x1f, 0x4c, 0x13, 0x52, 0x65, 0x35, 0x9e, 0x6a, 0xa1, 0xa5,
+	0x81, 0x49, 0x0a, 0x7c, 0x02, 0x8a, 0x3e, 0x57, 0x6d, 0xb4, 0x03, 0x45, 0x9f, 0x17, 0xf4, 0x3b,
+	0xd3, 0xe8, 0xd5, 0xa7, 0x30, 0x9a, 0x68, 0xa1, 0xc5, 0x9e, 0x41, 0x52, 0xbb, 0x00, 0x86, 0x6d,
+	0x3f, 0xb6, 0xbd, 0x00, 0x3b, 0x3e, 0x89, 0x15, 0x7d, 0x41, 0xd0, 0xff, 0x72, 0x1a, 0xfd, 0x3d,
+	0x49, 0x7f, 0x33, 0xd9, 0x42, 0x06, 0x37, 0xfe, 0x4e, 0xda, 0xa4, 0x4a, 0x1d, 0x14, 0x1a, 0x24,
+	0x0e, 0xfc, 0x48, 0xf1, 0x2f, 0x0a, 0xfe, 0x87, 0xd3, 0xf8, 0x55, 0x05, 0x0d, 0xa7, 0x59, 0x28,
+	0x2f, 0x61, 0x9f, 0x34, 0xa0, 0x91, 0x4b, 0x7b, 0xa4, 0xcb, 0xb7, 0x26, 0x1d, 0x4e, 0xb3, 0x50,
+	0x5e, 0x42, 0x49, 0xea, 0x81, 0x15, 0x1c, 0xc7, 0xf4, 0xe5, 0xd8, 0x84, 0x40, 0xc1, 0xfd, 0xab,
+	0x69, 0xdc, 0xbd, 0xcd, 0xf5, 0x66, 0x36, 0xdf, 0x5c, 0xb9, 0x75, 0x64, 0x4a, 0x5c, 0x00, 0xbd,
+	0x18, 0x5f, 0x8c, 0xe9, 0x94, 0x6e, 0x3d, 0xf1, 0x37, 0x93, 0x2d, 0x64, 0x70, 0xe3, 0x88, 0xca,
+	0x67, 0xa0, 0x14, 0x92, 0xd8, 0x23, 0x76, 0x44, 0x12, 0xd6, 0x0a, 0xfc, 0x44, 0xe9, 0xdc, 0xb9,
+	0xf5, 0x77, 0x30, 0x29, 0xdd, 0x42, 0x50, 0x98, 0x9f, 0x2a, 0xab, 0xd4, 0xba, 0x07, 0x74, 0x87,
+	0x9f, 0x16, 0xb6, 0xef, 0x96, 0xcb, 0xe2, 0xca, 0xb5, 0x20, 0xf0, 0xa1, 0x0b, 0x4b, 0x60, 0x4e,
+	0x9e, 0xed, 0xf7, 0xc4, 0xd9, 0x2e, 0x01, 0xac, 0x00, 0xdd, 0x25, 0x8e, 0x1f, 0xe2, 0x80, 0x95,
+	0x2b, 0x22, 0xa1, 0x8f, 0xe1, 0xc7, 0x60, 0x91, 0x35, 0x71, 0xe4, 0x35, 0xb1, 0x6f, 0x27, 0x7e,
+	0x48, 0xca, 0xf7, 0x45, 0x8f, 0xb7, 0xa7, 0xf5, 0xb8, 0x24, 0x7b, 0x3c, 0x92, 0x67, 0xa1, 0x42,
+	0x0f, 0x9f, 0xf9, 0x21, 0x81, 0xa7, 0x20, 0xef, 0xe0, 0xc8, 0x69, 0x47, 0x92, 0xf5, 0x2d, 0xc1,
+	0xba, 0x35, 0x8d, 0x55, 0x1d, 0xc5, 0x43, 0x59, 0x16, 0x02, 0x12, 0xf5, 0x18, 0x5b, 0x31, 0xf6,
+	0xda, 0x44, 0x32, 0xbe, 0x7d, 0x6b, 0xc6, 0xa1, 0x2c, 0x0b, 0x01, 0x89, 0x7a, 0x8c, 0x1d, 0x12,
+	0x9f, 0x07, 0x8a, 0x71, 0xed, 0xd6, 0x8c, 0x43, 0x59, 0x16, 0x02, 0x12, 0x09, 0xc6, 0x13, 0x00,
+	0x28, 0xc3, 0xe7, 0x58, 0x12, 0x9a, 0x82, 0x70, 0x73, 0x1a, 0xa1, 0x7a, 0x37, 0x0c, 0x92, 0x2c,
+	0x94, 0x13, 0x80, 0xd3, 0xf5, 0xef, 0x99, 0xab, 0xc6, 0xdd, 0x23, 0x4d, 0xbf, 0x6b, 0x94, 0xad,
+	0x2d, 0x30, 0xc7, 0xef, 0xe3, 0x04, 0x1a, 0x20, 0x7b, 0x4e, 0x2e, 0xd4, 0x1d, 0x8e, 0x37, 0xf9,
+	0xda, 0x77, 0x70, 0xd0, 0x26, 0xf2, 0x38, 0x47, 0x12, 0x58, 0xa7, 0x60, 0xe9, 0x2c, 0xc6, 0x11,
+	0xe3, 0x77, 0x79, 0x1a, 0x1d, 0x53, 0x8f, 0x41, 0x08, 0xb4, 0x26, 0x66, 0x4d, 0x95, 0x2b, 0xda,
+	0xf0, 0xe7, 0x40, 0x0b, 0xa8, 0xc7, 0xc4, 0xc5, 0x26, 0xbf, 0x73, 0xe7, 0xe6, 0x2d, 0xea, 0x98,
+	0x7a, 0x48, 0x84, 0x58, 0x7f, 0xc9, 0x82, 0xec, 0x31, 0xf5, 0x60, 0x19, 0x2c, 0x60, 0xd7, 0x8d,
+	0x09, 0x63, 0x8a, 0xa9, 0x07, 0xe1, 0x2a, 0x98, 0x4f, 0x68, 0xcb, 0x77, 0x24, 0x5d, 0x0e, 0x29,
+	0xc4, 0x85, 0x5d, 0x9c, 0x60, 0x71, 0x07, 0x28, 0x20, 0xd1, 0xe6, 0x4f, 0x23, 0x51, 0xea, 0x76,
+	0xd4, 0x0e, 0x1b, 0x24, 0x16, 0x47, 0xb9, 0x56, 0x5d, 0xba, 0x4a, 0xcd, 0xbc, 0xb0, 0x3f, 0x15,
+	0x66, 0x34, 0x0c, 0xe0, 0x7b, 0x60, 0x21, 0xe9, 0xda, 0x62, 0x0c, 0x73, 0x62, 0x8a, 0x57, 0xae,
+	0x52, 0x73, 0x29, 0x19, 0x0c, 0xf3, 0xf7, 0x98, 0x35, 0xd1, 0x7c, 0xd2, 0xe5, 0xff, 0xc3, 0x2d,
+	0xa0, 0x27, 0xfc, 0xd6, 0xec, 0x92, 0xae, 0x38, 0xc4, 0xb5, 0x6a, 0xe9, 0x2a, 0x35, 0x8d, 0xa1,
+	0xf0, 0x43, 0xee, 0x43, 0x0b, 0x49, 0x57, 0x34, 0xe0, 0x7b, 0x00, 0xc8, 0x2e, 0x09, 0x05, 0x79,
+	0x26, 0x2f, 0x5e, 0xa5, 0x66, 0x4e, 0x58, 0x05, 0xf7, 0xa0, 0x09, 0x2d, 0x30, 0x27, 0xb9, 0x75,
+	0xc1, 0x5d, 0xb8, 0x4a, 0x4d, 0x3d, 0xa0, 0x9e, 0xe4, 0x94, 0x2e, 0x3e, 0x55, 0x31, 0x09, 0x69,
+	0x87, 0xb8, 0xe2, 0x60, 0xd4, 0x51, 0x0f, 0xc2, 0x0f, 0xc1, 0x92, 0xd4, 0xe2, 0x6b, 0xcf, 0x12,
+	0x1c, 0xb6, 0xe4, 0x2b, 0xaa, 0x0a, 0xaf, 0x52, 0xb3, 0x28, 0x5c, 0x67, 0x3d, 0x0f, 0x1a, 0xc3,
+	0xd6, 0x97, 0xb3, 0x40, 0x3f, 0xeb, 0x22, 0xc2, 0xda, 0x41, 0x02, 0x9f, 0x00, 0x43, 0x5c, 0x34,
+	0xb1, 0x93, 0xd8, 0x23, 0xeb, 0x52, 0xbd, 0x3f, 0x38, 0x03, 0xc7, 0x23, 0x2c, 0xb4, 0xd4, 0x33,
+	0xed, 0xa9, 0xc5, 0x2b, 0x81, 0xb9, 0x46, 0x40, 0x69, 0x28, 0xca, 0xa8, 0x80, 0x24, 0x80, 0xcf,
+	0xc5, 0x94, 0x8b, 0x12, 0xc9, 0x8a, 0x4b, 0xfc, 0x4f, 0x6e, 0x96, 0xc8, 0x58, 0x9d, 0x55, 0xef,
+	0xf3, 0x2b, 0xfc, 0x75, 0x6a, 0x16, 0xa5, 0xb6, 0xca, 0xb7, 0xbe, 0xfe, 0xe1, 0x9b, 0x07, 0x19,
+	0xbe, 0x3a, 0xa2, 0x18, 0x0d, 0x90, 0x8d, 0x49, 0x22, 0x96, 0xbd, 0x80, 0x78, 0x93, 0xef, 0x56,
+	0x31, 0xe9, 0x90, 0x38, 0x21, 0xae, 0x58, 0x5e, 0x1d, 0xf5, 0x31, 0xdf, 0xfa, 0x3c, 0xcc, 0xec,
+	0x36, 0x23, 0xae, 0x5c, 0x4b, 0xb4, 0xe0, 0x61, 0xf6, 0x47, 0x46, 0xdc, 0xc7, 0xda, 0x17, 0x5f,
+	0x99, 0x33, 0x16, 0x06, 0x79, 0x75, 0xbf, 0x6f, 0xb7, 0x02, 0x32, 0xa5, 0x46, 0x77, 0x40, 0x81,
+	0xbf, 0x52, 0xb1, 0x47, 0xec, 0x73, 0x72, 0xa1, 0x2a, 0x55, 0xd6, 0x9d, 0xb2, 0xff, 0x81, 0x5c,
+	0x30, 0x34, 0x0c, 0x94, 0xc4, 0x57, 0x1a, 0xc8, 0x9f, 0xc5, 0xd8, 0x21, 0xea, 0xb6, 0xce, 0xab,
+	0x9d, 0xc3, 0x58, 0x49, 0x28, 0xc4, 0xb5, 0xf9, 0xa2, 0xd2, 0x76, 0xa2, 0xbe, 0xc8, 0x1e, 0xe4,
+	0x19, 0x31, 0x21, 0x5d, 0xe2, 0x88, 0xb9, 0xd4, 0x90, 0x42, 0x70, 0x17, 0x2c, 0xaa, 0x07, 0x22,
+	0x7f, 0x94, 0x3b, 0xe7, 0x72, 0xf8, 0x55, 0xe3, 0x2a, 0x35, 0x0b, 0xca, 0x51, 0xe7, 0x76, 0x34,
+	0x82, 0x78, 0x0d, 0x0d, 0xd2, 0x44, 0x6f, 0xc5, 0xdc, 0xe8, 0xb2, 0x86, 0xfa, 0xa1, 0xc2, 0x83,
+	0xc6, 0xb0, 0x3c, 0x31, 0x1a, 0x6d, 0x4f, 0x94, 0xaf, 0x8e, 0x24, 0xe0, 0x56, 0xf1, 0x96, 0x16,
+	0xe5, 0x3a, 0x87, 0x24, 0x80, 0x1f, 0x82, 0x1c, 0xed, 0x90, 0x38, 0xf6, 0x5d, 0xc2, 0x44, 0x99,
+	0x4e, 0x7c, 0x64, 0x0f, 0xbd, 0x64, 0xd0, 0x20, 0x9e, 0x0f, 0x8e, 0x44, 0xa2, 0x93, 0x21, 0x09,
+	0x69, 0x7c, 0x21, 0xae, 0x56, 0x6a, 0x70, 0xd2, 0x71, 0x22, 0xec, 0x68, 0x04, 0xc1, 0x2a, 0x80,
+	0x2a, 0x2d, 0x26, 0x49, 0x3b, 0x8e, 0x6c, 0xb1, 0x83, 0x14, 0x44, 0xae, 0xf8, 0x8e, 0xa5, 0x17,
+	0x09, 0xe7, 0x01, 0x4e, 0x30, 0xba, 0x61, 0x81, 0xbf, 0x01, 0x50, 0xae, 0x89, 0xfd, 0x19, 0xa3,
+	0x11, 0x7f, 0x8f, 0xbd, 0xf0, 0x3d, 0x75, 0x37, 0x12, 0xfa, 0xd2, 0xab, 0xfa, 0x6c, 0x48, 0x74,
+	0xc4, 0xa8, 0x1a, 0xc5, 0x91, 0xa6, 0x6b, 0xc6, 0xdc, 0x91, 0xa6, 0x2f, 0x18, 0x7a, 0x7f, 0xfe,
+	0xd4, 0x28, 0xd0, 0x4a, 0x0f, 0x0f, 0x75, 0xcf, 0x7a, 0x0a, 0xc0, 0x69, 0x4c, 0x7c, 0x7e, 0x83,
+	0x0d, 0x02, 0xbe, 0xed, 0x45, 0x38, 0x24, 0xbd, 0xfd, 0x96, 0xb7, 0x87, 0x0b, 0x73, 0x76, 0xb4,
+	0x30, 0x21, 0xd0, 0x1c, 0xea, 0x12, 0x51, 0x1a, 0x39, 0x24, 0xda, 0xd6, 0x5f, 0x33, 0x20, 0x5f,
+	0xeb, 0x84, 0xfb, 0xd4, 0x8f, 0x0e, 0xa3, 0x17, 0x74, 0x70, 0xcc, 0x67, 0x86, 0x8f, 0xf9, 0x9b,
+	0x2f, 0xfc, 0xd9, 0x09, 0x2f, 0x7c, 0xf8, 0x8e, 0xa8, 0xb2, 0x56, 0x80, 0x2f, 0x54, 0x94, 0x54,
+	0x2a, 0x28, 0xe3, 0xc1, 0x8d, 0x2b, 0x83, 0x26, 0x7e, 0x65, 0xe8, 0xe3, 0x07, 0xff, 0xcc, 0x80,
+	0xa1, 0x47, 0x34, 0xfc, 0x35, 0xa8, 0xec, 0xed, 0xef, 0xd7, 0xea, 0x75, 0xfb, 0xec, 0x93, 0xd3,
+	0x9a, 0x7d, 0x5a, 0x43, 0x27, 0x87, 0xf5, 0xfa, 0xe1, 0xb3, 0xa7, 0xc7, 0xb5, 0x7a, 0xdd, 0x98,
+	0xa9, 0xbc, 0xf5, 0xea, 0xf5, 0x7a, 0x79, 0x10, 0x7f, 0x4a, 0xe2, 0xd0, 0x67, 0xcc, 0xa7, 0x51,
+	0xc0, 0x87, 0xfb, 0x01, 0x58, 0x1d, 0xce, 0x46, 0xb5, 0xfa, 0x19, 0x3a, 0xdc, 0x3f, 0xab, 0x1d,
+	0x18, 0x99, 0x4a, 0xf9, 0xd5, 0xeb, 0xf5, 0xd2, 0x20, 0x13, 0x11, 0x96, 0xc4, 0xbe, 0xc3, 0xf7,
+	0x81, 0x47, 0xa0, 0x3c, 0x59, 0xb3, 0x76, 0x60, 0xcc, 0x56, 0x2a, 0xaf, 0x5e, 0xaf, 0xaf, 0x4e,
+	0x52, 0x24, 0x6e, 0x45, 0xfb, 0xe2, 0x1f, 0x6b, 0x33, 0xd5, 0xc7, 0xdf, 0x5e, 0xae, 0x65, 0xbe,
+	0xbb, 0x5c, 0xcb, 0xfc, 0xe7, 0x72, 0x2d, 0xf3, 0xe5, 0x9b, 0xb5, 0x99, 0xef, 0xde, 0xac, 0xcd,
+	0xfc, 0xeb, 0xcd, 0xda, 0xcc, 0x9f, 0xd6, 0x3d, 0x3f, 0x69, 0xb6, 0x1b, 0x9b, 0x0e, 0x0d, 0xb7,
+	0xc6, 0x7f, 0x09, 0x12, 0x3f, 0xe5, 0x34, 0xe6, 0xc5, 0x8f, 0x8a, 0xef, 0xff, 0x37, 0x00, 0x00,
+	0xff, 0xff, 0x98, 0x6d, 0x1a, 0xbf, 0xad, 0x14, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -1226,6 +1313,16 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.AuthzLimits.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvm(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x62
 	if m.ExtendedDenomOptions != nil {
 		{
 			size, err := m.ExtendedDenomOptions.MarshalToSizedBuffer(dAtA[:i])
@@ -1272,21 +1369,21 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		}
 	}
 	if len(m.ExtraEIPs) > 0 {
-		dAtA4 := make([]byte, len(m.ExtraEIPs)*10)
-		var j3 int
+		dAtA5 := make([]byte, len(m.ExtraEIPs)*10)
+		var j4 int
 		for _, num1 := range m.ExtraEIPs {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA5[j4] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j3++
+				j4++
 			}
-			dAtA4[j3] = uint8(num)
-			j3++
+			dAtA5[j4] = uint8(num)
+			j4++
 		}
-		i -= j3
-		copy(dAtA[i:], dAtA4[:j3])
-		i = encodeVarintEvm(dAtA, i, uint64(j3))
+		i -= j4
+		copy(dAtA[i:], dAtA5[:j4])
+		i = encodeVarintEvm(dAtA, i, uint64(j4))
 		i--
 		dAtA[i] = 0x22
 	}
@@ -1300,6 +1397,48 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *AuthzLimits) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AuthzLimits) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AuthzLimits) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxInnerMsgs != 0 {
+		i = encodeVarintEvm(dAtA, i, uint64(m.MaxInnerMsgs))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MaxNestingDepth != 0 {
+		i = encodeVarintEvm(dAtA, i, uint64(m.MaxNestingDepth))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.DisabledMsgTypes) > 0 {
+		for iNdEx := len(m.DisabledMsgTypes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DisabledMsgTypes[iNdEx])
+			copy(dAtA[i:], m.DisabledMsgTypes[iNdEx])
+			i = encodeVarintEvm(dAtA, i, uint64(len(m.DisabledMsgTypes[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *ExtendedDenomOptions) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2261,6 +2400,29 @@ func (m *Params) Size() (n int) {
 		l = m.ExtendedDenomOptions.Size()
 		n += 1 + l + sovEvm(uint64(l))
 	}
+	l = m.AuthzLimits.Size()
+	n += 1 + l + sovEvm(uint64(l))
+	return n
+}
+
+func (m *AuthzLimits) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.DisabledMsgTypes) > 0 {
+		for _, s := range m.DisabledMsgTypes {
+			l = len(s)
+			n += 1 + l + sovEvm(uint64(l))
+		}
+	}
+	if m.MaxNestingDepth != 0 {
+		n += 1 + sovEvm(uint64(m.MaxNestingDepth))
+	}
+	if m.MaxInnerMsgs != 0 {
+		n += 1 + sovEvm(uint64(m.MaxInnerMsgs))
+	}
 	return n
 }
 
@@ -2926,6 +3088,159 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthzLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AuthzLimits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvm(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AuthzLimits) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvm
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AuthzLimits: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AuthzLimits: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisabledMsgTypes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DisabledMsgTypes = append(m.DisabledMsgTypes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxNestingDepth", wireType)
+			}
+			m.MaxNestingDepth = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxNestingDepth |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxInnerMsgs", wireType)
+			}
+			m.MaxInnerMsgs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxInnerMsgs |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvm(dAtA[iNdEx:])
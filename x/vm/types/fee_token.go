@@ -0,0 +1,63 @@
+package types
+
+import (
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+
+	"cosmossdk.io/math"
+)
+
+// feeTokenOracleABIJSON is the ABI of the TWAP oracles of the fee tokens, as the
+// example oracle of Uniswap V2.
+const feeTokenOracleABIJSON = `[{
+	"name": "consult",
+	"type": "function",
+	"stateMutability": "view",
+	"inputs": [{"name": "token", "type": "address"}, {"name": "amountIn", "type": "uint256"}],
+	"outputs": [{"name": "amountOut", "type": "uint256"}]
+}]`
+
+var (
+	// FeeTokenOracleABI is the ABI of the TWAP oracles of the fee tokens.
+	FeeTokenOracleABI abi.ABI
+	// FeeTokenOracleAmountIn is the amount of the fee token the TWAP oracles are
+	// consulted with, so that the rate keeps 18 decimals of precision.
+	FeeTokenOracleAmountIn = new(big.Int).Exp(big.NewInt(10), big.NewInt(18), nil)
+)
+
+func init() {
+	var err error
+	FeeTokenOracleABI, err = abi.JSON(strings.NewReader(feeTokenOracleABIJSON))
+	if err != nil {
+		panic(err)
+	}
+}
+
+// FeeTokenPayment records the whitelisted fee token the fees of an Ethereum
+// transaction are paid with, and the rate they were converted at, so that the
+// leftover gas is refunded in the same token at the same rate.
+type FeeTokenPayment struct {
+	Denom string
+	Rate  math.LegacyDec
+}
+
+// ConvertFeeToFeeToken converts an amount of the EVM denom into the fee token
+// at the given rate, rounding up so that the fees are never underpaid.
+func ConvertFeeToFeeToken(amount math.Int, rate math.LegacyDec) math.Int {
+	return math.LegacyNewDecFromInt(amount).Quo(rate).Ceil().TruncateInt()
+}
+
+// ConvertRefundToFeeToken converts an amount of the EVM denom into the fee
+// token at the given rate, rounding down so that the refunds never exceed the
+// fees paid.
+func ConvertRefundToFeeToken(amount math.Int, rate math.LegacyDec) math.Int {
+	return math.LegacyNewDecFromInt(amount).Quo(rate).TruncateInt()
+}
+
+// ConvertFeeTokenToEVMDenom converts an amount of the fee token into the EVM
+// denom at the given rate, rounding down.
+func ConvertFeeTokenToEVMDenom(amount math.Int, rate math.LegacyDec) math.Int {
+	return rate.MulInt(amount).TruncateInt()
+}
@@ -0,0 +1,52 @@
+package types_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	"cosmossdk.io/math"
+)
+
+func TestFeeTokenConversions(t *testing.T) {
+	rate := math.LegacyNewDecWithPrec(15, 1) // 1.5 of the EVM denom per unit of the token
+
+	testCases := []struct {
+		name      string
+		amount    math.Int
+		expFee    math.Int
+		expRefund math.Int
+	}{
+		{"zero", math.ZeroInt(), math.ZeroInt(), math.ZeroInt()},
+		{"exact", math.NewInt(300), math.NewInt(200), math.NewInt(200)},
+		{"fee rounded up, refund rounded down", math.NewInt(100), math.NewInt(67), math.NewInt(66)},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expFee.String(), evmtypes.ConvertFeeToFeeToken(tc.amount, rate).String())
+			require.Equal(t, tc.expRefund.String(), evmtypes.ConvertRefundToFeeToken(tc.amount, rate).String())
+		})
+	}
+
+	require.Equal(t, "150", evmtypes.ConvertFeeTokenToEVMDenom(math.NewInt(100), rate).String())
+	require.Equal(t, "1", evmtypes.ConvertFeeTokenToEVMDenom(math.NewInt(1), rate).String())
+}
+
+func TestFeeTokenOracleABI(t *testing.T) {
+	token := common.HexToAddress("0x2000000000000000000000000000000000000002")
+	data, err := evmtypes.FeeTokenOracleABI.Pack("consult", token, evmtypes.FeeTokenOracleAmountIn)
+	require.NoError(t, err)
+	// consult(address,uint256)
+	require.Equal(t, "3ddac953", common.Bytes2Hex(data[:4]))
+
+	ret, err := evmtypes.FeeTokenOracleABI.Methods["consult"].Outputs.Pack(big.NewInt(42))
+	require.NoError(t, err)
+	var amountOut *big.Int
+	require.NoError(t, evmtypes.FeeTokenOracleABI.UnpackIntoInterface(&amountOut, "consult", ret))
+	require.Equal(t, int64(42), amountOut.Int64())
+}
@@ -68,6 +68,7 @@ type FeeMarketKeeper interface {
 	GetBaseFee(ctx sdk.Context) math.LegacyDec
 	GetParams(ctx sdk.Context) feemarkettypes.Params
 	CalculateBaseFee(ctx sdk.Context) math.LegacyDec
+	AddEVMLaneGas(ctx sdk.Context, gasWanted, gasUsed uint64)
 }
 
 // Erc20Keeper defines the expected interface needed to instantiate ERC20 precompiles.
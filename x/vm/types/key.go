@@ -36,6 +36,8 @@ const (
 const (
 	prefixObjectBloom = iota + 1
 	prefixObjectGasUsed
+	prefixObjectFeeTokenRate
+	prefixObjectFeeTokenPayment
 )
 
 // KVStore key prefixes
@@ -49,8 +51,10 @@ var (
 
 // Object Store key prefixes
 var (
-	KeyPrefixObjectBloom   = []byte{prefixObjectBloom}
-	KeyPrefixObjectGasUsed = []byte{prefixObjectGasUsed}
+	KeyPrefixObjectBloom           = []byte{prefixObjectBloom}
+	KeyPrefixObjectGasUsed         = []byte{prefixObjectGasUsed}
+	KeyPrefixObjectFeeTokenRate    = []byte{prefixObjectFeeTokenRate}
+	KeyPrefixObjectFeeTokenPayment = []byte{prefixObjectFeeTokenPayment}
 )
 
 // AddressStoragePrefix returns a prefix to iterate over a given account storage.
@@ -70,6 +74,21 @@ func ObjectGasUsedKey(txIndex int) []byte {
 	return key[:]
 }
 
+// ObjectFeeTokenRateKey returns the key of the rate of a fee token consulted on
+// its TWAP oracle, the oracle being part of the key so that an updated oracle
+// is consulted within the block.
+func ObjectFeeTokenRateKey(denom, twapOracle, twapToken string) []byte {
+	key := append([]byte{prefixObjectFeeTokenRate}, denom...)
+	return append(key, "/"+twapOracle+"/"+twapToken...)
+}
+
+func ObjectFeeTokenPaymentKey(txIndex int) []byte {
+	var key [1 + 8]byte
+	key[0] = prefixObjectFeeTokenPayment
+	binary.BigEndian.PutUint64(key[1:], uint64(txIndex)) //nolint:gosec
+	return key[:]
+}
+
 func ObjectBloomKey(txIndex, msgIndex int) []byte {
 	var key [1 + 8 + 8]byte
 	key[0] = prefixObjectBloom
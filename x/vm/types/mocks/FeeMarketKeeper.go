@@ -16,6 +16,11 @@ type FeeMarketKeeper struct {
 	mock.Mock
 }
 
+// AddEVMLaneGas provides a mock function with given fields: ctx, gasWanted, gasUsed
+func (_m *FeeMarketKeeper) AddEVMLaneGas(ctx types.Context, gasWanted uint64, gasUsed uint64) {
+	_m.Called(ctx, gasWanted, gasUsed)
+}
+
 // CalculateBaseFee provides a mock function with given fields: ctx
 func (_m *FeeMarketKeeper) CalculateBaseFee(ctx types.Context) math.LegacyDec {
 	ret := _m.Called(ctx)
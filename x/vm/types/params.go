@@ -50,6 +50,20 @@ var (
 
 const DefaultHistoryServeWindow = 8192 // same as EIP-2935
 
+// DefaultAuthzMaxNestingDepth is the nesting depth cap of the authz MsgExec messages
+// used when the authz limits don't set one.
+const DefaultAuthzMaxNestingDepth = 7
+
+// MinAuthzMaxNestingDepth is the lowest nesting depth cap the authz limits can set,
+// a lower cap would block the top-level messages of every transaction.
+const MinAuthzMaxNestingDepth = 2
+
+// DefaultAuthzLimits defines the default limits on the messages granted or executed
+// through authz, which disable the creation of vesting accounts.
+var DefaultAuthzLimits = AuthzLimits{
+	DisabledMsgTypes: []string{"/cosmos.vesting.v1beta1.MsgCreateVestingAccount"},
+}
+
 // NewParams creates a new Params instance
 func NewParams(
 	extraEIPs []int64,
@@ -75,6 +89,7 @@ func DefaultParams() Params {
 		AccessControl:           DefaultAccessControl,
 		HistoryServeWindow:      DefaultHistoryServeWindow,
 		ExtendedDenomOptions:    &ExtendedDenomOptions{ExtendedDenom: sdk.DefaultBondDenom},
+		AuthzLimits:             DefaultAuthzLimits,
 	}
 }
 
@@ -105,6 +120,10 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := p.AuthzLimits.Validate(); err != nil {
+		return err
+	}
+
 	return validateChannels(p.EVMChannels)
 }
 
@@ -123,6 +142,38 @@ func (p Params) IsEVMChannel(channel string) bool {
 	return slices.Contains(p.EVMChannels, channel)
 }
 
+// Validate performs basic validation on the authz limits.
+func (l AuthzLimits) Validate() error {
+	seen := make(map[string]bool, len(l.DisabledMsgTypes))
+	for _, msgTypeURL := range l.DisabledMsgTypes {
+		if msgTypeURL == "" || msgTypeURL[0] != '/' {
+			return fmt.Errorf("invalid authz disabled msg type url %q", msgTypeURL)
+		}
+		if seen[msgTypeURL] {
+			return fmt.Errorf("duplicate authz disabled msg type %s", msgTypeURL)
+		}
+		seen[msgTypeURL] = true
+	}
+	if l.MaxNestingDepth != 0 && l.MaxNestingDepth < MinAuthzMaxNestingDepth {
+		return fmt.Errorf("authz max nesting depth must be 0 (default) or at least %d, got %d", MinAuthzMaxNestingDepth, l.MaxNestingDepth)
+	}
+	return nil
+}
+
+// NestingDepth returns the nesting depth cap of the authz MsgExec messages.
+func (l AuthzLimits) NestingDepth() int {
+	if l.MaxNestingDepth == 0 {
+		return DefaultAuthzMaxNestingDepth
+	}
+	return int(l.MaxNestingDepth)
+}
+
+// IsDisabledMsg returns true if the given message type cannot be granted or
+// executed through authz.
+func (l AuthzLimits) IsDisabledMsg(msgTypeURL string) bool {
+	return slices.Contains(l.DisabledMsgTypes, msgTypeURL)
+}
+
 func (ac AccessControl) Validate() error {
 	if err := ac.Create.Validate(); err != nil {
 		return err
@@ -49,6 +49,45 @@ func TestParamsValidate(t *testing.T) {
 			},
 			errContains: "precompiles need to be sorted",
 		},
+		{
+			name: "valid authz limits",
+			params: Params{
+				AuthzLimits: AuthzLimits{
+					DisabledMsgTypes: []string{"/cosmos.bank.v1beta1.MsgSend"},
+					MaxNestingDepth:  3,
+					MaxInnerMsgs:     10,
+				},
+			},
+			expPass: true,
+		},
+		{
+			name: "invalid authz disabled msg type",
+			params: Params{
+				AuthzLimits: AuthzLimits{DisabledMsgTypes: []string{"cosmos.bank.v1beta1.MsgSend"}},
+			},
+			errContains: "invalid authz disabled msg type url",
+		},
+		{
+			name: "duplicate authz disabled msg type",
+			params: Params{
+				AuthzLimits: AuthzLimits{DisabledMsgTypes: []string{"/cosmos.bank.v1beta1.MsgSend", "/cosmos.bank.v1beta1.MsgSend"}},
+			},
+			errContains: "duplicate authz disabled msg type",
+		},
+		{
+			name: "invalid authz max nesting depth",
+			params: Params{
+				AuthzLimits: AuthzLimits{MaxNestingDepth: 1},
+			},
+			errContains: "authz max nesting depth must be 0 (default) or at least 2",
+		},
+		{
+			name: "valid lowest authz max nesting depth",
+			params: Params{
+				AuthzLimits: AuthzLimits{MaxNestingDepth: MinAuthzMaxNestingDepth},
+			},
+			expPass: true,
+		},
 	}
 
 	for _, tc := range testCases {
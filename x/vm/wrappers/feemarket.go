@@ -64,6 +64,9 @@ func (w FeeMarketWrapper) GetParams(ctx sdk.Context) feemarkettypes.Params {
 	if !params.BaseFee.IsNil() {
 		params.BaseFee = types.ConvertAmountTo18DecimalsLegacy(params.BaseFee)
 	}
+	if !params.CosmosBaseFee.IsNil() {
+		params.CosmosBaseFee = types.ConvertAmountTo18DecimalsLegacy(params.CosmosBaseFee)
+	}
 	params.MinGasPrice = types.ConvertAmountTo18DecimalsLegacy(params.MinGasPrice)
 	return params
 }
@@ -545,6 +545,18 @@ func (m *MockFeeMarketKeeper) EXPECT() *MockFeeMarketKeeperMockRecorder {
 	return m.recorder
 }
 
+// AddEVMLaneGas mocks base method.
+func (m *MockFeeMarketKeeper) AddEVMLaneGas(ctx types.Context, gasWanted, gasUsed uint64) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "AddEVMLaneGas", ctx, gasWanted, gasUsed)
+}
+
+// AddEVMLaneGas indicates an expected call of AddEVMLaneGas.
+func (mr *MockFeeMarketKeeperMockRecorder) AddEVMLaneGas(ctx, gasWanted, gasUsed any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddEVMLaneGas", reflect.TypeOf((*MockFeeMarketKeeper)(nil).AddEVMLaneGas), ctx, gasWanted, gasUsed)
+}
+
 // CalculateBaseFee mocks base method.
 func (m *MockFeeMarketKeeper) CalculateBaseFee(ctx types.Context) math.LegacyDec {
 	m.ctrl.T.Helper()